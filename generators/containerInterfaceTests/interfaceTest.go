@@ -22,6 +22,7 @@ type (
 		GenericDecl string `required:"t" help:"The generic type signature to use."`
 		Factory     string `required:"t" help:"The factory that will produce containers to test."`
 		Category    string `required:"t" help:"Either static or dynamic."`
+		PntrFactory bool   `required:"f" default:"false" help:"Set if the factory returns a pointer to a container rather than a container."`
 		CapType     string `required:"f" default:"" help:"The type but the first letter is capitilized. This will be calculated if left blank."`
 		Debug       bool   `required:"f" default:"false" help:"Print diagonistic information to the console."`
 		ShowInfo    bool   `required:"f" default:"t" help:"Show debug info."`
@@ -39,6 +40,7 @@ type (
 		FuncNames     []FuncTemplateVals
 		GeneratorName string
 		Factory       string
+		PntrFactory   bool
 	}
 	FuncTemplateVals struct {
 		Name      string
@@ -86,7 +88,7 @@ import (
 
 func {{ .Type }}To{{ .Interface }}InterfaceFactory(capacity int) {{ .Cat }}Containers.{{ .Interface }}{{ .GenericDecl }} {
 	v:= {{ .Factory }}(capacity)
-	var rv {{ .Cat }}Containers.{{ .Interface }}{{ .GenericDecl }}=&v
+	var rv {{ .Cat }}Containers.{{ .Interface }}{{ .GenericDecl }}={{ if .PntrFactory }}v{{ else }}&v{{ end }}
 	return rv
 }
 {{range .FuncNames}}
@@ -161,6 +163,7 @@ func main() {
 		FuncNames:     make([]FuncTemplateVals, len(PROG_STATE.ViableFuncs)),
 		GeneratorName: os.Args[0],
 		Factory:       INLINE_ARGS.Factory,
+		PntrFactory:   INLINE_ARGS.PntrFactory,
 	}
 	for i, f := range PROG_STATE.ViableFuncs {
 		templateData.FuncNames[i] = FuncTemplateVals{
@@ -3,7 +3,11 @@
 // to allow for very specific sub-types of containers to be specified.
 package containerTypes
 
-import "math"
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+)
 
 const (
 	// A constant that may be passed to the pop function of the [DeleteOps]
@@ -25,6 +29,16 @@ type RWSyncable interface {
 // An interface that determines if a container is addressable or not.
 type Addressable interface{ IsAddressable() bool }
 
+// An interface that allows a container to be converted to and from both JSON
+// and a compact binary format. Unmarshaling a value into a container replaces
+// all of the containers existing values.
+type Serializable interface {
+	json.Marshaler
+	json.Unmarshaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
 // An interface that allows access to the containers length.
 type Length interface{ Length() int }
 
@@ -90,3 +104,12 @@ type GraphComparisonsConstraint[V any, E any] interface {
 	Addressable
 	ReadGraphOps[V, E]
 }
+
+// An interface that defines what kinds of values can be passed to the methods
+// in the [Comparisons] and [KeyedComparisons] interfaces of an undirected
+// graph.
+type UndirectedGraphComparisonsConstraint[V any, E any] interface {
+	RWSyncable
+	Addressable
+	ReadUndirectedGraphOps[V, E]
+}
@@ -22,6 +22,12 @@ type DeleteKeyedSequentialOps[K any, V any] interface {
 	DeleteSequential(start int, end int) error
 }
 
+// An interface that enforces implementation of delete-only, key/value,
+// operations where a single key can be associated with many values.
+type DeleteMultiKeyedOps[K any, V any] interface {
+	DeletePair(k K, v V) error
+}
+
 // An interface that enforces the implementation of delete-only first element access.
 type FirstElemDelete[V any] interface {
 	PopFront() (V, error)
@@ -49,3 +55,12 @@ type DeleteDirectedGraphOps[V any, E any] interface {
 	DeleteLinks(from V, to V) error
 	DeleteLinksPntr(from *V, to *V) error
 }
+
+// An interface that enforces implementaiton of delete-only, undirected, graph
+// structure, operations. The order of the supplied vertices does not matter.
+type DeleteUndirectedGraphOps[V any, E any] interface {
+	DeleteLink(v1 V, v2 V, e E) error
+	DeleteLinkPntr(v1 *V, v2 *V, e *E) error
+	DeleteLinks(v1 V, v2 V) error
+	DeleteLinksPntr(v1 *V, v2 *V) error
+}
@@ -27,6 +27,25 @@ type ReadKeyedOps[K any, V any] interface {
 	Keys() iter.Iter[K]
 }
 
+// An interface that enforces implementation of read-only, key/value,
+// operations where a single key can be associated with many values.
+type ReadMultiKeyedOps[K any, V any] interface {
+	Get(k K) iter.Iter[V]
+	Count(k K) int
+	NumKeys() int
+	ContainsKey(k K) bool
+	ContainsPair(k K, v V) bool
+	Keys() iter.Iter[K]
+}
+
+// An interface that enforces implementation of read-only, key/value,
+// operations where both the keys and values are unique, allowing lookups to
+// be performed in either direction.
+type ReadBiKeyedOps[K any, V any] interface {
+	GetKey(v V) (K, error)
+	ContainsKey(k K) bool
+}
+
 // An interface that enforces the implementation of read-only first element access.
 type FirstElemRead[V any] interface {
 	PeekFront() (V, error)
@@ -68,3 +87,38 @@ type ReadGraphOps[V any, E any] interface {
 	ContainsLink(from V, to V, e E) bool
 	ContainsLinkPntr(from *V, to *V, e *E) bool
 }
+
+// An interface that enforces implementation of read-only, undirected graph
+// structure, operations. Links in an undirected graph have no direction, so the
+// order of the vertices supplied to any of these methods does not matter.
+type ReadUndirectedGraphOps[V any, E any] interface {
+	NumLinks() int
+	NumEdges() int
+	NumVertices() int
+	Edges() iter.Iter[E]
+	EdgePntrs() iter.Iter[*E]
+	Vertices() iter.Iter[V]
+	VerticePntrs() iter.Iter[*V]
+	GetEdge(e *E) error
+	GetVertex(v *V) error
+	ContainsEdge(e E) bool
+	ContainsEdgePntr(e *E) bool
+	ContainsVertex(v V) bool
+	ContainsVertexPntr(v *V) bool
+	Degree(v V) int
+	DegreePntr(v *V) int
+	Neighbors(v V) iter.Iter[V]
+	NeighborPntrs(v *V) iter.Iter[*V]
+	IncidentEdges(v V) iter.Iter[E]
+	IncidentEdgePntrs(v *V) iter.Iter[*E]
+	IncidentEdgesAndNeighbors(v V) iter.Iter[basic.Pair[E, V]]
+	IncidentEdgesAndNeighborPntrs(v *V) iter.Iter[basic.Pair[*E, *V]]
+	EdgesBetween(v1 V, v2 V) iter.Iter[E]
+	EdgesBetweenPntr(v1 *V, v2 *V) iter.Iter[*E]
+	ContainsLink(v1 V, v2 V, e E) bool
+	ContainsLinkPntr(v1 *V, v2 *V, e *E) bool
+	Connected(v1 V, v2 V) bool
+	ConnectedPntr(v1 *V, v2 *V) bool
+	ConnectedComponents() iter.Iter[[]V]
+	NumConnectedComponents() int
+}
@@ -56,6 +56,13 @@ type WriteStaticKeyedSequentialOps[K any, V any] interface {
 	EmplaceSequential(idk K, v ...V) error
 }
 
+// An interface that enforces implementation of write-only, key/value,
+// operations where a single key can be associated with many values. Adding a
+// pair never replaces the values that are already associated with a key.
+type WriteMultiKeyedOps[K any, V any] interface {
+	Add(kvPairs ...basic.Pair[K, V]) error
+}
+
 // An interface that enforces the implementation of write-only first element access.
 type FirstElemWrite[V any] interface {
 	PushFront(v ...V) error
@@ -0,0 +1,883 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a map that enforces uniqueness on both its keys and
+	// its values. Internally two hash maps are maintained, one from keys to
+	// values and one from values to keys, making lookups in either direction
+	// O(1). The type constraints on the generics define the logic for how
+	// value specific operations, such as equality comparisons, will be
+	// handled. The KI widget is used for all key comparisons and the VI widget
+	// is used for all value comparisons, including when checking for
+	// duplicated values.
+	BiMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		forward  *HashMap[K, V, KI, VI]
+		backward *HashMap[V, K, VI, KI]
+	}
+
+	// A synchronized version of BiMap. All operations will be wrapped in the
+	// appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedBiMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		BiMap[K, V, KI, VI]
+	}
+)
+
+// Creates a new bi map initialized with enough memory to hold size elements.
+// Size must be >= 0, an error will be returned if it is not. If size is 0 the
+// map will be initialized with 0 elements.
+func NewBiMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](size int) (BiMap[K, V, KI, VI], error) {
+	if size < 0 {
+		return BiMap[K, V, KI, VI]{}, getSizeError(size)
+	}
+	forward, _ := NewHashMap[K, V, KI, VI](size)
+	backward, _ := NewHashMap[V, K, VI, KI](size)
+	return BiMap[K, V, KI, VI]{
+		forward:  &forward,
+		backward: &backward,
+	}, nil
+}
+
+// Creates a new synced bi map initialized with enough memory to hold size
+// elements. Size must be >= 0, an error will be returned if it is not. If size
+// is 0 the map will be initialized with 0 elements. The underlying RWMutex
+// value will be fully unlocked upon initialization.
+func NewSyncedBiMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](size int) (SyncedBiMap[K, V, KI, VI], error) {
+	rv, err := NewBiMap[K, V, KI, VI](size)
+	return SyncedBiMap[K, V, KI, VI]{
+		RWMutex: &sync.RWMutex{},
+		BiMap:   rv,
+	}, err
+}
+
+// Converts the supplied bi map to a synchronized bi map. Beware: The original
+// non-synced bi map will remain useable and will share its underlying data with
+// the returned synced bi map.
+func (m *BiMap[K, V, KI, VI]) ToSynced() SyncedBiMap[K, V, KI, VI] {
+	return SyncedBiMap[K, V, KI, VI]{
+		RWMutex: &sync.RWMutex{},
+		BiMap:   *m,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *BiMap[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *BiMap[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *BiMap[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *BiMap[K, V, KI, VI]) RUnlock() {}
+
+// The SyncedBiMap method to override the BiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedBiMap[K, V, KI, VI]) Lock() { m.RWMutex.Lock() }
+
+// The SyncedBiMap method to override the BiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedBiMap[K, V, KI, VI]) Unlock() { m.RWMutex.Unlock() }
+
+// The SyncedBiMap method to override the BiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedBiMap[K, V, KI, VI]) RLock() { m.RWMutex.RLock() }
+
+// The SyncedBiMap method to override the BiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedBiMap[K, V, KI, VI]) RUnlock() { m.RWMutex.RUnlock() }
+
+// Returns false, bi maps are not addressable.
+func (m *BiMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, a bi map is not synced.
+func (m *BiMap[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced bi map is synced.
+func (m *SyncedBiMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns a bi map that maps the values of the original bi map to
+// its keys. The returned bi map shares its underlying data with the original
+// bi map, so any changes made to one will be visible in the other.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Inverse() BiMap[V, K, VI, KI] {
+	return BiMap[V, K, VI, KI]{
+		forward:  m.backward,
+		backward: m.forward,
+	}
+}
+
+// Description: Returns a synced bi map that maps the values of the original bi
+// map to its keys. The returned bi map shares its underlying data and its
+// RWMutex with the original bi map, so any changes made to one will be visible
+// in the other and locking one will lock the other.
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Inverse() SyncedBiMap[V, K, VI, KI] {
+	return SyncedBiMap[V, K, VI, KI]{
+		RWMutex: m.RWMutex,
+		BiMap:   m.BiMap.Inverse(),
+	}
+}
+
+// Description: Returns the number of key value pairs in the bi map.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Length() int {
+	return m.forward.Length()
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi map [BiMap.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.Length()
+}
+
+// Description: Contains will return true if the supplied value is in the bi
+// map, false otherwise. All equality comparisons are performed by the generic
+// VI widget type that the bi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Contains(v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// bi map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the bi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	_, found := m.backward.getHashPosition(v)
+	return found
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.ContainsPntr(v)
+}
+
+// Description: ContainsKey will return true if the supplied key is in the bi
+// map, false otherwise. All equality comparisons are performed by the generic
+// KI widget type that the bi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) ContainsKey(k K) bool {
+	_, found := m.forward.getHashPosition(&k)
+	return found
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.ContainsKey] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) ContainsKey(k K) bool {
+	m.RLock()
+	defer m.RUnlock()
+	_, found := m.forward.getHashPosition(&k)
+	return found
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the bi map.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Get(k K) (V, error) {
+	return m.forward.Get(k)
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Get(k K) (V, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.forward.Get(k)
+}
+
+// Panics, bi maps are not addressable.
+func (m *BiMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("bi map"))
+}
+
+// Description: Gets the key that is associated with the specified value.
+// Returns a [containerTypes.ValueError] if the value is not found in the bi
+// map.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) GetKey(v V) (K, error) {
+	if h, ok := m.backward.getHashPosition(&v); ok {
+		return m.backward.internalHashMapImpl[h].B, nil
+	}
+	var tmp K
+	return tmp, getValueError[V](&v)
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.GetKey] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) GetKey(v V) (K, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.GetKey(v)
+}
+
+// Description: KeyOf will return the key that is associated with the supplied
+// value. If the value is not found then the returned key will be a zero
+// initialized key value and the boolean flag will be set to false. If the value
+// is found then the boolean flag will be set to true. All equality comparisons
+// are performed by the generic VI widget type that the bi map was initialized
+// with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	return m.KeyOfPntr(&v)
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.KeyOfPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.KeyOfPntr(&v)
+}
+
+// Description: KeyOfPntr will return the key that is associated with the
+// supplied value. If the value is not found then the returned key will be a
+// zero initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the bi map was
+// initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	if h, ok := m.backward.getHashPosition(v); ok {
+		return m.backward.internalHashMapImpl[h].B, true
+	}
+	var tmp K
+	return tmp, false
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.KeyOfPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.KeyOfPntr(v)
+}
+
+// Description: Sets the values at the specified keys. Returns a
+// [containerTypes.KeyError] if the key is not in the bi map and a
+// [containerTypes.Duplicate] error if the value is already associated with a
+// different key. The value that was previously associated with a key is
+// removed from the bi map. Stops setting values as soon as an error is
+// encountered.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *BiMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	return m.putImpl(kvPairs, true)
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.Set] implementation method. The [BiMap.Set] method
+// is not called directly to avoid copying the vals varargs twice, which could
+// be expensive with a large types for the K or V generics or a large number of
+// values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *SyncedBiMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.putImpl(kvPairs, true)
+}
+
+// Description: Emplace will insert the supplied key value pairs into the bi map
+// if the key does not exist and will set the keys value if it already exists
+// in the bi map. The value that was previously associated with a key is
+// removed from the bi map. Returns a [containerTypes.Duplicate] error if a
+// value is already associated with a different key. The values will be
+// inserted in the order that they are given and inserting will stop as soon as
+// an error is encountered.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *BiMap[K, V, KI, VI]) Emplace(kvPairs ...basic.Pair[K, V]) error {
+	return m.putImpl(kvPairs, false)
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.Emplace] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *SyncedBiMap[K, V, KI, VI]) Emplace(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.putImpl(kvPairs, false)
+}
+
+func (m *BiMap[K, V, KI, VI]) putImpl(
+	kvPairs []basic.Pair[K, V],
+	mustExist bool,
+) error {
+	kw := widgets.Base[K, KI]{}
+	for i := 0; i < len(kvPairs); i++ {
+		k, v := &kvPairs[i].A, &kvPairs[i].B
+		kh, kFound := m.forward.getHashPosition(k)
+		if !kFound && mustExist {
+			return getKeyError[K](k)
+		}
+		if vh, vFound := m.backward.getHashPosition(v); vFound {
+			if curK := m.backward.internalHashMapImpl[vh].B; !kw.Eq(k, &curK) {
+				return getDuplicateValueError[V](*v)
+			}
+		} else if kFound {
+			oldV := m.forward.internalHashMapImpl[kh].B
+			m.backward.deleteImpl(&oldV)
+		}
+		m.forward.Emplace(kvPairs[i])
+		m.backward.Emplace(basic.Pair[V, K]{A: *v, B: *k})
+	}
+	return nil
+}
+
+// Description: Pop will remove the supplied value, and the key associated with
+// it, from the bi map. Due to values being unique the returned count will
+// always be either 0 or 1. All equality comparisons are performed by the
+// generic VI widget type that the bi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Pop(v V) int {
+	return m.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi map [BiMap.Pop] implementation method. The [BiMap.Pop] method
+// is not called directly to avoid copying the v argument twice, which could be
+// expensive with a large type for the V generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Pop(v V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.popImpl(&v)
+}
+
+// Description: PopPntr will remove the supplied value, and the key associated
+// with it, from the bi map. Due to values being unique the returned count will
+// always be either 0 or 1. All equality comparisons are performed by the
+// generic VI widget type that the bi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) PopPntr(v *V) int {
+	return m.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi map [BiMap.PopPntr] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.popImpl(v)
+}
+
+func (m *BiMap[K, V, KI, VI]) popImpl(v *V) int {
+	if h, found := m.backward.getHashPosition(v); found {
+		k := m.backward.internalHashMapImpl[h].B
+		m.forward.deleteImpl(&k)
+		m.backward.removeSingleValue(h)
+		return 1
+	}
+	return 0
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// a [containerTypes.KeyError] if the key is not found in the bi map.
+//
+// Time Complexity: O(1)
+func (m *BiMap[K, V, KI, VI]) Delete(k K) error {
+	return m.deleteImpl(&k)
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (m *SyncedBiMap[K, V, KI, VI]) Delete(k K) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.deleteImpl(&k)
+}
+
+func (m *BiMap[K, V, KI, VI]) deleteImpl(k *K) error {
+	if h, found := m.forward.getHashPosition(k); found {
+		v := m.forward.internalHashMapImpl[h].B
+		m.backward.deleteImpl(&v)
+		m.forward.removeSingleValue(h)
+		return nil
+	}
+	return getKeyError[K](k)
+}
+
+// Description: Clears all values from the bi map.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) Clear() {
+	m.forward.Clear()
+	m.backward.Clear()
+}
+
+// Description: Places a write lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.BiMap.Clear()
+}
+
+// Description: Returns an iterator that iterates over the keys of the bi map.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.forward.Keys()
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [BiMap.Keys] method such that a read lock will be placed on the underlying
+// bi map when the iterator is consumed. The bi map will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.BiMap.Keys().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the bi map that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [BiMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the bi map that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [SyncedBiMap.Keys], so the bi map will have a read lock the
+// entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values in the bi
+// map.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return m.forward.Vals()
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [BiMap.Vals] method such that a read lock will be placed on the underlying
+// bi map when the iterator is consumed. The bi map will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return m.BiMap.Vals().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the bi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [BiMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the bi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedBiMap.Vals], so the bi map will have a read lock
+// the entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the bi map that
+// can be used in a for-range loop.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.forward.pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the bi map that
+// can be used in a for-range loop. The bi map will have a read lock the entire
+// time the loop is running. The lock is released once the loop ends, even if
+// the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.BiMap.forward.pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	))
+}
+
+// Panics, a bi map is not addressable.
+func (m *BiMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("bi map"))
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *BiMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	return m.forward.KeyedEq(other)
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi map [BiMap.KeyedEq] method. Attempts to place a read lock on
+// other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this bi map, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *SyncedBiMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.RLock()
+	other.RLock()
+	defer m.RUnlock()
+	defer other.RUnlock()
+	return m.BiMap.KeyedEq(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [BiMap.KeyedEq]. Returns true
+// if l==r, false otherwise.
+func (_ *BiMap[K, V, KI, VI]) Eq(
+	l *BiMap[K, V, KI, VI],
+	r *BiMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedBiMap.KeyedEq]. Returns
+// true if l==r, false otherwise.
+func (_ *SyncedBiMap[K, V, KI, VI]) Eq(
+	l *SyncedBiMap[K, V, KI, VI],
+	r *SyncedBiMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a bi map. The hash is the same as the hash
+// of a [HashMap] that contains the same key value pairs, making it represent
+// the same equality operation that [BiMap.KeyedEq] and [BiMap.Eq] provide.
+func (_ *BiMap[K, V, KI, VI]) Hash(other *BiMap[K, V, KI, VI]) hash.Hash {
+	return other.forward.Hash(other.forward)
+}
+
+// Places a read lock on the underlying bi map of other and then calls others
+// underlying bi maps [BiMap.Hash] method.
+func (_ *SyncedBiMap[K, V, KI, VI]) Hash(
+	other *SyncedBiMap[K, V, KI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.BiMap.Hash(&other.BiMap)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [BiMap.Clear].
+func (_ *BiMap[K, V, KI, VI]) Zero(other *BiMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedBiMap.Clear].
+func (_ *SyncedBiMap[K, V, KI, VI]) Zero(other *SyncedBiMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+func (m *BiMap[K, V, KI, VI]) toSerialized() []serializedKV[K, V] {
+	return m.forward.toSerialized()
+}
+
+func (m *BiMap[K, V, KI, VI]) fromSerialized(
+	kvs []serializedKV[K, V],
+) error {
+	rv, _ := NewBiMap[K, V, KI, VI](len(kvs))
+	for _, kv := range kvs {
+		if err := rv.Emplace(basic.Pair[K, V]{A: kv.Key, B: kv.Val}); err != nil {
+			return getMalformedDataError(fmt.Sprintf(
+				"the value %v is associated with more than one key", kv.Val,
+			))
+		}
+	}
+	if m.forward == nil {
+		m.forward, m.backward = rv.forward, rv.backward
+		return nil
+	}
+	m.Clear()
+	m.forward.internalHashMapImpl = rv.forward.internalHashMapImpl
+	m.backward.internalHashMapImpl = rv.backward.internalHashMapImpl
+	return nil
+}
+
+// Description: Returns the JSON encoding of the bi map. The bi map is encoded
+// as a list of key value objects in no particular order, the same as a
+// [HashMap]. If a key is present more than once when decoding the last value
+// is kept. If a value is associated with more than one key when decoding an
+// error is returned.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.MarshalJSON()
+}
+
+// Description: Replaces the contents of the bi map with the values decoded from
+// the supplied JSON data, which must be in the format produced by
+// [BiMap.MarshalJSON]. If an error is returned the bi map is left unchanged.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying bi map while its contents are replaced. Exhibits the same
+// behavior as [BiMap.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the bi map. The values are
+// laid out the same way as [BiMap.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bi map and then calls the
+// underlying bi maps [BiMap.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.BiMap.MarshalBinary()
+}
+
+// Description: Replaces the contents of the bi map with the values decoded from
+// the supplied binary data, which must have been produced by
+// [BiMap.MarshalBinary]. If an error is returned the bi map is left unchanged.
+//
+// Time Complexity: O(n)
+func (m *BiMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying bi map while its contents are replaced. Exhibits the same
+// behavior as [BiMap.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedBiMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.BiMap.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m BiMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("biMap["))
+	cntr := 0
+	for _, v := range m.forward.internalHashMapImpl {
+		fmt.Fprintf(f, fmtStr, v.A, v.B)
+		cntr++
+		if cntr < len(m.forward.internalHashMapImpl) {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *BiMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
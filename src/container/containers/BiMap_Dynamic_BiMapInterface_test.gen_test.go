@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func BiMapToBiMapInterfaceFactory(capacity int) dynamicContainers.BiMap[int, int] {
+	v := generateBiMap(capacity)
+	var rv dynamicContainers.BiMap[int, int] = &v
+	return rv
+}
+
+func TestBiMap_DynBiMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceSyncableInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceAddressableInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynBiMapInterfaceLengthInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceClearInterface(t *testing.T) {
+	tests.DynBiMapInterfaceClearInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceWriteKeyedOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadKeyedOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceReadBiKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadBiKeyedOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceDeleteOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceDeleteKeyedOpsInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_ReadDynBiMapInterface(t *testing.T) {
+	tests.ReadDynBiMapInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_WriteDynBiMapInterface(t *testing.T) {
+	tests.WriteDynBiMapInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceInterface(t *testing.T) {
+	tests.DynBiMapInterfaceInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceSerializableInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynBiMapInterfaceStaticCapacityInterface(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceGet(t *testing.T) {
+	tests.DynBiMapInterfaceGet(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceGetPntr(t *testing.T) {
+	tests.DynBiMapInterfaceGetPntr(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceGetKey(t *testing.T) {
+	tests.DynBiMapInterfaceGetKey(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceContainsKey(t *testing.T) {
+	tests.DynBiMapInterfaceContainsKey(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceSet(t *testing.T) {
+	tests.DynBiMapInterfaceSet(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceEmplace(t *testing.T) {
+	tests.DynBiMapInterfaceEmplace(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceContains(t *testing.T) {
+	tests.DynBiMapInterfaceContains(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceKeyOf(t *testing.T) {
+	tests.DynBiMapInterfaceKeyOf(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfacePop(t *testing.T) {
+	tests.DynBiMapInterfacePop(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceDelete(t *testing.T) {
+	tests.DynBiMapInterfaceDelete(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceClear(t *testing.T) {
+	tests.DynBiMapInterfaceClear(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceKeysVals(t *testing.T) {
+	tests.DynBiMapInterfaceKeysVals(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceValPntrs(t *testing.T) {
+	tests.DynBiMapInterfaceValPntrs(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceKeyedEq(t *testing.T) {
+	tests.DynBiMapInterfaceKeyedEq(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceJSON(t *testing.T) {
+	tests.DynBiMapInterfaceJSON(BiMapToBiMapInterfaceFactory, t)
+}
+
+func TestBiMap_DynBiMapInterfaceBinary(t *testing.T) {
+	tests.DynBiMapInterfaceBinary(BiMapToBiMapInterfaceFactory, t)
+}
@@ -0,0 +1,212 @@
+package containers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=BiMap -category=dynamic -interface=BiMap -genericDecl=[int,int] -factory=generateBiMap
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedBiMap -category=dynamic -interface=BiMap -genericDecl=[int,int] -factory=generateSyncedBiMap
+
+func generateBiMap(capacity int) BiMap[int, int, badBuiltinInt, badBuiltinInt2] {
+	m, _ := NewBiMap[int, int, badBuiltinInt, badBuiltinInt2](capacity)
+	return m
+}
+
+func generateSyncedBiMap(capacity int) SyncedBiMap[
+	int,
+	int,
+	badBuiltinInt,
+	badBuiltinInt2,
+] {
+	m, _ := NewSyncedBiMap[int, int, badBuiltinInt, badBuiltinInt2](capacity)
+	return m
+}
+
+func TestBiMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[BiMap[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v, _ := NewBiMap[string, string, widgets.BuiltinString, widgets.BuiltinString](0)
+	widget = &v
+	_ = widget
+}
+
+func TestBiMapNewError(t *testing.T) {
+	_, err := NewBiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestBiMapRandomOps(t *testing.T) {
+	m, _ := NewBiMap[int, int, badBuiltinInt, badBuiltinInt2](0)
+	forward := map[int]int{}
+	backward := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		k, v := (i*7919)%37, (i*104729)%41
+		switch i % 4 {
+		case 0, 1:
+			err := m.Emplace(basic.Pair[int, int]{k, v})
+			if oldK, ok := backward[v]; ok && oldK != k {
+				test.ContainsError(containerTypes.Duplicate, err, t)
+			} else {
+				test.Nil(err, t)
+				if oldV, ok := forward[k]; ok {
+					delete(backward, oldV)
+				}
+				forward[k], backward[v] = v, k
+			}
+		case 2:
+			oldV, ok := forward[k]
+			test.Eq(ok, m.Delete(k) == nil, t)
+			if ok {
+				delete(backward, oldV)
+				delete(forward, k)
+			}
+		case 3:
+			n := m.Pop(v)
+			oldK, ok := backward[v]
+			test.Eq(ok, n == 1, t)
+			if ok {
+				delete(forward, oldK)
+				delete(backward, v)
+			}
+		}
+		test.Eq(len(forward), m.Length(), t)
+		test.Eq(len(backward), m.backward.Length(), t)
+	}
+	for k, v := range forward {
+		iterV, err := m.Get(k)
+		test.Nil(err, t)
+		test.Eq(v, iterV, t)
+		iterK, err := m.GetKey(v)
+		test.Nil(err, t)
+		test.Eq(k, iterK, t)
+	}
+}
+
+func TestBiMapInverse(t *testing.T) {
+	m, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m.Emplace(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{1, "one"},
+	)
+	inv := m.Inverse()
+	test.Eq(2, inv.Length(), t)
+	v, err := inv.Get("one")
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+	inv.Emplace(basic.Pair[string, int]{"two", 2})
+	s, err := m.Get(2)
+	test.Nil(err, t)
+	test.Eq("two", s, t)
+	m.Clear()
+	test.Eq(0, inv.Length(), t)
+	inv.Emplace(basic.Pair[string, int]{"three", 3})
+	test.True(m.ContainsKey(3), t)
+	err = m.Emplace(basic.Pair[int, string]{4, "three"})
+	test.ContainsError(containerTypes.Duplicate, err, t)
+
+	sm, _ := NewSyncedBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	sInv := sm.Inverse()
+	test.True(sm.RWMutex == sInv.RWMutex, t)
+}
+
+func TestBiMapEq(t *testing.T) {
+	m1, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m2, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Emplace(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{1, "one"},
+		basic.Pair[int, string]{2, "two"},
+	)
+	m2.Emplace(
+		basic.Pair[int, string]{2, "two"},
+		basic.Pair[int, string]{1, "one"},
+		basic.Pair[int, string]{0, "zero"},
+	)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.True(m2.Eq(&m1, &m2), t)
+	m2.Delete(0)
+	test.False(m1.Eq(&m1, &m2), t)
+	test.False(m2.Eq(&m1, &m2), t)
+}
+
+func TestBiMapHash(t *testing.T) {
+	m1, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m2, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	hm, _ := NewHashMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	for _, m := range []interface {
+		Emplace(kvPairs ...basic.Pair[int, string]) error
+	}{&m1, &m2, &hm} {
+		m.Emplace(
+			basic.Pair[int, string]{0, "zero"},
+			basic.Pair[int, string]{1, "one"},
+			basic.Pair[int, string]{2, "two"},
+		)
+	}
+	test.Eq(m1.Hash(&m1), m2.Hash(&m2), t)
+	test.Eq(hm.Hash(&hm), m1.Hash(&m1), t)
+	m2.Set(basic.Pair[int, string]{0, "nil"})
+	test.Neq(m1.Hash(&m1), m2.Hash(&m2), t)
+}
+
+func TestBiMapZero(t *testing.T) {
+	m1, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Emplace(basic.Pair[int, string]{0, "zero"})
+	m1.Zero(&m1)
+	test.Eq(0, m1.Length(), t)
+	test.Eq(0, m1.backward.Length(), t)
+}
+
+func TestBiMapFormat(t *testing.T) {
+	m1, _ := NewBiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Emplace(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{1, "one"},
+	)
+	for _, res := range []string{fmt.Sprintf("%v", m1), m1.String()} {
+		test.Eq(len("biMap[0:zero 1:one]"), len(res), t)
+		test.True(strings.HasPrefix(res, "biMap["), t)
+		test.True(strings.Contains(res, "0:zero"), t)
+		test.True(strings.Contains(res, "1:one"), t)
+	}
+}
+
+func TestBiMapUnmarshalZeroValue(t *testing.T) {
+	var m BiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString]
+	test.Nil(m.UnmarshalJSON([]byte(`[{"key":1,"val":"one"}]`)), t)
+	k, err := m.GetKey("one")
+	test.Nil(err, t)
+	test.Eq(1, k, t)
+}
+
+func TestSyncedBiMapConcurrentEmplace(t *testing.T) {
+	m, _ := NewSyncedBiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				// Every goroutine competes for the same values, only one
+				// emplace per value can succeed.
+				m.Emplace(basic.Pair[int, int]{g*1000 + i, i})
+				m.Contains(i)
+				m.GetKey(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+	test.Eq(250, m.Length(), t)
+	for i := 0; i < 250; i++ {
+		k, err := m.GetKey(i)
+		test.Nil(err, t)
+		test.Eq(i, k%1000, t)
+	}
+}
@@ -0,0 +1,1267 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"math/bits"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+)
+
+type (
+	// A type to represent a dense set of small non-negative integers. Every
+	// value in the range [0, capacity) is represented by a single bit, making
+	// membership checks O(1) and allowing set operations between bit sets to
+	// operate on 64 values at a time. The capacity of a bit set grows as
+	// larger values are added to it, so the initial capacity only needs to be
+	// a guess at the range of values, which makes bit sets well suited for
+	// things like tracking vertex ids in graph algorithms.
+	//
+	// Bit sets implement the dynamic set interface but not the static set
+	// interface. A static sets capacity limits how many values it can hold,
+	// where as a bit sets capacity limits how large the values it holds can
+	// be, and negative values can never be held.
+	BitSet struct {
+		words  []uint64
+		size   int
+		numSet int
+	}
+
+	// A synchronized version of BitSet. All operations will be wrapped in the
+	// appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedBitSet struct {
+		*sync.RWMutex
+		BitSet
+	}
+)
+
+// Creates a new bit set that can hold the values [0, size) without growing.
+// Size must be >= 0, an error will be returned if it is not.
+func NewBitSet(size int) (BitSet, error) {
+	if size < 0 {
+		return BitSet{}, getSizeError(size)
+	}
+	return BitSet{
+		words: make([]uint64, (size+63)/64),
+		size:  size,
+	}, nil
+}
+
+// Creates a new synced bit set that can hold the values [0, size) without
+// growing. Size must be >= 0, an error will be returned if it is not. The
+// underlying RWMutex value will be fully unlocked upon initialization.
+func NewSyncedBitSet(size int) (SyncedBitSet, error) {
+	rv, err := NewBitSet(size)
+	return SyncedBitSet{
+		RWMutex: &sync.RWMutex{},
+		BitSet:  rv,
+	}, err
+}
+
+// Converts the supplied bit set to a syncronized bit set. Beware: The original
+// non-synced bit set will remain useable.
+func (b *BitSet) ToSynced() SyncedBitSet {
+	return SyncedBitSet{
+		RWMutex: &sync.RWMutex{},
+		BitSet:  *b,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (b *BitSet) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (b *BitSet) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (b *BitSet) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (b *BitSet) RUnlock() {}
+
+// The SyncedBitSet method to override the BitSet pass through function and
+// actually apply the mutex operation.
+func (b *SyncedBitSet) Lock() { b.RWMutex.Lock() }
+
+// The SyncedBitSet method to override the BitSet pass through function and
+// actually apply the mutex operation.
+func (b *SyncedBitSet) Unlock() { b.RWMutex.Unlock() }
+
+// The SyncedBitSet method to override the BitSet pass through function and
+// actually apply the mutex operation.
+func (b *SyncedBitSet) RLock() { b.RWMutex.RLock() }
+
+// The SyncedBitSet method to override the BitSet pass through function and
+// actually apply the mutex operation.
+func (b *SyncedBitSet) RUnlock() { b.RWMutex.RUnlock() }
+
+// Returns false, bit sets are not addressable.
+func (b *BitSet) IsAddressable() bool { return false }
+
+// Returns false, a bit set is not synced.
+func (b *BitSet) IsSynced() bool { return false }
+
+// Returns true, a synced bit set is synced.
+func (b *SyncedBitSet) IsSynced() bool { return true }
+
+// Returns the word at index i, treating any word past the end of the bit set
+// as if it had no bits set.
+func (b *BitSet) word(i int) uint64 {
+	if i < len(b.words) {
+		return b.words[i]
+	}
+	return 0
+}
+
+// Clears any bits in the last word that are past the capacity of the bit set
+// and recalculates the number of values in the bit set.
+func (b *BitSet) normalize() {
+	if rem := b.size % 64; rem != 0 {
+		b.words[len(b.words)-1] &= (uint64(1) << rem) - 1
+	}
+	b.numSet = 0
+	for _, w := range b.words {
+		b.numSet += bits.OnesCount64(w)
+	}
+}
+
+// Returns the bit set that backs other if other is a bit set, allowing set
+// operations to work on whole words instead of individual values.
+func bitSetFromOther(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) (*BitSet, bool) {
+	switch o := other.(type) {
+	case *BitSet:
+		return o, true
+	case *SyncedBitSet:
+		return &o.BitSet, true
+	}
+	return nil, false
+}
+
+// Description: Returns the number of values in the bit set.
+//
+// Time Complexity: O(1)
+func (b *BitSet) Length() int {
+	return b.numSet
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) Length() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.Length()
+}
+
+// Description: Returns the capacity of the bit set. The bit set can hold the
+// values [0, capacity) without growing.
+//
+// Time Complexity: O(1)
+func (b *BitSet) Capacity() int {
+	return b.size
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.Capacity] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) Capacity() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.Capacity()
+}
+
+// Description: Sets the capacity of the bit set. If the new capacity is less
+// than the old capacity then any values >= the new capacity will be removed.
+// The capacity must be >= 0, an error will be returned if it is not and the
+// bit set will not be modified.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) SetCapacity(c int) error {
+	if c < 0 {
+		return getSizeError(c)
+	}
+	newWords := make([]uint64, (c+63)/64)
+	copy(newWords, b.words)
+	b.words = newWords
+	b.size = c
+	b.normalize()
+	return nil
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.SetCapacity] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) SetCapacity(c int) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.SetCapacity(c)
+}
+
+// Description: Returns an iterator that iterates over the values in the bit
+// set in ascending order. Words that have no bits set are skipped over 64
+// values at a time.
+//
+// Time Complexity: O(m+n), where m is the capacity of the bit set divided by
+// 64 and n is the number of values in the bit set.
+func (b *BitSet) Vals() iter.Iter[int] {
+	next := 0
+	return func(f iter.IteratorFeedback) (int, error, bool) {
+		if f == iter.Break {
+			return 0, nil, false
+		}
+		v, ok := b.NextSet(next)
+		if !ok {
+			return 0, nil, false
+		}
+		next = v + 1
+		return v, nil, true
+	}
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [BitSet.Vals] method such that a read lock will be placed on the underlying
+// bit set when iterator is consumed. The bit set will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m+n), where m is the capacity of the bit set divided by
+// 64 and n is the number of values in the bit set.
+func (b *SyncedBitSet) Vals() iter.Iter[int] {
+	return b.BitSet.Vals().SetupTeardown(
+		func() error { b.RLock(); return nil },
+		func() error { b.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the bit set that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [BitSet.Vals].
+//
+// Time Complexity: O(m+n), where m is the capacity of the bit set divided by
+// 64 and n is the number of values in the bit set.
+func (b *BitSet) ValsSeq() stditer.Seq[int] {
+	return b.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the bit set that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedBitSet.Vals], so the bit set will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m+n), where m is the capacity of the bit set divided by
+// 64 and n is the number of values in the bit set.
+func (b *SyncedBitSet) ValsSeq() stditer.Seq[int] {
+	return b.Vals().Seq()
+}
+
+// Panics, bit sets are not addressable.
+func (b *BitSet) ValPntrs() iter.Iter[*int] {
+	panic(getNonAddressablePanicText("bit set"))
+}
+
+// Description: Returns the smallest value in the bit set that is >= v. The
+// boolean flag will be false if there is no such value.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) NextSet(v int) (int, bool) {
+	v = max(v, 0)
+	if v >= b.size {
+		return 0, false
+	}
+	i := v / 64
+	if w := b.words[i] >> (v % 64); w != 0 {
+		return v + bits.TrailingZeros64(w), true
+	}
+	for i++; i < len(b.words); i++ {
+		if b.words[i] != 0 {
+			return i*64 + bits.TrailingZeros64(b.words[i]), true
+		}
+	}
+	return 0, false
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.NextSet] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) NextSet(v int) (int, bool) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.NextSet(v)
+}
+
+// Description: Returns nil if the supplied value is in the bit set, a value
+// error otherwise. Present for consistency with the other sets, there is no
+// additional information to populate the supplied value with.
+//
+// Time complexity: O(1)
+func (b *BitSet) GetUnique(v *int) error {
+	if !b.ContainsPntr(v) {
+		return getValueError[int](v)
+	}
+	return nil
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.GetUnique] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) GetUnique(v *int) error {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.GetUnique(v)
+}
+
+// Description: Contains will return true if the supplied value is in the bit
+// set, false otherwise. Values outside of [0, capacity) are never in the bit
+// set.
+//
+// Time Complexity: O(1)
+func (b *BitSet) Contains(v int) bool {
+	return v >= 0 && v < b.size && b.words[v/64]&(uint64(1)<<(v%64)) != 0
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.Contains] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) Contains(v int) bool {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.Contains(v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// bit set, false otherwise. Values outside of [0, capacity) are never in the
+// bit set.
+//
+// Time Complexity: O(1)
+func (b *BitSet) ContainsPntr(v *int) bool {
+	return b.Contains(*v)
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) ContainsPntr(v *int) bool {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.ContainsPntr(v)
+}
+
+// Grows the bit set so that it can hold the values [0, c). The bit set is
+// left unchanged if it can already hold those values.
+func (b *BitSet) grow(c int) {
+	if c <= b.size {
+		return
+	}
+	if n := (c + 63) / 64; n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+	b.size = c
+}
+
+// Description: AppendUnique will add the supplied values to the bit set if
+// they are not already present in the bit set. Values that are already present
+// are ignored. Values >= the capacity of the bit set will grow the bit set so
+// that it can hold them. Negative values cannot be held by a bit set and will
+// cause a [customerr.ValOutsideRange] error to be returned. Values are added in
+// order until an error is encountered.
+//
+// Time Complexity: O(m), where m=len(vals). Amortized O(1) per value when the
+// bit set needs to grow.
+func (b *BitSet) AppendUnique(vals ...int) error {
+	for _, v := range vals {
+		if v < 0 {
+			return getNegativeValueError(v)
+		}
+		if b.Contains(v) {
+			continue
+		}
+		b.grow(v + 1)
+		b.words[v/64] |= uint64(1) << (v % 64)
+		b.numSet++
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.AppendUnique] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals). Amortized O(1) per value when the
+// bit set needs to grow.
+func (b *SyncedBitSet) AppendUnique(vals ...int) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.AppendUnique(vals...)
+}
+
+// Description: Calls updateOp with the supplied value if it is present in the
+// bit set. Present for consistency with the other sets, the value of an int is
+// its identity so updateOp must not change the value. If it does an update
+// violation error will be returned. If the value is not found then a value
+// error will be returned.
+//
+// Time Complexity: O(1)
+func (b *BitSet) UpdateUnique(orig int, updateOp func(orig *int)) error {
+	if !b.Contains(orig) {
+		return getValueError[int](&orig)
+	}
+	updated := orig
+	updateOp(&updated)
+	if updated != orig {
+		return getUpdateViolationEqError[int](&updated, &orig)
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.UpdateUnique] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) UpdateUnique(orig int, updateOp func(orig *int)) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.UpdateUnique(orig, updateOp)
+}
+
+// Description: Pop will remove the supplied value from the bit set. Returns
+// the number of values that were removed, which will be either 0 or 1.
+//
+// Time Complexity: O(1)
+func (b *BitSet) Pop(v int) int {
+	if !b.Contains(v) {
+		return 0
+	}
+	b.words[v/64] &^= uint64(1) << (v % 64)
+	b.numSet--
+	return 1
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.Pop] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) Pop(v int) int {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.Pop(v)
+}
+
+// Description: PopPntr will remove the supplied value from the bit set.
+// Returns the number of values that were removed, which will be either 0 or 1.
+//
+// Time Complexity: O(1)
+func (b *BitSet) PopPntr(v *int) int {
+	return b.Pop(*v)
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.PopPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (b *SyncedBitSet) PopPntr(v *int) int {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.PopPntr(v)
+}
+
+// Description: Clears all values from the bit set. The capacity of the bit set
+// is not changed.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) Clear() {
+	clear(b.words)
+	b.numSet = 0
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) Clear() {
+	b.Lock()
+	defer b.Unlock()
+	b.BitSet.Clear()
+}
+
+func (b *BitSet) rank(v int) int {
+	rv := 0
+	for i := 0; i < v/64; i++ {
+		rv += bits.OnesCount64(b.words[i])
+	}
+	if rem := v % 64; rem != 0 {
+		rv += bits.OnesCount64(b.words[v/64] & ((uint64(1) << rem) - 1))
+	}
+	return rv
+}
+
+// Description: Returns the number of values in the bit set that are < v. V
+// must be in the range [0, capacity], an error will be returned if it is not.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) Rank(v int) (int, error) {
+	if v < 0 || v > b.size {
+		return 0, getIndexOutOfBoundsError(v, b.size+1, 0)
+	}
+	return b.rank(v), nil
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.Rank] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) Rank(v int) (int, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.Rank(v)
+}
+
+// Description: Returns the k-th smallest value in the bit set, starting from
+// 0. This is the inverse of [BitSet.Rank], meaning Rank(Select(k))==k. K must
+// be in the range [0, Length()), an error will be returned if it is not.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) Select(k int) (int, error) {
+	if k < 0 || k >= b.numSet {
+		return 0, getIndexOutOfBoundsError(k, b.numSet, 0)
+	}
+	for i, w := range b.words {
+		if cnt := bits.OnesCount64(w); k >= cnt {
+			k -= cnt
+			continue
+		}
+		for ; k > 0; k-- {
+			w &= w - 1
+		}
+		return i*64 + bits.TrailingZeros64(w), nil
+	}
+	// Unreachable as long as numSet is kept in sync with the words.
+	return 0, getIndexOutOfBoundsError(k, b.numSet, 0)
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.Select] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) Select(k int) (int, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.Select(k)
+}
+
+// Description: Returns the number of values in the bit set that are in the
+// range [start, end). Start and end must be in the range [0, capacity] and
+// start must be <= end, an error will be returned if they are not.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) PopCount(start int, end int) (int, error) {
+	if start < 0 || start > b.size {
+		return 0, getIndexOutOfBoundsError(start, b.size+1, 0)
+	}
+	if end < 0 || end > b.size {
+		return 0, getIndexOutOfBoundsError(end, b.size+1, 0)
+	}
+	if start > end {
+		return 0, getStartEndIndexError(start, end)
+	}
+	return b.rank(end) - b.rank(start), nil
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.PopCount] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) PopCount(start int, end int) (int, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.PopCount(start, end)
+}
+
+// Description: Adds n to every value in the bit set. The capacity of the bit
+// set grows by n so that no values are lost. N must be >= 0, an error will be
+// returned if it is not and the bit set will not be modified.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) ShiftLeft(n int) error {
+	if n < 0 {
+		return getShiftError(n)
+	}
+	b.grow(b.size + n)
+	wordShift, bitShift := n/64, uint(n%64)
+	for i := len(b.words) - 1; i >= 0; i-- {
+		src := i - wordShift
+		if src < 0 {
+			b.words[i] = 0
+			continue
+		}
+		b.words[i] = b.words[src] << bitShift
+		if bitShift > 0 && src > 0 {
+			b.words[i] |= b.words[src-1] >> (64 - bitShift)
+		}
+	}
+	b.normalize()
+	return nil
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.ShiftLeft] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) ShiftLeft(n int) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.ShiftLeft(n)
+}
+
+// Description: Subtracts n from every value in the bit set. Any values that
+// become < 0 are removed. N must be >= 0, an error will be returned if it is
+// not and the bit set will not be modified.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) ShiftRight(n int) error {
+	if n < 0 {
+		return getShiftError(n)
+	}
+	wordShift, bitShift := n/64, uint(n%64)
+	for i := 0; i < len(b.words); i++ {
+		src := i + wordShift
+		if src >= len(b.words) {
+			b.words[i] = 0
+			continue
+		}
+		b.words[i] = b.words[src] >> bitShift
+		if bitShift > 0 && src+1 < len(b.words) {
+			b.words[i] |= b.words[src+1] << (64 - bitShift)
+		}
+	}
+	b.normalize()
+	return nil
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.ShiftRight] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) ShiftRight(n int) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.ShiftRight(n)
+}
+
+// Description: Returns true if the elements in b are all contained in other
+// and the elements of other are all contained in b, regardless of position.
+// Returns false otherwise. The capacities of the containers are not
+// considered. If other is a bit set the comparison is performed 64 values at a
+// time.
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of the
+// largest bit set divided by 64. Otherwise dependent on the time complexity of
+// the implementation of the ContainsPntr method on other. In big-O it might
+// look something like this, O(n*O(other.ContainsPntr))), where
+// O(other.ContainsPntr) represents the time complexity of the ContainsPntr
+// method on other with m values.
+func (b *BitSet) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	if b.numSet != other.Length() {
+		return false
+	}
+	if o, ok := bitSetFromOther(other); ok {
+		for i := 0; i < max(len(b.words), len(o.words)); i++ {
+			if b.word(i) != o.word(i) {
+				return false
+			}
+		}
+		return true
+	}
+	return b.isSubsetOf(other)
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.UnorderedEq] method. Attempts to place a read
+// lock on other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this bit set, read on other
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of the
+// largest bit set divided by 64. Otherwise dependent on the time complexity of
+// the implementation of the ContainsPntr method on other. In big-O it might
+// look something like this, O(n*O(other.ContainsPntr))), where
+// O(other.ContainsPntr) represents the time complexity of the ContainsPntr
+// method on other with m values.
+func (b *SyncedBitSet) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	b.RLock()
+	other.RLock()
+	defer b.RUnlock()
+	defer other.RUnlock()
+	return b.BitSet.UnorderedEq(other)
+}
+
+// Populates a new bit set with all of the values from the supplied containers
+// that op returns true for. Values < 0 cannot be represented by a bit set and
+// are skipped. The capacity of the returned bit set will be one more than the
+// largest value it contains.
+func bitSetFromContainers(
+	op func(v *int) bool,
+	others ...containerTypes.ComparisonsOtherConstraint[int],
+) BitSet {
+	vals := []int{}
+	largest := -1
+	for _, o := range others {
+		addressableSafeValIter[int](o).ForEach(
+			func(index int, val *int) (iter.IteratorFeedback, error) {
+				if *val >= 0 && op(val) {
+					vals = append(vals, *val)
+					largest = max(largest, *val)
+				}
+				return iter.Continue, nil
+			},
+		)
+	}
+	rv, _ := NewBitSet(largest + 1)
+	rv.AppendUnique(vals...)
+	return rv
+}
+
+// Description: Populates the bit set with the intersection of values from the
+// l and r containers. The current contents of the bit set are discarded. If l
+// and r are both bit sets the intersection is calculated 64 values at a time
+// and the resulting bit set will have a capacity equal to the smaller of the
+// two capacities. Otherwise the resulting bit set will have a capacity that is
+// one more than the largest value in the intersection and any values < 0 will
+// be ignored. The bit set may be the same as l or r.
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of the largest bit set divided by 64. Otherwise dependent on the time
+// complexity of the implementation of the ContainsPntr method on l and r. In
+// big-O it might look something like this, O(O(r.ContainsPntr)*O(l.ContainsPntr)),
+// where O(r.ContainsPntr) represents the time complexity of the containsPntr
+// method on r and O(l.ContainsPntr) represents the time complexity of the
+// containsPntr method on l.
+func (b *BitSet) Intersection(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	lb, lOk := bitSetFromOther(l)
+	rb, rOk := bitSetFromOther(r)
+	if !lOk || !rOk {
+		*b = bitSetFromContainers(r.ContainsPntr, l)
+		return
+	}
+	newB, _ := NewBitSet(min(lb.size, rb.size))
+	for i := 0; i < len(newB.words); i++ {
+		newB.words[i] = lb.words[i] & rb.words[i]
+	}
+	newB.normalize()
+	*b = newB
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.Intersection] method. Attempts to place a
+// read lock on l and r but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Write on this bit set, read on l and r
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of the largest bit set divided by 64. Otherwise dependent on the time
+// complexity of the implementation of the ContainsPntr method on l and r. In
+// big-O it might look something like this, O(O(r.ContainsPntr)*O(l.ContainsPntr)),
+// where O(r.ContainsPntr) represents the time complexity of the containsPntr
+// method on r and O(l.ContainsPntr) represents the time complexity of the
+// containsPntr method on l.
+func (b *SyncedBitSet) Intersection(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	r.RLock()
+	l.RLock()
+	b.Lock()
+	defer r.RUnlock()
+	defer l.RUnlock()
+	defer b.Unlock()
+	b.BitSet.Intersection(l, r)
+}
+
+// Description: Populates the bit set with the union of values from the l and r
+// containers. The current contents of the bit set are discarded. If l and r
+// are both bit sets the union is calculated 64 values at a time and the
+// resulting bit set will have a capacity equal to the larger of the two
+// capacities. Otherwise the resulting bit set will have a capacity that is one
+// more than the largest value in the union and any values < 0 will be ignored.
+// The bit set may be the same as l or r.
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of the largest bit set divided by 64. Otherwise O(n+m), where n is the
+// number of values in l and m is the number of values in r.
+func (b *BitSet) Union(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	lb, lOk := bitSetFromOther(l)
+	rb, rOk := bitSetFromOther(r)
+	if !lOk || !rOk {
+		*b = bitSetFromContainers(func(v *int) bool { return true }, l, r)
+		return
+	}
+	newB, _ := NewBitSet(max(lb.size, rb.size))
+	for i := 0; i < len(newB.words); i++ {
+		newB.words[i] = lb.word(i) | rb.word(i)
+	}
+	newB.normalize()
+	*b = newB
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.Union] method. Attempts to place a read lock
+// on l and r but whether or not that happens is implementation dependent.
+//
+// Lock Type: Write on this bit set, read on l and r
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of the largest bit set divided by 64. Otherwise O(n+m), where n is the
+// number of values in l and m is the number of values in r.
+func (b *SyncedBitSet) Union(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	r.RLock()
+	l.RLock()
+	b.Lock()
+	defer r.RUnlock()
+	defer l.RUnlock()
+	defer b.Unlock()
+	b.BitSet.Union(l, r)
+}
+
+// Description: Populates the bit set with the result of taking the difference
+// of r from l. The current contents of the bit set are discarded. If l and r
+// are both bit sets the difference is calculated 64 values at a time and the
+// resulting bit set will have the same capacity as l. Otherwise the resulting
+// bit set will have a capacity that is one more than the largest value in the
+// difference and any values < 0 will be ignored. The bit set may be the same
+// as l or r.
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of l divided by 64. Otherwise dependent on the time complexity of the
+// implementation of the ContainsPntr method on r. In big-O it might look
+// something like this, O(n*O(r.ContainsPntr)), where n is the number of values
+// in l and O(r.ContainsPntr) represents the time complexity of the
+// containsPntr method on r.
+func (b *BitSet) Difference(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	lb, lOk := bitSetFromOther(l)
+	rb, rOk := bitSetFromOther(r)
+	if !lOk || !rOk {
+		*b = bitSetFromContainers(
+			func(v *int) bool { return !r.ContainsPntr(v) }, l,
+		)
+		return
+	}
+	newB, _ := NewBitSet(lb.size)
+	for i := 0; i < len(newB.words); i++ {
+		newB.words[i] = lb.words[i] &^ rb.word(i)
+	}
+	newB.normalize()
+	*b = newB
+}
+
+// Description: Places a write lock on the underlying bit set and then calls
+// the underlying bit sets [BitSet.Difference] method. Attempts to place a read
+// lock on l and r but whether or not that happens is implementation dependent.
+//
+// Lock Type: Write on this bit set, read on l and r
+//
+// Time Complexity: O(m) if l and r are both bit sets, where m is the capacity
+// of l divided by 64. Otherwise dependent on the time complexity of the
+// implementation of the ContainsPntr method on r. In big-O it might look
+// something like this, O(n*O(r.ContainsPntr)), where n is the number of values
+// in l and O(r.ContainsPntr) represents the time complexity of the
+// containsPntr method on r.
+func (b *SyncedBitSet) Difference(
+	l containerTypes.ComparisonsOtherConstraint[int],
+	r containerTypes.ComparisonsOtherConstraint[int],
+) {
+	r.RLock()
+	l.RLock()
+	b.Lock()
+	defer r.RUnlock()
+	defer l.RUnlock()
+	defer b.Unlock()
+	b.BitSet.Difference(l, r)
+}
+
+func (b *BitSet) isSubsetOf(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	for v, ok := b.NextSet(0); ok; v, ok = b.NextSet(v + 1) {
+		if !other.ContainsPntr(&v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Description: Returns true if this bit set is a superset to other. If other
+// is a bit set the comparison is performed 64 values at a time.
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of
+// other divided by 64. Otherwise O(n), where n is the number of values in
+// other.
+func (b *BitSet) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	if b.numSet < other.Length() {
+		return false
+	}
+	if o, ok := bitSetFromOther(other); ok {
+		for i := 0; i < len(o.words); i++ {
+			if o.words[i]&^b.word(i) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	rv := true
+	addressableSafeValIter[int](other).ForEach(
+		func(index int, val *int) (iter.IteratorFeedback, error) {
+			if rv = b.ContainsPntr(val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.IsSuperset] method. Attempts to place a read
+// lock on other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this bit set, read on other
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of
+// other divided by 64. Otherwise O(n), where n is the number of values in
+// other.
+func (b *SyncedBitSet) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	b.RLock()
+	other.RLock()
+	defer b.RUnlock()
+	defer other.RUnlock()
+	return b.BitSet.IsSuperset(other)
+}
+
+// Description: Returns true if this bit set is a subset to other. If other is
+// a bit set the comparison is performed 64 values at a time.
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of this
+// bit set divided by 64. Otherwise dependent on the ContainsPntr method of
+// other. In big-O terms it may look somwthing like this:
+// O(n*O(other.ContainsPntr)), where n is the number of values in the bit set
+// and other.ContainsPntr represents the time complexity of the containsPntr
+// method on other.
+func (b *BitSet) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	if b.numSet > other.Length() {
+		return false
+	}
+	if o, ok := bitSetFromOther(other); ok {
+		for i := 0; i < len(b.words); i++ {
+			if b.words[i]&^o.word(i) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return b.isSubsetOf(other)
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.IsSubset] method. Attempts to place a read lock
+// on other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this bit set, read on other
+//
+// Time Complexity: O(m) if other is a bit set, where m is the capacity of this
+// bit set divided by 64. Otherwise dependent on the ContainsPntr method of
+// other. In big-O terms it may look somwthing like this:
+// O(n*O(other.ContainsPntr)), where n is the number of values in the bit set
+// and other.ContainsPntr represents the time complexity of the containsPntr
+// method on other.
+func (b *SyncedBitSet) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[int],
+) bool {
+	b.RLock()
+	other.RLock()
+	defer b.RUnlock()
+	defer other.RUnlock()
+	return b.BitSet.IsSubset(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two bit sets are equal if they contain the same values, their
+// capacities are not considered. Returns true if l==r, false otherwise.
+func (_ *BitSet) Eq(l *BitSet, r *BitSet) bool {
+	return l.UnorderedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying bit
+// sets [BitSet.Eq] method. Returns true if l==r, false otherwise.
+func (_ *SyncedBitSet) Eq(l *SyncedBitSet, r *SyncedBitSet) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.BitSet.Eq(&l.BitSet, &r.BitSet)
+}
+
+// A function that returns a hash of a bit set. The hash is created from the
+// words of the bit set up to the last word that has a bit set, making it so
+// the hash will represent the same equality operation that [BitSet.Eq]
+// provides.
+func (_ *BitSet) Hash(other *BitSet) hash.Hash {
+	last := len(other.words) - 1
+	for ; last >= 0 && other.words[last] == 0; last-- {
+	}
+	var rv hash.Hash
+	for i := 0; i <= last; i++ {
+		rv = rv.Combine(hash.Hash(other.words[i]))
+	}
+	return rv
+}
+
+// Places a read lock on the underlying bit set of other and then calls others
+// underlying bit sets [BitSet.Hash] method.
+func (_ *SyncedBitSet) Hash(other *SyncedBitSet) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.BitSet.Hash(&other.BitSet)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [BitSet.Clear].
+func (_ *BitSet) Zero(other *BitSet) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedBitSet.Clear].
+func (_ *SyncedBitSet) Zero(other *SyncedBitSet) {
+	other.Clear()
+}
+
+type serializedBitSet struct {
+	Capacity int      `json:"capacity"`
+	Words    []uint64 `json:"words"`
+}
+
+func (b *BitSet) toSerialized() serializedBitSet {
+	return serializedBitSet{
+		Capacity: b.size,
+		Words:    b.words,
+	}
+}
+
+func (b *BitSet) fromSerialized(s serializedBitSet) error {
+	if s.Capacity < 0 {
+		return getSizeError(s.Capacity)
+	}
+	if len(s.Words) != (s.Capacity+63)/64 {
+		return getMalformedDataError(fmt.Sprintf(
+			"expected %d words for a capacity of %d, got %d",
+			(s.Capacity+63)/64, s.Capacity, len(s.Words),
+		))
+	}
+	if rem := s.Capacity % 64; rem != 0 && s.Words[len(s.Words)-1]>>rem != 0 {
+		return getMalformedDataError("bits past the capacity of the set are set")
+	}
+	b.words = s.Words
+	b.size = s.Capacity
+	b.normalize()
+	return nil
+}
+
+// Description: Returns the JSON encoding of the bit set. The capacity of the
+// bit set is encoded along with the underlying words, where the value v is
+// represented by bit v%64 of word v/64.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) MarshalJSON() ([]byte, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.MarshalJSON()
+}
+
+// Description: Replaces the contents of the bit set with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [BitSet.MarshalJSON]. If an error is returned the bit set is left unchanged.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedBitSet](data)
+	if err != nil {
+		return err
+	}
+	return b.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying bit set while its contents are replaced. Exhibits the same
+// behavior as [BitSet.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedBitSet](data)
+	if err != nil {
+		return err
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the bit set. The values
+// are laid out the same way as [BitSet.MarshalJSON] and are encoded using the
+// [encoding/gob] package.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	return marshalBinary(b.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bit set and then calls the
+// underlying bit sets [BitSet.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) MarshalBinary() ([]byte, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BitSet.MarshalBinary()
+}
+
+// Description: Replaces the contents of the bit set with the values decoded
+// from the supplied binary data, which must have been produced by
+// [BitSet.MarshalBinary]. If an error is returned the bit set is left
+// unchanged.
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedBitSet](data)
+	if err != nil {
+		return err
+	}
+	return b.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying bit set while its contents are replaced. Exhibits the same
+// behavior as [BitSet.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the capacity of the bit set divided by 64
+func (b *SyncedBitSet) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedBitSet](data)
+	if err != nil {
+		return err
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.BitSet.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values are printed in
+// ascending order.
+func (b BitSet) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("bitSet["))
+	for v, ok := b.NextSet(0); ok; {
+		fmt.Fprintf(f, fmtStr, v)
+		if v, ok = b.NextSet(v + 1); ok {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (b *BitSet) String() string {
+	return fmt.Sprintf("%v", b)
+}
@@ -0,0 +1,134 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func BitSetToSetInterfaceFactory(capacity int) dynamicContainers.Set[int] {
+	v := generateBitSet(capacity)
+	var rv dynamicContainers.Set[int] = v
+	return rv
+}
+
+func TestBitSet_DynSetInterfaceSyncableInterface(t *testing.T) {
+	tests.DynSetInterfaceSyncableInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceAddressableInterface(t *testing.T) {
+	tests.DynSetInterfaceAddressableInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceLengthInterface(t *testing.T) {
+	tests.DynSetInterfaceLengthInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceClearInterface(t *testing.T) {
+	tests.DynSetInterfaceClearInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceWriteUniqueOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceWriteUniqueOpsInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceReadOpsInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceDeleteOpsInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_ReadDynSetInterface(t *testing.T) {
+	tests.ReadDynSetInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_WriteDynSetInterface(t *testing.T) {
+	tests.WriteDynSetInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceInterface(t *testing.T) {
+	tests.DynSetInterfaceInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynSetInterfaceStaticCapacityInterface(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceVals(t *testing.T) {
+	tests.DynSetInterfaceVals(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceValPntrs(t *testing.T) {
+	tests.DynSetInterfaceValPntrs(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceContainsPntr(t *testing.T) {
+	tests.DynSetInterfaceContainsPntr(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceGetUnique(t *testing.T) {
+	tests.DynSetInterfaceGetUnique(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceContains(t *testing.T) {
+	tests.DynSetInterfaceContains(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceClear(t *testing.T) {
+	tests.DynSetInterfaceClear(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceAppendUnique(t *testing.T) {
+	tests.DynSetInterfaceAppendUnique(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceUpdateUnique(t *testing.T) {
+	tests.DynSetInterfaceUpdateUnique(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfacePop(t *testing.T) {
+	tests.DynSetInterfacePop(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfacePopPntr(t *testing.T) {
+	tests.DynSetInterfacePopPntr(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceUnorderedEq(t *testing.T) {
+	tests.DynSetInterfaceUnorderedEq(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceIntersection(t *testing.T) {
+	tests.DynSetInterfaceIntersection(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceUnion(t *testing.T) {
+	tests.DynSetInterfaceUnion(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceDifference(t *testing.T) {
+	tests.DynSetInterfaceDifference(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceIsSuperset(t *testing.T) {
+	tests.DynSetInterfaceIsSuperset(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceIsSubset(t *testing.T) {
+	tests.DynSetInterfaceIsSubset(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(BitSetToSetInterfaceFactory, t)
+}
+
+func TestBitSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(BitSetToSetInterfaceFactory, t)
+}
@@ -0,0 +1,416 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=BitSet -category=dynamic -interface=Set -genericDecl=[int] -factory=generateBitSet -pntrFactory
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedBitSet -category=dynamic -interface=Set -genericDecl=[int] -factory=generateSyncedBitSet -pntrFactory
+
+func generateBitSet(capacity int) *BitSet {
+	v, _ := NewBitSet(capacity)
+	return &v
+}
+
+func generateSyncedBitSet(capacity int) *SyncedBitSet {
+	v, _ := NewSyncedBitSet(capacity)
+	return &v
+}
+
+func TestBitSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[BitSet]
+	v, _ := NewBitSet(5)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedBitSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedBitSet]
+	v, _ := NewSyncedBitSet(5)
+	widget = &v
+	_ = widget
+}
+
+func TestBitSetNewErrors(t *testing.T) {
+	_, err := NewBitSet(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedBitSet(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	b, err := NewBitSet(0)
+	test.Nil(err, t)
+	test.Eq(0, b.Length(), t)
+	test.Eq(0, b.Capacity(), t)
+	test.Nil(b.AppendUnique(0), t)
+	test.Eq(1, b.Capacity(), t)
+}
+
+func TestBitSetAppendUniqueGrows(t *testing.T) {
+	b, _ := NewBitSet(70)
+	test.Nil(b.AppendUnique(1, 70, 2), t)
+	test.Eq(3, b.Length(), t)
+	test.Eq(71, b.Capacity(), t)
+	test.Nil(b.AppendUnique(69, 64, 63), t)
+	test.Eq(6, b.Length(), t)
+	test.Eq(71, b.Capacity(), t)
+	test.Nil(b.AppendUnique(300), t)
+	test.Eq(301, b.Capacity(), t)
+	test.True(b.Contains(300), t)
+	test.False(b.Contains(299), t)
+	res, err := b.Rank(301)
+	test.Nil(err, t)
+	test.Eq(7, res, t)
+	err = b.AppendUnique(5, -1, 6)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.Eq(8, b.Length(), t)
+	test.True(b.Contains(5), t)
+	test.False(b.Contains(-1), t)
+	test.False(b.Contains(6), t)
+	test.Eq(301, b.Capacity(), t)
+}
+
+func TestBitSetRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{1, 63, 64, 65, 200} {
+		b, _ := NewBitSet(n)
+		exp := map[int]struct{}{}
+		sorted := func() []int {
+			rv := []int{}
+			for v := range exp {
+				rv = append(rv, v)
+			}
+			sort.Ints(rv)
+			return rv
+		}
+		for op := 0; op < 2000; op++ {
+			v := r.Intn(n)
+			switch r.Intn(6) {
+			case 0, 1:
+				test.Nil(b.AppendUnique(v), t)
+				exp[v] = struct{}{}
+			case 2:
+				_, ok := exp[v]
+				if ok {
+					test.Eq(1, b.Pop(v), t)
+				} else {
+					test.Eq(0, b.Pop(v), t)
+				}
+				delete(exp, v)
+			case 3:
+				cnt := 0
+				for k := range exp {
+					if k < v {
+						cnt++
+					}
+				}
+				res, err := b.Rank(v)
+				test.Nil(err, t)
+				test.Eq(cnt, res, t)
+			case 4:
+				s := sorted()
+				if len(s) > 0 {
+					k := r.Intn(len(s))
+					res, err := b.Select(k)
+					test.Nil(err, t)
+					test.Eq(s[k], res, t)
+					rank, _ := b.Rank(res)
+					test.Eq(k, rank, t)
+				}
+			case 5:
+				end := v + r.Intn(n-v+1)
+				cnt := 0
+				for k := range exp {
+					if k >= v && k < end {
+						cnt++
+					}
+				}
+				res, err := b.PopCount(v, end)
+				test.Nil(err, t)
+				test.Eq(cnt, res, t)
+			}
+			test.Eq(len(exp), b.Length(), t)
+		}
+		vals, err := b.Vals().Collect()
+		test.Nil(err, t)
+		test.SlicesMatch[int](sorted(), vals, t)
+	}
+}
+
+func TestBitSetRankSelectErrors(t *testing.T) {
+	b, _ := NewBitSet(10)
+	b.AppendUnique(3, 7)
+	_, err := b.Rank(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = b.Rank(11)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	res, err := b.Rank(10)
+	test.Nil(err, t)
+	test.Eq(2, res, t)
+	_, err = b.Select(2)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = b.Select(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = b.PopCount(-1, 5)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = b.PopCount(0, 11)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = b.PopCount(5, 4)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	res, err = b.PopCount(3, 7)
+	test.Nil(err, t)
+	test.Eq(1, res, t)
+}
+
+func TestBitSetNextSet(t *testing.T) {
+	b, _ := NewBitSet(300)
+	b.AppendUnique(0, 64, 65, 299)
+	for _, c := range []struct{ from, exp int }{
+		{-5, 0}, {0, 0}, {1, 64}, {64, 64}, {65, 65}, {66, 299}, {299, 299},
+	} {
+		res, ok := b.NextSet(c.from)
+		test.True(ok, t)
+		test.Eq(c.exp, res, t)
+	}
+	_, ok := b.NextSet(300)
+	test.False(ok, t)
+	b.Pop(299)
+	_, ok = b.NextSet(66)
+	test.False(ok, t)
+	vals, _ := b.Vals().Take(2).Collect()
+	test.SlicesMatch[int]([]int{0, 64}, vals, t)
+}
+
+func TestBitSetShifts(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 64, 100, 257} {
+		for _, shift := range []int{0, 1, 5, 63, 64, 65, 128, 130, 300} {
+			left, _ := NewBitSet(n)
+			right, _ := NewBitSet(n)
+			vals := []int{}
+			for i := 0; i < n/2+1; i++ {
+				v := r.Intn(n)
+				left.AppendUnique(v)
+				right.AppendUnique(v)
+				vals = append(vals, v)
+			}
+			test.Nil(left.ShiftLeft(shift), t)
+			test.Nil(right.ShiftRight(shift), t)
+
+			expLeft, _ := NewBitSet(n)
+			expRight, _ := NewBitSet(n)
+			for _, v := range vals {
+				expLeft.AppendUnique(v + shift)
+				if v-shift >= 0 {
+					expRight.AppendUnique(v - shift)
+				}
+			}
+			test.True(expLeft.Eq(&expLeft, &left), t)
+			test.Eq(expLeft.Length(), left.Length(), t)
+			test.True(expRight.Eq(&expRight, &right), t)
+			test.Eq(expRight.Length(), right.Length(), t)
+			test.Eq(n+shift, left.Capacity(), t)
+			test.Eq(n, right.Capacity(), t)
+		}
+	}
+	b, _ := NewBitSet(5)
+	b.AppendUnique(1)
+	test.ContainsError(customerr.ValOutsideRange, b.ShiftLeft(-1), t)
+	test.ContainsError(customerr.ValOutsideRange, b.ShiftRight(-1), t)
+	test.True(b.Contains(1), t)
+}
+
+func TestBitSetSetOperationsWordParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, sizes := range [][2]int{{10, 10}, {64, 200}, {200, 64}, {130, 129}} {
+		l, _ := NewBitSet(sizes[0])
+		rb, _ := NewBitSet(sizes[1])
+		for i := 0; i < sizes[0]/2; i++ {
+			l.AppendUnique(r.Intn(sizes[0]))
+		}
+		for i := 0; i < sizes[1]/2; i++ {
+			rb.AppendUnique(r.Intn(sizes[1]))
+		}
+		lVals, _ := l.Vals().Collect()
+		rVals, _ := rb.Vals().Collect()
+		lh := HashSetValInit[int, widgets.BuiltinInt](lVals...)
+		rh := HashSetValInit[int, widgets.BuiltinInt](rVals...)
+
+		var res BitSet
+		var expRes HashSet[int, widgets.BuiltinInt]
+		res.Intersection(&l, &rb)
+		expRes.Intersection(&lh, &rh)
+		test.Eq(min(sizes[0], sizes[1]), res.Capacity(), t)
+		test.True(res.UnorderedEq(&expRes), t)
+
+		res.Union(&l, &rb)
+		expRes.Union(&lh, &rh)
+		test.Eq(max(sizes[0], sizes[1]), res.Capacity(), t)
+		test.True(res.UnorderedEq(&expRes), t)
+
+		res.Difference(&l, &rb)
+		expRes.Difference(&lh, &rh)
+		test.Eq(sizes[0], res.Capacity(), t)
+		test.True(res.UnorderedEq(&expRes), t)
+
+		res.Difference(&rb, &l)
+		expRes.Difference(&rh, &lh)
+		test.Eq(sizes[1], res.Capacity(), t)
+		test.True(res.UnorderedEq(&expRes), t)
+
+		test.Eq(lh.IsSuperset(&rh), l.IsSuperset(&rb), t)
+		test.Eq(lh.IsSubset(&rh), l.IsSubset(&rb), t)
+		res.Intersection(&l, &rb)
+		test.True(l.IsSuperset(&res), t)
+		test.True(res.IsSubset(&rb), t)
+	}
+}
+
+func TestBitSetSetOperationsOtherContainers(t *testing.T) {
+	b, _ := NewBitSet(10)
+	b.AppendUnique(1, 2, 3, 9)
+	h := HashSetValInit[int, widgets.BuiltinInt](-1, 2, 3, 20)
+
+	var res BitSet
+	res.Intersection(&b, &h)
+	test.Eq(4, res.Capacity(), t)
+	test.Eq("bitSet[2 3]", res.String(), t)
+	res.Union(&b, &h)
+	test.Eq(21, res.Capacity(), t)
+	test.Eq("bitSet[1 2 3 9 20]", res.String(), t)
+	res.Difference(&b, &h)
+	test.Eq(10, res.Capacity(), t)
+	test.Eq("bitSet[1 9]", res.String(), t)
+	res.Difference(&h, &b)
+	test.Eq(21, res.Capacity(), t)
+	test.Eq("bitSet[20]", res.String(), t)
+
+	h = HashSetValInit[int, widgets.BuiltinInt](1, 2, 3, 9)
+	test.True(b.UnorderedEq(&h), t)
+	test.True(b.IsSuperset(&h), t)
+	test.True(b.IsSubset(&h), t)
+	h.AppendUnique(-1)
+	test.False(b.UnorderedEq(&h), t)
+	test.False(b.IsSuperset(&h), t)
+	test.True(b.IsSubset(&h), t)
+}
+
+func TestBitSetSetOperationsAliased(t *testing.T) {
+	l, _ := NewBitSet(100)
+	r, _ := NewBitSet(100)
+	l.AppendUnique(1, 2, 3, 4, 70)
+	r.AppendUnique(2, 4, 5, 70)
+	l.Intersection(&l, &r)
+	test.Eq("bitSet[2 4 70]", l.String(), t)
+	l.Union(&l, &r)
+	test.Eq("bitSet[2 4 5 70]", l.String(), t)
+	r.AppendUnique(99)
+	r.Difference(&r, &l)
+	test.Eq("bitSet[99]", r.String(), t)
+}
+
+func TestBitSetSetCapacity(t *testing.T) {
+	b, _ := NewBitSet(130)
+	b.AppendUnique(0, 63, 64, 100, 129)
+	test.ContainsError(customerr.ValOutsideRange, b.SetCapacity(-1), t)
+	test.Eq(130, b.Capacity(), t)
+	test.Nil(b.SetCapacity(100), t)
+	test.Eq(100, b.Capacity(), t)
+	test.Eq("bitSet[0 63 64]", b.String(), t)
+	test.Eq(3, b.Length(), t)
+	test.Nil(b.SetCapacity(1000), t)
+	test.False(b.Contains(100), t)
+	test.Nil(b.AppendUnique(999), t)
+	test.Eq(4, b.Length(), t)
+}
+
+func TestBitSetEqHashFormat(t *testing.T) {
+	l, _ := NewBitSet(10)
+	r, _ := NewBitSet(200)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	l.AppendUnique(1, 5)
+	test.False(l.Eq(&l, &r), t)
+	r.AppendUnique(5, 1)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	r.AppendUnique(150)
+	test.False(l.Eq(&l, &r), t)
+	test.False(r.Eq(&r, &l), t)
+	test.Eq("bitSet[1 5]", l.String(), t)
+	test.Eq("bitSet[1 5]", fmt.Sprint(&l), t)
+	l.Zero(&l)
+	test.Eq(0, l.Length(), t)
+	test.Eq(10, l.Capacity(), t)
+	test.Eq("bitSet[]", l.String(), t)
+}
+
+func TestSyncedBitSetConcurrent(t *testing.T) {
+	b, _ := NewSyncedBitSet(1000)
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				v := j*250 + i
+				b.AppendUnique(v)
+				b.Contains(v)
+				b.Rank(v)
+				b.Vals().Collect()
+				if i%2 == 0 {
+					b.Pop(v)
+				}
+			}
+		}(j)
+	}
+	wg.Wait()
+	test.Eq(500, b.Length(), t)
+	res, err := b.Select(0)
+	test.Nil(err, t)
+	test.Eq(1, res, t)
+}
+
+func TestBitSetSerialization(t *testing.T) {
+	b, _ := NewBitSet(70)
+	b.AppendUnique(0, 3, 64, 69)
+	data, err := json.Marshal(&b)
+	test.Nil(err, t)
+	test.Eq(`{"capacity":70,"words":[9,33]}`, string(data), t)
+	for _, binary := range []bool{false, true} {
+		if binary {
+			data, err = b.MarshalBinary()
+			test.Nil(err, t)
+		}
+		res, _ := NewBitSet(2)
+		res.AppendUnique(1)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(b.Eq(&b, &res), t)
+		test.Eq(70, res.Capacity(), t)
+		test.Eq(4, res.Length(), t)
+	}
+
+	for _, bad := range []string{
+		`{"capacity":-1,"words":[]}`,
+		`{"capacity":70,"words":[1]}`,
+		`{"capacity":3,"words":[8]}`,
+	} {
+		err = json.Unmarshal([]byte(bad), &b)
+		test.NotNil(err, t)
+		test.Eq(4, b.Length(), t)
+		test.Eq(70, b.Capacity(), t)
+	}
+	err = json.Unmarshal([]byte(`{"capacity":3,"words":[8]}`), &b)
+	test.ContainsError(customerr.InvalidValue, err, t)
+}
@@ -0,0 +1,817 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	bloomFilterState struct {
+		bits      []uint64
+		numBits   int
+		numHashes int
+	}
+
+	// A type to represent a probabilistic set. A bloom filter can tell if a
+	// value is definitely not in the set or if it may be in the set, while only
+	// using a fixed number of bits regardless of how many values are added.
+	// Values cannot be removed from a bloom filter, see [CountingBloomFilter]
+	// for a variant that supports removal. The positions of the bits that a
+	// value maps to are derived from the hash that the U widget produces using
+	// double hashing. Copies of a bloom filter share the same underlying state,
+	// the same as the builtin map type.
+	BloomFilter[T any, U widgets.BaseInterface[T]] struct {
+		state *bloomFilterState
+	}
+
+	// A synchronized version of BloomFilter. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedBloomFilter[T any, U widgets.BaseInterface[T]] struct {
+		*sync.RWMutex
+		BloomFilter[T, U]
+	}
+)
+
+const (
+	bloomFilterSeed1 hash.Hash = 0x243f6a8885a308d3
+	bloomFilterSeed2 hash.Hash = 0x13198a2e03707344
+)
+
+// Calls op with each of the numHashes positions that v maps to in a filter
+// with size positions. Stops early and returns false if op returns false.
+// Double hashing is used so that only a single call to the widgets hash
+// function is needed regardless of the number of hashes.
+func bloomFilterPositions[T any, U widgets.BaseInterface[T]](
+	v *T,
+	size int,
+	numHashes int,
+	op func(pos int) bool,
+) bool {
+	w := widgets.Base[T, U]{}
+	base := w.Hash(v)
+	h1 := uint64(base.Combine(bloomFilterSeed1).Combine(bloomFilterSeed2))
+	// The second hash is forced to be odd so that it is never 0, which would
+	// cause every position to be the same.
+	h2 := uint64(base.Combine(bloomFilterSeed2).Combine(bloomFilterSeed1)) | 1
+	for i := 0; i < numHashes; i++ {
+		if !op(int((h1 + uint64(i)*h2) % uint64(size))) {
+			return false
+		}
+	}
+	return true
+}
+
+// Description: Returns the number of bits and the number of hashes that a bloom
+// filter needs to hold numVals values while keeping the false positive rate at
+// or below falsePositiveRate. NumVals must be > 0 and falsePositiveRate must be
+// > 0 and < 1, an error will be returned if they are not. The returned values
+// can be used with [NewBloomFilter] and [NewCountingBloomFilter].
+//
+// Time Complexity: O(1)
+func BloomFilterSize(
+	numVals int,
+	falsePositiveRate float64,
+) (numBits int, numHashes int, err error) {
+	if numVals <= 0 {
+		return 0, 0, getMustBePositiveError("number of values", numVals)
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		return 0, 0, getFalsePositiveRateError(falsePositiveRate)
+	}
+	m := math.Ceil(
+		-float64(numVals) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2),
+	)
+	k := math.Round(m / float64(numVals) * math.Ln2)
+	return int(m), max(1, int(k)), nil
+}
+
+// Description: Returns the expected false positive rate of a bloom filter with
+// numBits bits and numHashes hashes once numVals values have been added to it.
+// Returns 1 if numBits or numHashes are not > 0.
+//
+// Time Complexity: O(1)
+func BloomFilterFalsePositiveRate(
+	numBits int,
+	numHashes int,
+	numVals int,
+) float64 {
+	if numBits <= 0 || numHashes <= 0 {
+		return 1
+	}
+	return math.Pow(
+		1-math.Exp(-float64(numHashes)*float64(numVals)/float64(numBits)),
+		float64(numHashes),
+	)
+}
+
+// Returns the approximate number of values that were added to a filter with
+// size positions, numHashes hashes, and numSet set positions.
+func bloomFilterApproxLength(size int, numHashes int, numSet int) int {
+	if numSet >= size {
+		return math.MaxInt
+	}
+	return int(math.Round(
+		-float64(size) / float64(numHashes) *
+			math.Log(1-float64(numSet)/float64(size)),
+	))
+}
+
+// Creates a new bloom filter with numBits bits that sets numHashes bits for
+// every value that is added. NumBits and numHashes must be > 0, an error will be
+// returned if they are not. [BloomFilterSize] can be used to calculate these
+// values from an expected number of values and false positive rate.
+func NewBloomFilter[T any, U widgets.BaseInterface[T]](
+	numBits int,
+	numHashes int,
+) (BloomFilter[T, U], error) {
+	if numBits <= 0 {
+		return BloomFilter[T, U]{}, getMustBePositiveError("number of bits", numBits)
+	}
+	if numHashes <= 0 {
+		return BloomFilter[T, U]{}, getMustBePositiveError("number of hashes", numHashes)
+	}
+	return BloomFilter[T, U]{
+		state: &bloomFilterState{
+			bits:      make([]uint64, (numBits+63)/64),
+			numBits:   numBits,
+			numHashes: numHashes,
+		},
+	}, nil
+}
+
+// Creates a new synced bloom filter with numBits bits that sets numHashes bits
+// for every value that is added. NumBits and numHashes must be > 0, an error
+// will be returned if they are not. The underlying RWMutex value will be fully
+// unlocked upon initialization.
+func NewSyncedBloomFilter[T any, U widgets.BaseInterface[T]](
+	numBits int,
+	numHashes int,
+) (SyncedBloomFilter[T, U], error) {
+	rv, err := NewBloomFilter[T, U](numBits, numHashes)
+	return SyncedBloomFilter[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		BloomFilter: rv,
+	}, err
+}
+
+// Creates a new bloom filter that is sized to hold numVals values while keeping
+// the false positive rate at or below falsePositiveRate. Returns an error under
+// the same conditions as [BloomFilterSize].
+func NewBloomFilterForRate[T any, U widgets.BaseInterface[T]](
+	numVals int,
+	falsePositiveRate float64,
+) (BloomFilter[T, U], error) {
+	numBits, numHashes, err := BloomFilterSize(numVals, falsePositiveRate)
+	if err != nil {
+		return BloomFilter[T, U]{}, err
+	}
+	return NewBloomFilter[T, U](numBits, numHashes)
+}
+
+// Creates a new synced bloom filter that is sized to hold numVals values while
+// keeping the false positive rate at or below falsePositiveRate. Returns an
+// error under the same conditions as [BloomFilterSize]. The underlying RWMutex
+// value will be fully unlocked upon initialization.
+func NewSyncedBloomFilterForRate[T any, U widgets.BaseInterface[T]](
+	numVals int,
+	falsePositiveRate float64,
+) (SyncedBloomFilter[T, U], error) {
+	rv, err := NewBloomFilterForRate[T, U](numVals, falsePositiveRate)
+	return SyncedBloomFilter[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		BloomFilter: rv,
+	}, err
+}
+
+// Converts the supplied bloom filter to a synchronized bloom filter. Beware:
+// The original non-synced bloom filter will remain useable.
+func (b *BloomFilter[T, U]) ToSynced() SyncedBloomFilter[T, U] {
+	return SyncedBloomFilter[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		BloomFilter: *b,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (b *BloomFilter[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (b *BloomFilter[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (b *BloomFilter[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (b *BloomFilter[T, U]) RUnlock() {}
+
+// The SyncedBloomFilter method to override the BloomFilter pass through
+// function and actually apply the mutex operation.
+func (b *SyncedBloomFilter[T, U]) Lock() { b.RWMutex.Lock() }
+
+// The SyncedBloomFilter method to override the BloomFilter pass through
+// function and actually apply the mutex operation.
+func (b *SyncedBloomFilter[T, U]) Unlock() { b.RWMutex.Unlock() }
+
+// The SyncedBloomFilter method to override the BloomFilter pass through
+// function and actually apply the mutex operation.
+func (b *SyncedBloomFilter[T, U]) RLock() { b.RWMutex.RLock() }
+
+// The SyncedBloomFilter method to override the BloomFilter pass through
+// function and actually apply the mutex operation.
+func (b *SyncedBloomFilter[T, U]) RUnlock() { b.RWMutex.RUnlock() }
+
+// Returns false, a bloom filter is not synced.
+func (b *BloomFilter[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced bloom filter is synced.
+func (b *SyncedBloomFilter[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of bits in the bloom filter.
+//
+// Time Complexity: O(1)
+func (b *BloomFilter[T, U]) NumBits() int {
+	return b.state.numBits
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.NumBits] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBloomFilter[T, U]) NumBits() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.NumBits()
+}
+
+// Description: Returns the number of bits that are set for each value that is
+// added to the bloom filter.
+//
+// Time Complexity: O(1)
+func (b *BloomFilter[T, U]) NumHashes() int {
+	return b.state.numHashes
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.NumHashes] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (b *SyncedBloomFilter[T, U]) NumHashes() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.NumHashes()
+}
+
+// Description: Returns the number of bits that are set in the bloom filter.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) PopCount() int {
+	rv := 0
+	for _, word := range b.state.bits {
+		rv += bits.OnesCount64(word)
+	}
+	return rv
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.PopCount] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) PopCount() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.PopCount()
+}
+
+// Description: Returns an estimate of the number of unique values that have
+// been added to the bloom filter based on the number of bits that are set. If
+// every bit is set the estimate is unbounded and [math.MaxInt] is returned.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) ApproxLength() int {
+	return bloomFilterApproxLength(
+		b.state.numBits, b.state.numHashes, b.PopCount(),
+	)
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.ApproxLength] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) ApproxLength() int {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.ApproxLength()
+}
+
+// Description: Returns the current false positive rate of the bloom filter,
+// which is the probability that [BloomFilter.MayContain] returns true for a
+// value that was never added. This is calculated from the fraction of bits that
+// are set rather than the number of values that were added.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) FalsePositiveRate() float64 {
+	return math.Pow(
+		float64(b.PopCount())/float64(b.state.numBits),
+		float64(b.state.numHashes),
+	)
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.FalsePositiveRate] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) FalsePositiveRate() float64 {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.FalsePositiveRate()
+}
+
+// Description: Adds the supplied values to the bloom filter. This function will
+// never return an error.
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (b *BloomFilter[T, U]) Add(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		b.addImpl(&vals[i])
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.Add] implementation method.
+// The [BloomFilter.Add] method is not called directly to avoid copying the vals
+// varargs twice, which could be expensive with a large type for the T generic
+// or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (b *SyncedBloomFilter[T, U]) Add(vals ...T) error {
+	b.Lock()
+	defer b.Unlock()
+	for i := 0; i < len(vals); i++ {
+		b.addImpl(&vals[i])
+	}
+	return nil
+}
+
+// Sets all of the bits for v, returning true if all of the bits were already
+// set.
+func (b *BloomFilter[T, U]) addImpl(v *T) bool {
+	rv := true
+	bloomFilterPositions[T, U](
+		v, b.state.numBits, b.state.numHashes,
+		func(pos int) bool {
+			mask := uint64(1) << (pos % 64)
+			rv = rv && b.state.bits[pos/64]&mask != 0
+			b.state.bits[pos/64] |= mask
+			return true
+		},
+	)
+	return rv
+}
+
+// Description: Adds the supplied value to the bloom filter and returns true if
+// the value may have already been in the bloom filter. If false is returned the
+// value was definitely not in the bloom filter before it was added. This is
+// useful when using a bloom filter to remove duplicate values from a stream of
+// values.
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *BloomFilter[T, U]) TestAndAdd(v T) bool {
+	return b.addImpl(&v)
+}
+
+// Description: Places a write lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.TestAndAdd] implementation
+// method. The check and the add are performed atomically.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *SyncedBloomFilter[T, U]) TestAndAdd(v T) bool {
+	b.Lock()
+	defer b.Unlock()
+	return b.addImpl(&v)
+}
+
+// Description: Returns false if the supplied value is definitely not in the
+// bloom filter and true if the value may be in the bloom filter. The
+// probability of a false positive is given by [BloomFilter.FalsePositiveRate].
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *BloomFilter[T, U]) MayContain(v T) bool {
+	return b.MayContainPntr(&v)
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.MayContainPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *SyncedBloomFilter[T, U]) MayContain(v T) bool {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.MayContainPntr(&v)
+}
+
+// Description: Returns false if the supplied value is definitely not in the
+// bloom filter and true if the value may be in the bloom filter. The
+// probability of a false positive is given by [BloomFilter.FalsePositiveRate].
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *BloomFilter[T, U]) MayContainPntr(v *T) bool {
+	return bloomFilterPositions[T, U](
+		v, b.state.numBits, b.state.numHashes,
+		func(pos int) bool {
+			return b.state.bits[pos/64]&(uint64(1)<<(pos%64)) != 0
+		},
+	)
+}
+
+// Description: Places a read lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.MayContainPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (b *SyncedBloomFilter[T, U]) MayContainPntr(v *T) bool {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.MayContainPntr(v)
+}
+
+func (b *BloomFilter[T, U]) combine(
+	l *BloomFilter[T, U],
+	r *BloomFilter[T, U],
+	op func(l uint64, r uint64) uint64,
+) error {
+	if l.state.numBits != r.state.numBits ||
+		l.state.numHashes != r.state.numHashes {
+		return getIncompatibleFiltersError(
+			l.state.numBits, l.state.numHashes,
+			r.state.numBits, r.state.numHashes,
+		)
+	}
+	newBits := make([]uint64, len(l.state.bits))
+	for i := 0; i < len(newBits); i++ {
+		newBits[i] = op(l.state.bits[i], r.state.bits[i])
+	}
+	b.state.bits = newBits
+	b.state.numBits = l.state.numBits
+	b.state.numHashes = l.state.numHashes
+	return nil
+}
+
+// Description: Populates the bloom filter with the union of the l and r bloom
+// filters. Any value that may be in l or r may be in the resulting bloom
+// filter. The current contents of the bloom filter are discarded, and the
+// bloom filter will take on the size and number of hashes of l and r. The
+// bloom filter may be the same as l or r. L and r must have the same number of
+// bits and hashes, a [customerr.DimensionsDoNotAgree] error will be returned
+// if they do not and the bloom filter will not be modified.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) Union(l *BloomFilter[T, U], r *BloomFilter[T, U]) error {
+	return b.combine(l, r, func(l uint64, r uint64) uint64 { return l | r })
+}
+
+// Description: Places a write lock on the underlying bloom filter and read
+// locks on l and r and then calls the underlying bloom filters
+// [BloomFilter.Union] method. The bloom filter may be the same as l or r.
+//
+// Lock Type: Write on this bloom filter, read on l and r
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) Union(
+	l *SyncedBloomFilter[T, U],
+	r *SyncedBloomFilter[T, U],
+) error {
+	defer b.lockForCombine(l, r)()
+	return b.BloomFilter.Union(&l.BloomFilter, &r.BloomFilter)
+}
+
+// Description: Populates the bloom filter with the intersection of the l and r
+// bloom filters. Any value that may be in both l and r may be in the resulting
+// bloom filter. The false positive rate of the result may be higher than a
+// bloom filter that was populated with only the values in the intersection.
+// The current contents of the bloom filter are discarded, and the bloom filter
+// will take on the size and number of hashes of l and r. The bloom filter may
+// be the same as l or r. L and r must have the same number of bits and hashes,
+// a [customerr.DimensionsDoNotAgree] error will be returned if they do not and
+// the bloom filter will not be modified.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) Intersection(
+	l *BloomFilter[T, U],
+	r *BloomFilter[T, U],
+) error {
+	return b.combine(l, r, func(l uint64, r uint64) uint64 { return l & r })
+}
+
+// Description: Places a write lock on the underlying bloom filter and read
+// locks on l and r and then calls the underlying bloom filters
+// [BloomFilter.Intersection] method. The bloom filter may be the same as l or
+// r.
+//
+// Lock Type: Write on this bloom filter, read on l and r
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) Intersection(
+	l *SyncedBloomFilter[T, U],
+	r *SyncedBloomFilter[T, U],
+) error {
+	defer b.lockForCombine(l, r)()
+	return b.BloomFilter.Intersection(&l.BloomFilter, &r.BloomFilter)
+}
+
+// Locks b for writing and l and r for reading, skipping any locks that are
+// shared so the same lock is never acquired twice. Returns a function that
+// releases all of the acquired locks.
+func (b *SyncedBloomFilter[T, U]) lockForCombine(
+	l *SyncedBloomFilter[T, U],
+	r *SyncedBloomFilter[T, U],
+) func() {
+	b.Lock()
+	lLocked := l.RWMutex != b.RWMutex
+	rLocked := r.RWMutex != b.RWMutex && r.RWMutex != l.RWMutex
+	if lLocked {
+		l.RLock()
+	}
+	if rLocked {
+		r.RLock()
+	}
+	return func() {
+		if rLocked {
+			r.RUnlock()
+		}
+		if lLocked {
+			l.RUnlock()
+		}
+		b.Unlock()
+	}
+}
+
+// Description: Clears all values from the bloom filter. The number of bits and
+// hashes are not changed.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) Clear() {
+	clear(b.state.bits)
+}
+
+// Description: Places a write lock on the underlying bloom filter and then
+// calls the underlying bloom filters [BloomFilter.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) Clear() {
+	b.Lock()
+	defer b.Unlock()
+	b.BloomFilter.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two bloom filters are equal if they have the same number of bits
+// and hashes and the same bits are set. Returns true if l==r, false otherwise.
+func (_ *BloomFilter[T, U]) Eq(l *BloomFilter[T, U], r *BloomFilter[T, U]) bool {
+	if l.state.numBits != r.state.numBits ||
+		l.state.numHashes != r.state.numHashes {
+		return false
+	}
+	for i := 0; i < len(l.state.bits); i++ {
+		if l.state.bits[i] != r.state.bits[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying bloom
+// filters [BloomFilter.Eq] method. Returns true if l==r, false otherwise.
+func (_ *SyncedBloomFilter[T, U]) Eq(
+	l *SyncedBloomFilter[T, U],
+	r *SyncedBloomFilter[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.BloomFilter.Eq(&l.BloomFilter, &r.BloomFilter)
+}
+
+// A function that returns a hash of a bloom filter. The hash is created from
+// the number of hashes and the bits of the bloom filter, making it so the hash
+// will represent the same equality operation that [BloomFilter.Eq] provides.
+func (_ *BloomFilter[T, U]) Hash(other *BloomFilter[T, U]) hash.Hash {
+	rv := hash.Hash(other.state.numBits).Combine(hash.Hash(other.state.numHashes))
+	for _, word := range other.state.bits {
+		rv = rv.Combine(hash.Hash(word))
+	}
+	return rv
+}
+
+// Places a read lock on the underlying bloom filter of other and then calls
+// others underlying bloom filters [BloomFilter.Hash] method.
+func (_ *SyncedBloomFilter[T, U]) Hash(other *SyncedBloomFilter[T, U]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.BloomFilter.Hash(&other.BloomFilter)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [BloomFilter.Clear].
+func (_ *BloomFilter[T, U]) Zero(other *BloomFilter[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedBloomFilter.Clear].
+func (_ *SyncedBloomFilter[T, U]) Zero(other *SyncedBloomFilter[T, U]) {
+	other.Clear()
+}
+
+type serializedBloomFilter struct {
+	NumBits   int      `json:"numBits"`
+	NumHashes int      `json:"numHashes"`
+	Words     []uint64 `json:"words"`
+}
+
+func (b *BloomFilter[T, U]) toSerialized() serializedBloomFilter {
+	return serializedBloomFilter{
+		NumBits:   b.state.numBits,
+		NumHashes: b.state.numHashes,
+		Words:     b.state.bits,
+	}
+}
+
+func (b *BloomFilter[T, U]) fromSerialized(s serializedBloomFilter) error {
+	if s.NumBits <= 0 {
+		return getMustBePositiveError("number of bits", s.NumBits)
+	}
+	if s.NumHashes <= 0 {
+		return getMustBePositiveError("number of hashes", s.NumHashes)
+	}
+	if len(s.Words) != (s.NumBits+63)/64 {
+		return getMalformedDataError(fmt.Sprintf(
+			"expected %d words for %d bits, got %d",
+			(s.NumBits+63)/64, s.NumBits, len(s.Words),
+		))
+	}
+	if rem := s.NumBits % 64; rem != 0 && s.Words[len(s.Words)-1]>>rem != 0 {
+		return getMalformedDataError("bits past the end of the filter are set")
+	}
+	if b.state == nil {
+		b.state = &bloomFilterState{}
+	}
+	b.state.numBits = s.NumBits
+	b.state.numHashes = s.NumHashes
+	b.state.bits = s.Words
+	return nil
+}
+
+// Description: Returns the JSON encoding of the bloom filter. The number of
+// bits, the number of hashes, and the underlying bits of the filter are
+// encoded. The values that were added to the filter are not recoverable from
+// the encoding.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bloom filter and then calls
+// the underlying bloom filters [BloomFilter.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) MarshalJSON() ([]byte, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.MarshalJSON()
+}
+
+// Description: Replaces the contents of the bloom filter with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [BloomFilter.MarshalJSON]. If an error is returned the bloom filter is left
+// unchanged.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	return b.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying bloom filter while its contents are replaced. Exhibits the
+// same behavior as [BloomFilter.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.BloomFilter.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the bloom filter. The
+// values are laid out the same way as [BloomFilter.MarshalJSON] and are encoded
+// using the [encoding/gob] package.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(b.toSerialized())
+}
+
+// Description: Places a read lock on the underlying bloom filter and then calls
+// the underlying bloom filters [BloomFilter.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) MarshalBinary() ([]byte, error) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.BloomFilter.MarshalBinary()
+}
+
+// Description: Replaces the contents of the bloom filter with the values
+// decoded from the supplied binary data, which must have been produced by
+// [BloomFilter.MarshalBinary]. If an error is returned the bloom filter is left
+// unchanged.
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *BloomFilter[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	return b.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying bloom filter while its contents are replaced. Exhibits the
+// same behavior as [BloomFilter.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of bits
+func (b *SyncedBloomFilter[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.BloomFilter.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values in a bloom filter cannot
+// be recovered so the size, number of hashes, and number of set bits are
+// printed instead.
+func (b BloomFilter[T, U]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(
+		f, "bloomFilter[bits:%d hashes:%d set:%d]",
+		b.state.numBits, b.state.numHashes, b.PopCount(),
+	)
+}
+
+// Implements the [fmt.Stringer] interface.
+func (b *BloomFilter[T, U]) String() string {
+	return fmt.Sprintf("%v", b)
+}
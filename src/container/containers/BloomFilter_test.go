@@ -0,0 +1,279 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestBloomFilterWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[BloomFilter[string, widgets.BuiltinString]]
+	v, _ := NewBloomFilter[string, widgets.BuiltinString](64, 3)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedBloomFilterWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedBloomFilter[string, widgets.BuiltinString]]
+	v, _ := NewSyncedBloomFilter[string, widgets.BuiltinString](64, 3)
+	widget = &v
+	_ = widget
+}
+
+func TestBloomFilterNewErrors(t *testing.T) {
+	_, err := NewBloomFilter[int, widgets.BuiltinInt](0, 3)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewBloomFilter[int, widgets.BuiltinInt](64, 0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedBloomFilter[int, widgets.BuiltinInt](-1, 3)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewBloomFilterForRate[int, widgets.BuiltinInt](0, 0.01)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewBloomFilterForRate[int, widgets.BuiltinInt](100, 0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedBloomFilterForRate[int, widgets.BuiltinInt](100, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestBloomFilterSize(t *testing.T) {
+	numBits, numHashes, err := BloomFilterSize(1000, 0.01)
+	test.Nil(err, t)
+	test.Eq(9586, numBits, t)
+	test.Eq(7, numHashes, t)
+	test.True(math.Abs(BloomFilterFalsePositiveRate(numBits, numHashes, 1000)-0.01) < 0.0005, t)
+	numBits, numHashes, err = BloomFilterSize(1, 0.5)
+	test.Nil(err, t)
+	test.True(numBits > 0, t)
+	test.Eq(1, numHashes, t)
+	test.Eq(1.0, BloomFilterFalsePositiveRate(0, 1, 1), t)
+	test.Eq(0.0, BloomFilterFalsePositiveRate(64, 3, 0), t)
+}
+
+func TestBloomFilterAdd(t *testing.T) {
+	b, err := NewBloomFilterForRate[int, widgets.BuiltinInt](1000, 0.01)
+	test.Nil(err, t)
+	test.False(b.MayContain(1), t)
+	test.Eq(0, b.PopCount(), t)
+	test.Eq(0.0, b.FalsePositiveRate(), t)
+	for i := 0; i < 1000; i++ {
+		test.Nil(b.Add(i), t)
+	}
+	for i := 0; i < 1000; i++ {
+		test.True(b.MayContain(i), t)
+		test.True(b.MayContainPntr(&i), t)
+	}
+	test.True(b.PopCount() <= b.NumHashes()*1000, t)
+	approx := b.ApproxLength()
+	test.True(approx > 900 && approx < 1100, t)
+	b.Clear()
+	test.Eq(0, b.PopCount(), t)
+	test.False(b.MayContain(1), t)
+}
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	b, err := NewBloomFilterForRate[int, widgets.BuiltinInt](10000, 0.01)
+	test.Nil(err, t)
+	for i := 0; i < 10000; i++ {
+		b.Add(i)
+	}
+	falsePositives := 0
+	for i := 10000; i < 110000; i++ {
+		if b.MayContain(i) {
+			falsePositives++
+		}
+	}
+	rate := float64(falsePositives) / 100000
+	test.True(rate < 0.02, t)
+	test.True(math.Abs(b.FalsePositiveRate()-0.01) < 0.005, t)
+}
+
+func TestBloomFilterTestAndAdd(t *testing.T) {
+	b, _ := NewBloomFilterForRate[int, widgets.BuiltinInt](100, 0.001)
+	vals := []int{1, 2, 1, 3, 2, 4}
+	unique := []int{}
+	for _, v := range vals {
+		if !b.TestAndAdd(v) {
+			unique = append(unique, v)
+		}
+	}
+	test.SlicesMatch[int]([]int{1, 2, 3, 4}, unique, t)
+}
+
+func TestBloomFilterDedupeIter(t *testing.T) {
+	b, _ := NewBloomFilterForRate[int, widgets.BuiltinInt](100, 0.001)
+	res, err := iter.SliceElems([]int{5, 1, 5, 2, 1, 3}).Filter(
+		func(index int, val int) bool { return !b.TestAndAdd(val) },
+	).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{5, 1, 2, 3}, res, t)
+}
+
+func TestBloomFilterPrescreen(t *testing.T) {
+	b, _ := NewBloomFilterForRate[int, widgets.BuiltinInt](100, 0.001)
+	m, _ := NewHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	for i := 0; i < 100; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i, B: i * 2})
+		b.Add(i)
+	}
+	numLookups := 0
+	for i := 0; i < 1000; i++ {
+		if !b.MayContain(i) {
+			_, err := m.Get(i)
+			test.NotNil(err, t)
+			continue
+		}
+		numLookups++
+		if v, err := m.Get(i); err == nil {
+			test.Eq(i*2, v, t)
+		}
+	}
+	test.True(numLookups >= 100 && numLookups < 110, t)
+}
+
+func TestBloomFilterUnionIntersection(t *testing.T) {
+	l, _ := NewBloomFilter[int, widgets.BuiltinInt](1024, 4)
+	r, _ := NewBloomFilter[int, widgets.BuiltinInt](1024, 4)
+	l.Add(1, 2, 3)
+	r.Add(3, 4, 5)
+	u, _ := NewBloomFilter[int, widgets.BuiltinInt](1, 1)
+	test.Nil(u.Union(&l, &r), t)
+	test.Eq(1024, u.NumBits(), t)
+	test.Eq(4, u.NumHashes(), t)
+	for i := 1; i <= 5; i++ {
+		test.True(u.MayContain(i), t)
+	}
+	i, _ := NewBloomFilter[int, widgets.BuiltinInt](1, 1)
+	test.Nil(i.Intersection(&l, &r), t)
+	test.True(i.MayContain(3), t)
+	test.True(i.PopCount() <= l.PopCount(), t)
+	test.True(i.PopCount() <= r.PopCount(), t)
+	test.Nil(l.Union(&l, &r), t)
+	test.True(l.Eq(&l, &u), t)
+}
+
+func TestBloomFilterIncompatible(t *testing.T) {
+	l, _ := NewBloomFilter[int, widgets.BuiltinInt](1024, 4)
+	r, _ := NewBloomFilter[int, widgets.BuiltinInt](1024, 3)
+	l.Add(1)
+	err := l.Union(&l, &r)
+	test.ContainsError(customerr.DimensionsDoNotAgree, err, t)
+	test.Eq(4, l.NumHashes(), t)
+	test.True(l.MayContain(1), t)
+	r, _ = NewBloomFilter[int, widgets.BuiltinInt](512, 4)
+	err = l.Intersection(&l, &r)
+	test.ContainsError(customerr.DimensionsDoNotAgree, err, t)
+	test.Eq(1024, l.NumBits(), t)
+}
+
+func TestBloomFilterEqHash(t *testing.T) {
+	l, _ := NewBloomFilter[int, widgets.BuiltinInt](256, 3)
+	r, _ := NewBloomFilter[int, widgets.BuiltinInt](256, 3)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	l.Add(1, 2)
+	test.False(l.Eq(&l, &r), t)
+	test.Neq(l.Hash(&l), r.Hash(&r), t)
+	r.Add(2, 1)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	o, _ := NewBloomFilter[int, widgets.BuiltinInt](256, 4)
+	test.False(l.Eq(&l, &o), t)
+	l.Zero(&l)
+	test.Eq(0, l.PopCount(), t)
+}
+
+func TestBloomFilterCopySharesState(t *testing.T) {
+	b, _ := NewBloomFilter[int, widgets.BuiltinInt](256, 3)
+	c := b
+	c.Add(1)
+	test.True(b.MayContain(1), t)
+	s := b.ToSynced()
+	s.Add(2)
+	test.True(b.MayContain(2), t)
+	test.False(b.IsSynced(), t)
+	test.True(s.IsSynced(), t)
+}
+
+func TestBloomFilterFormat(t *testing.T) {
+	b, _ := NewBloomFilter[int, widgets.BuiltinInt](64, 2)
+	test.Eq("bloomFilter[bits:64 hashes:2 set:0]", b.String(), t)
+	test.Eq("bloomFilter[bits:64 hashes:2 set:0]", fmt.Sprint(b), t)
+}
+
+func TestSyncedBloomFilterConcurrent(t *testing.T) {
+	b, _ := NewSyncedBloomFilterForRate[int, widgets.BuiltinInt](4000, 0.01)
+	other, _ := NewSyncedBloomFilterForRate[int, widgets.BuiltinInt](4000, 0.01)
+	other.Add(-1)
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			for i := j * 1000; i < (j+1)*1000; i++ {
+				b.Add(i)
+				b.MayContain(i)
+				b.TestAndAdd(i)
+				if i%100 == 0 {
+					test.Nil(b.Union(&b, &other), t)
+				}
+			}
+		}(j)
+	}
+	wg.Wait()
+	for i := 0; i < 4000; i++ {
+		test.True(b.MayContain(i), t)
+	}
+	test.True(b.MayContain(-1), t)
+}
+
+func TestBloomFilterSerialization(t *testing.T) {
+	b, _ := NewBloomFilter[int, widgets.BuiltinInt](100, 3)
+	b.Add(1, 2, 3, 50)
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = b.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&b)
+		}
+		test.Nil(err, t)
+
+		res, _ := NewBloomFilter[int, widgets.BuiltinInt](10, 1)
+		res.Add(7)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(b.Eq(&b, &res), t)
+		test.Eq(100, res.NumBits(), t)
+		test.Eq(3, res.NumHashes(), t)
+		for _, v := range []int{1, 2, 3, 50} {
+			test.True(res.MayContain(v), t)
+		}
+	}
+}
+
+func TestBloomFilterDeserializationErrors(t *testing.T) {
+	b, _ := NewBloomFilter[int, widgets.BuiltinInt](64, 2)
+	b.Add(1)
+	for _, data := range []string{
+		`{"numBits":0,"numHashes":1,"words":[]}`,
+		`{"numBits":64,"numHashes":0,"words":[0]}`,
+		`{"numBits":65,"numHashes":1,"words":[0]}`,
+		`{"numBits":4,"numHashes":1,"words":[16]}`,
+	} {
+		test.NotNil(json.Unmarshal([]byte(data), &b), t)
+		test.Eq(64, b.NumBits(), t)
+		test.True(b.MayContain(1), t)
+	}
+}
@@ -0,0 +1,1314 @@
+package containers
+
+//go:generate ../../../bin/enum -type=CachePolicy -package=containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	//gen:enum unknownValue UnknownCachePolicy
+	//gen:enum default LRUCachePolicy
+	CachePolicy int
+
+	// An interface defining the operations that can be performed with a cache.
+	// These functions are call backs that the cache will call as values are
+	// evicted. When used with a [SyncedCache] the call backs are called while
+	// the caches write lock is held, so they must not call methods on the cache
+	// that evicted the value.
+	CacheHooks[K any, V any] interface {
+		// Called with the key value pair that is being evicted to make room
+		// for a new key value pair. Values that are removed by calling Pop,
+		// Delete, or Clear are not considered evicted.
+		EvictOp(k K, v V)
+	}
+
+	cacheEntry[K any, V any] struct {
+		key  K
+		val  V
+		node *cacheFreqNode[K, V]
+		prev *cacheEntry[K, V]
+		next *cacheEntry[K, V]
+	}
+
+	// A group of entries that have all been used the same number of times. The
+	// entries are kept in a circular list ordered from most to least recently
+	// used, with the sentinel value at the head.
+	cacheFreqNode[K any, V any] struct {
+		freq    int
+		entries cacheEntry[K, V]
+		prev    *cacheFreqNode[K, V]
+		next    *cacheFreqNode[K, V]
+	}
+
+	cacheState[K any, V any] struct {
+		policy   CachePolicy
+		capacity int
+		length   int
+		hooks    CacheHooks[K, V]
+		buckets  map[hash.Hash][]*cacheEntry[K, V]
+		// A circular list of frequency nodes ordered from least to most used,
+		// with the sentinel value at the head. The LRU policy only ever uses a
+		// single frequency node.
+		freqs cacheFreqNode[K, V]
+	}
+
+	// A type to represent a map that holds at most a fixed number of key value
+	// pairs. When a new key is added to a full cache a key value pair is
+	// evicted according to the caches policy. With the [LRUCachePolicy] the
+	// least recently used key value pair is evicted. With the [LFUCachePolicy]
+	// the least frequently used key value pair is evicted, with ties broken by
+	// evicting the least recently used key value pair. The type constraints on
+	// the generics define the logic for how value specific operations, such as
+	// equality comparisons, will be handled. Copies of a cache share the same
+	// underlying state, the same as the builtin map type.
+	Cache[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		state *cacheState[K, V]
+	}
+
+	// A synchronized version of Cache. All operations will be wrapped in the
+	// appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value. Reading a
+	// value from a cache updates the caches usage information, so a second lock
+	// is used to protect that information while allowing values to be read
+	// concurrently. Every read that walks the usage information also holds the
+	// second lock.
+	SyncedCache[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		usageLock *sync.Mutex
+		Cache[K, V, KI, VI]
+	}
+)
+
+const (
+	//gen:enum string UnknownCachePolicy
+	UnknownCachePolicy CachePolicy = iota
+	// Evicts the least recently used key value pair when the cache is full.
+	//gen:enum string LRUCachePolicy
+	LRUCachePolicy
+	// Evicts the least frequently used key value pair when the cache is full.
+	// Ties are broken by evicting the least recently used key value pair.
+	//gen:enum string LFUCachePolicy
+	LFUCachePolicy
+)
+
+// Creates a new cache that can hold up to capacity key value pairs and will
+// evict key value pairs using the supplied policy. Capacity must be >= 0, an
+// error will be returned if it is not. The policy must be one of
+// [LRUCachePolicy] or [LFUCachePolicy], an error will be returned if it is
+// not. The hooks are optional and may be nil.
+func NewCache[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](
+	policy CachePolicy,
+	capacity int,
+	hooks CacheHooks[K, V],
+) (Cache[K, V, KI, VI], error) {
+	if capacity < 0 {
+		return Cache[K, V, KI, VI]{}, getSizeError(capacity)
+	}
+	if policy != LRUCachePolicy && policy != LFUCachePolicy {
+		return Cache[K, V, KI, VI]{}, customerr.Wrap(
+			InvalidCachePolicy,
+			"Expected one of: %s, %s Got: %s",
+			LRUCachePolicy, LFUCachePolicy, policy,
+		)
+	}
+	rv := Cache[K, V, KI, VI]{
+		state: &cacheState[K, V]{
+			policy:   policy,
+			capacity: capacity,
+			hooks:    hooks,
+			buckets:  make(map[hash.Hash][]*cacheEntry[K, V], capacity),
+		},
+	}
+	rv.state.freqs.prev = &rv.state.freqs
+	rv.state.freqs.next = &rv.state.freqs
+	return rv, nil
+}
+
+// Creates a new synced cache that can hold up to capacity key value pairs and
+// will evict key value pairs using the supplied policy. Capacity must be >= 0,
+// an error will be returned if it is not. The policy must be one of
+// [LRUCachePolicy] or [LFUCachePolicy], an error will be returned if it is
+// not. The hooks are optional and may be nil. The underlying RWMutex value
+// will be fully unlocked upon initialization.
+func NewSyncedCache[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](
+	policy CachePolicy,
+	capacity int,
+	hooks CacheHooks[K, V],
+) (SyncedCache[K, V, KI, VI], error) {
+	rv, err := NewCache[K, V, KI, VI](policy, capacity, hooks)
+	return SyncedCache[K, V, KI, VI]{
+		RWMutex:   &sync.RWMutex{},
+		usageLock: &sync.Mutex{},
+		Cache:     rv,
+	}, err
+}
+
+// Converts the supplied cache to a synchronized cache. Beware: The original
+// non-synced cache will remain useable.
+func (c *Cache[K, V, KI, VI]) ToSynced() SyncedCache[K, V, KI, VI] {
+	return SyncedCache[K, V, KI, VI]{
+		RWMutex:   &sync.RWMutex{},
+		usageLock: &sync.Mutex{},
+		Cache:     *c,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (c *Cache[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (c *Cache[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (c *Cache[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (c *Cache[K, V, KI, VI]) RUnlock() {}
+
+// The SyncedCache method to override the Cache pass through function and
+// actually apply the mutex operation.
+func (c *SyncedCache[K, V, KI, VI]) Lock() { c.RWMutex.Lock() }
+
+// The SyncedCache method to override the Cache pass through function and
+// actually apply the mutex operation.
+func (c *SyncedCache[K, V, KI, VI]) Unlock() { c.RWMutex.Unlock() }
+
+// The SyncedCache method to override the Cache pass through function and
+// actually apply the mutex operation.
+func (c *SyncedCache[K, V, KI, VI]) RLock() { c.RWMutex.RLock() }
+
+// The SyncedCache method to override the Cache pass through function and
+// actually apply the mutex operation.
+func (c *SyncedCache[K, V, KI, VI]) RUnlock() { c.RWMutex.RUnlock() }
+
+// Returns false, caches are not addressable.
+func (c *Cache[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, a cache is not synced.
+func (c *Cache[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced cache is synced.
+func (c *SyncedCache[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the eviction policy of the cache.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Policy() CachePolicy {
+	return c.state.policy
+}
+
+// Description: Returns the maximum number of key value pairs the cache can
+// hold.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Capacity() int {
+	return c.state.capacity
+}
+
+// Description: Returns the number of elements in the cache.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Length() int {
+	return c.state.length
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (c *SyncedCache[K, V, KI, VI]) Length() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.Cache.Length()
+}
+
+func (c *Cache[K, V, KI, VI]) find(k *K) *cacheEntry[K, V] {
+	kw := widgets.Base[K, KI]{}
+	for _, e := range c.state.buckets[kw.Hash(k)] {
+		if kw.Eq(k, &e.key) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Calls op on every entry in eviction order, stopping early if op returns
+// false.
+func (c *Cache[K, V, KI, VI]) forEach(op func(e *cacheEntry[K, V]) bool) {
+	for n := c.state.freqs.next; n != &c.state.freqs; n = n.next {
+		for e := n.entries.prev; e != &n.entries; e = e.prev {
+			if !op(e) {
+				return
+			}
+		}
+	}
+}
+
+// Removes the entry from its frequency node, removing the frequency node if it
+// is left empty.
+func (c *Cache[K, V, KI, VI]) unlink(e *cacheEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	if n := e.node; n.entries.next == &n.entries {
+		n.prev.next = n.next
+		n.next.prev = n.prev
+	}
+	e.node, e.prev, e.next = nil, nil, nil
+}
+
+// Places the entry at the front of the frequency node, creating the node
+// after prev if it does not exist.
+func (c *Cache[K, V, KI, VI]) link(
+	e *cacheEntry[K, V],
+	prev *cacheFreqNode[K, V],
+	freq int,
+) {
+	n := prev.next
+	if n == &c.state.freqs || n.freq != freq {
+		n = &cacheFreqNode[K, V]{freq: freq, prev: prev, next: prev.next}
+		n.entries.prev = &n.entries
+		n.entries.next = &n.entries
+		prev.next.prev = n
+		prev.next = n
+	}
+	e.node = n
+	e.prev = &n.entries
+	e.next = n.entries.next
+	n.entries.next.prev = e
+	n.entries.next = e
+}
+
+// Records a use of the supplied entry.
+func (c *Cache[K, V, KI, VI]) touch(e *cacheEntry[K, V]) {
+	n := e.node
+	freq := n.freq
+	if c.state.policy == LFUCachePolicy {
+		freq++
+	}
+	prev := n
+	if n.entries.next == e && n.entries.prev == e {
+		// The node will be removed when the entry is unlinked
+		prev = n.prev
+	}
+	if freq == n.freq {
+		prev = n.prev
+	}
+	c.unlink(e)
+	c.link(e, prev, freq)
+}
+
+func (c *Cache[K, V, KI, VI]) removeEntry(e *cacheEntry[K, V]) {
+	kw := widgets.Base[K, KI]{}
+	h := kw.Hash(&e.key)
+	bucket := c.state.buckets[h]
+	for i := 0; i < len(bucket); i++ {
+		if bucket[i] == e {
+			bucket[i] = bucket[len(bucket)-1]
+			bucket[len(bucket)-1] = nil
+			bucket = bucket[:len(bucket)-1]
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(c.state.buckets, h)
+	} else {
+		c.state.buckets[h] = bucket
+	}
+	c.unlink(e)
+	c.state.length--
+}
+
+func (c *Cache[K, V, KI, VI]) zeroEntry(e *cacheEntry[K, V]) {
+	kw := widgets.Base[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	kw.Zero(&e.key)
+	vw.Zero(&e.val)
+}
+
+// Evicts the entry that the caches policy dictates, calling the evict hook if
+// one was supplied.
+func (c *Cache[K, V, KI, VI]) evict() {
+	n := c.state.freqs.next
+	e := n.entries.prev
+	c.removeEntry(e)
+	if c.state.hooks != nil {
+		c.state.hooks.EvictOp(e.key, e.val)
+	}
+}
+
+// Description: Contains will return true if the supplied value is in the
+// cache, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the cache was initialized with. This does not
+// count as a use of any key value pair.
+//
+// Time Complexity: O(n) (linear search)
+func (c *Cache[K, V, KI, VI]) Contains(v V) bool {
+	return c.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.ContainsPntr] method.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n) (linear search)
+func (c *SyncedCache[K, V, KI, VI]) Contains(v V) bool {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// cache, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the cache was initialized with. This does not
+// count as a use of any key value pair.
+//
+// Time Complexity: O(n) (linear search)
+func (c *Cache[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return c.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.ContainsPntr] method.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n) (linear search)
+func (c *SyncedCache[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.ContainsPntr(v)
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the cache. This counts
+// as a use of the key value pair.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Get(k K) (V, error) {
+	if e := c.find(&k); e != nil {
+		c.touch(e)
+		return e.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying cache and a lock on the
+// caches usage information and then calls the underlying caches [Cache.Get]
+// method. Concurrent calls to Get are allowed to look up values in parallel
+// but will update the caches usage information one at a time.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(1)
+func (c *SyncedCache[K, V, KI, VI]) Get(k K) (V, error) {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.Get(k)
+}
+
+// Panics, caches are not addressable.
+func (c *Cache[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("cache"))
+}
+
+// Description: Gets the value at the specified key without counting it as a
+// use of the key value pair. Returns a [containerTypes.KeyError] if the key is
+// not found in the cache.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Peek(k K) (V, error) {
+	if e := c.find(&k); e != nil {
+		return e.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.Peek] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (c *SyncedCache[K, V, KI, VI]) Peek(k K) (V, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.Cache.Peek(k)
+}
+
+// Description: KeyOf will return the key of the first value in eviction order
+// that equals the supplied value. If the value is not found then the returned
+// key will be a zero initialized key value and the boolean flag will be set to
+// false. If the value is found then the boolean flag will be set to true. All
+// equality comparisons are performed by the generic VI widget type that the
+// cache was initialized with. This does not count as a use of any key value
+// pair.
+//
+// Time Complexity: O(n) (linear search)
+func (c *Cache[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	var tmp K
+	return tmp, c.keyOfImpl(&tmp, &v)
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.KeyOf] implementation method. The [Cache.KeyOf]
+// method is not called directly to avoid copying the val variable twice, which
+// could be expensive with a large type for the V generic.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n) (linear search)
+func (c *SyncedCache[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	var tmp K
+	return tmp, c.keyOfImpl(&tmp, &v)
+}
+
+// Description: KeyOfPntr will return the key of the first value in eviction
+// order that equals the supplied value. If the value is not found then the
+// returned key will be a zero initialized key value and the boolean flag will
+// be set to false. If the value is found then the boolean flag will be set to
+// true. All equality comparisons are performed by the generic VI widget type
+// that the cache was initialized with. This does not count as a use of any key
+// value pair.
+//
+// Time Complexity: O(n) (linear search)
+func (c *Cache[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	var tmp K
+	return tmp, c.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.KeyOfPntr] method.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n) (linear search)
+func (c *SyncedCache[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	var tmp K
+	return tmp, c.keyOfImpl(&tmp, v)
+}
+
+func (c *Cache[K, V, KI, VI]) keyOfImpl(k *K, v *V) bool {
+	found := false
+	vw := widgets.Base[V, VI]{}
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		if vw.Eq(v, &e.val) {
+			*k = e.key
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the cache. Stops setting values as soon as an error is
+// encountered. Note that the key will be updated as well. Each set value
+// counts as a use of the key value pair.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *Cache[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	return c.setImpl(kvPairs)
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.Set] implementaiton method. The [Cache.Set] method
+// is not called directly to avoid copying the vals varargs twice, which could
+// be expensive with a large types for the K or V generics or a large number of
+// values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *SyncedCache[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.setImpl(kvPairs)
+}
+
+func (c *Cache[K, V, KI, VI]) setImpl(kvPairs []basic.Pair[K, V]) error {
+	for i := 0; i < len(kvPairs); i++ {
+		e := c.find(&kvPairs[i].A)
+		if e == nil {
+			return getKeyError[K](&kvPairs[i].A)
+		}
+		c.zeroEntry(e)
+		e.key = kvPairs[i].A
+		e.val = kvPairs[i].B
+		c.touch(e)
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the cache if they
+// do not exist and will set they keys value if it already exists in the
+// cache. The values will be inserted in the order that they are given. Setting
+// an existing keys value counts as a use of the key value pair. If a new key is
+// inserted into a full cache then a key value pair will be evicted to make
+// room for it. If the cache has a capacity of 0 then new key value pairs are
+// evicted immediately.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *Cache[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	kw := widgets.Base[K, KI]{}
+	for i := 0; i < len(vals); i++ {
+		if e := c.find(&vals[i].A); e != nil {
+			c.zeroEntry(e)
+			e.key = vals[i].A
+			e.val = vals[i].B
+			c.touch(e)
+			continue
+		}
+		if c.state.capacity == 0 {
+			if c.state.hooks != nil {
+				c.state.hooks.EvictOp(vals[i].A, vals[i].B)
+			}
+			continue
+		}
+		if c.state.length == c.state.capacity {
+			c.evict()
+		}
+		e := &cacheEntry[K, V]{key: vals[i].A, val: vals[i].B}
+		h := kw.Hash(&e.key)
+		c.state.buckets[h] = append(c.state.buckets[h], e)
+		c.link(e, &c.state.freqs, 1)
+		c.state.length++
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.Emplace] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *SyncedCache[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.Emplace(vals...)
+}
+
+// Description: Pop will remove all occurrences of val in the cache. All
+// equality comparisons are performed by the generic VI widget type that the
+// cache was initialized with. Popped values are not considered evicted.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) Pop(v V) int {
+	return c.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.Pop] implementation method. The [Cache.Pop] method
+// is not called directly to avoid copying the v argument twice, which could be
+// expensive with a large type for the V generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) Pop(v V) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.popImpl(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the cache. All
+// equality comparisons are performed by the generic VI widget type that the
+// cache was initialized with. Popped values are not considered evicted.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) PopPntr(v *V) int {
+	return c.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.PopPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) PopPntr(v *V) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.popImpl(v)
+}
+
+func (c *Cache[K, V, KI, VI]) popImpl(v *V) int {
+	vw := widgets.Base[V, VI]{}
+	entries := []*cacheEntry[K, V]{}
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		if vw.Eq(v, &e.val) {
+			entries = append(entries, e)
+		}
+		return true
+	})
+	for _, e := range entries {
+		c.removeEntry(e)
+		c.zeroEntry(e)
+	}
+	return len(entries)
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the cache. Deleted values are not
+// considered evicted.
+//
+// Time Complexity: O(1)
+func (c *Cache[K, V, KI, VI]) Delete(k K) error {
+	return c.deleteImpl(&k)
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (c *SyncedCache[K, V, KI, VI]) Delete(k K) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.deleteImpl(&k)
+}
+
+func (c *Cache[K, V, KI, VI]) deleteImpl(k *K) error {
+	e := c.find(k)
+	if e == nil {
+		return getKeyError[K](k)
+	}
+	c.removeEntry(e)
+	c.zeroEntry(e)
+	return nil
+}
+
+// Description: Clears all values from the cache. Cleared values are not
+// considered evicted. The capacity and policy of the cache are not changed.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) Clear() {
+	if c.state == nil {
+		*c, _ = NewCache[K, V, KI, VI](LRUCachePolicy, 0, nil)
+		return
+	}
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		c.zeroEntry(e)
+		return true
+	})
+	c.state.length = 0
+	c.state.buckets = make(map[hash.Hash][]*cacheEntry[K, V], c.state.capacity)
+	c.state.freqs.prev = &c.state.freqs
+	c.state.freqs.next = &c.state.freqs
+}
+
+// Description: Places a write lock on the underlying cache and then calls the
+// underlying caches [Cache.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) Clear() {
+	c.Lock()
+	defer c.Unlock()
+	c.Cache.Clear()
+}
+
+// The eviction order is copied when the iterator is first called so that
+// using values while iterating (ex: calling Get) does not reorder the entries
+// that have yet to be returned. If usageLock is not nil it is only held while
+// the eviction order is copied, so a synced cache can still be used while
+// iterating.
+func (c *Cache[K, V, KI, VI]) entries(
+	usageLock *sync.Mutex,
+) iter.Iter[*cacheEntry[K, V]] {
+	var order []*cacheEntry[K, V]
+	i := -1
+	return func(f iter.IteratorFeedback) (*cacheEntry[K, V], error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if i == -1 {
+			if usageLock != nil {
+				usageLock.Lock()
+			}
+			order = make([]*cacheEntry[K, V], 0, c.state.length)
+			c.forEach(func(e *cacheEntry[K, V]) bool {
+				order = append(order, e)
+				return true
+			})
+			if usageLock != nil {
+				usageLock.Unlock()
+			}
+		}
+		if i++; i < len(order) {
+			return order[i], nil, true
+		}
+		return nil, nil, false
+	}
+}
+
+// Description: Returns an iterator that iterates over the keys in the cache.
+// The keys are returned in eviction order, meaning the key that would be
+// evicted next is returned first. This does not count as a use of any key
+// value pair.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return c.keys(nil)
+}
+
+func (c *Cache[K, V, KI, VI]) keys(usageLock *sync.Mutex) iter.Iter[K] {
+	return iter.Map[*cacheEntry[K, V], K](
+		c.entries(usageLock),
+		func(index int, val *cacheEntry[K, V]) (K, error) { return val.key, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [Cache.Keys] method such that a read lock will be placed on the underlying
+// cache when the iterator is consumed. The cache will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed. The usage information is only
+// locked while the eviction order is copied, so values can be gotten while
+// iterating.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return c.Cache.keys(c.usageLock).SetupTeardown(
+		func() error { c.RLock(); return nil },
+		func() error { c.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the cache that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [Cache.Keys].
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return c.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the cache that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [SyncedCache.Keys], so the cache will have a read lock the entire
+// time the loop is running. The lock is released once the loop ends, even if
+// the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return c.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// cache. The values are returned in eviction order, meaning the value that
+// would be evicted next is returned first. This does not count as a use of any
+// key value pair.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return c.vals(nil)
+}
+
+func (c *Cache[K, V, KI, VI]) vals(usageLock *sync.Mutex) iter.Iter[V] {
+	return iter.Map[*cacheEntry[K, V], V](
+		c.entries(usageLock),
+		func(index int, val *cacheEntry[K, V]) (V, error) { return val.val, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [Cache.Vals] method such that a read lock will be placed on the underlying
+// cache when the iterator is consumed. The cache will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed. The usage information is only
+// locked while the eviction order is copied, so values can be gotten while
+// iterating.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return c.Cache.vals(c.usageLock).SetupTeardown(
+		func() error { c.RLock(); return nil },
+		func() error { c.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the cache that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [Cache.Vals].
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return c.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the cache that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [SyncedCache.Vals], so the cache will have a read lock the entire
+// time the loop is running. The lock is released once the loop ends, even if
+// the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return c.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the cache that
+// can be used in a for-range loop. The key value pairs are returned in eviction
+// order. This does not count as a use of any key value pair.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(c.pairs(nil))
+}
+
+// Description: Returns a sequence over the key value pairs in the cache that
+// can be used in a for-range loop. The cache will have a read lock the entire
+// time the loop is running. The lock is released once the loop ends, even if
+// the loop is exited early. The usage information is only locked while the
+// eviction order is copied, so values can be gotten while iterating.
+//
+// Lock Type: Read, plus an exclusive lock on the usage information
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(c.Cache.pairs(c.usageLock).SetupTeardown(
+		func() error { c.RLock(); return nil },
+		func() error { c.RUnlock(); return nil },
+	))
+}
+
+func (c *Cache[K, V, KI, VI]) pairs(
+	usageLock *sync.Mutex,
+) iter.Iter[basic.Pair[K, V]] {
+	return iter.Map[*cacheEntry[K, V], basic.Pair[K, V]](
+		c.entries(usageLock),
+		func(index int, val *cacheEntry[K, V]) (basic.Pair[K, V], error) {
+			return basic.Pair[K, V]{A: val.key, B: val.val}, nil
+		},
+	)
+}
+
+// Panics, caches are not addressable.
+func (c *Cache[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("cache"))
+}
+
+// Gets a value from other without counting it as a use if other is a cache.
+func cacheSafeGet[K any, V any, KI widgets.BaseInterface[K], VI widgets.BaseInterface[V]](
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+	k K,
+) (*V, error) {
+	var rv V
+	var err error
+	switch o := other.(type) {
+	case *Cache[K, V, KI, VI]:
+		rv, err = o.Peek(k)
+	case *SyncedCache[K, V, KI, VI]:
+		rv, err = o.Cache.Peek(k)
+	default:
+		return addressableSafeGet[K, V](other, k)
+	}
+	return &rv, err
+}
+
+// Description: KeyedEq will return true if the cache and the supplied
+// container are equal. Two containers are equal if they have the same keys and
+// the same values at each key. All equality comparisons are performed by the
+// generic KI and VI widget types that the cache was initialized with. The
+// capacity, policy, and usage information of the caches are not considered. If
+// other is a cache then its usage information will not be updated.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in the cache and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (c *Cache[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	if c.Length() != other.Length() {
+		return false
+	}
+	rv := true
+	vw := widgets.Base[V, VI]{}
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		otherV, err := cacheSafeGet[K, V, KI, VI](other, e.key)
+		rv = (err == nil && vw.Eq(&e.val, otherV))
+		return rv
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.KeyedEq] method. Attempts to place a read lock on
+// other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this cache, read on other, plus an exclusive lock on
+// this caches usage information
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in the cache and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (c *SyncedCache[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	c.RLock()
+	other.RLock()
+	defer c.RUnlock()
+	defer other.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.KeyedEq(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [Cache.KeyedEq]. Returns true if
+// l==r, false otherwise.
+func (_ *Cache[K, V, KI, VI]) Eq(l *Cache[K, V, KI, VI], r *Cache[K, V, KI, VI]) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedCache.KeyedEq]. Returns
+// true if l==r, false otherwise.
+func (_ *SyncedCache[K, V, KI, VI]) Eq(
+	l *SyncedCache[K, V, KI, VI],
+	r *SyncedCache[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a cache. To do this all of the individual
+// hashes that are produced from the elements of the cache are combined in a
+// way that maintains identity, making it so the hash will represent the same
+// equality operation that [Cache.KeyedEq] and [Cache.Eq] provide.
+func (_ *Cache[K, V, KI, VI]) Hash(other *Cache[K, V, KI, VI]) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	kw := widgets.Base[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	other.forEach(func(e *cacheEntry[K, V]) bool {
+		iterH := kw.Hash(&e.key).Combine(vw.Hash(&e.val))
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.CombineUnordered(iterH)
+		}
+		return true
+	})
+	return rv
+}
+
+// Places a read lock on the underlying cache of other, as well as a lock on its
+// usage information, and then calls others underlying caches [Cache.Hash]
+// method.
+func (_ *SyncedCache[K, V, KI, VI]) Hash(other *SyncedCache[K, V, KI, VI]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	other.usageLock.Lock()
+	defer other.usageLock.Unlock()
+	return other.Cache.Hash(&other.Cache)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [Cache.Clear].
+func (_ *Cache[K, V, KI, VI]) Zero(other *Cache[K, V, KI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedCache.Clear].
+func (_ *SyncedCache[K, V, KI, VI]) Zero(other *SyncedCache[K, V, KI, VI]) {
+	other.Clear()
+}
+
+type serializedCacheEntry[K any, V any] struct {
+	Key  K   `json:"key"`
+	Val  V   `json:"val"`
+	Freq int `json:"freq"`
+}
+
+type serializedCache[K any, V any] struct {
+	Policy   string                       `json:"policy"`
+	Capacity int                          `json:"capacity"`
+	Entries  []serializedCacheEntry[K, V] `json:"entries"`
+}
+
+func (c *Cache[K, V, KI, VI]) toSerialized() serializedCache[K, V] {
+	if c.state == nil {
+		return serializedCache[K, V]{
+			Policy:  LRUCachePolicy.String(),
+			Entries: []serializedCacheEntry[K, V]{},
+		}
+	}
+	rv := serializedCache[K, V]{
+		Policy:   c.state.policy.String(),
+		Capacity: c.state.capacity,
+		Entries:  make([]serializedCacheEntry[K, V], 0, c.state.length),
+	}
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		rv.Entries = append(rv.Entries, serializedCacheEntry[K, V]{
+			Key: e.key, Val: e.val, Freq: e.node.freq,
+		})
+		return true
+	})
+	return rv
+}
+
+func (c *Cache[K, V, KI, VI]) fromSerialized(s serializedCache[K, V]) error {
+	if s.Capacity < 0 {
+		return getSizeError(s.Capacity)
+	}
+	// The policy is encoded by name because the enums generated JSON encoding
+	// is not quoted, and therefore is not valid JSON.
+	var policy CachePolicy
+	policy.FromString(s.Policy)
+	if policy != LRUCachePolicy && policy != LFUCachePolicy {
+		return customerr.Wrap(
+			InvalidCachePolicy,
+			"Expected one of: %s, %s Got: %s",
+			LRUCachePolicy, LFUCachePolicy, s.Policy,
+		)
+	}
+	if len(s.Entries) > s.Capacity {
+		return getFullError(s.Capacity)
+	}
+	seen, _ := NewHashSet[K, KI](len(s.Entries))
+	prevFreq := 1
+	for i := 0; i < len(s.Entries); i++ {
+		if s.Entries[i].Freq < prevFreq ||
+			(policy == LRUCachePolicy && s.Entries[i].Freq != 1) {
+			return getMalformedDataError(
+				"cache entries must be in eviction order with valid use counts",
+			)
+		}
+		prevFreq = s.Entries[i].Freq
+		if seen.ContainsPntr(&s.Entries[i].Key) {
+			return getDuplicateValueError(s.Entries[i].Key)
+		}
+		seen.AppendUnique(s.Entries[i].Key)
+	}
+
+	if c.state == nil {
+		*c, _ = NewCache[K, V, KI, VI](policy, s.Capacity, nil)
+	} else {
+		c.Clear()
+		c.state.policy = policy
+		c.state.capacity = s.Capacity
+	}
+	kw := widgets.Base[K, KI]{}
+	for _, iterE := range s.Entries {
+		e := &cacheEntry[K, V]{key: iterE.Key, val: iterE.Val}
+		h := kw.Hash(&e.key)
+		c.state.buckets[h] = append(c.state.buckets[h], e)
+		// Entries are in eviction order so each entry is the most recently
+		// used entry of the highest frequency seen so far.
+		tail := c.state.freqs.prev
+		if tail != &c.state.freqs && tail.freq == iterE.Freq {
+			tail = tail.prev
+		}
+		c.link(e, tail, iterE.Freq)
+		c.state.length++
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the cache. The policy and capacity
+// of the cache are encoded along with its key value pairs, which are listed in
+// eviction order along with the number of times they have been used. Decoding
+// restores the usage information, so the decoded cache will evict key value
+// pairs in the same order as the original cache. Encoding and decoding do not
+// count as a use of any key value pair and decoding never evicts a value.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.MarshalJSON] method. The usage lock is also held so
+// that concurrent reads cannot reorder the key value pairs while they are
+// encoded.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.MarshalJSON()
+}
+
+// Description: Replaces the contents of the cache with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [Cache.MarshalJSON]. If an error is returned the cache is left unchanged.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCache[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying cache while its contents are replaced. Exhibits the same
+// behavior as [Cache.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCache[K, V]](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the cache. The values are
+// laid out the same way as [Cache.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying cache and then calls the
+// underlying caches [Cache.MarshalBinary] method. The usage lock is also held
+// so that concurrent reads cannot reorder the key value pairs while they are
+// encoded.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+	return c.Cache.MarshalBinary()
+}
+
+// Description: Replaces the contents of the cache with the values decoded
+// from the supplied binary data, which must have been produced by
+// [Cache.MarshalBinary]. If an error is returned the cache is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (c *Cache[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCache[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying cache while its contents are replaced. Exhibits the same
+// behavior as [Cache.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCache[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCache[K, V]](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.Cache.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. Key value pairs are printed in
+// eviction order.
+func (c Cache[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("cache["))
+	cntr := 0
+	c.forEach(func(e *cacheEntry[K, V]) bool {
+		fmt.Fprintf(f, fmtStr, e.key, e.val)
+		cntr++
+		if cntr < c.state.length {
+			f.Write([]byte{' '})
+		}
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (c *Cache[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", c)
+}
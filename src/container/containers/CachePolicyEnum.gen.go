@@ -0,0 +1,114 @@
+package containers
+
+// Code generated by ../../../bin/enum - DO NOT EDIT.
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	InvalidCachePolicy               = errors.New("Invalid CachePolicy")
+	CACHE_POLICY       []CachePolicy = []CachePolicy{
+		UnknownCachePolicy,
+		LRUCachePolicy,
+		LFUCachePolicy,
+	}
+)
+
+func NewCachePolicy() CachePolicy {
+	return LRUCachePolicy
+}
+
+func (o CachePolicy) Value() CachePolicy {
+	return o
+}
+
+func (o CachePolicy) Valid() error {
+	switch o {
+
+	case UnknownCachePolicy:
+		return nil
+
+	case LRUCachePolicy:
+		return nil
+
+	case LFUCachePolicy:
+		return nil
+
+	default:
+		return InvalidCachePolicy
+	}
+}
+
+func (o CachePolicy) String() string {
+	switch o {
+	case UnknownCachePolicy:
+		return "UnknownCachePolicy"
+	case LRUCachePolicy:
+		return "LRUCachePolicy"
+	case LFUCachePolicy:
+		return "LFUCachePolicy"
+
+	default:
+		return "UnknownCachePolicy"
+	}
+}
+
+func (o CachePolicy) MarshalJSON() ([]byte, error) {
+	switch o {
+
+	case UnknownCachePolicy:
+		return []byte("UnknownCachePolicy"), nil
+
+	case LRUCachePolicy:
+		return []byte("LRUCachePolicy"), nil
+
+	case LFUCachePolicy:
+		return []byte("LFUCachePolicy"), nil
+
+	default:
+		return []byte("UnknownCachePolicy"), InvalidCachePolicy
+	}
+}
+
+func (o *CachePolicy) FromString(s string) error {
+	switch s {
+
+	case "UnknownCachePolicy":
+		*o = UnknownCachePolicy
+		return nil
+
+	case "LRUCachePolicy":
+		*o = LRUCachePolicy
+		return nil
+
+	case "LFUCachePolicy":
+		*o = LFUCachePolicy
+		return nil
+
+	default:
+		*o = UnknownCachePolicy
+		return fmt.Errorf("%w: %s", InvalidCachePolicy, s)
+	}
+}
+
+func (o *CachePolicy) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+
+	case "UnknownCachePolicy":
+		*o = UnknownCachePolicy
+		return nil
+
+	case "LRUCachePolicy":
+		*o = LRUCachePolicy
+		return nil
+
+	case "LFUCachePolicy":
+		*o = LFUCachePolicy
+		return nil
+
+	default:
+		*o = UnknownCachePolicy
+		return fmt.Errorf("%w: %s", InvalidCachePolicy, string(b))
+	}
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func CacheToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateCache(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestCache_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(CacheToMapInterfaceFactory, t)
+}
+
+func TestCache_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(CacheToMapInterfaceFactory, t)
+}
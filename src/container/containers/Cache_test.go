@@ -0,0 +1,424 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=Cache -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateCache
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedCache -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateSyncedCache
+
+// The map interface tests do not expect values to be evicted, so the caches
+// are given enough room to hold every value the tests add.
+const cacheMapTestCapacity int = 1000
+
+func generateCache(capacity int) Cache[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	v, _ := NewCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		LRUCachePolicy, cacheMapTestCapacity, nil,
+	)
+	return v
+}
+
+func generateSyncedCache(capacity int) SyncedCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	v, _ := NewSyncedCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		LFUCachePolicy, cacheMapTestCapacity, nil,
+	)
+	return v
+}
+
+type cacheTestHooks struct {
+	evicted []basic.Pair[int, string]
+}
+
+func (c *cacheTestHooks) EvictOp(k int, v string) {
+	c.evicted = append(c.evicted, basic.Pair[int, string]{A: k, B: v})
+}
+
+func newCacheForTest(
+	policy CachePolicy,
+	capacity int,
+	t *testing.T,
+) (Cache[int, string, widgets.BuiltinInt, widgets.BuiltinString], *cacheTestHooks) {
+	hooks := &cacheTestHooks{}
+	c, err := NewCache[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		policy, capacity, hooks,
+	)
+	test.Nil(err, t)
+	return c, hooks
+}
+
+func checkCacheKeys(
+	c *Cache[int, string, widgets.BuiltinInt, widgets.BuiltinString],
+	exp []int,
+	t *testing.T,
+) {
+	keys, err := c.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](exp, keys, t)
+	test.Eq(len(exp), c.Length(), t)
+}
+
+func TestCacheWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[Cache[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v, _ := NewCache[string, string, widgets.BuiltinString, widgets.BuiltinString](
+		LRUCachePolicy, 1, nil,
+	)
+	widget = &v
+	_ = widget
+}
+
+func TestCacheNewErrors(t *testing.T) {
+	_, err := NewCache[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		LRUCachePolicy, -1, nil,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewCache[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		UnknownCachePolicy, 1, nil,
+	)
+	test.ContainsError(InvalidCachePolicy, err, t)
+	_, err = NewSyncedCache[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		CachePolicy(10), 1, nil,
+	)
+	test.ContainsError(InvalidCachePolicy, err, t)
+}
+
+func TestCacheLRUEviction(t *testing.T) {
+	c, hooks := newCacheForTest(LRUCachePolicy, 3, t)
+	test.Eq(3, c.Capacity(), t)
+	test.Eq(LRUCachePolicy, c.Policy(), t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+		basic.Pair[int, string]{A: 3, B: "three"},
+	), t)
+	checkCacheKeys(&c, []int{1, 2, 3}, t)
+	test.Eq(0, len(hooks.evicted), t)
+
+	v, err := c.Get(1)
+	test.Nil(err, t)
+	test.Eq("one", v, t)
+	checkCacheKeys(&c, []int{2, 3, 1}, t)
+
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 4, B: "four"}), t)
+	checkCacheKeys(&c, []int{3, 1, 4}, t)
+	test.Eq(1, len(hooks.evicted), t)
+	test.Eq(basic.Pair[int, string]{A: 2, B: "two"}, hooks.evicted[0], t)
+
+	test.Nil(c.Set(basic.Pair[int, string]{A: 3, B: "THREE"}), t)
+	checkCacheKeys(&c, []int{1, 4, 3}, t)
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 5, B: "five"}), t)
+	checkCacheKeys(&c, []int{4, 3, 5}, t)
+	test.Eq(basic.Pair[int, string]{A: 1, B: "one"}, hooks.evicted[1], t)
+
+	_, err = c.Get(1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	err = c.Set(basic.Pair[int, string]{A: 1, B: "one"})
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestCacheLFUEviction(t *testing.T) {
+	c, hooks := newCacheForTest(LFUCachePolicy, 3, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+		basic.Pair[int, string]{A: 3, B: "three"},
+	), t)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	checkCacheKeys(&c, []int{3, 2, 1}, t)
+
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 4, B: "four"}), t)
+	checkCacheKeys(&c, []int{4, 2, 1}, t)
+	test.Eq(basic.Pair[int, string]{A: 3, B: "three"}, hooks.evicted[0], t)
+
+	// Ties between equally used values evict the least recently used one
+	c.Get(4)
+	checkCacheKeys(&c, []int{2, 4, 1}, t)
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 5, B: "five"}), t)
+	checkCacheKeys(&c, []int{5, 4, 1}, t)
+	test.Eq(basic.Pair[int, string]{A: 2, B: "two"}, hooks.evicted[1], t)
+	test.Eq(2, len(hooks.evicted), t)
+}
+
+func TestCachePeekDoesNotUpdateUsage(t *testing.T) {
+	for _, p := range []CachePolicy{LRUCachePolicy, LFUCachePolicy} {
+		c, hooks := newCacheForTest(p, 2, t)
+		test.Nil(c.Emplace(
+			basic.Pair[int, string]{A: 1, B: "one"},
+			basic.Pair[int, string]{A: 2, B: "two"},
+		), t)
+		v, err := c.Peek(1)
+		test.Nil(err, t)
+		test.Eq("one", v, t)
+		test.True(c.Contains("one"), t)
+		k, found := c.KeyOf("one")
+		test.True(found, t)
+		test.Eq(1, k, t)
+		checkCacheKeys(&c, []int{1, 2}, t)
+		test.Nil(c.Emplace(basic.Pair[int, string]{A: 3, B: "three"}), t)
+		checkCacheKeys(&c, []int{2, 3}, t)
+		test.Eq(1, hooks.evicted[0].A, t)
+		_, err = c.Peek(1)
+		test.ContainsError(containerTypes.KeyError, err, t)
+	}
+}
+
+func TestCacheDeleteAndPopAreNotEvictions(t *testing.T) {
+	c, hooks := newCacheForTest(LFUCachePolicy, 3, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "dup"},
+		basic.Pair[int, string]{A: 3, B: "dup"},
+	), t)
+	c.Get(2)
+	test.Eq(2, c.Pop("dup"), t)
+	checkCacheKeys(&c, []int{1}, t)
+	test.Nil(c.Delete(1), t)
+	checkCacheKeys(&c, []int{}, t)
+	test.ContainsError(containerTypes.KeyError, c.Delete(1), t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+	), t)
+	c.Clear()
+	checkCacheKeys(&c, []int{}, t)
+	test.Eq(3, c.Capacity(), t)
+	test.Eq(0, len(hooks.evicted), t)
+}
+
+func TestCacheZeroCapacity(t *testing.T) {
+	c, hooks := newCacheForTest(LRUCachePolicy, 0, t)
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 1, B: "one"}), t)
+	checkCacheKeys(&c, []int{}, t)
+	test.Eq(1, len(hooks.evicted), t)
+	test.Eq(basic.Pair[int, string]{A: 1, B: "one"}, hooks.evicted[0], t)
+}
+
+func TestCacheNilHooks(t *testing.T) {
+	c, err := NewCache[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		LRUCachePolicy, 1, nil,
+	)
+	test.Nil(err, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+	), t)
+	checkCacheKeys(&c, []int{2}, t)
+}
+
+func TestCacheKeyedEqDoesNotUpdateUsage(t *testing.T) {
+	c1, _ := newCacheForTest(LRUCachePolicy, 2, t)
+	c2, _ := newCacheForTest(LRUCachePolicy, 2, t)
+	vals := []basic.Pair[int, string]{{A: 1, B: "one"}, {A: 2, B: "two"}}
+	test.Nil(c1.Emplace(vals...), t)
+	test.Nil(c2.Emplace(vals...), t)
+	test.True(c1.Eq(&c1, &c2), t)
+	test.Eq(c1.Hash(&c1), c1.Hash(&c2), t)
+	checkCacheKeys(&c2, []int{1, 2}, t)
+	c2.Set(basic.Pair[int, string]{A: 1, B: "ONE"})
+	test.False(c1.Eq(&c1, &c2), t)
+}
+
+func TestCacheFormat(t *testing.T) {
+	c, _ := newCacheForTest(LRUCachePolicy, 3, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+	), t)
+	c.Get(1)
+	test.Eq("cache[2:two 1:one]", fmt.Sprintf("%v", c), t)
+}
+
+func TestSyncedCacheConcurrentGets(t *testing.T) {
+	for _, p := range []CachePolicy{LRUCachePolicy, LFUCachePolicy} {
+		c, err := NewSyncedCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+			p, 10, nil,
+		)
+		test.Nil(err, t)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 1000; j++ {
+					k := (i + j) % 20
+					if _, err := c.Get(k); err != nil {
+						c.Emplace(basic.Pair[int, int]{A: k, B: k})
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		test.Eq(10, c.Length(), t)
+		cnt, err := c.Keys().Count()
+		test.Nil(err, t)
+		test.Eq(10, cnt, t)
+		c.Keys().ForEach(func(index int, val int) (iter.IteratorFeedback, error) {
+			v, err := c.Cache.Peek(val)
+			test.Nil(err, t)
+			test.Eq(val, v, t)
+			return iter.Continue, nil
+		})
+	}
+}
+
+// Meant to be run with the race detector enabled. Gets update the usage
+// information while the other readers walk it.
+func TestSyncedCacheConcurrentGetsAndReads(t *testing.T) {
+	for _, p := range []CachePolicy{LRUCachePolicy, LFUCachePolicy} {
+		c, err := NewSyncedCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+			p, 10, nil,
+		)
+		test.Nil(err, t)
+		for i := 0; i < 10; i++ {
+			c.Emplace(basic.Pair[int, int]{A: i, B: i})
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(3)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 500; j++ {
+					v, err := c.Get((i + j) % 10)
+					test.Nil(err, t)
+					test.Eq((i+j)%10, v, t)
+				}
+			}(i)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 500; j++ {
+					test.True(c.Contains((i+j)%10), t)
+					_, ok := c.KeyOf((i + j) % 10)
+					test.True(ok, t)
+				}
+			}(i)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					cnt, err := c.Keys().Count()
+					test.Nil(err, t)
+					test.Eq(10, cnt, t)
+				}
+			}()
+		}
+		wg.Wait()
+		test.Eq(10, c.Length(), t)
+	}
+}
+
+func TestCacheAll(t *testing.T) {
+	c, _ := newCacheForTest(LRUCachePolicy, 3, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+		basic.Pair[int, string]{A: 3, B: "three"},
+	), t)
+	c.Get(1)
+	keys, vals := []int{}, []string{}
+	for k, v := range c.All() {
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+	test.SlicesMatch[int]([]int{2, 3, 1}, keys, t)
+	test.SlicesMatch[string]([]string{"two", "three", "one"}, vals, t)
+	checkCacheKeys(&c, []int{2, 3, 1}, t)
+}
+
+func TestSyncedCacheAllGetWhileIterating(t *testing.T) {
+	c, _ := NewSyncedCache[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		LRUCachePolicy, 3, nil,
+	)
+	for i := 0; i < 3; i++ {
+		c.Emplace(basic.Pair[int, int]{A: i, B: i})
+	}
+	keys := []int{}
+	for k := range c.All() {
+		v, err := c.Get(k)
+		test.Nil(err, t)
+		test.Eq(k, v, t)
+		keys = append(keys, k)
+		break
+	}
+	test.SlicesMatch[int]([]int{0}, keys, t)
+	test.True(c.RWMutex.TryLock(), t)
+	c.RWMutex.Unlock()
+	keys = []int{}
+	for k := range c.All() {
+		keys = append(keys, k)
+	}
+	test.SlicesMatch[int]([]int{1, 2, 0}, keys, t)
+}
+
+func TestCacheSerializationKeepsEvictionOrder(t *testing.T) {
+	c, _ := newCacheForTest(LFUCachePolicy, 3, t)
+	test.Nil(c.Emplace(
+		basic.Pair[int, string]{A: 1, B: "one"},
+		basic.Pair[int, string]{A: 2, B: "two"},
+		basic.Pair[int, string]{A: 3, B: "three"},
+	), t)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	checkCacheKeys(&c, []int{3, 2, 1}, t)
+
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = c.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&c)
+		}
+		test.Nil(err, t)
+
+		res, hooks := newCacheForTest(LRUCachePolicy, 1, t)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.Eq(LFUCachePolicy, res.Policy(), t)
+		test.Eq(3, res.Capacity(), t)
+		checkCacheKeys(&res, []int{3, 2, 1}, t)
+		test.Eq(0, len(hooks.evicted), t)
+
+		// The usage counts are restored, so a single use of 2 ties it with 1
+		res.Get(2)
+		checkCacheKeys(&res, []int{3, 1, 2}, t)
+		test.Nil(res.Emplace(basic.Pair[int, string]{A: 4, B: "four"}), t)
+		checkCacheKeys(&res, []int{4, 1, 2}, t)
+		test.Eq(basic.Pair[int, string]{A: 3, B: "three"}, hooks.evicted[0], t)
+	}
+}
+
+func TestCacheDeserializationErrors(t *testing.T) {
+	c, _ := newCacheForTest(LRUCachePolicy, 2, t)
+	test.Nil(c.Emplace(basic.Pair[int, string]{A: 1, B: "one"}), t)
+	for _, data := range []string{
+		`{"policy":"LRUCachePolicy","capacity":-1,"entries":[]}`,
+		`{"policy":"badPolicy","capacity":1,"entries":[]}`,
+		`{"policy":"LRUCachePolicy","capacity":1,"entries":[` +
+			`{"key":1,"val":"a","freq":1},{"key":2,"val":"b","freq":1}]}`,
+		`{"policy":"LRUCachePolicy","capacity":2,"entries":[` +
+			`{"key":1,"val":"a","freq":2}]}`,
+		`{"policy":"LFUCachePolicy","capacity":2,"entries":[` +
+			`{"key":1,"val":"a","freq":2},{"key":2,"val":"b","freq":1}]}`,
+		`{"policy":"LFUCachePolicy","capacity":2,"entries":[` +
+			`{"key":1,"val":"a","freq":1},{"key":1,"val":"b","freq":1}]}`,
+	} {
+		test.NotNil(json.Unmarshal([]byte(data), &c), t)
+		test.Eq(LRUCachePolicy, c.Policy(), t)
+		test.Eq(2, c.Capacity(), t)
+		checkCacheKeys(&c, []int{1}, t)
+	}
+}
@@ -1,7 +1,9 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
+	stditer "iter"
 	"sync"
 
 	"github.com/barbell-math/util/src/container/basic"
@@ -1342,6 +1344,28 @@ func (c *SyncedCircularBuffer[T, U]) Vals() iter.Iter[T] {
 	)
 }
 
+// Description: Returns a sequence over the values in the circular buffer that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [CircularBuffer.Vals].
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) ValsSeq() stditer.Seq[T] {
+	return c.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the circular buffer that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedCircularBuffer.Vals], so the circular buffer will
+// have a read lock the entire time the loop is running. The lock is released
+// once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) ValsSeq() stditer.Seq[T] {
+	return c.Vals().Seq()
+}
+
 // Description: Returns an iterator that iterates over the pointers to the
 // values in the circular buffer. The circular buffer will have a read lock the
 // entire time the iteration is being performed. The lock will not be applied
@@ -1394,6 +1418,28 @@ func (c *SyncedCircularBuffer[T, U]) Keys() iter.Iter[int] {
 	)
 }
 
+// Description: Returns a sequence over the keys in the circular buffer that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [CircularBuffer.Keys].
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) KeysSeq() stditer.Seq[int] {
+	return c.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the circular buffer that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedCircularBuffer.Keys], so the circular buffer will
+// have a read lock the entire time the loop is running. The lock is released
+// once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) KeysSeq() stditer.Seq[int] {
+	return c.Keys().Seq()
+}
+
 func (c *CircularBuffer[T, U]) inclusiveEnd() wrapingIndex {
 	return c.start.Add(c.numElems-1, len(c.vals))
 }
@@ -1789,6 +1835,147 @@ func (_ *SyncedCircularBuffer[T, U]) Zero(other *SyncedCircularBuffer[T, U]) {
 	other.Clear()
 }
 
+type serializedCircularBuffer[T any] struct {
+	Capacity int `json:"capacity"`
+	Vals     []T `json:"vals"`
+}
+
+func (c *CircularBuffer[T, U]) toSerialized() serializedCircularBuffer[T] {
+	rv := serializedCircularBuffer[T]{
+		Capacity: len(c.vals),
+		Vals:     make([]T, c.numElems),
+	}
+	for i := 0; i < c.numElems; i++ {
+		rv.Vals[i] = c.vals[c.start.GetProperIndex(i, len(c.vals))]
+	}
+	return rv
+}
+
+func (c *CircularBuffer[T, U]) fromSerialized(
+	s serializedCircularBuffer[T],
+) error {
+	if s.Capacity <= 0 {
+		return customerr.Wrap(
+			customerr.ValOutsideRange,
+			"Size of buffer must be >0 | Have: %d", s.Capacity,
+		)
+	}
+	if len(s.Vals) > s.Capacity {
+		return getFullError(s.Capacity)
+	}
+	c.Clear()
+	c.vals = make([]T, s.Capacity)
+	c.numElems = copy(c.vals, s.Vals)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the circular buffer. The capacity
+// of the circular buffer is encoded along with its values, starting at the
+// front.
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying circular buffer and then
+// calls the underlying circular buffers [CircularBuffer.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) MarshalJSON() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CircularBuffer.MarshalJSON()
+}
+
+// Description: Replaces the contents of the circular buffer with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [CircularBuffer.MarshalJSON]. If an error is returned the circular buffer is
+// left unchanged.
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying circular buffer while its contents are replaced. Exhibits the
+// same behavior as [CircularBuffer.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.CircularBuffer.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the circular buffer. The
+// values are laid out the same way as [CircularBuffer.MarshalJSON] and are
+// encoded using the [encoding/gob] package, so the contained values must be
+// encodable by gob.
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying circular buffer and then
+// calls the underlying circular buffers [CircularBuffer.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) MarshalBinary() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CircularBuffer.MarshalBinary()
+}
+
+// Description: Replaces the contents of the circular buffer with the values
+// decoded from the supplied binary data, which must have been produced by
+// [CircularBuffer.MarshalBinary]. If an error is returned the circular buffer
+// is left unchanged.
+//
+// Time Complexity: O(n)
+func (c *CircularBuffer[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying circular buffer while its contents are replaced. Exhibits the
+// same behavior as [CircularBuffer.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (c *SyncedCircularBuffer[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.CircularBuffer.fromSerialized(decoded)
+}
+
 // Implements the [fmt.Formatter] interface.
 func (c CircularBuffer[T, U]) Format(f fmt.State, verb rune) {
 	fmtStr := string([]byte{'%', byte(verb)})
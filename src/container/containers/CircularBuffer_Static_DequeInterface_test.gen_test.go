@@ -65,6 +65,10 @@ func TestCircularBuffer_StaticDequeInterfaceInterface(t *testing.T) {
 	tests.StaticDequeInterfaceInterface(CircularBufferToDequeInterfaceFactory, t)
 }
 
+func TestCircularBuffer_StaticDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticDequeInterfaceSerializableInterface(CircularBufferToDequeInterfaceFactory, t)
+}
+
 func TestCircularBuffer_StaticDequeInterfaceClear(t *testing.T) {
 	tests.StaticDequeInterfaceClear(CircularBufferToDequeInterfaceFactory, t)
 }
@@ -108,3 +112,11 @@ func TestCircularBuffer_StaticDequeInterfacePushBack(t *testing.T) {
 func TestCircularBuffer_StaticDequeInterfaceForcePushBack(t *testing.T) {
 	tests.StaticDequeInterfaceForcePushBack(CircularBufferToDequeInterfaceFactory, t)
 }
+
+func TestCircularBuffer_StaticDequeInterfaceJSON(t *testing.T) {
+	tests.StaticDequeInterfaceJSON(CircularBufferToDequeInterfaceFactory, t)
+}
+
+func TestCircularBuffer_StaticDequeInterfaceBinary(t *testing.T) {
+	tests.StaticDequeInterfaceBinary(CircularBufferToDequeInterfaceFactory, t)
+}
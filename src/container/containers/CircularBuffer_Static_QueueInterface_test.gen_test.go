@@ -53,6 +53,10 @@ func TestCircularBuffer_StaticQueueInterfaceInterface(t *testing.T) {
 	tests.StaticQueueInterfaceInterface(CircularBufferToQueueInterfaceFactory, t)
 }
 
+func TestCircularBuffer_StaticQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticQueueInterfaceSerializableInterface(CircularBufferToQueueInterfaceFactory, t)
+}
+
 func TestCircularBuffer_StaticQueueInterfaceClear(t *testing.T) {
 	tests.StaticQueueInterfaceClear(CircularBufferToQueueInterfaceFactory, t)
 }
@@ -76,3 +80,11 @@ func TestCircularBuffer_StaticQueueInterfacePushBack(t *testing.T) {
 func TestCircularBuffer_StaticQueueInterfaceForcePushBack(t *testing.T) {
 	tests.StaticQueueInterfaceForcePushBack(CircularBufferToQueueInterfaceFactory, t)
 }
+
+func TestCircularBuffer_StaticQueueInterfaceJSON(t *testing.T) {
+	tests.StaticQueueInterfaceJSON(CircularBufferToQueueInterfaceFactory, t)
+}
+
+func TestCircularBuffer_StaticQueueInterfaceBinary(t *testing.T) {
+	tests.StaticQueueInterfaceBinary(CircularBufferToQueueInterfaceFactory, t)
+}
@@ -49,6 +49,10 @@ func TestCircularBuffer_StaticSetInterfaceInterface(t *testing.T) {
 	tests.StaticSetInterfaceInterface(CircularBufferToSetInterfaceFactory, t)
 }
 
+func TestCircularBuffer_StaticSetInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticSetInterfaceSerializableInterface(CircularBufferToSetInterfaceFactory, t)
+}
+
 func TestCircularBuffer_StaticSetInterfaceVals(t *testing.T) {
 	tests.StaticSetInterfaceVals(CircularBufferToSetInterfaceFactory, t)
 }
@@ -108,3 +112,11 @@ func TestCircularBuffer_StaticSetInterfaceIsSuperset(t *testing.T) {
 func TestCircularBuffer_StaticSetInterfaceIsSubset(t *testing.T) {
 	tests.StaticSetInterfaceIsSubset(CircularBufferToSetInterfaceFactory, t)
 }
+
+func TestCircularBuffer_StaticSetInterfaceJSON(t *testing.T) {
+	tests.StaticSetInterfaceJSON(CircularBufferToSetInterfaceFactory, t)
+}
+
+func TestCircularBuffer_StaticSetInterfaceBinary(t *testing.T) {
+	tests.StaticSetInterfaceBinary(CircularBufferToSetInterfaceFactory, t)
+}
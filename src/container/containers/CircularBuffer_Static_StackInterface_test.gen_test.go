@@ -53,6 +53,10 @@ func TestCircularBuffer_StaticStackInterfaceInterface(t *testing.T) {
 	tests.StaticStackInterfaceInterface(CircularBufferToStackInterfaceFactory, t)
 }
 
+func TestCircularBuffer_StaticStackInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticStackInterfaceSerializableInterface(CircularBufferToStackInterfaceFactory, t)
+}
+
 func TestCircularBuffer_StaticStackInterfaceClear(t *testing.T) {
 	tests.StaticStackInterfaceClear(CircularBufferToStackInterfaceFactory, t)
 }
@@ -76,3 +80,11 @@ func TestCircularBuffer_StaticStackInterfacePushBack(t *testing.T) {
 func TestCircularBuffer_StaticStackInterfaceForcePushBack(t *testing.T) {
 	tests.StaticStackInterfaceForcePushBack(CircularBufferToStackInterfaceFactory, t)
 }
+
+func TestCircularBuffer_StaticStackInterfaceJSON(t *testing.T) {
+	tests.StaticStackInterfaceJSON(CircularBufferToStackInterfaceFactory, t)
+}
+
+func TestCircularBuffer_StaticStackInterfaceBinary(t *testing.T) {
+	tests.StaticStackInterfaceBinary(CircularBufferToStackInterfaceFactory, t)
+}
@@ -85,6 +85,10 @@ func TestCircularBuffer_StaticVectorInterfaceInterface(t *testing.T) {
 	tests.StaticVectorInterfaceInterface(CircularBufferToVectorInterfaceFactory, t)
 }
 
+func TestCircularBuffer_StaticVectorInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticVectorInterfaceSerializableInterface(CircularBufferToVectorInterfaceFactory, t)
+}
+
 func TestCircularBuffer_StaticVectorInterfaceGet(t *testing.T) {
 	tests.StaticVectorInterfaceGet(CircularBufferToVectorInterfaceFactory, t)
 }
@@ -168,3 +172,11 @@ func TestCircularBuffer_StaticVectorInterfaceKeys(t *testing.T) {
 func TestCircularBuffer_StaticVectorInterfaceKeyedEq(t *testing.T) {
 	tests.StaticVectorInterfaceKeyedEq(CircularBufferToVectorInterfaceFactory, t)
 }
+
+func TestCircularBuffer_StaticVectorInterfaceJSON(t *testing.T) {
+	tests.StaticVectorInterfaceJSON(CircularBufferToVectorInterfaceFactory, t)
+}
+
+func TestCircularBuffer_StaticVectorInterfaceBinary(t *testing.T) {
+	tests.StaticVectorInterfaceBinary(CircularBufferToVectorInterfaceFactory, t)
+}
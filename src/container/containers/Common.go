@@ -2,7 +2,11 @@
 package containers
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/barbell-math/util/src/container/basic"
 	"github.com/barbell-math/util/src/container/containerTypes"
@@ -72,6 +76,82 @@ func addressableSafeOutVerticesAndEdgesIter[V any, E any](
 	)
 }
 
+func addressableSafeUndirectedVerticesIter[V any, E any](
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) iter.Iter[*V] {
+	if other.IsAddressable() {
+		return other.VerticePntrs()
+	}
+	return iter.ValToPntr[V](other.Vertices())
+}
+
+func addressableSafeUndirectedEdgesIter[V any, E any](
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) iter.Iter[*E] {
+	if other.IsAddressable() {
+		return other.EdgePntrs()
+	}
+	return iter.ValToPntr[E](other.Edges())
+}
+
+func addressableSafeIncidentEdgesAndNeighborsIter[V any, E any](
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	v V,
+) iter.Iter[basic.Pair[*E, *V]] {
+	if other.IsAddressable() {
+		return other.IncidentEdgesAndNeighborPntrs(&v)
+	}
+	return iter.Map[basic.Pair[E, V], basic.Pair[*E, *V]](
+		other.IncidentEdgesAndNeighbors(v),
+		func(index int, val basic.Pair[E, V]) (basic.Pair[*E, *V], error) {
+			return basic.Pair[*E, *V]{A: &val.A, B: &val.B}, nil
+		},
+	)
+}
+
+// The serialized form of a single key value pair. All keyed containers use
+// this type so they share the same JSON and binary layout.
+type serializedKV[K any, V any] struct {
+	Key K `json:"key"`
+	Val V `json:"val"`
+}
+
+// The serialized form of a single graph link.
+type serializedLink[V any, E any] struct {
+	From V `json:"from"`
+	To   V `json:"to"`
+	Edge E `json:"edge"`
+}
+
+// The serialized form of a graph. All of the vertices and edges are listed,
+// including those that are not part of any link, so a graph with isolated
+// vertices or unused edges will round trip.
+type serializedGraph[V any, E any] struct {
+	Vertices []V                    `json:"vertices"`
+	Edges    []E                    `json:"edges"`
+	Links    []serializedLink[V, E] `json:"links"`
+}
+
+func unmarshalJSON[S any](data []byte) (S, error) {
+	var rv S
+	err := json.Unmarshal(data, &rv)
+	return rv, err
+}
+
+func marshalBinary[S any](s S) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalBinary[S any](data []byte) (S, error) {
+	var rv S
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&rv)
+	return rv, err
+}
+
 func getNonAddressablePanicText(thingName string) string {
 	return fmt.Sprintf("A %s is not addressable!", thingName)
 }
@@ -197,3 +277,81 @@ func getUpdateViolationEqError[T any](
 		),
 	)
 }
+
+func getEmptyError() error {
+	return customerr.Wrap(
+		containerTypes.Empty,
+		"The container is empty, there are no values to return.",
+	)
+}
+
+func getDurationError(d time.Duration) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"Duration must be >=0. Got: %s", d,
+	)
+}
+
+func getNumShardsError(numShards int) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"The number of shards must be >0. Got: %d", numShards,
+	)
+}
+
+func getMustBePositiveError(name string, val int) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"The %s must be >0. Got: %d", name, val,
+	)
+}
+
+func getFalsePositiveRateError(rate float64) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"The false positive rate must be >0 and <1. Got: %f", rate,
+	)
+}
+
+func getIncompatibleFiltersError(
+	lSize int, lNumHashes int,
+	rSize int, rNumHashes int,
+) error {
+	return customerr.Wrap(
+		customerr.DimensionsDoNotAgree,
+		"Filters must have the same size and number of hashes to be combined. Got: (%d, %d) and (%d, %d)",
+		lSize, lNumHashes, rSize, rNumHashes,
+	)
+}
+
+func getMalformedDataError(reason string) error {
+	return customerr.Wrap(
+		customerr.InvalidValue,
+		"The serialized data is malformed: %s", reason,
+	)
+}
+
+func getIntervalError[T any](lo *T, hi *T) error {
+	return customerr.WrapValueList(
+		customerr.InvalidValue,
+		"The low end of an interval must be < the high end of the interval.",
+		[]customerr.WrapListVal{
+			{ItemName: "Lo", Item: *lo},
+			{ItemName: "Hi", Item: *hi},
+		},
+	)
+}
+
+func getNegativeValueError(v int) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"Values must be >=0. Got: %d", v,
+	)
+}
+
+func getShiftError(n int) error {
+	return customerr.Wrap(
+		customerr.ValOutsideRange,
+		"The shift amount must be >=0. Got: %d", n,
+	)
+}
@@ -0,0 +1,804 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	countingBloomFilterState struct {
+		counters  []uint8
+		numHashes int
+	}
+
+	// A type to represent a probabilistic set that supports removing values.
+	// A counting bloom filter behaves the same as a [BloomFilter] except that
+	// each position holds a counter rather than a single bit, allowing values
+	// to be removed with [CountingBloomFilter.Remove]. This comes at the cost
+	// of using eight times the memory of a bloom filter with the same number
+	// of positions. Counters saturate at their maximum value, and once a
+	// counter is saturated it is never decremented. Copies of a counting bloom
+	// filter share the same underlying state, the same as the builtin map
+	// type.
+	CountingBloomFilter[T any, U widgets.BaseInterface[T]] struct {
+		state *countingBloomFilterState
+	}
+
+	// A synchronized version of CountingBloomFilter. All operations will be
+	// wrapped in the appropriate calls to the embedded RWMutex. A pointer to a
+	// RWMutex is embedded rather than a value to avoid copying the lock value.
+	SyncedCountingBloomFilter[T any, U widgets.BaseInterface[T]] struct {
+		*sync.RWMutex
+		CountingBloomFilter[T, U]
+	}
+)
+
+// Creates a new counting bloom filter with numCounters counters that
+// increments numHashes counters for every value that is added. NumCounters and
+// numHashes must be > 0, an error will be returned if they are not.
+// [BloomFilterSize] can be used to calculate these values from an expected
+// number of values and false positive rate.
+func NewCountingBloomFilter[T any, U widgets.BaseInterface[T]](
+	numCounters int,
+	numHashes int,
+) (CountingBloomFilter[T, U], error) {
+	if numCounters <= 0 {
+		return CountingBloomFilter[T, U]{}, getMustBePositiveError(
+			"number of counters", numCounters,
+		)
+	}
+	if numHashes <= 0 {
+		return CountingBloomFilter[T, U]{}, getMustBePositiveError(
+			"number of hashes", numHashes,
+		)
+	}
+	return CountingBloomFilter[T, U]{
+		state: &countingBloomFilterState{
+			counters:  make([]uint8, numCounters),
+			numHashes: numHashes,
+		},
+	}, nil
+}
+
+// Creates a new synced counting bloom filter with numCounters counters that
+// increments numHashes counters for every value that is added. NumCounters and
+// numHashes must be > 0, an error will be returned if they are not. The
+// underlying RWMutex value will be fully unlocked upon initialization.
+func NewSyncedCountingBloomFilter[T any, U widgets.BaseInterface[T]](
+	numCounters int,
+	numHashes int,
+) (SyncedCountingBloomFilter[T, U], error) {
+	rv, err := NewCountingBloomFilter[T, U](numCounters, numHashes)
+	return SyncedCountingBloomFilter[T, U]{
+		RWMutex:             &sync.RWMutex{},
+		CountingBloomFilter: rv,
+	}, err
+}
+
+// Creates a new counting bloom filter that is sized to hold numVals values
+// while keeping the false positive rate at or below falsePositiveRate. Returns
+// an error under the same conditions as [BloomFilterSize].
+func NewCountingBloomFilterForRate[T any, U widgets.BaseInterface[T]](
+	numVals int,
+	falsePositiveRate float64,
+) (CountingBloomFilter[T, U], error) {
+	numCounters, numHashes, err := BloomFilterSize(numVals, falsePositiveRate)
+	if err != nil {
+		return CountingBloomFilter[T, U]{}, err
+	}
+	return NewCountingBloomFilter[T, U](numCounters, numHashes)
+}
+
+// Creates a new synced counting bloom filter that is sized to hold numVals
+// values while keeping the false positive rate at or below falsePositiveRate.
+// Returns an error under the same conditions as [BloomFilterSize]. The
+// underlying RWMutex value will be fully unlocked upon initialization.
+func NewSyncedCountingBloomFilterForRate[T any, U widgets.BaseInterface[T]](
+	numVals int,
+	falsePositiveRate float64,
+) (SyncedCountingBloomFilter[T, U], error) {
+	rv, err := NewCountingBloomFilterForRate[T, U](numVals, falsePositiveRate)
+	return SyncedCountingBloomFilter[T, U]{
+		RWMutex:             &sync.RWMutex{},
+		CountingBloomFilter: rv,
+	}, err
+}
+
+// Converts the supplied counting bloom filter to a synchronized counting bloom
+// filter. Beware: The original non-synced counting bloom filter will remain
+// useable.
+func (c *CountingBloomFilter[T, U]) ToSynced() SyncedCountingBloomFilter[T, U] {
+	return SyncedCountingBloomFilter[T, U]{
+		RWMutex:             &sync.RWMutex{},
+		CountingBloomFilter: *c,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (c *CountingBloomFilter[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (c *CountingBloomFilter[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (c *CountingBloomFilter[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (c *CountingBloomFilter[T, U]) RUnlock() {}
+
+// The SyncedCountingBloomFilter method to override the CountingBloomFilter
+// pass through function and actually apply the mutex operation.
+func (c *SyncedCountingBloomFilter[T, U]) Lock() { c.RWMutex.Lock() }
+
+// The SyncedCountingBloomFilter method to override the CountingBloomFilter
+// pass through function and actually apply the mutex operation.
+func (c *SyncedCountingBloomFilter[T, U]) Unlock() { c.RWMutex.Unlock() }
+
+// The SyncedCountingBloomFilter method to override the CountingBloomFilter
+// pass through function and actually apply the mutex operation.
+func (c *SyncedCountingBloomFilter[T, U]) RLock() { c.RWMutex.RLock() }
+
+// The SyncedCountingBloomFilter method to override the CountingBloomFilter
+// pass through function and actually apply the mutex operation.
+func (c *SyncedCountingBloomFilter[T, U]) RUnlock() { c.RWMutex.RUnlock() }
+
+// Returns false, a counting bloom filter is not synced.
+func (c *CountingBloomFilter[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced counting bloom filter is synced.
+func (c *SyncedCountingBloomFilter[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of counters in the counting bloom filter.
+//
+// Time Complexity: O(1)
+func (c *CountingBloomFilter[T, U]) NumCounters() int {
+	return len(c.state.counters)
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.NumCounters] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (c *SyncedCountingBloomFilter[T, U]) NumCounters() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.NumCounters()
+}
+
+// Description: Returns the number of counters that are incremented for each
+// value that is added to the counting bloom filter.
+//
+// Time Complexity: O(1)
+func (c *CountingBloomFilter[T, U]) NumHashes() int {
+	return c.state.numHashes
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.NumHashes] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (c *SyncedCountingBloomFilter[T, U]) NumHashes() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.NumHashes()
+}
+
+func (c *CountingBloomFilter[T, U]) numNonZero() int {
+	rv := 0
+	for _, iterV := range c.state.counters {
+		if iterV > 0 {
+			rv++
+		}
+	}
+	return rv
+}
+
+// Description: Returns an estimate of the number of unique values that are in
+// the counting bloom filter based on the number of counters that are not zero.
+// If every counter is non-zero the estimate is unbounded and [math.MaxInt] is
+// returned.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) ApproxLength() int {
+	return bloomFilterApproxLength(
+		len(c.state.counters), c.state.numHashes, c.numNonZero(),
+	)
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.ApproxLength] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) ApproxLength() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.ApproxLength()
+}
+
+// Description: Returns the current false positive rate of the counting bloom
+// filter, which is the probability that [CountingBloomFilter.MayContain]
+// returns true for a value that is not in the filter. This is calculated from
+// the fraction of counters that are not zero.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) FalsePositiveRate() float64 {
+	return math.Pow(
+		float64(c.numNonZero())/float64(len(c.state.counters)),
+		float64(c.state.numHashes),
+	)
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.FalsePositiveRate] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) FalsePositiveRate() float64 {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.FalsePositiveRate()
+}
+
+// Description: Adds the supplied values to the counting bloom filter. Adding
+// the same value multiple times requires it to be removed the same number of
+// times before the counting bloom filter no longer reports that it may
+// contain the value. This function will never return an error.
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (c *CountingBloomFilter[T, U]) Add(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		c.addImpl(&vals[i])
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters [CountingBloomFilter.Add]
+// implementation method. The [CountingBloomFilter.Add] method is not called
+// directly to avoid copying the vals varargs twice, which could be expensive
+// with a large type for the T generic or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (c *SyncedCountingBloomFilter[T, U]) Add(vals ...T) error {
+	c.Lock()
+	defer c.Unlock()
+	for i := 0; i < len(vals); i++ {
+		c.addImpl(&vals[i])
+	}
+	return nil
+}
+
+// Increments all of the counters for v, returning true if all of the counters
+// were already non-zero.
+func (c *CountingBloomFilter[T, U]) addImpl(v *T) bool {
+	rv := true
+	bloomFilterPositions[T, U](
+		v, len(c.state.counters), c.state.numHashes,
+		func(pos int) bool {
+			rv = rv && c.state.counters[pos] > 0
+			if c.state.counters[pos] < math.MaxUint8 {
+				c.state.counters[pos]++
+			}
+			return true
+		},
+	)
+	return rv
+}
+
+// Description: Adds the supplied value to the counting bloom filter and returns
+// true if the value may have already been in the counting bloom filter. If
+// false is returned the value was definitely not in the counting bloom filter
+// before it was added.
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *CountingBloomFilter[T, U]) TestAndAdd(v T) bool {
+	return c.addImpl(&v)
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.TestAndAdd] implementation method. The check and the add
+// are performed atomically.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *SyncedCountingBloomFilter[T, U]) TestAndAdd(v T) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.addImpl(&v)
+}
+
+// Description: Removes the supplied values from the counting bloom filter. If
+// a value is definitely not in the counting bloom filter a
+// [containerTypes.ValueError] will be returned and no more values will be
+// removed. Beware: removing a value that was never added but is reported as
+// possibly present due to a false positive will decrement counters that belong
+// to other values, which can cause false negatives.
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (c *CountingBloomFilter[T, U]) Remove(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		if err := c.removeImpl(&vals[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters [CountingBloomFilter.Remove]
+// implementation method. The [CountingBloomFilter.Remove] method is not called
+// directly to avoid copying the vals varargs twice, which could be expensive
+// with a large type for the T generic or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the number of hashes
+func (c *SyncedCountingBloomFilter[T, U]) Remove(vals ...T) error {
+	c.Lock()
+	defer c.Unlock()
+	for i := 0; i < len(vals); i++ {
+		if err := c.removeImpl(&vals[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CountingBloomFilter[T, U]) removeImpl(v *T) error {
+	if !c.MayContainPntr(v) {
+		return getValueError[T](v)
+	}
+	bloomFilterPositions[T, U](
+		v, len(c.state.counters), c.state.numHashes,
+		func(pos int) bool {
+			// A saturated counter no longer knows how many values map to it
+			if c.state.counters[pos] < math.MaxUint8 {
+				c.state.counters[pos]--
+			}
+			return true
+		},
+	)
+	return nil
+}
+
+// Description: Returns false if the supplied value is definitely not in the
+// counting bloom filter and true if the value may be in the counting bloom
+// filter. The probability of a false positive is given by
+// [CountingBloomFilter.FalsePositiveRate].
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *CountingBloomFilter[T, U]) MayContain(v T) bool {
+	return c.MayContainPntr(&v)
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.MayContainPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *SyncedCountingBloomFilter[T, U]) MayContain(v T) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.MayContainPntr(&v)
+}
+
+// Description: Returns false if the supplied value is definitely not in the
+// counting bloom filter and true if the value may be in the counting bloom
+// filter. The probability of a false positive is given by
+// [CountingBloomFilter.FalsePositiveRate].
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *CountingBloomFilter[T, U]) MayContainPntr(v *T) bool {
+	return bloomFilterPositions[T, U](
+		v, len(c.state.counters), c.state.numHashes,
+		func(pos int) bool { return c.state.counters[pos] > 0 },
+	)
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.MayContainPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the number of hashes
+func (c *SyncedCountingBloomFilter[T, U]) MayContainPntr(v *T) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.MayContainPntr(v)
+}
+
+func (c *CountingBloomFilter[T, U]) combine(
+	l *CountingBloomFilter[T, U],
+	r *CountingBloomFilter[T, U],
+	op func(l uint8, r uint8) uint8,
+) error {
+	if len(l.state.counters) != len(r.state.counters) ||
+		l.state.numHashes != r.state.numHashes {
+		return getIncompatibleFiltersError(
+			len(l.state.counters), l.state.numHashes,
+			len(r.state.counters), r.state.numHashes,
+		)
+	}
+	newCounters := make([]uint8, len(l.state.counters))
+	for i := 0; i < len(newCounters); i++ {
+		newCounters[i] = op(l.state.counters[i], r.state.counters[i])
+	}
+	c.state.counters = newCounters
+	c.state.numHashes = l.state.numHashes
+	return nil
+}
+
+// Description: Populates the counting bloom filter with the union of the l and
+// r counting bloom filters. Each counter in the result is the maximum of the
+// corresponding counters in l and r, so any value that may be in l or r may be
+// in the resulting counting bloom filter. The current contents of the counting
+// bloom filter are discarded, and the counting bloom filter will take on the
+// size and number of hashes of l and r. The counting bloom filter may be the
+// same as l or r. L and r must have the same number of counters and hashes, a
+// [customerr.DimensionsDoNotAgree] error will be returned if they do not and
+// the counting bloom filter will not be modified.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) Union(
+	l *CountingBloomFilter[T, U],
+	r *CountingBloomFilter[T, U],
+) error {
+	return c.combine(l, r, func(l uint8, r uint8) uint8 { return max(l, r) })
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// read locks on l and r and then calls the underlying counting bloom filters
+// [CountingBloomFilter.Union] method. The counting bloom filter may be the
+// same as l or r.
+//
+// Lock Type: Write on this counting bloom filter, read on l and r
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) Union(
+	l *SyncedCountingBloomFilter[T, U],
+	r *SyncedCountingBloomFilter[T, U],
+) error {
+	defer c.lockForCombine(l, r)()
+	return c.CountingBloomFilter.Union(
+		&l.CountingBloomFilter, &r.CountingBloomFilter,
+	)
+}
+
+// Description: Populates the counting bloom filter with the intersection of
+// the l and r counting bloom filters. Each counter in the result is the
+// minimum of the corresponding counters in l and r, so any value that may be
+// in both l and r may be in the resulting counting bloom filter. The current
+// contents of the counting bloom filter are discarded, and the counting bloom
+// filter will take on the size and number of hashes of l and r. The counting
+// bloom filter may be the same as l or r. L and r must have the same number of
+// counters and hashes, a [customerr.DimensionsDoNotAgree] error will be
+// returned if they do not and the counting bloom filter will not be modified.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) Intersection(
+	l *CountingBloomFilter[T, U],
+	r *CountingBloomFilter[T, U],
+) error {
+	return c.combine(l, r, func(l uint8, r uint8) uint8 { return min(l, r) })
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// read locks on l and r and then calls the underlying counting bloom filters
+// [CountingBloomFilter.Intersection] method. The counting bloom filter may be
+// the same as l or r.
+//
+// Lock Type: Write on this counting bloom filter, read on l and r
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) Intersection(
+	l *SyncedCountingBloomFilter[T, U],
+	r *SyncedCountingBloomFilter[T, U],
+) error {
+	defer c.lockForCombine(l, r)()
+	return c.CountingBloomFilter.Intersection(
+		&l.CountingBloomFilter, &r.CountingBloomFilter,
+	)
+}
+
+// Locks c for writing and l and r for reading, skipping any locks that are
+// shared so the same lock is never acquired twice. Returns a function that
+// releases all of the acquired locks.
+func (c *SyncedCountingBloomFilter[T, U]) lockForCombine(
+	l *SyncedCountingBloomFilter[T, U],
+	r *SyncedCountingBloomFilter[T, U],
+) func() {
+	c.Lock()
+	lLocked := l.RWMutex != c.RWMutex
+	rLocked := r.RWMutex != c.RWMutex && r.RWMutex != l.RWMutex
+	if lLocked {
+		l.RLock()
+	}
+	if rLocked {
+		r.RLock()
+	}
+	return func() {
+		if rLocked {
+			r.RUnlock()
+		}
+		if lLocked {
+			l.RUnlock()
+		}
+		c.Unlock()
+	}
+}
+
+// Description: Clears all values from the counting bloom filter. The number of
+// counters and hashes are not changed.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) Clear() {
+	clear(c.state.counters)
+}
+
+// Description: Places a write lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters [CountingBloomFilter.Clear]
+// method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) Clear() {
+	c.Lock()
+	defer c.Unlock()
+	c.CountingBloomFilter.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two counting bloom filters are equal if they have the same number
+// of counters and hashes and all of the counters are equal. Returns true if
+// l==r, false otherwise.
+func (_ *CountingBloomFilter[T, U]) Eq(
+	l *CountingBloomFilter[T, U],
+	r *CountingBloomFilter[T, U],
+) bool {
+	if len(l.state.counters) != len(r.state.counters) ||
+		l.state.numHashes != r.state.numHashes {
+		return false
+	}
+	for i := 0; i < len(l.state.counters); i++ {
+		if l.state.counters[i] != r.state.counters[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// counting bloom filters [CountingBloomFilter.Eq] method. Returns true if
+// l==r, false otherwise.
+func (_ *SyncedCountingBloomFilter[T, U]) Eq(
+	l *SyncedCountingBloomFilter[T, U],
+	r *SyncedCountingBloomFilter[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.CountingBloomFilter.Eq(&l.CountingBloomFilter, &r.CountingBloomFilter)
+}
+
+// A function that returns a hash of a counting bloom filter. The hash is
+// created from the number of hashes and the counters of the counting bloom
+// filter, making it so the hash will represent the same equality operation
+// that [CountingBloomFilter.Eq] provides.
+func (_ *CountingBloomFilter[T, U]) Hash(
+	other *CountingBloomFilter[T, U],
+) hash.Hash {
+	rv := hash.Hash(len(other.state.counters)).Combine(
+		hash.Hash(other.state.numHashes),
+	)
+	for _, iterV := range other.state.counters {
+		rv = rv.Combine(hash.Hash(iterV))
+	}
+	return rv
+}
+
+// Places a read lock on the underlying counting bloom filter of other and then
+// calls others underlying counting bloom filters [CountingBloomFilter.Hash]
+// method.
+func (_ *SyncedCountingBloomFilter[T, U]) Hash(
+	other *SyncedCountingBloomFilter[T, U],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.CountingBloomFilter.Hash(&other.CountingBloomFilter)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [CountingBloomFilter.Clear].
+func (_ *CountingBloomFilter[T, U]) Zero(other *CountingBloomFilter[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedCountingBloomFilter.Clear].
+func (_ *SyncedCountingBloomFilter[T, U]) Zero(
+	other *SyncedCountingBloomFilter[T, U],
+) {
+	other.Clear()
+}
+
+type serializedCountingBloomFilter struct {
+	NumHashes int     `json:"numHashes"`
+	Counters  []uint8 `json:"counters"`
+}
+
+func (c *CountingBloomFilter[T, U]) toSerialized() serializedCountingBloomFilter {
+	return serializedCountingBloomFilter{
+		NumHashes: c.state.numHashes,
+		Counters:  c.state.counters,
+	}
+}
+
+func (c *CountingBloomFilter[T, U]) fromSerialized(
+	s serializedCountingBloomFilter,
+) error {
+	if len(s.Counters) == 0 {
+		return getMustBePositiveError("number of counters", len(s.Counters))
+	}
+	if s.NumHashes <= 0 {
+		return getMustBePositiveError("number of hashes", s.NumHashes)
+	}
+	if c.state == nil {
+		c.state = &countingBloomFilterState{}
+	}
+	c.state.numHashes = s.NumHashes
+	c.state.counters = s.Counters
+	return nil
+}
+
+// Description: Returns the JSON encoding of the counting bloom filter. The
+// number of hashes and the counters of the filter are encoded. The counters are
+// encoded as a base64 string, the same as any other byte slice.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) MarshalJSON() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.MarshalJSON()
+}
+
+// Description: Replaces the contents of the counting bloom filter with the
+// values decoded from the supplied JSON data, which must be in the format
+// produced by [CountingBloomFilter.MarshalJSON]. If an error is returned the
+// counting bloom filter is left unchanged.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCountingBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying counting bloom filter while its contents are replaced.
+// Exhibits the same behavior as [CountingBloomFilter.UnmarshalJSON]. The lock
+// is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCountingBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.CountingBloomFilter.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the counting bloom filter.
+// The values are laid out the same way as [CountingBloomFilter.MarshalJSON] and
+// are encoded using the [encoding/gob] package.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c.toSerialized())
+}
+
+// Description: Places a read lock on the underlying counting bloom filter and
+// then calls the underlying counting bloom filters
+// [CountingBloomFilter.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) MarshalBinary() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.CountingBloomFilter.MarshalBinary()
+}
+
+// Description: Replaces the contents of the counting bloom filter with the
+// values decoded from the supplied binary data, which must have been produced
+// by [CountingBloomFilter.MarshalBinary]. If an error is returned the counting
+// bloom filter is left unchanged.
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *CountingBloomFilter[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCountingBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying counting bloom filter while its contents are replaced.
+// Exhibits the same behavior as [CountingBloomFilter.UnmarshalBinary]. The lock
+// is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of counters
+func (c *SyncedCountingBloomFilter[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCountingBloomFilter](data)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.CountingBloomFilter.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values in a counting bloom
+// filter cannot be recovered so the size, number of hashes, and number of
+// non-zero counters are printed instead.
+func (c CountingBloomFilter[T, U]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(
+		f, "countingBloomFilter[counters:%d hashes:%d set:%d]",
+		len(c.state.counters), c.state.numHashes, c.numNonZero(),
+	)
+}
+
+// Implements the [fmt.Stringer] interface.
+func (c *CountingBloomFilter[T, U]) String() string {
+	return fmt.Sprintf("%v", c)
+}
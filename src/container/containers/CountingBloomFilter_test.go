@@ -0,0 +1,225 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestCountingBloomFilterWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[CountingBloomFilter[string, widgets.BuiltinString]]
+	v, _ := NewCountingBloomFilter[string, widgets.BuiltinString](64, 3)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedCountingBloomFilterWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedCountingBloomFilter[string, widgets.BuiltinString]]
+	v, _ := NewSyncedCountingBloomFilter[string, widgets.BuiltinString](64, 3)
+	widget = &v
+	_ = widget
+}
+
+func TestCountingBloomFilterNewErrors(t *testing.T) {
+	_, err := NewCountingBloomFilter[int, widgets.BuiltinInt](0, 3)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedCountingBloomFilter[int, widgets.BuiltinInt](64, -1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewCountingBloomFilterForRate[int, widgets.BuiltinInt](-1, 0.01)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedCountingBloomFilterForRate[int, widgets.BuiltinInt](10, 2)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestCountingBloomFilterAdd(t *testing.T) {
+	c, err := NewCountingBloomFilterForRate[int, widgets.BuiltinInt](1000, 0.01)
+	test.Nil(err, t)
+	test.False(c.MayContain(1), t)
+	for i := 0; i < 1000; i++ {
+		test.Nil(c.Add(i), t)
+	}
+	for i := 0; i < 1000; i++ {
+		test.True(c.MayContain(i), t)
+	}
+	approx := c.ApproxLength()
+	test.True(approx > 900 && approx < 1100, t)
+	test.True(c.FalsePositiveRate() < 0.015, t)
+	c.Clear()
+	test.False(c.MayContain(1), t)
+	test.Eq(0.0, c.FalsePositiveRate(), t)
+}
+
+func TestCountingBloomFilterFalsePositiveRate(t *testing.T) {
+	c, _ := NewCountingBloomFilterForRate[int, widgets.BuiltinInt](10000, 0.01)
+	for i := 0; i < 10000; i++ {
+		c.Add(i)
+	}
+	falsePositives := 0
+	for i := 10000; i < 110000; i++ {
+		if c.MayContain(i) {
+			falsePositives++
+		}
+	}
+	test.True(float64(falsePositives)/100000 < 0.02, t)
+}
+
+func TestCountingBloomFilterRemove(t *testing.T) {
+	c, _ := NewCountingBloomFilterForRate[int, widgets.BuiltinInt](100, 0.001)
+	c.Add(1, 2, 3, 2)
+	test.Nil(c.Remove(1), t)
+	test.False(c.MayContain(1), t)
+	test.True(c.MayContain(2), t)
+	test.Nil(c.Remove(2), t)
+	test.True(c.MayContain(2), t)
+	test.Nil(c.Remove(2), t)
+	test.False(c.MayContain(2), t)
+	test.True(c.MayContain(3), t)
+	err := c.Remove(3, 4, 3)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.False(c.MayContain(3), t)
+	c.Add(5)
+	test.Nil(c.Remove(5), t)
+	test.Eq(0.0, c.FalsePositiveRate(), t)
+}
+
+func TestCountingBloomFilterSaturation(t *testing.T) {
+	c, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](16, 1)
+	for i := 0; i < 300; i++ {
+		c.Add(1)
+	}
+	for i := 0; i < 300; i++ {
+		test.Nil(c.Remove(1), t)
+	}
+	// A saturated counter is never decremented so the value can no longer
+	// be removed.
+	test.True(c.MayContain(1), t)
+}
+
+func TestCountingBloomFilterTestAndAdd(t *testing.T) {
+	c, _ := NewCountingBloomFilterForRate[int, widgets.BuiltinInt](100, 0.001)
+	test.False(c.TestAndAdd(1), t)
+	test.True(c.TestAndAdd(1), t)
+	test.Nil(c.Remove(1), t)
+	test.True(c.MayContain(1), t)
+	test.Nil(c.Remove(1), t)
+	test.False(c.TestAndAdd(1), t)
+}
+
+func TestCountingBloomFilterUnionIntersection(t *testing.T) {
+	l, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](1024, 4)
+	r, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](1024, 4)
+	l.Add(1, 2, 3)
+	r.Add(3, 4, 5)
+	u, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](1, 1)
+	test.Nil(u.Union(&l, &r), t)
+	test.Eq(1024, u.NumCounters(), t)
+	test.Eq(4, u.NumHashes(), t)
+	for i := 1; i <= 5; i++ {
+		test.True(u.MayContain(i), t)
+	}
+	i, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](1, 1)
+	test.Nil(i.Intersection(&l, &r), t)
+	test.True(i.MayContain(3), t)
+	test.Nil(i.Remove(3), t)
+	test.False(i.MayContain(3), t)
+
+	r, _ = NewCountingBloomFilter[int, widgets.BuiltinInt](1024, 3)
+	err := l.Union(&l, &r)
+	test.ContainsError(customerr.DimensionsDoNotAgree, err, t)
+	err = l.Intersection(&l, &r)
+	test.ContainsError(customerr.DimensionsDoNotAgree, err, t)
+	test.True(l.MayContain(1), t)
+}
+
+func TestCountingBloomFilterEqHash(t *testing.T) {
+	l, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](256, 3)
+	r, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](256, 3)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	l.Add(1, 1)
+	r.Add(1)
+	test.False(l.Eq(&l, &r), t)
+	test.Neq(l.Hash(&l), r.Hash(&r), t)
+	r.Add(1)
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	l.Zero(&l)
+	test.False(l.MayContain(1), t)
+}
+
+func TestCountingBloomFilterFormat(t *testing.T) {
+	c, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](64, 2)
+	test.Eq("countingBloomFilter[counters:64 hashes:2 set:0]", c.String(), t)
+	test.Eq(
+		"countingBloomFilter[counters:64 hashes:2 set:0]",
+		fmt.Sprint(c), t,
+	)
+}
+
+func TestSyncedCountingBloomFilterConcurrent(t *testing.T) {
+	c, _ := NewSyncedCountingBloomFilterForRate[int, widgets.BuiltinInt](4000, 0.01)
+	test.True(c.IsSynced(), t)
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			for i := j * 1000; i < (j+1)*1000; i++ {
+				c.Add(i, i)
+				c.MayContain(i)
+				test.Nil(c.Remove(i), t)
+			}
+		}(j)
+	}
+	wg.Wait()
+	for i := 0; i < 4000; i++ {
+		test.True(c.MayContain(i), t)
+	}
+}
+
+func TestCountingBloomFilterSerialization(t *testing.T) {
+	c, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](100, 3)
+	c.Add(1, 2, 2, 3)
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = c.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&c)
+		}
+		test.Nil(err, t)
+
+		res, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](10, 1)
+		res.Add(7)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(c.Eq(&c, &res), t)
+		test.Eq(100, res.NumCounters(), t)
+		// The counts are restored, so 2 can be removed twice
+		test.Nil(res.Remove(2), t)
+		test.Nil(res.Remove(2), t)
+		test.False(res.MayContain(2), t)
+		test.True(res.MayContain(3), t)
+	}
+}
+
+func TestCountingBloomFilterDeserializationErrors(t *testing.T) {
+	c, _ := NewCountingBloomFilter[int, widgets.BuiltinInt](64, 2)
+	c.Add(1)
+	err := json.Unmarshal([]byte(`{"numHashes":1,"counters":""}`), &c)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = json.Unmarshal([]byte(`{"numHashes":0,"counters":"AAA="}`), &c)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.Eq(64, c.NumCounters(), t)
+	test.True(c.MayContain(1), t)
+}
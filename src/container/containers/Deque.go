@@ -0,0 +1,935 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"math"
+	"sync"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+const (
+	// The number of values that are stored in a single chunk of a deque.
+	dequeChunkSize = 64
+	// The number of push operations that the moving average of the front/back
+	// ratio of a deque is (approximately) calculated over.
+	dequeRatioWindow = 64.0
+)
+
+type (
+	dequeChunk[T any] [dequeChunkSize]T
+
+	// A container that dynamically grows as values are added to either end. The
+	// values are stored in fixed size chunks, so growing the deque only ever
+	// requires re-allocating the list of chunk pointers rather than copying the
+	// values themselves. This makes pushing and popping from either end O(1),
+	// unlike a [Vector] which must shift all of its values when pushing to the
+	// front.
+	//
+	// When the deque needs to grow, the free chunks are distributed between the
+	// front and the back according to the ratio of front pushes to back pushes.
+	// This ratio is tracked with a moving average that is seeded when the deque
+	// is created, so a deque that mostly grows at one end will allocate most of
+	// its free space at that end.
+	Deque[T any, U widgets.BaseInterface[T]] struct {
+		chunks     []*dequeChunk[T]
+		start      int
+		numElems   int
+		frontRatio float64
+	}
+
+	// A synchronized version of Deque. All operations will be wrapped in the
+	// appropriate calls the embedded RWMutex. A pointer to a RWMutex is embedded
+	// rather than a value to avoid copying the lock value.
+	SyncedDeque[T any, U widgets.BaseInterface[T]] struct {
+		*sync.RWMutex
+		Deque[T, U]
+	}
+)
+
+// Creates a new deque initialized with enough memory to hold size elements.
+// Size must be >= 0, an error will be returned if it is not. The frontRatio
+// argument seeds the moving average that tracks the proportion of values that
+// are pushed to the front of the deque. It must be in the range [0,1], an
+// error will be returned if it is not. A value of 0.5 is a good default when
+// the deque is expected to grow equally at both ends.
+func NewDeque[T any, U widgets.BaseInterface[T]](
+	size int,
+	frontRatio float64,
+) (Deque[T, U], error) {
+	if size < 0 {
+		return Deque[T, U]{}, getSizeError(size)
+	}
+	if frontRatio < 0 || frontRatio > 1 {
+		return Deque[T, U]{}, customerr.Wrap(
+			customerr.ValOutsideRange,
+			"Front ratio must be >=0 and <=1. Got: %f", frontRatio,
+		)
+	}
+	numChunks := (size + dequeChunkSize - 1) / dequeChunkSize
+	rv := Deque[T, U]{
+		chunks:     make([]*dequeChunk[T], numChunks),
+		start:      int(math.Round(float64(numChunks)*frontRatio)) * dequeChunkSize,
+		frontRatio: frontRatio,
+	}
+	for i := 0; i < numChunks; i++ {
+		rv.chunks[i] = &dequeChunk[T]{}
+	}
+	return rv, nil
+}
+
+// Creates a new synced deque initialized with enough memory to hold size
+// elements. Size must be >= 0 and frontRatio must be in the range [0,1], an
+// error will be returned if either of these are not true. The underlying
+// RWMutex value will be fully unlocked upon initialization.
+func NewSyncedDeque[T any, U widgets.BaseInterface[T]](
+	size int,
+	frontRatio float64,
+) (SyncedDeque[T, U], error) {
+	rv, err := NewDeque[T, U](size, frontRatio)
+	return SyncedDeque[T, U]{
+		RWMutex: &sync.RWMutex{},
+		Deque:   rv,
+	}, err
+}
+
+// Creates a new deque and populates it with the supplied values. The front
+// ratio of the deque will be seeded with a value of 0.5.
+func DequeValInit[T any, U widgets.BaseInterface[T]](vals ...T) Deque[T, U] {
+	rv, _ := NewDeque[T, U](len(vals), 0.5)
+	rv.pushBackImpl(vals)
+	return rv
+}
+
+// Creates a new synced deque and populates it with the supplied values. The
+// front ratio of the deque will be seeded with a value of 0.5.
+func SyncedDequeValInit[T any, U widgets.BaseInterface[T]](
+	vals ...T,
+) SyncedDeque[T, U] {
+	rv, _ := NewSyncedDeque[T, U](len(vals), 0.5)
+	rv.Deque.pushBackImpl(vals)
+	return rv
+}
+
+// Converts the supplied deque to a synchronized deque. Beware: The original
+// non-synced deque will remain useable.
+func (d *Deque[T, U]) ToSynced() SyncedDeque[T, U] {
+	return SyncedDeque[T, U]{
+		RWMutex: &sync.RWMutex{},
+		Deque:   *d,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *Deque[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *Deque[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *Deque[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *Deque[T, U]) RUnlock() {}
+
+// The SyncedDeque method to override the Deque pass through function and
+// actually apply the mutex operation.
+func (d *SyncedDeque[T, U]) Lock() { d.RWMutex.Lock() }
+
+// The SyncedDeque method to override the Deque pass through function and
+// actually apply the mutex operation.
+func (d *SyncedDeque[T, U]) Unlock() { d.RWMutex.Unlock() }
+
+// The SyncedDeque method to override the Deque pass through function and
+// actually apply the mutex operation.
+func (d *SyncedDeque[T, U]) RLock() { d.RWMutex.RLock() }
+
+// The SyncedDeque method to override the Deque pass through function and
+// actually apply the mutex operation.
+func (d *SyncedDeque[T, U]) RUnlock() { d.RWMutex.RUnlock() }
+
+// Returns true, a deque is addressable. Values are stored in fixed size chunks
+// that are never moved, so pointers to values remain valid as the deque grows.
+func (d *Deque[T, U]) IsAddressable() bool { return true }
+
+// Returns false, a deque is not synced.
+func (d *Deque[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced deque is synced.
+func (d *SyncedDeque[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of values in the deque.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) Length() int {
+	return d.numElems
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) Length() int {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.Length()
+}
+
+// Description: Returns the number of values the deque can hold before it will
+// need to allocate more chunks.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) Capacity() int {
+	return len(d.chunks) * dequeChunkSize
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.Capacity] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) Capacity() int {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.Capacity()
+}
+
+func (d *Deque[T, U]) getPntr(idx int) *T {
+	p := d.start + idx
+	return &d.chunks[p/dequeChunkSize][p%dequeChunkSize]
+}
+
+// Description: Gets the value at the specified index, where index 0 is the
+// front of the deque. Returns an error if the index is >= the length of the
+// deque.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) Get(idx int) (T, error) {
+	if idx >= 0 && idx < d.numElems {
+		return *d.getPntr(idx), nil
+	}
+	var tmp T
+	return tmp, getIndexOutOfBoundsError(idx, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) Get(idx int) (T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.Get(idx)
+}
+
+// Description: Gets a pointer to the value at the specified index, where index
+// 0 is the front of the deque. Returns an error if the index is >= the length
+// of the deque.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) GetPntr(idx int) (*T, error) {
+	if idx >= 0 && idx < d.numElems {
+		return d.getPntr(idx), nil
+	}
+	return nil, getIndexOutOfBoundsError(idx, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.GetPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) GetPntr(idx int) (*T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.GetPntr(idx)
+}
+
+// Description: Returns the value at the front of the deque if one is present.
+// If the deque has no elements then an error is returned.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PeekFront() (T, error) {
+	if d.numElems > 0 {
+		return *d.getPntr(0), nil
+	}
+	var tmp T
+	return tmp, getIndexOutOfBoundsError(0, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.PeekFront] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PeekFront() (T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.PeekFront()
+}
+
+// Description: Returns a pointer to the value at the front of the deque if one
+// is present. If the deque has no elements then an error is returned.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PeekPntrFront() (*T, error) {
+	if d.numElems > 0 {
+		return d.getPntr(0), nil
+	}
+	return nil, getIndexOutOfBoundsError(0, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.PeekPntrFront] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PeekPntrFront() (*T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.PeekPntrFront()
+}
+
+// Description: Returns the value at the back of the deque if one is present.
+// If the deque has no elements then an error is returned.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PeekBack() (T, error) {
+	if d.numElems > 0 {
+		return *d.getPntr(d.numElems - 1), nil
+	}
+	var tmp T
+	return tmp, getIndexOutOfBoundsError(0, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.PeekBack] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PeekBack() (T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.PeekBack()
+}
+
+// Description: Returns a pointer to the value at the back of the deque if one
+// is present. If the deque has no elements then an error is returned.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PeekPntrBack() (*T, error) {
+	if d.numElems > 0 {
+		return d.getPntr(d.numElems - 1), nil
+	}
+	return nil, getIndexOutOfBoundsError(0, 0, d.numElems)
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.PeekPntrBack] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PeekPntrBack() (*T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.PeekPntrBack()
+}
+
+// Description: Returns and removes the element at the front of the deque.
+// Returns an error if the deque has no elements.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PopFront() (T, error) {
+	var rv T
+	return rv, d.popFrontImpl(&rv)
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.PopFront] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PopFront() (T, error) {
+	d.Lock()
+	defer d.Unlock()
+	var rv T
+	return rv, d.Deque.popFrontImpl(&rv)
+}
+
+func (d *Deque[T, U]) popFrontImpl(rv *T) error {
+	if d.numElems == 0 {
+		return getEmptyError()
+	}
+	w := widgets.Base[T, U]{}
+	p := d.getPntr(0)
+	*rv = *p
+	w.Zero(p)
+	d.start++
+	d.numElems--
+	return nil
+}
+
+// Description: Returns and removes the element at the back of the deque.
+// Returns an error if the deque has no elements.
+//
+// Time Complexity: O(1)
+func (d *Deque[T, U]) PopBack() (T, error) {
+	var rv T
+	return rv, d.popBackImpl(&rv)
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.PopBack] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (d *SyncedDeque[T, U]) PopBack() (T, error) {
+	d.Lock()
+	defer d.Unlock()
+	var rv T
+	return rv, d.Deque.popBackImpl(&rv)
+}
+
+func (d *Deque[T, U]) popBackImpl(rv *T) error {
+	if d.numElems == 0 {
+		return getEmptyError()
+	}
+	w := widgets.Base[T, U]{}
+	p := d.getPntr(d.numElems - 1)
+	*rv = *p
+	w.Zero(p)
+	d.numElems--
+	return nil
+}
+
+// Description: Pushes the supplied values to the front of the deque. Values
+// will be pushed to the front in the order that they are given. For example,
+// calling push front on [0,1,2] with vals of [3,4] will result in [3,4,0,1,2].
+// This function will never return an error.
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *Deque[T, U]) PushFront(vals ...T) error {
+	d.pushFrontImpl(vals)
+	return nil
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.PushFront] implementation method. The
+// [Deque.PushFront] method is not called directly to avoid copying the vals
+// varargs twice, which could be expensive with a large type for the T generic
+// or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *SyncedDeque[T, U]) PushFront(vals ...T) error {
+	d.Lock()
+	defer d.Unlock()
+	d.Deque.pushFrontImpl(vals)
+	return nil
+}
+
+// Description: Pushes the supplied values to the front of the deque. Has the
+// same behavior as [Deque.PushFront] because the deque grows as needed.
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *Deque[T, U]) ForcePushFront(vals ...T) {
+	d.pushFrontImpl(vals)
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.ForcePushFront] implementation method. The
+// [Deque.ForcePushFront] method is not called directly to avoid copying the
+// vals varargs twice, which could be expensive with a large type for the T
+// generic or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *SyncedDeque[T, U]) ForcePushFront(vals ...T) {
+	d.Lock()
+	defer d.Unlock()
+	d.Deque.pushFrontImpl(vals)
+}
+
+func (d *Deque[T, U]) pushFrontImpl(vals []T) {
+	d.updateFrontRatio(len(vals), 1)
+	d.makeRoom(len(vals), true)
+	for i := len(vals) - 1; i >= 0; i-- {
+		d.start--
+		d.numElems++
+		*d.getPntr(0) = vals[i]
+	}
+}
+
+// Description: Pushes the supplied values to the back of the deque. Values
+// will be pushed back in the order that they are given. For example, calling
+// push back on [0,1,2] with vals of [3,4] will result in [0,1,2,3,4]. This
+// function will never return an error.
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *Deque[T, U]) PushBack(vals ...T) error {
+	d.pushBackImpl(vals)
+	return nil
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.PushBack] implementation method. The
+// [Deque.PushBack] method is not called directly to avoid copying the vals
+// varargs twice, which could be expensive with a large type for the T generic
+// or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *SyncedDeque[T, U]) PushBack(vals ...T) error {
+	d.Lock()
+	defer d.Unlock()
+	d.Deque.pushBackImpl(vals)
+	return nil
+}
+
+// Description: Pushes the supplied values to the back of the deque. Has the
+// same behavior as [Deque.PushBack] because the deque grows as needed.
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *Deque[T, U]) ForcePushBack(vals ...T) {
+	d.pushBackImpl(vals)
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.ForcePushBack] implementation method. The
+// [Deque.ForcePushBack] method is not called directly to avoid copying the
+// vals varargs twice, which could be expensive with a large type for the T
+// generic or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: amortized O(m), where m=len(vals)
+func (d *SyncedDeque[T, U]) ForcePushBack(vals ...T) {
+	d.Lock()
+	defer d.Unlock()
+	d.Deque.pushBackImpl(vals)
+}
+
+func (d *Deque[T, U]) pushBackImpl(vals []T) {
+	d.updateFrontRatio(len(vals), 0)
+	d.makeRoom(len(vals), false)
+	for i := 0; i < len(vals); i++ {
+		d.numElems++
+		*d.getPntr(d.numElems - 1) = vals[i]
+	}
+}
+
+// Updates the moving average of the front/back ratio as if n values were
+// pushed to the same end of the deque. The val argument should be 1 for front
+// pushes and 0 for back pushes. This is the closed form of applying
+// avg+=(val-avg)/window n times.
+func (d *Deque[T, U]) updateFrontRatio(n int, val float64) {
+	d.frontRatio = val + (d.frontRatio-val)*math.Pow(
+		1-1/dequeRatioWindow, float64(n),
+	)
+}
+
+// Makes sure there is space for n values at the requested end of the deque.
+// If there is not enough space then the list of chunk pointers is rebuilt.
+// The chunks holding values are kept in order and the free chunks, both the
+// ones that already exist and any newly allocated ones, are split between the
+// front and the back based on the current front/back ratio. Free chunks are
+// only allocated when fewer than half of the chunks would be free, so a deque
+// that drifts in one direction will reuse its chunks rather than continually
+// allocating.
+func (d *Deque[T, U]) makeRoom(n int, front bool) {
+	end := d.start + d.numElems
+	if front && d.start >= n {
+		return
+	} else if !front && len(d.chunks)*dequeChunkSize-end >= n {
+		return
+	}
+
+	firstChunk, lastChunk := 0, -1
+	frontSpare, backSpare := 0, 0
+	if d.numElems > 0 {
+		firstChunk = d.start / dequeChunkSize
+		lastChunk = (end - 1) / dequeChunkSize
+		frontSpare = d.start % dequeChunkSize
+		backSpare = (lastChunk+1)*dequeChunkSize - end
+	}
+	used := lastChunk - firstChunk + 1
+	required := used + (n+dequeChunkSize-1)/dequeChunkSize
+	newLen := len(d.chunks)
+	if newLen < 2*required {
+		newLen = max(2*len(d.chunks), 2*required)
+	}
+	free := newLen - used
+
+	var need int
+	if front {
+		need = (max(n-frontSpare, 0) + dequeChunkSize - 1) / dequeChunkSize
+	} else {
+		need = (max(n-backSpare, 0) + dequeChunkSize - 1) / dequeChunkSize
+	}
+	numFront := int(math.Round(float64(free) * d.frontRatio))
+	if front {
+		numFront = max(numFront, need)
+	} else {
+		numFront = min(numFront, free-need)
+	}
+
+	newChunks := make([]*dequeChunk[T], newLen)
+	copy(newChunks[numFront:], d.chunks[firstChunk:lastChunk+1])
+	spare := append(d.chunks[:firstChunk:firstChunk], d.chunks[lastChunk+1:]...)
+	fill := func(i int) {
+		if len(spare) > 0 {
+			newChunks[i] = spare[len(spare)-1]
+			spare = spare[:len(spare)-1]
+		} else {
+			newChunks[i] = &dequeChunk[T]{}
+		}
+	}
+	for i := 0; i < numFront; i++ {
+		fill(i)
+	}
+	for i := numFront + used; i < newLen; i++ {
+		fill(i)
+	}
+	d.chunks = newChunks
+	d.start = numFront*dequeChunkSize + frontSpare
+}
+
+// Description: Clears all values from the deque and releases all of the
+// chunks. The front/back ratio of the deque is preserved.
+//
+// Time Complexity: O(n) (Because of zeroing)
+func (d *Deque[T, U]) Clear() {
+	w := widgets.Base[T, U]{}
+	for i := 0; i < d.numElems; i++ {
+		w.Zero(d.getPntr(i))
+	}
+	d.chunks = nil
+	d.start = 0
+	d.numElems = 0
+}
+
+// Description: Places a write lock on the underlying deque and then calls the
+// underlying deques [Deque.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) Clear() {
+	d.Lock()
+	defer d.Unlock()
+	d.Deque.Clear()
+}
+
+// Description: Returns an iterator that iterates over the values in the deque,
+// starting at the front.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) Vals() iter.Iter[T] {
+	return iter.SequentialElems[T](
+		d.numElems,
+		func(i int) (T, error) { return *d.getPntr(i), nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [Deque.Vals] method such that a read lock will be placed on the underlying
+// deque when the iterator is consumed. The deque will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) Vals() iter.Iter[T] {
+	return d.Deque.Vals().SetupTeardown(
+		func() error { d.RLock(); return nil },
+		func() error { d.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the deque that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [Deque.Vals].
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) ValsSeq() stditer.Seq[T] {
+	return d.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the deque that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [SyncedDeque.Vals], so the deque will have a read lock the entire
+// time the loop is running. The lock is released once the loop ends, even if
+// the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) ValsSeq() stditer.Seq[T] {
+	return d.Vals().Seq()
+}
+
+// Description: Returns an iterator that iterates over the pointers to the
+// values in the deque, starting at the front.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) ValPntrs() iter.Iter[*T] {
+	return iter.SequentialElems[*T](
+		d.numElems,
+		func(i int) (*T, error) { return d.getPntr(i), nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [Deque.ValPntrs] method such that a read lock will be placed on the
+// underlying deque when the iterator is consumed. The deque will have a read
+// lock the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) ValPntrs() iter.Iter[*T] {
+	return d.Deque.ValPntrs().SetupTeardown(
+		func() error { d.RLock(); return nil },
+		func() error { d.RUnlock(); return nil },
+	)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Returns true if l and r contain the same values in the same
+// order, false otherwise.
+func (_ *Deque[T, U]) Eq(l *Deque[T, U], r *Deque[T, U]) bool {
+	if l.numElems != r.numElems {
+		return false
+	}
+	w := widgets.Base[T, U]{}
+	for i := 0; i < l.numElems; i++ {
+		if !w.Eq(l.getPntr(i), r.getPntr(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// deques [Deque.Eq] method.
+func (_ *SyncedDeque[T, U]) Eq(l *SyncedDeque[T, U], r *SyncedDeque[T, U]) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.Deque.Eq(&l.Deque, &r.Deque)
+}
+
+// A function that returns a hash of a deque to implement the
+// [algo.widget.WidgetInterface]. To do this all of the individual hashes that
+// are produced from the elements of the deque are combined in a way that
+// maintains identity, making it so the hash will represent the same equality
+// operation that [Deque.Eq] provides.
+func (_ *Deque[T, U]) Hash(other *Deque[T, U]) hash.Hash {
+	var rv hash.Hash
+	w := widgets.Base[T, U]{}
+	if other.numElems > 0 {
+		rv = w.Hash(other.getPntr(0))
+		for i := 1; i < other.numElems; i++ {
+			rv = rv.Combine(w.Hash(other.getPntr(i)))
+		}
+	}
+	return rv
+}
+
+// Places a read lock on the underlying deque of other and then calls others
+// underlying deque [Deque.Hash] method. Implements the
+// [algo.widget.WidgetInterface].
+func (_ *SyncedDeque[T, U]) Hash(other *SyncedDeque[T, U]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.Deque.Hash(&other.Deque)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [Deque.Clear].
+func (_ *Deque[T, U]) Zero(other *Deque[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedDeque.Clear].
+func (_ *SyncedDeque[T, U]) Zero(other *SyncedDeque[T, U]) {
+	other.Clear()
+}
+
+type serializedDeque[T any] struct {
+	FrontRatio float64 `json:"frontRatio"`
+	Vals       []T     `json:"vals"`
+}
+
+func (d *Deque[T, U]) toSerialized() serializedDeque[T] {
+	rv := serializedDeque[T]{
+		FrontRatio: d.frontRatio,
+		Vals:       make([]T, d.numElems),
+	}
+	for i := 0; i < d.numElems; i++ {
+		rv.Vals[i] = *d.getPntr(i)
+	}
+	return rv
+}
+
+func (d *Deque[T, U]) fromSerialized(s serializedDeque[T]) error {
+	if s.FrontRatio < 0 || s.FrontRatio > 1 {
+		return customerr.Wrap(
+			customerr.ValOutsideRange,
+			"Front ratio must be >=0 and <=1. Got: %f", s.FrontRatio,
+		)
+	}
+	d.Clear()
+	d.pushBackImpl(s.Vals)
+	d.frontRatio = s.FrontRatio
+	return nil
+}
+
+// Description: Returns the JSON encoding of the deque. The front/back ratio of
+// the deque is encoded along with its values, starting at the front.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.toSerialized())
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) MarshalJSON() ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.MarshalJSON()
+}
+
+// Description: Replaces the contents of the deque with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [Deque.MarshalJSON]. If an error is returned the deque is left unchanged.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedDeque[T]](data)
+	if err != nil {
+		return err
+	}
+	return d.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying deque while its contents are replaced. Exhibits the same
+// behavior as [Deque.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedDeque[T]](data)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	defer d.Unlock()
+	return d.Deque.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the deque. The values are
+// laid out the same way as [Deque.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(d.toSerialized())
+}
+
+// Description: Places a read lock on the underlying deque and then calls the
+// underlying deques [Deque.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) MarshalBinary() ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.Deque.MarshalBinary()
+}
+
+// Description: Replaces the contents of the deque with the values decoded
+// from the supplied binary data, which must have been produced by
+// [Deque.MarshalBinary]. If an error is returned the deque is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (d *Deque[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedDeque[T]](data)
+	if err != nil {
+		return err
+	}
+	return d.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying deque while its contents are replaced. Exhibits the same
+// behavior as [Deque.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (d *SyncedDeque[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedDeque[T]](data)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	defer d.Unlock()
+	return d.Deque.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (d Deque[T, U]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("deque["))
+	for i := 0; i < d.numElems; i++ {
+		fmt.Fprintf(f, fmtStr, *d.getPntr(i))
+		if i+1 < d.numElems {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (d *Deque[T, U]) String() string {
+	return fmt.Sprintf("%v", d)
+}
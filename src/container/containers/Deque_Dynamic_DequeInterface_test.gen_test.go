@@ -0,0 +1,130 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func DequeToDequeInterfaceFactory(capacity int) dynamicContainers.Deque[int] {
+	v := generateDeque(capacity)
+	var rv dynamicContainers.Deque[int] = &v
+	return rv
+}
+
+func TestDeque_DynDequeInterfaceSyncableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSyncableInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceAddressableInterface(t *testing.T) {
+	tests.DynDequeInterfaceAddressableInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceLengthInterface(t *testing.T) {
+	tests.DynDequeInterfaceLengthInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceCapacityInterface(t *testing.T) {
+	tests.DynDequeInterfaceCapacityInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceClearInterface(t *testing.T) {
+	tests.DynDequeInterfaceClearInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceFirstElemReadInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemReadInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceFirstElemWriteInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemWriteInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceFirstElemDeleteInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemDeleteInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceLastElemReadInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemReadInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceLastElemWriteInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemWriteInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceLastElemDeleteInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemDeleteInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_ReadDynDequeInterface(t *testing.T) {
+	tests.ReadDynDequeInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_WriteDynDequeInterface(t *testing.T) {
+	tests.WriteDynDequeInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceInterface(t *testing.T) {
+	tests.DynDequeInterfaceInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSerializableInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynDequeInterfaceStaticCapacityInterface(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceClear(t *testing.T) {
+	tests.DynDequeInterfaceClear(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePeekPntrFront(t *testing.T) {
+	tests.DynDequeInterfacePeekPntrFront(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePeekFront(t *testing.T) {
+	tests.DynDequeInterfacePeekFront(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePeekPntrBack(t *testing.T) {
+	tests.DynDequeInterfacePeekPntrBack(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePeekBack(t *testing.T) {
+	tests.DynDequeInterfacePeekBack(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePopFront(t *testing.T) {
+	tests.DynDequeInterfacePopFront(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePopBack(t *testing.T) {
+	tests.DynDequeInterfacePopBack(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePushFront(t *testing.T) {
+	tests.DynDequeInterfacePushFront(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceForcePushFront(t *testing.T) {
+	tests.DynDequeInterfaceForcePushFront(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfacePushBack(t *testing.T) {
+	tests.DynDequeInterfacePushBack(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceForcePushBack(t *testing.T) {
+	tests.DynDequeInterfaceForcePushBack(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceJSON(t *testing.T) {
+	tests.DynDequeInterfaceJSON(DequeToDequeInterfaceFactory, t)
+}
+
+func TestDeque_DynDequeInterfaceBinary(t *testing.T) {
+	tests.DynDequeInterfaceBinary(DequeToDequeInterfaceFactory, t)
+}
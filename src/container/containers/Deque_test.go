@@ -0,0 +1,191 @@
+package containers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=Deque -category=dynamic -interface=Deque -genericDecl=[int] -factory=generateDeque
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedDeque -category=dynamic -interface=Deque -genericDecl=[int] -factory=generateSyncedDeque
+
+func generateDeque(capacity int) Deque[int, widgets.BuiltinInt] {
+	v, _ := NewDeque[int, widgets.BuiltinInt](capacity, 0.5)
+	return v
+}
+
+func generateSyncedDeque(capacity int) SyncedDeque[int, widgets.BuiltinInt] {
+	v, _ := NewSyncedDeque[int, widgets.BuiltinInt](capacity, 0.5)
+	return v
+}
+
+func TestDequeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[Deque[string, widgets.BuiltinString]]
+	v, _ := NewDeque[string, widgets.BuiltinString](0, 0.5)
+	widget = &v
+	_ = widget
+}
+
+func TestNewDequeErrors(t *testing.T) {
+	_, err := NewDeque[int, widgets.BuiltinInt](-1, 0.5)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewDeque[int, widgets.BuiltinInt](0, -0.1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewDeque[int, widgets.BuiltinInt](0, 1.1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	d, err := NewDeque[int, widgets.BuiltinInt](dequeChunkSize+1, 0.5)
+	test.Nil(err, t)
+	test.Eq(2*dequeChunkSize, d.Capacity(), t)
+}
+
+func TestDequeEq(t *testing.T) {
+	d1 := DequeValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d2, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	d2.PushFront(2, 3)
+	d2.PushFront(0, 1)
+	test.True(d1.Eq(&d1, &d2), t)
+	d2.PopBack()
+	test.False(d1.Eq(&d1, &d2), t)
+	d2.PushBack(4)
+	test.False(d1.Eq(&d1, &d2), t)
+}
+
+func TestDequeHash(t *testing.T) {
+	d1 := DequeValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d2, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	d2.PushFront(2, 3)
+	d2.PushFront(0, 1)
+	test.Eq(d1.Hash(&d1), d2.Hash(&d2), t)
+	d2.PopBack()
+	d2.PushBack(4)
+	test.Neq(d1.Hash(&d1), d2.Hash(&d2), t)
+}
+
+func TestDequeZero(t *testing.T) {
+	d := DequeValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d.Zero(&d)
+	test.Eq(0, d.Length(), t)
+	test.Eq(0, d.Capacity(), t)
+}
+
+func TestDequeFormat(t *testing.T) {
+	d := DequeValInit[int, widgets.BuiltinInt](1, 2)
+	d.PushFront(0)
+	test.Eq("deque[0 1 2]", fmt.Sprintf("%v", d), t)
+	test.Eq("deque[0 1 2]", d.String(), t)
+}
+
+func TestDequeGet(t *testing.T) {
+	d, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	for i := 0; i < 3*dequeChunkSize; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	for i := 0; i < d.Length(); i++ {
+		v, err := d.Get(i)
+		test.Nil(err, t)
+		test.Eq(i-3*dequeChunkSize, v, t)
+	}
+	_, err := d.Get(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = d.Get(d.Length())
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestDequePntrsStableAcrossGrowth(t *testing.T) {
+	d, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	d.PushBack(1)
+	p, _ := d.PeekPntrFront()
+	for i := 0; i < 10*dequeChunkSize; i++ {
+		d.PushFront(0)
+		d.PushBack(2)
+	}
+	*p = 100
+	v, _ := d.Get(10 * dequeChunkSize)
+	test.Eq(100, v, t)
+}
+
+func TestDequeAdaptiveGrowth(t *testing.T) {
+	d, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	for i := 0; i < 20*dequeChunkSize; i++ {
+		d.PushFront(i)
+	}
+	test.True(d.frontRatio > 0.99, t)
+	// Almost all of the free space should have been placed in front of the
+	// values.
+	test.True(d.start > d.Capacity()-d.start-d.numElems, t)
+
+	d, _ = NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	for i := 0; i < 20*dequeChunkSize; i++ {
+		d.PushBack(i)
+	}
+	test.True(d.frontRatio < 0.01, t)
+	test.True(d.start < d.Capacity()-d.start-d.numElems, t)
+}
+
+func TestDequeReusesChunksWhenDrifting(t *testing.T) {
+	d, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	for i := 0; i < 2*dequeChunkSize; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 10*dequeChunkSize; i++ {
+		d.PushBack(i)
+		d.PopFront()
+	}
+	c := d.Capacity()
+	for i := 0; i < 100*dequeChunkSize; i++ {
+		d.PushBack(i)
+		d.PopFront()
+	}
+	test.Eq(c, d.Capacity(), t)
+	test.Eq(2*dequeChunkSize, d.Length(), t)
+}
+
+func TestDequeRandomOps(t *testing.T) {
+	d, _ := NewDeque[int, widgets.BuiltinInt](0, 0.5)
+	ref := []int{}
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 20000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			vals := make([]int, r.Intn(dequeChunkSize*2))
+			for j := range vals {
+				vals[j] = r.Int()
+			}
+			d.PushFront(vals...)
+			ref = append(append([]int{}, vals...), ref...)
+		case 1:
+			v := r.Int()
+			d.PushBack(v)
+			ref = append(ref, v)
+		case 2:
+			v, err := d.PopFront()
+			if len(ref) == 0 {
+				test.NotNil(err, t)
+			} else {
+				test.Nil(err, t)
+				test.Eq(ref[0], v, t)
+				ref = ref[1:]
+			}
+		case 3:
+			v, err := d.PopBack()
+			if len(ref) == 0 {
+				test.NotNil(err, t)
+			} else {
+				test.Nil(err, t)
+				test.Eq(ref[len(ref)-1], v, t)
+				ref = ref[:len(ref)-1]
+			}
+		}
+		test.Eq(len(ref), d.Length(), t)
+	}
+	for i := 0; i < len(ref); i++ {
+		v, err := d.Get(i)
+		test.Nil(err, t)
+		test.Eq(ref[i], v, t)
+	}
+}
@@ -0,0 +1,895 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	disjointSetState[T any, U widgets.BaseInterface[T]] struct {
+		indexes HashMap[T, int, U, widgets.BuiltinInt]
+		vals    []T
+		parents []int
+		ranks   []int
+		// Only valid for the root of each set.
+		sizes []int
+		// The members of each set are linked together in a circular list so
+		// that the members of a set can be iterated over without visiting
+		// every value in the disjoint set.
+		next    []int
+		numSets int
+	}
+
+	// A type to represent a collection of non-overlapping sets, also known as
+	// a union-find data structure. Every value in a disjoint set belongs to
+	// exactly one set, and sets can be merged together with
+	// [DisjointSet.Union]. Each set is identified by one of its members, called
+	// its representative. Union by rank and path compression are used to keep
+	// the trees that represent each set shallow. The type constraints on the
+	// generics define the logic for how value specific operations, such as
+	// equality comparisons, will be handled. Copies of a disjoint set share the
+	// same underlying state, the same as the builtin map type.
+	DisjointSet[T any, U widgets.BaseInterface[T]] struct {
+		state *disjointSetState[T, U]
+	}
+
+	// A synchronized version of DisjointSet. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value. Path
+	// compression modifies the disjoint set, so it is only performed by
+	// operations that hold the write lock. This allows operations like
+	// [SyncedDisjointSet.Find] to run concurrently under a read lock.
+	SyncedDisjointSet[T any, U widgets.BaseInterface[T]] struct {
+		*sync.RWMutex
+		DisjointSet[T, U]
+	}
+)
+
+// Creates a new disjoint set initialized with enough memory to hold size
+// elements. Size must be >= 0, an error will be returned if it is not. If size
+// is 0 the disjoint set will be initialized with 0 elements.
+func NewDisjointSet[T any, U widgets.BaseInterface[T]](
+	size int,
+) (DisjointSet[T, U], error) {
+	if size < 0 {
+		return DisjointSet[T, U]{}, getSizeError(size)
+	}
+	indexes, _ := NewHashMap[T, int, U, widgets.BuiltinInt](size)
+	return DisjointSet[T, U]{
+		state: &disjointSetState[T, U]{
+			indexes: indexes,
+			vals:    make([]T, 0, size),
+			parents: make([]int, 0, size),
+			ranks:   make([]int, 0, size),
+			sizes:   make([]int, 0, size),
+			next:    make([]int, 0, size),
+		},
+	}, nil
+}
+
+// Creates a new synced disjoint set initialized with enough memory to hold
+// size elements. Size must be >= 0, an error will be returned if it is not. If
+// size is 0 the disjoint set will be initialized with 0 elements. The
+// underlying RWMutex value will be fully unlocked upon initialization.
+func NewSyncedDisjointSet[T any, U widgets.BaseInterface[T]](
+	size int,
+) (SyncedDisjointSet[T, U], error) {
+	rv, err := NewDisjointSet[T, U](size)
+	return SyncedDisjointSet[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		DisjointSet: rv,
+	}, err
+}
+
+// Creates a new disjoint set and populates it with the supplied values. Each
+// value will be placed in its own set. Duplicate values will be ignored.
+func DisjointSetValInit[T any, U widgets.BaseInterface[T]](
+	vals ...T,
+) DisjointSet[T, U] {
+	rv, _ := NewDisjointSet[T, U](len(vals))
+	rv.Add(vals...)
+	return rv
+}
+
+// Creates a new synced disjoint set and populates it with the supplied values.
+// Each value will be placed in its own set. Duplicate values will be ignored.
+func SyncedDisjointSetValInit[T any, U widgets.BaseInterface[T]](
+	vals ...T,
+) SyncedDisjointSet[T, U] {
+	rv, _ := NewSyncedDisjointSet[T, U](len(vals))
+	rv.Add(vals...)
+	return rv
+}
+
+// Converts the supplied disjoint set to a synchronized disjoint set. Beware:
+// The original non-synced disjoint set will remain useable.
+func (d *DisjointSet[T, U]) ToSynced() SyncedDisjointSet[T, U] {
+	return SyncedDisjointSet[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		DisjointSet: *d,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *DisjointSet[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *DisjointSet[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *DisjointSet[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (d *DisjointSet[T, U]) RUnlock() {}
+
+// The SyncedDisjointSet method to override the DisjointSet pass through
+// function and actually apply the mutex operation.
+func (d *SyncedDisjointSet[T, U]) Lock() { d.RWMutex.Lock() }
+
+// The SyncedDisjointSet method to override the DisjointSet pass through
+// function and actually apply the mutex operation.
+func (d *SyncedDisjointSet[T, U]) Unlock() { d.RWMutex.Unlock() }
+
+// The SyncedDisjointSet method to override the DisjointSet pass through
+// function and actually apply the mutex operation.
+func (d *SyncedDisjointSet[T, U]) RLock() { d.RWMutex.RLock() }
+
+// The SyncedDisjointSet method to override the DisjointSet pass through
+// function and actually apply the mutex operation.
+func (d *SyncedDisjointSet[T, U]) RUnlock() { d.RWMutex.RUnlock() }
+
+// Returns false, disjoint sets are not addressable.
+func (d *DisjointSet[T, U]) IsAddressable() bool { return false }
+
+// Returns false, a disjoint set is not synced.
+func (d *DisjointSet[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced disjoint set is synced.
+func (d *SyncedDisjointSet[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of values in the disjoint set.
+//
+// Time Complexity: O(1)
+func (d *DisjointSet[T, U]) Length() int {
+	return len(d.state.vals)
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDisjointSet[T, U]) Length() int {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.Length()
+}
+
+// Description: Returns the number of sets in the disjoint set.
+//
+// Time Complexity: O(1)
+func (d *DisjointSet[T, U]) NumSets() int {
+	return d.state.numSets
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.NumSets] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDisjointSet[T, U]) NumSets() int {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.NumSets()
+}
+
+func (d *DisjointSet[T, U]) index(v *T) (int, bool) {
+	if h, found := d.state.indexes.getHashPosition(v); found {
+		return d.state.indexes.internalHashMapImpl[h].B, true
+	}
+	return -1, false
+}
+
+func (d *DisjointSet[T, U]) find(i int, compress bool) int {
+	root := i
+	for d.state.parents[root] != root {
+		root = d.state.parents[root]
+	}
+	for compress && d.state.parents[i] != root {
+		d.state.parents[i], i = root, d.state.parents[i]
+	}
+	return root
+}
+
+// Description: Contains will return true if the supplied value is in the
+// disjoint set, false otherwise. All equality comparisons are performed by the
+// generic U widget type that the disjoint set was initialized with.
+//
+// Time Complexity: O(1)
+func (d *DisjointSet[T, U]) Contains(v T) bool {
+	_, found := d.index(&v)
+	return found
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDisjointSet[T, U]) Contains(v T) bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// disjoint set, false otherwise. All equality comparisons are performed by the
+// generic U widget type that the disjoint set was initialized with.
+//
+// Time Complexity: O(1)
+func (d *DisjointSet[T, U]) ContainsPntr(v *T) bool {
+	_, found := d.index(v)
+	return found
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (d *SyncedDisjointSet[T, U]) ContainsPntr(v *T) bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.ContainsPntr(v)
+}
+
+// Description: Adds the supplied values to the disjoint set, placing each
+// value in its own set. Values that are already in the disjoint set are
+// ignored and will stay in the set they are currently in. This function will
+// never return an error.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (d *DisjointSet[T, U]) Add(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		d.addImpl(&vals[i])
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.Add] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (d *SyncedDisjointSet[T, U]) Add(vals ...T) error {
+	d.Lock()
+	defer d.Unlock()
+	return d.DisjointSet.Add(vals...)
+}
+
+func (d *DisjointSet[T, U]) addImpl(v *T) int {
+	if i, found := d.index(v); found {
+		return i
+	}
+	i := len(d.state.vals)
+	d.state.indexes.Emplace(basic.Pair[T, int]{A: *v, B: i})
+	d.state.vals = append(d.state.vals, *v)
+	d.state.parents = append(d.state.parents, i)
+	d.state.ranks = append(d.state.ranks, 0)
+	d.state.sizes = append(d.state.sizes, 1)
+	d.state.next = append(d.state.next, i)
+	d.state.numSets++
+	return i
+}
+
+// Description: Merges the set that contains a with the set that contains b.
+// Values that are not in the disjoint set are added to it before the sets are
+// merged. Returns true if the sets were merged and false if a and b were
+// already in the same set.
+//
+// Time Complexity: O(α(n)) amortized, where α is the inverse Ackermann function
+func (d *DisjointSet[T, U]) Union(a T, b T) bool {
+	return d.unionImpl(&a, &b)
+}
+
+// Description: Places a write lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.Union] implementation
+// method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(α(n)) amortized, where α is the inverse Ackermann function
+func (d *SyncedDisjointSet[T, U]) Union(a T, b T) bool {
+	d.Lock()
+	defer d.Unlock()
+	return d.unionImpl(&a, &b)
+}
+
+func (d *DisjointSet[T, U]) unionImpl(a *T, b *T) bool {
+	aRoot := d.find(d.addImpl(a), true)
+	bRoot := d.find(d.addImpl(b), true)
+	if aRoot == bRoot {
+		return false
+	}
+	if d.state.ranks[aRoot] < d.state.ranks[bRoot] {
+		aRoot, bRoot = bRoot, aRoot
+	} else if d.state.ranks[aRoot] == d.state.ranks[bRoot] {
+		d.state.ranks[aRoot]++
+	}
+	d.state.parents[bRoot] = aRoot
+	d.state.sizes[aRoot] += d.state.sizes[bRoot]
+	// Splice the two circular member lists together
+	d.state.next[aRoot], d.state.next[bRoot] = d.state.next[bRoot], d.state.next[aRoot]
+	d.state.numSets--
+	return true
+}
+
+// Description: Returns the representative of the set that contains the
+// supplied value. Two values are in the same set if and only if they have the
+// same representative. The representative of a set only changes when the set
+// is merged with another set. Returns a [containerTypes.ValueError] if the
+// value is not in the disjoint set.
+//
+// Time Complexity: O(α(n)) amortized, where α is the inverse Ackermann function
+func (d *DisjointSet[T, U]) Find(v T) (T, error) {
+	if i, found := d.index(&v); found {
+		return d.state.vals[d.find(i, true)], nil
+	}
+	var tmp T
+	return tmp, getValueError[T](&v)
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// returns the representative of the set that contains the supplied value.
+// Exhibits the same behavior as the [DisjointSet.Find] method except that path
+// compression is not performed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (d *SyncedDisjointSet[T, U]) Find(v T) (T, error) {
+	d.RLock()
+	defer d.RUnlock()
+	if i, found := d.index(&v); found {
+		return d.state.vals[d.find(i, false)], nil
+	}
+	var tmp T
+	return tmp, getValueError[T](&v)
+}
+
+// Description: Returns true if the supplied values are in the same set, false
+// otherwise. If either value is not in the disjoint set false is returned.
+//
+// Time Complexity: O(α(n)) amortized, where α is the inverse Ackermann function
+func (d *DisjointSet[T, U]) Connected(a T, b T) bool {
+	return d.connectedImpl(&a, &b, true)
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.Connected] implementation
+// method. Path compression is not performed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (d *SyncedDisjointSet[T, U]) Connected(a T, b T) bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.connectedImpl(&a, &b, false)
+}
+
+func (d *DisjointSet[T, U]) connectedImpl(a *T, b *T, compress bool) bool {
+	aIdx, aFound := d.index(a)
+	bIdx, bFound := d.index(b)
+	if !aFound || !bFound {
+		return false
+	}
+	return d.find(aIdx, compress) == d.find(bIdx, compress)
+}
+
+// Description: Returns the number of values in the set that contains the
+// supplied value. Returns a [containerTypes.ValueError] if the value is not in
+// the disjoint set.
+//
+// Time Complexity: O(α(n)) amortized, where α is the inverse Ackermann function
+func (d *DisjointSet[T, U]) SetSize(v T) (int, error) {
+	return d.setSizeImpl(&v, true)
+}
+
+// Description: Places a read lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.SetSize] implementation
+// method. Path compression is not performed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (d *SyncedDisjointSet[T, U]) SetSize(v T) (int, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.setSizeImpl(&v, false)
+}
+
+func (d *DisjointSet[T, U]) setSizeImpl(v *T, compress bool) (int, error) {
+	if i, found := d.index(v); found {
+		return d.state.sizes[d.find(i, compress)], nil
+	}
+	return 0, getValueError[T](v)
+}
+
+// Description: Returns an iterator over the members of the set that contains
+// the supplied value, including the value itself. The iterator will return a
+// [containerTypes.ValueError] if the value is not in the disjoint set. The
+// order of the members is unspecified.
+//
+// Time Complexity: O(m), where m is the number of members in the set
+func (d *DisjointSet[T, U]) Members(v T) iter.Iter[T] {
+	start, found := d.index(&v)
+	if !found {
+		var tmp T
+		return iter.ValElem[T](tmp, getValueError[T](&v), 1)
+	}
+	return d.membersImpl(start)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [DisjointSet.Members] method such that a read lock will be placed on the
+// underlying disjoint set when the iterator is consumed. The disjoint set will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of members in the set
+func (d *SyncedDisjointSet[T, U]) Members(v T) iter.Iter[T] {
+	started := false
+	var members iter.Iter[T]
+	return iter.Iter[T](func(f iter.IteratorFeedback) (T, error, bool) {
+		// The value has to be looked up after the lock is acquired.
+		if !started {
+			started = true
+			members = d.DisjointSet.Members(v)
+		}
+		return members(f)
+	}).SetupTeardown(
+		func() error { d.RLock(); return nil },
+		func() error { d.RUnlock(); return nil },
+	)
+}
+
+func (d *DisjointSet[T, U]) membersImpl(start int) iter.Iter[T] {
+	cur := -1
+	return func(f iter.IteratorFeedback) (T, error, bool) {
+		if f == iter.Break || (cur != -1 && d.state.next[cur] == start) {
+			var tmp T
+			return tmp, nil, false
+		}
+		if cur == -1 {
+			cur = start
+		} else {
+			cur = d.state.next[cur]
+		}
+		return d.state.vals[cur], nil, true
+	}
+}
+
+// Description: Returns an iterator over all of the sets in the disjoint set.
+// Each set is returned as a slice of its members. The order of the sets, and
+// the order of the members within each set, is unspecified.
+//
+// Time Complexity: O(n)
+func (d *DisjointSet[T, U]) Sets() iter.Iter[[]T] {
+	i := -1
+	return func(f iter.IteratorFeedback) ([]T, error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		for i++; i < len(d.state.parents); i++ {
+			if d.state.parents[i] != i {
+				continue
+			}
+			rv := make([]T, 0, d.state.sizes[i])
+			d.membersImpl(i).ForEach(
+				func(index int, val T) (iter.IteratorFeedback, error) {
+					rv = append(rv, val)
+					return iter.Continue, nil
+				},
+			)
+			return rv, nil, true
+		}
+		return nil, nil, false
+	}
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [DisjointSet.Sets] method such that a read lock will be placed on the
+// underlying disjoint set when the iterator is consumed. The disjoint set will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDisjointSet[T, U]) Sets() iter.Iter[[]T] {
+	return d.DisjointSet.Sets().SetupTeardown(
+		func() error { d.RLock(); return nil },
+		func() error { d.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the values in the
+// disjoint set in the order they were added.
+//
+// Time Complexity: O(n)
+func (d *DisjointSet[T, U]) Vals() iter.Iter[T] {
+	return iter.SequentialElems[T](
+		len(d.state.vals),
+		func(i int) (T, error) { return d.state.vals[i], nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [DisjointSet.Vals] method such that a read lock will be placed on the
+// underlying disjoint set when the iterator is consumed. The disjoint set will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDisjointSet[T, U]) Vals() iter.Iter[T] {
+	return d.DisjointSet.Vals().SetupTeardown(
+		func() error { d.RLock(); return nil },
+		func() error { d.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the disjoint set that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [DisjointSet.Vals].
+//
+// Time Complexity: O(n)
+func (d *DisjointSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return d.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the disjoint set that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedDisjointSet.Vals], so the disjoint set will have
+// a read lock the entire time the loop is running. The lock is released once
+// the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (d *SyncedDisjointSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return d.Vals().Seq()
+}
+
+// Panics, disjoint sets are not addressable.
+func (d *DisjointSet[T, U]) ValPntrs() iter.Iter[*T] {
+	panic(getNonAddressablePanicText("disjoint set"))
+}
+
+// Description: Removes all values from the disjoint set.
+//
+// Time Complexity: O(n)
+func (d *DisjointSet[T, U]) Clear() {
+	w := widgets.Base[T, U]{}
+	for i := 0; i < len(d.state.vals); i++ {
+		w.Zero(&d.state.vals[i])
+	}
+	d.state.indexes.Clear()
+	d.state.vals = d.state.vals[:0]
+	d.state.parents = d.state.parents[:0]
+	d.state.ranks = d.state.ranks[:0]
+	d.state.sizes = d.state.sizes[:0]
+	d.state.next = d.state.next[:0]
+	d.state.numSets = 0
+}
+
+// Description: Places a write lock on the underlying disjoint set and then
+// calls the underlying disjoint sets [DisjointSet.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (d *SyncedDisjointSet[T, U]) Clear() {
+	d.Lock()
+	defer d.Unlock()
+	d.DisjointSet.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two disjoint sets are equal if they contain the same values and
+// the values are grouped into the same sets. Returns true if l==r, false
+// otherwise.
+//
+// Time Complexity: O(n*α(n)) amortized
+func (_ *DisjointSet[T, U]) Eq(l *DisjointSet[T, U], r *DisjointSet[T, U]) bool {
+	return l.eqImpl(r, true)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then performs the same
+// comparison as [DisjointSet.Eq], without performing path compression.
+// Returns true if l==r, false otherwise.
+//
+// Time Complexity: O(n*log(n))
+func (_ *SyncedDisjointSet[T, U]) Eq(
+	l *SyncedDisjointSet[T, U],
+	r *SyncedDisjointSet[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.DisjointSet.eqImpl(&r.DisjointSet, false)
+}
+
+func (d *DisjointSet[T, U]) eqImpl(other *DisjointSet[T, U], compress bool) bool {
+	if len(d.state.vals) != len(other.state.vals) ||
+		d.state.numSets != other.state.numSets {
+		return false
+	}
+	// If every set in d is contained in a set in other and both have the same
+	// number of sets and values then the sets must be the same.
+	for i := 0; i < len(d.state.vals); i++ {
+		root := d.find(i, compress)
+		if !other.connectedImpl(&d.state.vals[i], &d.state.vals[root], compress) {
+			return false
+		}
+	}
+	return true
+}
+
+// A function that returns a hash of a disjoint set. To do this the hashes of
+// the values in each set are combined together and then the hashes of each
+// set are combined, both in a way that does not depend on order. This makes
+// it so the hash will represent the same equality operation that
+// [DisjointSet.Eq] provides.
+func (_ *DisjointSet[T, U]) Hash(other *DisjointSet[T, U]) hash.Hash {
+	return other.hashImpl()
+}
+
+// Places a read lock on the underlying disjoint set of other and then calls
+// others underlying disjoint sets [DisjointSet.Hash] method.
+func (_ *SyncedDisjointSet[T, U]) Hash(other *SyncedDisjointSet[T, U]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.DisjointSet.hashImpl()
+}
+
+func (d *DisjointSet[T, U]) hashImpl() hash.Hash {
+	var rv hash.Hash
+	w := widgets.Base[T, U]{}
+	first := true
+	for i := 0; i < len(d.state.parents); i++ {
+		if d.state.parents[i] != i {
+			continue
+		}
+		var setH hash.Hash
+		d.membersImpl(i).ForEach(func(index int, val T) (iter.IteratorFeedback, error) {
+			if index == 0 {
+				setH = w.Hash(&val)
+			} else {
+				setH = setH.CombineUnordered(w.Hash(&val))
+			}
+			return iter.Continue, nil
+		})
+		// The size is combined so that different groupings of the same values
+		// produce different hashes.
+		setH = setH.Combine(hash.Hash(d.state.sizes[i]))
+		if first {
+			rv = setH
+			first = false
+		} else {
+			rv = rv.CombineUnordered(setH)
+		}
+	}
+	return rv
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [DisjointSet.Clear].
+func (_ *DisjointSet[T, U]) Zero(other *DisjointSet[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedDisjointSet.Clear].
+func (_ *SyncedDisjointSet[T, U]) Zero(other *SyncedDisjointSet[T, U]) {
+	other.Clear()
+}
+
+func (d *DisjointSet[T, U]) toSerialized() [][]T {
+	rv := make([][]T, 0, d.state.numSets)
+	d.Sets().ForEach(func(index int, set []T) (iter.IteratorFeedback, error) {
+		rv = append(rv, set)
+		return iter.Continue, nil
+	})
+	return rv
+}
+
+func (d *DisjointSet[T, U]) fromSerialized(sets [][]T) error {
+	numVals := 0
+	for i := 0; i < len(sets); i++ {
+		if len(sets[i]) == 0 {
+			return getMalformedDataError(
+				"a disjoint set cannot contain an empty set",
+			)
+		}
+		numVals += len(sets[i])
+	}
+	seen, _ := NewHashSet[T, U](numVals)
+	for i := 0; i < len(sets); i++ {
+		for j := 0; j < len(sets[i]); j++ {
+			if seen.ContainsPntr(&sets[i][j]) {
+				return getDuplicateValueError[T](sets[i][j])
+			}
+			seen.AppendUnique(sets[i][j])
+		}
+	}
+	if d.state == nil {
+		*d, _ = NewDisjointSet[T, U](numVals)
+	} else {
+		d.Clear()
+	}
+	for i := 0; i < len(sets); i++ {
+		d.addImpl(&sets[i][0])
+		for j := 1; j < len(sets[i]); j++ {
+			d.unionImpl(&sets[i][0], &sets[i][j])
+		}
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the disjoint set. The disjoint set
+// is encoded as a list of sets, where each set is a list of its members. Only
+// the grouping of the values is encoded, so the representative of each set may
+// change after decoding.
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *DisjointSet[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.toSerialized())
+}
+
+// Description: Places a read lock on the underlying disjoint set and then calls
+// the underlying disjoint sets [DisjointSet.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *SyncedDisjointSet[T, U]) MarshalJSON() ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.MarshalJSON()
+}
+
+// Description: Replaces the contents of the disjoint set with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [DisjointSet.MarshalJSON]. If an error is returned the disjoint set is left
+// unchanged.
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *DisjointSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[][]T](data)
+	if err != nil {
+		return err
+	}
+	return d.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying disjoint set while its contents are replaced. Exhibits the
+// same behavior as [DisjointSet.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *SyncedDisjointSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[][]T](data)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	defer d.Unlock()
+	return d.DisjointSet.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the disjoint set. The
+// values are laid out the same way as [DisjointSet.MarshalJSON] and are encoded
+// using the [encoding/gob] package, so the contained values must be encodable
+// by gob.
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *DisjointSet[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(d.toSerialized())
+}
+
+// Description: Places a read lock on the underlying disjoint set and then calls
+// the underlying disjoint sets [DisjointSet.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *SyncedDisjointSet[T, U]) MarshalBinary() ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return d.DisjointSet.MarshalBinary()
+}
+
+// Description: Replaces the contents of the disjoint set with the values
+// decoded from the supplied binary data, which must have been produced by
+// [DisjointSet.MarshalBinary]. If an error is returned the disjoint set is left
+// unchanged.
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *DisjointSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[][]T](data)
+	if err != nil {
+		return err
+	}
+	return d.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying disjoint set while its contents are replaced. Exhibits the
+// same behavior as [DisjointSet.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*α(n)) amortized
+func (d *SyncedDisjointSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[][]T](data)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	defer d.Unlock()
+	return d.DisjointSet.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. Each set is printed as a list of
+// its members.
+func (d DisjointSet[T, U]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("disjointSet["))
+	d.Sets().ForEach(func(index int, set []T) (iter.IteratorFeedback, error) {
+		if index > 0 {
+			f.Write([]byte{' '})
+		}
+		f.Write([]byte{'['})
+		for i := 0; i < len(set); i++ {
+			if i > 0 {
+				f.Write([]byte{' '})
+			}
+			fmt.Fprintf(f, fmtStr, set[i])
+		}
+		f.Write([]byte{']'})
+		return iter.Continue, nil
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (d *DisjointSet[T, U]) String() string {
+	return fmt.Sprintf("%v", d)
+}
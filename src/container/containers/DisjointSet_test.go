@@ -0,0 +1,258 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestDisjointSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[DisjointSet[string, widgets.BuiltinString]]
+	v, _ := NewDisjointSet[string, widgets.BuiltinString](0)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedDisjointSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedDisjointSet[string, widgets.BuiltinString]]
+	v, _ := NewSyncedDisjointSet[string, widgets.BuiltinString](0)
+	widget = &v
+	_ = widget
+}
+
+func TestDisjointSetNewErrors(t *testing.T) {
+	_, err := NewDisjointSet[int, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedDisjointSet[int, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestDisjointSetAdd(t *testing.T) {
+	d := DisjointSetValInit[int, widgets.BuiltinInt](1, 2, 3, 2)
+	test.Eq(3, d.Length(), t)
+	test.Eq(3, d.NumSets(), t)
+	test.True(d.Contains(1), t)
+	test.False(d.Contains(4), t)
+	test.Nil(d.Add(4, 1), t)
+	test.Eq(4, d.Length(), t)
+	test.Eq(4, d.NumSets(), t)
+	vals, err := d.Vals().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{1, 2, 3, 4}, vals, t)
+	for i := 1; i <= 4; i++ {
+		rep, err := d.Find(i)
+		test.Nil(err, t)
+		test.Eq(i, rep, t)
+	}
+}
+
+func TestDisjointSetUnion(t *testing.T) {
+	d := DisjointSetValInit[int, badBuiltinInt](0, 1, 2, 3, 4, 5)
+	test.True(d.Union(0, 1), t)
+	test.True(d.Union(2, 3), t)
+	test.False(d.Union(1, 0), t)
+	test.Eq(4, d.NumSets(), t)
+	test.True(d.Connected(0, 1), t)
+	test.False(d.Connected(1, 2), t)
+	test.True(d.Union(1, 3), t)
+	test.Eq(3, d.NumSets(), t)
+	test.True(d.Connected(0, 2), t)
+	test.False(d.Connected(0, 4), t)
+	test.False(d.Connected(0, 100), t)
+
+	// Values that are not present are added
+	test.True(d.Union(6, 5), t)
+	test.Eq(7, d.Length(), t)
+	test.Eq(3, d.NumSets(), t)
+
+	r1, err := d.Find(0)
+	test.Nil(err, t)
+	r2, err := d.Find(3)
+	test.Nil(err, t)
+	test.Eq(r1, r2, t)
+	_, err = d.Find(100)
+	test.ContainsError(containerTypes.ValueError, err, t)
+
+	s, err := d.SetSize(2)
+	test.Nil(err, t)
+	test.Eq(4, s, t)
+	s, err = d.SetSize(4)
+	test.Nil(err, t)
+	test.Eq(1, s, t)
+	_, err = d.SetSize(100)
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestDisjointSetPathCompression(t *testing.T) {
+	d, _ := NewDisjointSet[int, widgets.BuiltinInt](0)
+	for i := 1; i < 1000; i++ {
+		d.Union(i-1, i)
+	}
+	test.Eq(1, d.NumSets(), t)
+	rep, err := d.Find(999)
+	test.Nil(err, t)
+	for i := 0; i < d.Length(); i++ {
+		d.find(i, true)
+	}
+	for i := 0; i < d.Length(); i++ {
+		test.Eq(rep, d.state.vals[d.state.parents[i]], t)
+	}
+	for i := 0; i < d.Length(); i++ {
+		test.True(d.state.ranks[i] <= 10, t)
+	}
+}
+
+func TestDisjointSetMembers(t *testing.T) {
+	d := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2, 3, 4, 5)
+	d.Union(0, 2)
+	d.Union(4, 0)
+	d.Union(1, 3)
+	members, err := d.Members(2).Collect()
+	test.Nil(err, t)
+	test.SlicesMatchUnordered[int]([]int{0, 2, 4}, members, t)
+	members, err = d.Members(5).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{5}, members, t)
+	cnt, err := d.Members(1).Take(1).Count()
+	test.Nil(err, t)
+	test.Eq(1, cnt, t)
+	_, err = d.Members(100).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+
+	sets, err := d.Sets().Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(sets), t)
+	total := 0
+	for _, s := range sets {
+		total += len(s)
+		for _, v := range s {
+			test.True(d.Connected(s[0], v), t)
+		}
+	}
+	test.Eq(6, total, t)
+}
+
+func TestDisjointSetClear(t *testing.T) {
+	d := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2)
+	d.Union(0, 1)
+	d.Clear()
+	test.Eq(0, d.Length(), t)
+	test.Eq(0, d.NumSets(), t)
+	test.False(d.Contains(0), t)
+	test.True(d.Union(1, 2), t)
+	test.Eq(1, d.NumSets(), t)
+}
+
+func TestDisjointSetEq(t *testing.T) {
+	d1 := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d2 := DisjointSetValInit[int, widgets.BuiltinInt](3, 2, 1, 0)
+	test.True(d1.Eq(&d1, &d2), t)
+	test.Eq(d1.Hash(&d1), d1.Hash(&d2), t)
+	d1.Union(0, 1)
+	d1.Union(2, 3)
+	test.False(d1.Eq(&d1, &d2), t)
+	d2.Union(0, 2)
+	d2.Union(1, 3)
+	test.False(d1.Eq(&d1, &d2), t)
+	test.False(d1.Eq(&d2, &d1), t)
+	d3 := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d3.Union(3, 2)
+	d3.Union(1, 0)
+	test.True(d1.Eq(&d1, &d3), t)
+	test.Eq(d1.Hash(&d1), d1.Hash(&d3), t)
+
+	// Merging all values into one set must not hash the same as a partition
+	d4 := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	d4.Union(0, 1)
+	d4.Union(2, 3)
+	d4.Union(0, 3)
+	test.Neq(d1.Hash(&d1), d1.Hash(&d4), t)
+
+	d1.Zero(&d1)
+	test.Eq(0, d1.Length(), t)
+}
+
+func TestDisjointSetFormat(t *testing.T) {
+	d := DisjointSetValInit[int, widgets.BuiltinInt](0, 1, 2)
+	d.Union(0, 1)
+	test.Eq("disjointSet[[0 1] [2]]", fmt.Sprintf("%v", d), t)
+	test.Eq("disjointSet[[0 1] [2]]", d.String(), t)
+}
+
+func TestSyncedDisjointSetConcurrentUnion(t *testing.T) {
+	d, _ := NewSyncedDisjointSet[int, widgets.BuiltinInt](0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for j := start; j < 1000; j += 4 {
+				d.Union(j%10, j)
+				d.Connected(j%10, j)
+				d.Find(j)
+				d.Members(j).Count()
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.Eq(1000, d.Length(), t)
+	test.Eq(10, d.NumSets(), t)
+	sets, err := d.Sets().Collect()
+	test.Nil(err, t)
+	test.Eq(10, len(sets), t)
+	for _, s := range sets {
+		test.Eq(100, len(s), t)
+	}
+	o := d.DisjointSet.ToSynced()
+	test.True(d.Eq(&d, &o), t)
+}
+
+func TestDisjointSetSerialization(t *testing.T) {
+	d := DisjointSetValInit[int, badBuiltinInt](0, 1, 2, 3, 4, 5, 6)
+	d.Union(0, 1)
+	d.Union(2, 3)
+	d.Union(3, 4)
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = d.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&d)
+		}
+		test.Nil(err, t)
+
+		res := DisjointSetValInit[int, badBuiltinInt](7, 8)
+		res.Union(7, 8)
+		var zeroRes DisjointSet[int, badBuiltinInt]
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+			test.Nil(zeroRes.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+			test.Nil(json.Unmarshal(data, &zeroRes), t)
+		}
+		test.True(d.Eq(&d, &res), t)
+		test.True(d.Eq(&d, &zeroRes), t)
+		test.Eq(4, res.NumSets(), t)
+		test.True(res.Connected(2, 4), t)
+		test.False(res.Contains(7), t)
+	}
+}
+
+func TestDisjointSetDeserializationErrors(t *testing.T) {
+	d := DisjointSetValInit[int, widgets.BuiltinInt](1, 2)
+	d.Union(1, 2)
+	err := json.Unmarshal([]byte(`[[3],[]]`), &d)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	err = json.Unmarshal([]byte(`[[3,4],[5,3]]`), &d)
+	test.ContainsError(containerTypes.Duplicate, err, t)
+	test.Eq(2, d.Length(), t)
+	test.True(d.Connected(1, 2), t)
+}
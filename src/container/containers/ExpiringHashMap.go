@@ -0,0 +1,1305 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+	"time"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	expiringHashMapEntry[V any] struct {
+		val V
+		// The zero time is used to represent a value that never expires.
+		expires time.Time
+	}
+
+	// A widget that only considers the value of an entry, the expiration time
+	// is ignored. This allows the value based operations of the underlying
+	// hash map to be used directly.
+	expiringHashMapEntryWidget[V any, VI widgets.BaseInterface[V]] struct{}
+
+	// A record of when a key expires. Records are never updated in place,
+	// when a key is given a new expiration time a new record is added and the
+	// old record is ignored once it reaches the front of the deadline queue.
+	expiringHashMapDeadline[K any] struct {
+		key     K
+		expires time.Time
+	}
+
+	expiringHashMapDeadlineWidget[K any] struct{}
+
+	expiringHashMapState[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		vals HashMap[
+			K, expiringHashMapEntry[V],
+			KI, expiringHashMapEntryWidget[V, VI],
+		]
+		deadlines PriorityQueue[
+			expiringHashMapDeadline[K],
+			expiringHashMapDeadlineWidget[K],
+		]
+		ttl   time.Duration
+		clock func() time.Time
+	}
+
+	expiringHashMapSweeper struct {
+		lock sync.Mutex
+		stop chan struct{}
+		done chan struct{}
+	}
+
+	// A type to represent a hash map where every key value pair has a time to
+	// live. Once a key value pair has expired it is no longer visible to any of
+	// the maps read operations, making it appear as though it was deleted.
+	// Expired key value pairs are reclaimed lazily by the maps write
+	// operations, by calling [ExpiringHashMap.Sweep], or by the background
+	// sweeper that a [SyncedExpiringHashMap] can run. The current time is
+	// provided by a clock function that is supplied when the map is created,
+	// allowing the passage of time to be controlled. The type constraints on
+	// the generics define the logic for how value specific operations, such as
+	// equality comparisons, will be handled. Copies of an expiring hash map
+	// share the same underlying state, the same as the builtin map type.
+	ExpiringHashMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		state *expiringHashMapState[K, V, KI, VI]
+	}
+
+	// A synchronized version of ExpiringHashMap. All operations will be
+	// wrapped in the appropriate calls to the embedded RWMutex. A pointer to a
+	// RWMutex is embedded rather than a value to avoid copying the lock value.
+	// A synced expiring hash map can also run a background sweeper that
+	// periodically reclaims expired key value pairs.
+	SyncedExpiringHashMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		sweeper *expiringHashMapSweeper
+		ExpiringHashMap[K, V, KI, VI]
+	}
+)
+
+func (e expiringHashMapEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+func (_ expiringHashMapEntryWidget[V, VI]) Eq(
+	l *expiringHashMapEntry[V],
+	r *expiringHashMapEntry[V],
+) bool {
+	w := widgets.Base[V, VI]{}
+	return w.Eq(&l.val, &r.val)
+}
+
+func (_ expiringHashMapEntryWidget[V, VI]) Hash(
+	v *expiringHashMapEntry[V],
+) hash.Hash {
+	w := widgets.Base[V, VI]{}
+	return w.Hash(&v.val)
+}
+
+func (_ expiringHashMapEntryWidget[V, VI]) Zero(v *expiringHashMapEntry[V]) {
+	w := widgets.Base[V, VI]{}
+	w.Zero(&v.val)
+	v.expires = time.Time{}
+}
+
+func (_ expiringHashMapDeadlineWidget[K]) Eq(
+	l *expiringHashMapDeadline[K],
+	r *expiringHashMapDeadline[K],
+) bool {
+	return l.expires.Equal(r.expires)
+}
+
+func (_ expiringHashMapDeadlineWidget[K]) Hash(
+	v *expiringHashMapDeadline[K],
+) hash.Hash {
+	return hash.Hash(v.expires.UnixNano())
+}
+
+func (_ expiringHashMapDeadlineWidget[K]) Zero(v *expiringHashMapDeadline[K]) {
+	*v = expiringHashMapDeadline[K]{}
+}
+
+func (_ expiringHashMapDeadlineWidget[K]) Lt(
+	l *expiringHashMapDeadline[K],
+	r *expiringHashMapDeadline[K],
+) bool {
+	return l.expires.Before(r.expires)
+}
+
+// Creates a new expiring hash map initialized with enough memory to hold size
+// elements. Size must be >= 0, an error will be returned if it is not. Key
+// value pairs that are added with [ExpiringHashMap.Emplace] will expire after
+// ttl has passed. A ttl of 0 means that those key value pairs never expire.
+// The ttl must be >= 0, an error will be returned if it is not. The clock is
+// used to get the current time and may be nil, in which case [time.Now] is
+// used.
+func NewExpiringHashMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](
+	size int,
+	ttl time.Duration,
+	clock func() time.Time,
+) (ExpiringHashMap[K, V, KI, VI], error) {
+	if size < 0 {
+		return ExpiringHashMap[K, V, KI, VI]{}, getSizeError(size)
+	}
+	if ttl < 0 {
+		return ExpiringHashMap[K, V, KI, VI]{}, getDurationError(ttl)
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	vals, _ := NewHashMap[
+		K, expiringHashMapEntry[V],
+		KI, expiringHashMapEntryWidget[V, VI],
+	](size)
+	deadlines, _ := NewPriorityQueue[
+		expiringHashMapDeadline[K],
+		expiringHashMapDeadlineWidget[K],
+	](size, false)
+	return ExpiringHashMap[K, V, KI, VI]{
+		state: &expiringHashMapState[K, V, KI, VI]{
+			vals:      vals,
+			deadlines: deadlines,
+			ttl:       ttl,
+			clock:     clock,
+		},
+	}, nil
+}
+
+// Creates a new synced expiring hash map initialized with enough memory to
+// hold size elements. Size must be >= 0, an error will be returned if it is
+// not. Key value pairs that are added with [SyncedExpiringHashMap.Emplace]
+// will expire after ttl has passed. A ttl of 0 means that those key value
+// pairs never expire. The ttl must be >= 0, an error will be returned if it is
+// not. The clock is used to get the current time and may be nil, in which case
+// [time.Now] is used. The underlying RWMutex value will be fully unlocked upon
+// initialization and the background sweeper will not be running.
+func NewSyncedExpiringHashMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](
+	size int,
+	ttl time.Duration,
+	clock func() time.Time,
+) (SyncedExpiringHashMap[K, V, KI, VI], error) {
+	rv, err := NewExpiringHashMap[K, V, KI, VI](size, ttl, clock)
+	return SyncedExpiringHashMap[K, V, KI, VI]{
+		RWMutex:         &sync.RWMutex{},
+		sweeper:         &expiringHashMapSweeper{},
+		ExpiringHashMap: rv,
+	}, err
+}
+
+// Converts the supplied map to a synchronized map. Beware: The original
+// non-synced map will remain useable.
+func (m *ExpiringHashMap[K, V, KI, VI]) ToSynced() SyncedExpiringHashMap[K, V, KI, VI] {
+	return SyncedExpiringHashMap[K, V, KI, VI]{
+		RWMutex:         &sync.RWMutex{},
+		sweeper:         &expiringHashMapSweeper{},
+		ExpiringHashMap: *m,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ExpiringHashMap[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ExpiringHashMap[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ExpiringHashMap[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ExpiringHashMap[K, V, KI, VI]) RUnlock() {}
+
+// The SyncedExpiringHashMap method to override the ExpiringHashMap pass
+// through function and actually apply the mutex operation.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Lock() { m.RWMutex.Lock() }
+
+// The SyncedExpiringHashMap method to override the ExpiringHashMap pass
+// through function and actually apply the mutex operation.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Unlock() { m.RWMutex.Unlock() }
+
+// The SyncedExpiringHashMap method to override the ExpiringHashMap pass
+// through function and actually apply the mutex operation.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) RLock() { m.RWMutex.RLock() }
+
+// The SyncedExpiringHashMap method to override the ExpiringHashMap pass
+// through function and actually apply the mutex operation.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) RUnlock() { m.RWMutex.RUnlock() }
+
+// Returns false, expiring hash maps are not addressable.
+func (m *ExpiringHashMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, an expiring hash map is not synced.
+func (m *ExpiringHashMap[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced expiring hash map is synced.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the time to live that is given to key value pairs that
+// are added with [ExpiringHashMap.Emplace]. A ttl of 0 means those key value
+// pairs never expire.
+//
+// Time Complexity: O(1)
+func (m *ExpiringHashMap[K, V, KI, VI]) TTL() time.Duration {
+	return m.state.ttl
+}
+
+// Description: Returns the number of unexpired elements in the map.
+//
+// Time Complexity: O(1) if no key value pairs have expired since the last write
+// operation, O(n) otherwise.
+func (m *ExpiringHashMap[K, V, KI, VI]) Length() int {
+	now := m.state.clock()
+	rv := m.state.vals.Length()
+	if d, err := m.state.deadlines.PeekPntrFront(); err != nil || now.Before(d.expires) {
+		return rv
+	}
+	for _, iterV := range m.state.vals.internalHashMapImpl {
+		if iterV.B.expired(now) {
+			rv--
+		}
+	}
+	return rv
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1) if no key value pairs have expired since the last write
+// operation, O(n) otherwise.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.Length()
+}
+
+// Description: Contains will return true if the supplied value is in the map
+// and has not expired, false otherwise. All equality comparisons are performed
+// by the generic VI widget type that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *ExpiringHashMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Contains(v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// map and has not expired, false otherwise. All equality comparisons are
+// performed by the generic VI widget type that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *ExpiringHashMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.ContainsPntr(v)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) find(
+	k *K,
+	now time.Time,
+) (hash.Hash, bool) {
+	h, found := m.state.vals.getHashPosition(k)
+	if found && m.state.vals.internalHashMapImpl[h].B.expired(now) {
+		return h, false
+	}
+	return h, found
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the map or if the key
+// value pair has expired.
+//
+// Time Complexity: O(1)
+func (m *ExpiringHashMap[K, V, KI, VI]) Get(k K) (V, error) {
+	if h, found := m.find(&k, m.state.clock()); found {
+		return m.state.vals.internalHashMapImpl[h].B.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying map and then gets the
+// value at the specified key. Exhibits the same behavior as the
+// [ExpiringHashMap.Get] method. The underlying [ExpiringHashMap.Get] method is
+// not called to avoid copying the return value twice, which could be
+// inefficient with a large value for the V generic.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Get(k K) (V, error) {
+	m.RLock()
+	defer m.RUnlock()
+	if h, found := m.find(&k, m.state.clock()); found {
+		return m.state.vals.internalHashMapImpl[h].B.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Panics, expiring hash maps are not addressable.
+func (m *ExpiringHashMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("expiring hash map"))
+}
+
+// Description: Gets the time that the key value pair at the specified key will
+// expire. The zero time will be returned if the key value pair never expires.
+// Returns a [containerTypes.KeyError] if the key is not found in the map or if
+// the key value pair has expired.
+//
+// Time Complexity: O(1)
+func (m *ExpiringHashMap[K, V, KI, VI]) ExpiresAt(k K) (time.Time, error) {
+	if h, found := m.find(&k, m.state.clock()); found {
+		return m.state.vals.internalHashMapImpl[h].B.expires, nil
+	}
+	return time.Time{}, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.ExpiresAt] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) ExpiresAt(k K) (time.Time, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.ExpiresAt(k)
+}
+
+// Description: KeyOf will return the key of the first occurrence of the
+// supplied unexpired value in the map. If the value is not found then the
+// returned key will be a zero initialized key value and the boolean flag will
+// be set to false. If the value is found then the boolean flag will be set to
+// true. All equality comparisons are performed by the generic VI widget type
+// that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *ExpiringHashMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.KeyOf] implementation method. The
+// [ExpiringHashMap.KeyOf] method is not called directly to avoid copying the
+// val variable twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: KeyOfPntr will return the key of the first occurrence of the
+// supplied unexpired value in the map. If the value is not found then the
+// returned key will be a zero initialized key value and the boolean flag will
+// be set to false. If the value is found then the boolean flag will be set to
+// true. All equality comparisons are performed by the generic VI widget type
+// that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *ExpiringHashMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.KeyOfPntr] implementation method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) keyOfImpl(k *K, v *V) bool {
+	now := m.state.clock()
+	w := widgets.Base[V, VI]{}
+	for _, iterV := range m.state.vals.internalHashMapImpl {
+		if !iterV.B.expired(now) && w.Eq(v, &iterV.B.val) {
+			*k = iterV.A
+			return true
+		}
+	}
+	return false
+}
+
+// Removes all key value pairs that have expired at the supplied time, returning
+// the number of key value pairs that were removed.
+func (m *ExpiringHashMap[K, V, KI, VI]) reclaim(now time.Time) int {
+	rv := 0
+	for {
+		d, err := m.state.deadlines.PeekPntrFront()
+		if err != nil || now.Before(d.expires) {
+			break
+		}
+		if h, found := m.state.vals.getHashPosition(&d.key); found &&
+			m.state.vals.internalHashMapImpl[h].B.expires.Equal(d.expires) {
+			m.state.vals.removeSingleValue(h)
+			rv++
+		}
+		m.state.deadlines.PopFront()
+	}
+	// Keys that have been given new expiration times, deleted, or popped
+	// leave stale deadlines behind. Rebuild the deadlines if they start to
+	// outnumber the key value pairs to keep memory usage bounded.
+	if m.state.deadlines.Length() > 2*m.state.vals.Length()+16 {
+		m.state.deadlines.Clear()
+		m.state.deadlines.Heapify(iter.Map[
+			basic.Pair[K, expiringHashMapEntry[V]],
+			expiringHashMapDeadline[K],
+		](
+			iter.MapVals[hash.Hash, basic.Pair[K, expiringHashMapEntry[V]]](
+				m.state.vals.internalHashMapImpl,
+			).Filter(func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) bool {
+				return !val.B.expires.IsZero()
+			}),
+			func(
+				index int,
+				val basic.Pair[K, expiringHashMapEntry[V]],
+			) (expiringHashMapDeadline[K], error) {
+				return expiringHashMapDeadline[K]{
+					key: val.A, expires: val.B.expires,
+				}, nil
+			},
+		))
+	}
+	return rv
+}
+
+// Description: Removes all key value pairs that have expired, returning the
+// number of key value pairs that were removed. Calling this method is never
+// required for correctness because expired key value pairs are never visible,
+// it only frees the memory that expired key value pairs are using.
+//
+// Time Complexity: O(m*log(n)), where m is the number of expired key value
+// pairs
+func (m *ExpiringHashMap[K, V, KI, VI]) Sweep() int {
+	return m.reclaim(m.state.clock())
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Sweep] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m is the number of expired key value
+// pairs
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Sweep() int {
+	m.Lock()
+	defer m.Unlock()
+	return m.ExpiringHashMap.Sweep()
+}
+
+// Description: Starts a background goroutine that calls
+// [SyncedExpiringHashMap.Sweep] every time interval passes. The interval is
+// measured using real time, not the clock the map was created with. If a
+// sweeper is already running it is stopped before the new one is started. The
+// interval must be > 0, an error will be returned if it is not. The sweeper
+// must be stopped with [SyncedExpiringHashMap.StopSweeper] once it is no longer
+// needed.
+//
+// Time Complexity: O(1)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) StartSweeper(
+	interval time.Duration,
+) error {
+	if interval <= 0 {
+		return customerr.Wrap(
+			customerr.ValOutsideRange,
+			"The sweep interval must be >0. Got: %s", interval,
+		)
+	}
+	m.sweeper.lock.Lock()
+	defer m.sweeper.lock.Unlock()
+	m.stopSweeperImpl()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	m.sweeper.stop = stop
+	m.sweeper.done = done
+	go func() {
+		defer close(done)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-t.C:
+				m.Sweep()
+			}
+		}
+	}()
+	return nil
+}
+
+// Description: Stops the background sweeper if it is running. This method
+// will not return until the sweepers goroutine has exited. Calling this method
+// when no sweeper is running has no effect. This method must not be called
+// while the maps lock is held, otherwise a deadlock can occur.
+//
+// Time Complexity: O(1)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) StopSweeper() {
+	m.sweeper.lock.Lock()
+	defer m.sweeper.lock.Unlock()
+	m.stopSweeperImpl()
+}
+
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) stopSweeperImpl() {
+	if m.sweeper.stop == nil {
+		return
+	}
+	close(m.sweeper.stop)
+	<-m.sweeper.done
+	m.sweeper.stop = nil
+	m.sweeper.done = nil
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the map or if the key value pair has expired. Stops setting
+// values as soon as an error is encountered. The expiration times of the key
+// value pairs are not changed.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *ExpiringHashMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	return m.setImpl(kvPairs)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Set] implementaiton method. The
+// [ExpiringHashMap.Set] method is not called directly to avoid copying the
+// vals varargs twice, which could be expensive with a large types for the K or
+// V generics or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.setImpl(kvPairs)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) setImpl(kvPairs []basic.Pair[K, V]) error {
+	m.reclaim(m.state.clock())
+	for i := 0; i < len(kvPairs); i++ {
+		if h, found := m.state.vals.getHashPosition(&kvPairs[i].A); found {
+			expires := m.state.vals.internalHashMapImpl[h].B.expires
+			m.state.vals.zeroKVPair(h)
+			m.state.vals.internalHashMapImpl[h] = basic.Pair[K, expiringHashMapEntry[V]]{
+				A: kvPairs[i].A,
+				B: expiringHashMapEntry[V]{val: kvPairs[i].B, expires: expires},
+			}
+		} else {
+			return getKeyError[K](&kvPairs[i].A)
+		}
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the map if they
+// do not exist and will set the keys value if it already exists in the map.
+// The key value pairs will expire once the maps ttl has passed, replacing any
+// expiration time the keys previously had. The values will be inserted in the
+// order that they are given.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *ExpiringHashMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	return m.emplaceImpl(m.state.ttl, vals)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Emplace] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.emplaceImpl(m.state.ttl, vals)
+}
+
+// Description: Behaves the same as [ExpiringHashMap.Emplace] except the key
+// value pairs will expire once the supplied ttl has passed rather than the
+// maps ttl. A ttl of 0 means the key value pairs never expire. The ttl must be
+// >= 0, an error will be returned if it is not and no values will be added.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *ExpiringHashMap[K, V, KI, VI]) EmplaceWithTTL(
+	ttl time.Duration,
+	vals ...basic.Pair[K, V],
+) error {
+	return m.emplaceImpl(ttl, vals)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.EmplaceWithTTL] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) EmplaceWithTTL(
+	ttl time.Duration,
+	vals ...basic.Pair[K, V],
+) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.emplaceImpl(ttl, vals)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) emplaceImpl(
+	ttl time.Duration,
+	vals []basic.Pair[K, V],
+) error {
+	if ttl < 0 {
+		return getDurationError(ttl)
+	}
+	now := m.state.clock()
+	m.reclaim(now)
+	var expires time.Time
+	if ttl > 0 {
+		expires = now.Add(ttl)
+	}
+	for i := 0; i < len(vals); i++ {
+		m.state.vals.Emplace(basic.Pair[K, expiringHashMapEntry[V]]{
+			A: vals[i].A,
+			B: expiringHashMapEntry[V]{val: vals[i].B, expires: expires},
+		})
+		if ttl > 0 {
+			m.state.deadlines.Push(expiringHashMapDeadline[K]{
+				key: vals[i].A, expires: expires,
+			})
+		}
+	}
+	return nil
+}
+
+// Description: Pop will remove all occurrences of val in the map. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with. Expired key value pairs are not counted in the returned
+// value.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) Pop(v V) int {
+	return m.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Pop] implementation method. The
+// [ExpiringHashMap.Pop] method is not called directly to avoid copying the v
+// argument twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Pop(v V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.popImpl(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the map. All
+// equality comparisons are performed by the generic VI widget type that the
+// map was initialized with. Expired key value pairs are not counted in the
+// returned value.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) PopPntr(v *V) int {
+	return m.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.PopPntr] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.popImpl(v)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) popImpl(v *V) int {
+	m.reclaim(m.state.clock())
+	return m.state.vals.popImpl(&expiringHashMapEntry[V]{val: *v})
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the map or if the key value pair has
+// expired.
+//
+// Time Complexity: O(1)
+func (m *ExpiringHashMap[K, V, KI, VI]) Delete(k K) error {
+	return m.deleteImpl(&k)
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Delete(k K) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.deleteImpl(&k)
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) deleteImpl(k *K) error {
+	m.reclaim(m.state.clock())
+	return m.state.vals.deleteImpl(k)
+}
+
+// Description: Clears all values from the map, expired or not.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) Clear() {
+	m.state.vals.Clear()
+	m.state.deadlines.Clear()
+}
+
+// Description: Places a write lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.ExpiringHashMap.Clear()
+}
+
+// Returns an iterator over the unexpired key value pairs. The current time is
+// read once when the iterator starts to be consumed.
+func (m *ExpiringHashMap[K, V, KI, VI]) unexpired() iter.Iter[basic.Pair[K, expiringHashMapEntry[V]]] {
+	var now time.Time
+	started := false
+	return iter.MapVals[hash.Hash, basic.Pair[K, expiringHashMapEntry[V]]](
+		m.state.vals.internalHashMapImpl,
+	).Filter(func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) bool {
+		if !started {
+			now = m.state.clock()
+			started = true
+		}
+		return !val.B.expired(now)
+	})
+}
+
+// Description: Returns an iterator that iterates over the keys of the
+// unexpired key value pairs in the map.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return iter.Map[basic.Pair[K, expiringHashMapEntry[V]], K](
+		m.unexpired(),
+		func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) (K, error) {
+			return val.A, nil
+		},
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [ExpiringHashMap.Keys] method such that a read lock will be placed on the
+// underlying map when the iterator is consumed. The map will have a read lock
+// the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.ExpiringHashMap.Keys().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the expiring hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [ExpiringHashMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the expiring hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedExpiringHashMap.Keys], so the expiring hash map
+// will have a read lock the entire time the loop is running. The lock is
+// released once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the
+// unexpired key value pairs in the map.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return iter.Map[basic.Pair[K, expiringHashMapEntry[V]], V](
+		m.unexpired(),
+		func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) (V, error) {
+			return val.B.val, nil
+		},
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [ExpiringHashMap.Vals] method such that a read lock will be placed on the
+// underlying map when the iterator is consumed. The map will have a read lock
+// the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return m.ExpiringHashMap.Vals().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the expiring hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [ExpiringHashMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the expiring hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedExpiringHashMap.Vals], so the expiring hash map
+// will have a read lock the entire time the loop is running. The lock is
+// released once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the expiring hash
+// map that can be used in a for-range loop. Expired key value pairs are
+// skipped.
+//
+// Time Complexity: O(n)
+func (m *ExpiringHashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the expiring hash
+// map that can be used in a for-range loop. The expiring hash map will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.ExpiringHashMap.pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	))
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) pairs() iter.Iter[basic.Pair[K, V]] {
+	return iter.Map[basic.Pair[K, expiringHashMapEntry[V]], basic.Pair[K, V]](
+		m.unexpired(),
+		func(
+			index int,
+			val basic.Pair[K, expiringHashMapEntry[V]],
+		) (basic.Pair[K, V], error) {
+			return basic.Pair[K, V]{A: val.A, B: val.B.val}, nil
+		},
+	)
+}
+
+// Panics, expiring hash maps are not addressable.
+func (m *ExpiringHashMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("expiring hash map"))
+}
+
+// Description: Returns true if all the unexpired key value pairs in m are all
+// contained in other and the key value pairs in other are all contained in m.
+// Returns false otherwise. Expiration times are not considered.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in m and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (m *ExpiringHashMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	if m.Length() != other.Length() {
+		return false
+	}
+	vw := widgets.Base[V, VI]{}
+	rv, _ := m.unexpired().All(func(val basic.Pair[K, expiringHashMapEntry[V]]) (bool, error) {
+		otherV, err := addressableSafeGet[K, V](other, val.A)
+		return err == nil && vw.Eq(&val.B.val, otherV), nil
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.KeyedEq] method. Attempts to place a read
+// lock on other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this map, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in m and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.RLock()
+	other.RLock()
+	defer m.RUnlock()
+	defer other.RUnlock()
+	return m.ExpiringHashMap.KeyedEq(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [ExpiringHashMap.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *ExpiringHashMap[K, V, KI, VI]) Eq(
+	l *ExpiringHashMap[K, V, KI, VI],
+	r *ExpiringHashMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedExpiringHashMap.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *SyncedExpiringHashMap[K, V, KI, VI]) Eq(
+	l *SyncedExpiringHashMap[K, V, KI, VI],
+	r *SyncedExpiringHashMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of an expiring hash map. To do this all of
+// the individual hashes that are produced from the unexpired elements of the
+// map are combined in a way that maintains identity, making it so the hash
+// will represent the same equality operation that [ExpiringHashMap.KeyedEq]
+// and [ExpiringHashMap.Eq] provide.
+func (_ *ExpiringHashMap[K, V, KI, VI]) Hash(
+	other *ExpiringHashMap[K, V, KI, VI],
+) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	kw := widgets.Base[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	other.unexpired().ForEach(
+		func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) (iter.IteratorFeedback, error) {
+			iterH := kw.Hash(&val.A).Combine(vw.Hash(&val.B.val))
+			if cntr == 0 {
+				rv = iterH
+				cntr++
+			} else {
+				rv = rv.CombineUnordered(iterH)
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Places a read lock on the underlying map of other and then calls others
+// underlying maps [ExpiringHashMap.Hash] method.
+func (_ *SyncedExpiringHashMap[K, V, KI, VI]) Hash(
+	other *SyncedExpiringHashMap[K, V, KI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.ExpiringHashMap.Hash(&other.ExpiringHashMap)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [ExpiringHashMap.Clear].
+func (_ *ExpiringHashMap[K, V, KI, VI]) Zero(other *ExpiringHashMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedExpiringHashMap.Clear].
+func (_ *SyncedExpiringHashMap[K, V, KI, VI]) Zero(
+	other *SyncedExpiringHashMap[K, V, KI, VI],
+) {
+	other.Clear()
+}
+
+type serializedExpiringHashMapEntry[K any, V any] struct {
+	Key     K         `json:"key"`
+	Val     V         `json:"val"`
+	Expires time.Time `json:"expires"`
+}
+
+type serializedExpiringHashMap[K any, V any] struct {
+	TTL     time.Duration                          `json:"ttl"`
+	Entries []serializedExpiringHashMapEntry[K, V] `json:"entries"`
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) toSerialized() serializedExpiringHashMap[K, V] {
+	if m.state == nil {
+		return serializedExpiringHashMap[K, V]{
+			Entries: []serializedExpiringHashMapEntry[K, V]{},
+		}
+	}
+	rv := serializedExpiringHashMap[K, V]{
+		TTL: m.state.ttl,
+		Entries: make(
+			[]serializedExpiringHashMapEntry[K, V], 0, m.state.vals.Length(),
+		),
+	}
+	now := m.state.clock()
+	for _, kv := range m.state.vals.internalHashMapImpl {
+		if !kv.B.expired(now) {
+			rv.Entries = append(rv.Entries, serializedExpiringHashMapEntry[K, V]{
+				Key: kv.A, Val: kv.B.val, Expires: kv.B.expires,
+			})
+		}
+	}
+	return rv
+}
+
+func (m *ExpiringHashMap[K, V, KI, VI]) fromSerialized(
+	s serializedExpiringHashMap[K, V],
+) error {
+	if s.TTL < 0 {
+		return getDurationError(s.TTL)
+	}
+	if m.state == nil {
+		*m, _ = NewExpiringHashMap[K, V, KI, VI](len(s.Entries), s.TTL, nil)
+	} else {
+		m.Clear()
+		m.state.ttl = s.TTL
+	}
+	for _, e := range s.Entries {
+		m.state.vals.Emplace(basic.Pair[K, expiringHashMapEntry[V]]{
+			A: e.Key,
+			B: expiringHashMapEntry[V]{val: e.Val, expires: e.Expires},
+		})
+		if !e.Expires.IsZero() {
+			m.state.deadlines.Push(expiringHashMapDeadline[K]{
+				key: e.Key, expires: e.Expires,
+			})
+		}
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the map. The default ttl of the map
+// is encoded along with the unexpired key value pairs, which are listed in no
+// particular order along with the time that they expire. Key value pairs that
+// never expire have the zero time as their expiration time. The clock of the
+// map is not encoded, decoding into a map keeps its clock. If a key is present
+// more than once when decoding the last value is kept.
+//
+// Time Complexity: O(n*log(n))
+func (m *ExpiringHashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.MarshalJSON()
+}
+
+// Description: Replaces the contents of the map with the values decoded from
+// the supplied JSON data, which must be in the format produced by
+// [ExpiringHashMap.MarshalJSON]. If an error is returned the map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *ExpiringHashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedExpiringHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying map while its contents are replaced. Exhibits the same
+// behavior as [ExpiringHashMap.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedExpiringHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.ExpiringHashMap.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the map. The values are
+// laid out the same way as [ExpiringHashMap.MarshalJSON] and are encoded using
+// the [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n*log(n))
+func (m *ExpiringHashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying map and then calls the
+// underlying maps [ExpiringHashMap.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.ExpiringHashMap.MarshalBinary()
+}
+
+// Description: Replaces the contents of the map with the values decoded
+// from the supplied binary data, which must have been produced by
+// [ExpiringHashMap.MarshalBinary]. If an error is returned the map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *ExpiringHashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedExpiringHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying map while its contents are replaced. Exhibits the same
+// behavior as [ExpiringHashMap.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedExpiringHashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedExpiringHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.ExpiringHashMap.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. Only unexpired key value pairs are
+// printed.
+func (m ExpiringHashMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("expiringHashMap["))
+	m.unexpired().ForEach(
+		func(index int, val basic.Pair[K, expiringHashMapEntry[V]]) (iter.IteratorFeedback, error) {
+			if index > 0 {
+				f.Write([]byte{' '})
+			}
+			fmt.Fprintf(f, fmtStr, val.A, val.B.val)
+			return iter.Continue, nil
+		},
+	)
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *ExpiringHashMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
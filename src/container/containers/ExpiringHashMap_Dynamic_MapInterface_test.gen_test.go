@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func ExpiringHashMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateExpiringHashMap(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestExpiringHashMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(ExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestExpiringHashMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(ExpiringHashMapToMapInterfaceFactory, t)
+}
@@ -0,0 +1,316 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=ExpiringHashMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateExpiringHashMap
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedExpiringHashMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateSyncedExpiringHashMap
+
+type expiringHashMapTestClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func newExpiringHashMapTestClock() *expiringHashMapTestClock {
+	return &expiringHashMapTestClock{
+		now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (c *expiringHashMapTestClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *expiringHashMapTestClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func generateExpiringHashMap(
+	capacity int,
+) ExpiringHashMap[int, int, badBuiltinInt, widgets.BuiltinInt] {
+	v, _ := NewExpiringHashMap[int, int, badBuiltinInt, widgets.BuiltinInt](
+		capacity, time.Hour, newExpiringHashMapTestClock().Now,
+	)
+	return v
+}
+
+func generateSyncedExpiringHashMap(
+	capacity int,
+) SyncedExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	v, _ := NewSyncedExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		capacity, 0, nil,
+	)
+	return v
+}
+
+func TestExpiringHashMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[ExpiringHashMap[
+		string, string,
+		widgets.BuiltinString, widgets.BuiltinString,
+	]]
+	v, _ := NewExpiringHashMap[
+		string, string,
+		widgets.BuiltinString, widgets.BuiltinString,
+	](0, 0, nil)
+	widget = &v
+	_ = widget
+}
+
+func TestExpiringHashMapNewErrors(t *testing.T) {
+	_, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		-1, time.Second, nil,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, -time.Second, nil,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestExpiringHashMapExpiredValuesAreHidden(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewExpiringHashMap[int, int, badBuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	test.Eq(time.Minute, m.TTL(), t)
+	test.Nil(m.Emplace(
+		basic.Pair[int, int]{A: 1, B: 1},
+		basic.Pair[int, int]{A: 2, B: 2},
+	), t)
+	test.Nil(m.EmplaceWithTTL(0, basic.Pair[int, int]{A: 3, B: 3}), t)
+	test.Nil(m.EmplaceWithTTL(time.Hour, basic.Pair[int, int]{A: 4, B: 4}), t)
+	test.ContainsError(
+		customerr.ValOutsideRange,
+		m.EmplaceWithTTL(-time.Hour, basic.Pair[int, int]{A: 5, B: 5}),
+		t,
+	)
+	test.Eq(4, m.Length(), t)
+
+	expires, err := m.ExpiresAt(1)
+	test.Nil(err, t)
+	test.Eq(clock.Now().Add(time.Minute), expires, t)
+	expires, err = m.ExpiresAt(3)
+	test.Nil(err, t)
+	test.True(expires.IsZero(), t)
+
+	clock.Advance(time.Minute - time.Nanosecond)
+	test.Eq(4, m.Length(), t)
+	clock.Advance(time.Nanosecond)
+	test.Eq(2, m.Length(), t)
+
+	_, err = m.Get(1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	_, err = m.ExpiresAt(2)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	v, err := m.Get(3)
+	test.Nil(err, t)
+	test.Eq(3, v, t)
+	test.False(m.Contains(1), t)
+	test.True(m.Contains(4), t)
+	_, found := m.KeyOf(2)
+	test.False(found, t)
+
+	keys, err := m.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatchUnordered[int]([]int{3, 4}, keys, t)
+	vals, err := m.Vals().Collect()
+	test.Nil(err, t)
+	test.SlicesMatchUnordered[int]([]int{3, 4}, vals, t)
+
+	// The expired values are still held in memory until a write op
+	test.Eq(4, m.state.vals.Length(), t)
+	test.ContainsError(containerTypes.KeyError, m.Delete(1), t)
+	test.Eq(2, m.state.vals.Length(), t)
+
+	clock.Advance(time.Hour)
+	test.Eq(1, m.Length(), t)
+	test.Eq(1, m.Sweep(), t)
+	test.Eq(1, m.state.vals.Length(), t)
+	test.Eq(0, m.Sweep(), t)
+}
+
+func TestExpiringHashMapEmplaceResetsExpiry(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 1, B: 1}), t)
+	clock.Advance(30 * time.Second)
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 1, B: 2}), t)
+	clock.Advance(45 * time.Second)
+
+	// The first deadline has passed but the key was refreshed
+	test.Eq(0, m.Sweep(), t)
+	v, err := m.Get(1)
+	test.Nil(err, t)
+	test.Eq(2, v, t)
+
+	// Set does not change the expiry
+	test.Nil(m.Set(basic.Pair[int, int]{A: 1, B: 3}), t)
+	clock.Advance(15 * time.Second)
+	_, err = m.Get(1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.ContainsError(
+		containerTypes.KeyError, m.Set(basic.Pair[int, int]{A: 1, B: 4}), t,
+	)
+	test.Eq(0, m.state.vals.Length(), t)
+
+	// A key that never expires is not removed by an old deadline
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 2, B: 2}), t)
+	test.Nil(m.EmplaceWithTTL(0, basic.Pair[int, int]{A: 2, B: 2}), t)
+	clock.Advance(time.Hour)
+	test.Eq(0, m.Sweep(), t)
+	test.Eq(1, m.Length(), t)
+}
+
+func TestExpiringHashMapPop(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	test.Nil(m.Emplace(
+		basic.Pair[int, int]{A: 1, B: 0},
+		basic.Pair[int, int]{A: 2, B: 0},
+	), t)
+	clock.Advance(time.Minute)
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 3, B: 0}), t)
+	test.Eq(1, m.Pop(0), t)
+	test.Eq(0, m.Length(), t)
+}
+
+func TestExpiringHashMapDeadlinesAreBounded(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	for i := 0; i < 1000; i++ {
+		test.Nil(m.Emplace(basic.Pair[int, int]{A: i % 4, B: i}), t)
+		clock.Advance(time.Millisecond)
+	}
+	test.Eq(4, m.Length(), t)
+	test.True(m.state.deadlines.Length() <= 2*4+16+1, t)
+	clock.Advance(time.Minute)
+	test.Eq(0, m.Length(), t)
+	test.Eq(4, m.Sweep(), t)
+	test.Eq(0, m.state.deadlines.Length(), t)
+}
+
+func TestExpiringHashMapEq(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m1, _ := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	m2, _ := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Hour, clock.Now,
+	)
+	test.Nil(m1.Emplace(basic.Pair[int, int]{A: 1, B: 1}), t)
+	test.Nil(m2.Emplace(basic.Pair[int, int]{A: 1, B: 1}), t)
+	test.Nil(m1.EmplaceWithTTL(time.Second, basic.Pair[int, int]{A: 2, B: 2}), t)
+	test.False(m1.Eq(&m1, &m2), t)
+	clock.Advance(time.Second)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.True(m1.Eq(&m2, &m1), t)
+	test.Eq(m1.Hash(&m1), m1.Hash(&m2), t)
+	test.Eq("expiringHashMap[1:1]", fmt.Sprintf("%v", m1), t)
+}
+
+func TestSyncedExpiringHashMapSweeper(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewSyncedExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	test.ContainsError(customerr.ValOutsideRange, m.StartSweeper(0), t)
+	// Stopping a sweeper that is not running does nothing
+	m.StopSweeper()
+
+	test.Nil(m.Emplace(
+		basic.Pair[int, int]{A: 1, B: 1},
+		basic.Pair[int, int]{A: 2, B: 2},
+	), t)
+	test.Nil(m.StartSweeper(time.Millisecond), t)
+	test.Nil(m.StartSweeper(time.Millisecond), t)
+	clock.Advance(time.Minute)
+	for i := 0; ; i++ {
+		m.RLock()
+		l := m.state.vals.Length()
+		m.RUnlock()
+		if l == 0 {
+			break
+		}
+		if i > 5000 {
+			t.Fatal("The sweeper did not remove the expired values.")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	m.StopSweeper()
+	m.StopSweeper()
+	test.Eq(0, m.Length(), t)
+}
+
+func TestExpiringHashMapSerializationKeepsExpiry(t *testing.T) {
+	clock := newExpiringHashMapTestClock()
+	m, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		0, time.Minute, clock.Now,
+	)
+	test.Nil(err, t)
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 1, B: 1}), t)
+	test.Nil(m.EmplaceWithTTL(0, basic.Pair[int, int]{A: 2, B: 2}), t)
+	clock.Advance(30 * time.Second)
+	test.Nil(m.Emplace(basic.Pair[int, int]{A: 3, B: 3}), t)
+	clock.Advance(45 * time.Second)
+
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		if binary {
+			data, err = m.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&m)
+		}
+		test.Nil(err, t)
+
+		res, err := NewExpiringHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+			0, time.Hour, clock.Now,
+		)
+		test.Nil(err, t)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		// Expired values are not encoded
+		test.Eq(2, res.Length(), t)
+		test.Eq(2, res.state.vals.Length(), t)
+		test.Eq(time.Minute, res.TTL(), t)
+		expires, err := res.ExpiresAt(2)
+		test.Nil(err, t)
+		test.True(expires.IsZero(), t)
+		expires, err = res.ExpiresAt(3)
+		test.Nil(err, t)
+		test.Eq(clock.Now().Add(-45*time.Second+time.Minute), expires, t)
+
+		clock.Advance(15 * time.Second)
+		test.Eq(1, res.Sweep(), t)
+		test.True(res.Contains(2), t)
+		clock.Advance(-15 * time.Second)
+	}
+}
@@ -0,0 +1,610 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a fixed length list of values that supports
+	// efficiently calculating the sum of any prefix or range of the values,
+	// also known as a binary indexed tree. Updating a single value and
+	// calculating a prefix or range sum both take O(log(n)) time, compared to
+	// O(1) and O(n) respectively with a [Vector]. The type constraints on the
+	// generics define the logic for how value specific operations, such as
+	// equality comparisons and arithmetic, will be handled.
+	FenwickTree[T any, U widgets.ArithInterface[T]] struct {
+		// Each index i holds the sum of the values in the index range
+		// (i-lsb(i+1),i], where lsb is the least significant set bit.
+		tree []T
+	}
+
+	// A synchronized version of FenwickTree. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedFenwickTree[T any, U widgets.ArithInterface[T]] struct {
+		*sync.RWMutex
+		FenwickTree[T, U]
+	}
+)
+
+// Returns the least significant set bit of i.
+func fenwickLsb(i int) int {
+	return i & -i
+}
+
+// Creates a new fenwick tree that holds size values, all of which are set to
+// the zero value provided by the U widget. Size must be >= 0, an error will be
+// returned if it is not.
+func NewFenwickTree[T any, U widgets.ArithInterface[T]](
+	size int,
+) (FenwickTree[T, U], error) {
+	if size < 0 {
+		return FenwickTree[T, U]{}, getSizeError(size)
+	}
+	w := widgets.Arith[T, U]{}
+	rv := FenwickTree[T, U]{tree: make([]T, size)}
+	for i := 0; i < size; i++ {
+		rv.tree[i] = w.ZeroVal()
+	}
+	return rv, nil
+}
+
+// Creates a new synced fenwick tree that holds size values, all of which are
+// set to the zero value provided by the U widget. Size must be >= 0, an error
+// will be returned if it is not. The underlying RWMutex value will be fully
+// unlocked upon initialization.
+func NewSyncedFenwickTree[T any, U widgets.ArithInterface[T]](
+	size int,
+) (SyncedFenwickTree[T, U], error) {
+	rv, err := NewFenwickTree[T, U](size)
+	return SyncedFenwickTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		FenwickTree: rv,
+	}, err
+}
+
+// Creates a new fenwick tree that holds the supplied values. The values are
+// copied. This is faster than creating an empty fenwick tree and setting each
+// value individually.
+func FenwickTreeValInit[T any, U widgets.ArithInterface[T]](
+	vals ...T,
+) FenwickTree[T, U] {
+	w := widgets.Arith[T, U]{}
+	rv := FenwickTree[T, U]{tree: make([]T, len(vals))}
+	copy(rv.tree, vals)
+	for i := 1; i <= len(rv.tree); i++ {
+		if parent := i + fenwickLsb(i); parent <= len(rv.tree) {
+			w.Add(&rv.tree[parent-1], &rv.tree[parent-1], &rv.tree[i-1])
+		}
+	}
+	return rv
+}
+
+// Creates a new synced fenwick tree that holds the supplied values. The values
+// are copied. The underlying RWMutex value will be fully unlocked upon
+// initialization.
+func SyncedFenwickTreeValInit[T any, U widgets.ArithInterface[T]](
+	vals ...T,
+) SyncedFenwickTree[T, U] {
+	return SyncedFenwickTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		FenwickTree: FenwickTreeValInit[T, U](vals...),
+	}
+}
+
+// Converts the supplied fenwick tree to a synchronized fenwick tree. Beware:
+// The original non-synced fenwick tree will remain useable.
+func (f *FenwickTree[T, U]) ToSynced() SyncedFenwickTree[T, U] {
+	return SyncedFenwickTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		FenwickTree: *f,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (f *FenwickTree[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (f *FenwickTree[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (f *FenwickTree[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (f *FenwickTree[T, U]) RUnlock() {}
+
+// The SyncedFenwickTree method to override the FenwickTree pass through
+// function and actually apply the mutex operation.
+func (f *SyncedFenwickTree[T, U]) Lock() { f.RWMutex.Lock() }
+
+// The SyncedFenwickTree method to override the FenwickTree pass through
+// function and actually apply the mutex operation.
+func (f *SyncedFenwickTree[T, U]) Unlock() { f.RWMutex.Unlock() }
+
+// The SyncedFenwickTree method to override the FenwickTree pass through
+// function and actually apply the mutex operation.
+func (f *SyncedFenwickTree[T, U]) RLock() { f.RWMutex.RLock() }
+
+// The SyncedFenwickTree method to override the FenwickTree pass through
+// function and actually apply the mutex operation.
+func (f *SyncedFenwickTree[T, U]) RUnlock() { f.RWMutex.RUnlock() }
+
+// Returns false, a fenwick tree is not synced.
+func (f *FenwickTree[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced fenwick tree is synced.
+func (f *SyncedFenwickTree[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of values in the fenwick tree.
+//
+// Time Complexity: O(1)
+func (f *FenwickTree[T, U]) Length() int {
+	return len(f.tree)
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (f *SyncedFenwickTree[T, U]) Length() int {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.Length()
+}
+
+// Description: Returns the value at the supplied index. Returns an error if
+// the index is out of bounds.
+//
+// Time Complexity: O(log(n))
+func (f *FenwickTree[T, U]) Get(idx int) (T, error) {
+	if idx < 0 || idx >= len(f.tree) {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(idx, 0, len(f.tree))
+	}
+	w := widgets.Arith[T, U]{}
+	rv := f.tree[idx]
+	// Subtract the children of idx, leaving only the value at idx
+	stop := idx + 1 - fenwickLsb(idx+1)
+	for i := idx; i > stop; i -= fenwickLsb(i) {
+		w.Sub(&rv, &rv, &f.tree[i-1])
+	}
+	return rv, nil
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (f *SyncedFenwickTree[T, U]) Get(idx int) (T, error) {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.Get(idx)
+}
+
+// Description: Adds delta to the value at the supplied index. Returns an error
+// if the index is out of bounds.
+//
+// Time Complexity: O(log(n))
+func (f *FenwickTree[T, U]) Add(idx int, delta T) error {
+	if idx < 0 || idx >= len(f.tree) {
+		return getIndexOutOfBoundsError(idx, 0, len(f.tree))
+	}
+	f.addImpl(idx, &delta)
+	return nil
+}
+
+// Description: Places a write lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.Add] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (f *SyncedFenwickTree[T, U]) Add(idx int, delta T) error {
+	f.Lock()
+	defer f.Unlock()
+	return f.FenwickTree.Add(idx, delta)
+}
+
+func (f *FenwickTree[T, U]) addImpl(idx int, delta *T) {
+	w := widgets.Arith[T, U]{}
+	for i := idx + 1; i <= len(f.tree); i += fenwickLsb(i) {
+		w.Add(&f.tree[i-1], &f.tree[i-1], delta)
+	}
+}
+
+// Description: Sets the value at the supplied index. Returns an error if the
+// index is out of bounds.
+//
+// Time Complexity: O(log(n))
+func (f *FenwickTree[T, U]) Set(idx int, val T) error {
+	cur, err := f.Get(idx)
+	if err != nil {
+		return err
+	}
+	w := widgets.Arith[T, U]{}
+	w.Sub(&val, &val, &cur)
+	f.addImpl(idx, &val)
+	return nil
+}
+
+// Description: Places a write lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.Set] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (f *SyncedFenwickTree[T, U]) Set(idx int, val T) error {
+	f.Lock()
+	defer f.Unlock()
+	return f.FenwickTree.Set(idx, val)
+}
+
+// Description: Returns the sum of the values in the index range [0,end).
+// Returns an error if end is < 0 or > the length of the fenwick tree.
+//
+// Time Complexity: O(log(n))
+func (f *FenwickTree[T, U]) PrefixSum(end int) (T, error) {
+	if end < 0 || end > len(f.tree) {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(end, 0, len(f.tree))
+	}
+	return f.prefixSumImpl(end), nil
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.PrefixSum] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (f *SyncedFenwickTree[T, U]) PrefixSum(end int) (T, error) {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.PrefixSum(end)
+}
+
+func (f *FenwickTree[T, U]) prefixSumImpl(end int) T {
+	w := widgets.Arith[T, U]{}
+	rv := w.ZeroVal()
+	for i := end; i > 0; i -= fenwickLsb(i) {
+		w.Add(&rv, &rv, &f.tree[i-1])
+	}
+	return rv
+}
+
+// Description: Returns the sum of the values in the index range [start,end).
+// Returns an error if the start index is < 0, the end index is > the length
+// of the fenwick tree, or the end index is < the start index.
+//
+// Time Complexity: O(log(n))
+func (f *FenwickTree[T, U]) RangeSum(start int, end int) (T, error) {
+	var tmp T
+	if start < 0 {
+		return tmp, getIndexOutOfBoundsError(start, 0, len(f.tree))
+	}
+	if end > len(f.tree) {
+		return tmp, getIndexOutOfBoundsError(end, 0, len(f.tree))
+	}
+	if end < start {
+		return tmp, getStartEndIndexError(start, end)
+	}
+	w := widgets.Arith[T, U]{}
+	rv := f.prefixSumImpl(end)
+	startSum := f.prefixSumImpl(start)
+	w.Sub(&rv, &rv, &startSum)
+	return rv, nil
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.RangeSum] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (f *SyncedFenwickTree[T, U]) RangeSum(start int, end int) (T, error) {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.RangeSum(start, end)
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// fenwick tree in index order.
+//
+// Time Complexity: O(n*log(n))
+func (f *FenwickTree[T, U]) Vals() iter.Iter[T] {
+	return iter.SequentialElems[T](len(f.tree), f.Get)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [FenwickTree.Vals] method such that a read lock will be placed on the
+// underlying fenwick tree when the iterator is consumed. The fenwick tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (f *SyncedFenwickTree[T, U]) Vals() iter.Iter[T] {
+	return f.FenwickTree.Vals().SetupTeardown(
+		func() error { f.RLock(); return nil },
+		func() error { f.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the fenwick tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [FenwickTree.Vals].
+//
+// Time Complexity: O(n*log(n))
+func (f *FenwickTree[T, U]) ValsSeq() stditer.Seq[T] {
+	return f.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the fenwick tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedFenwickTree.Vals], so the fenwick tree will have
+// a read lock the entire time the loop is running. The lock is released once
+// the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (f *SyncedFenwickTree[T, U]) ValsSeq() stditer.Seq[T] {
+	return f.Vals().Seq()
+}
+
+// Description: Sets every value in the fenwick tree to the zero value provided
+// by the U widget. The length of the fenwick tree is not changed.
+//
+// Time Complexity: O(n)
+func (f *FenwickTree[T, U]) Clear() {
+	w := widgets.Arith[T, U]{}
+	for i := 0; i < len(f.tree); i++ {
+		f.tree[i] = w.ZeroVal()
+	}
+}
+
+// Description: Places a write lock on the underlying fenwick tree and then
+// calls the underlying fenwick trees [FenwickTree.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (f *SyncedFenwickTree[T, U]) Clear() {
+	f.Lock()
+	defer f.Unlock()
+	f.FenwickTree.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two fenwick trees are equal if they have the same length and the
+// values at each index are equal. Returns true if l==r, false otherwise.
+//
+// Time Complexity: O(n*log(n))
+func (_ *FenwickTree[T, U]) Eq(
+	l *FenwickTree[T, U],
+	r *FenwickTree[T, U],
+) bool {
+	if len(l.tree) != len(r.tree) {
+		return false
+	}
+	w := widgets.Arith[T, U]{}
+	for i := 0; i < len(l.tree); i++ {
+		lv, _ := l.Get(i)
+		rv, _ := r.Get(i)
+		if !w.Eq(&lv, &rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// fenwick trees [FenwickTree.Eq] method. Returns true if l==r, false
+// otherwise.
+func (_ *SyncedFenwickTree[T, U]) Eq(
+	l *SyncedFenwickTree[T, U],
+	r *SyncedFenwickTree[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.FenwickTree.Eq(&l.FenwickTree, &r.FenwickTree)
+}
+
+// A function that returns a hash of a fenwick tree. The hash is created from
+// the values in the fenwick tree in index order, making it so the hash will
+// represent the same equality operation that [FenwickTree.Eq] provides.
+func (_ *FenwickTree[T, U]) Hash(other *FenwickTree[T, U]) hash.Hash {
+	w := widgets.Arith[T, U]{}
+	rv := hash.Hash(len(other.tree))
+	for i := 0; i < len(other.tree); i++ {
+		v, _ := other.Get(i)
+		rv = rv.Combine(w.Hash(&v))
+	}
+	return rv
+}
+
+// Places a read lock on the underlying fenwick tree of other and then calls
+// others underlying fenwick trees [FenwickTree.Hash] method.
+func (_ *SyncedFenwickTree[T, U]) Hash(
+	other *SyncedFenwickTree[T, U],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.FenwickTree.Hash(&other.FenwickTree)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [FenwickTree.Clear].
+func (_ *FenwickTree[T, U]) Zero(other *FenwickTree[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedFenwickTree.Clear].
+func (_ *SyncedFenwickTree[T, U]) Zero(other *SyncedFenwickTree[T, U]) {
+	other.Clear()
+}
+
+func (f *FenwickTree[T, U]) toSerialized() []T {
+	// Undoes the construction performed in FenwickTreeValInit
+	w := widgets.Arith[T, U]{}
+	rv := make([]T, len(f.tree))
+	copy(rv, f.tree)
+	for i := len(rv); i > 0; i-- {
+		if parent := i + fenwickLsb(i); parent <= len(rv) {
+			w.Sub(&rv[parent-1], &rv[parent-1], &rv[i-1])
+		}
+	}
+	return rv
+}
+
+func (f *FenwickTree[T, U]) fromSerialized(vals []T) error {
+	*f = FenwickTreeValInit[T, U](vals...)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the fenwick tree. The fenwick tree
+// is encoded as a list of its values in index order, not as its internal
+// representation.
+//
+// Time Complexity: O(n)
+func (f *FenwickTree[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.toSerialized())
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then calls
+// the underlying fenwick trees [FenwickTree.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (f *SyncedFenwickTree[T, U]) MarshalJSON() ([]byte, error) {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.MarshalJSON()
+}
+
+// Description: Replaces the contents of the fenwick tree with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [FenwickTree.MarshalJSON]. If an error is returned the fenwick tree is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (f *FenwickTree[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return f.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying fenwick tree while its contents are replaced. Exhibits the
+// same behavior as [FenwickTree.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (f *SyncedFenwickTree[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	return f.FenwickTree.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the fenwick tree. The
+// values are laid out the same way as [FenwickTree.MarshalJSON] and are encoded
+// using the [encoding/gob] package, so the contained values must be encodable
+// by gob.
+//
+// Time Complexity: O(n)
+func (f *FenwickTree[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(f.toSerialized())
+}
+
+// Description: Places a read lock on the underlying fenwick tree and then calls
+// the underlying fenwick trees [FenwickTree.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (f *SyncedFenwickTree[T, U]) MarshalBinary() ([]byte, error) {
+	f.RLock()
+	defer f.RUnlock()
+	return f.FenwickTree.MarshalBinary()
+}
+
+// Description: Replaces the contents of the fenwick tree with the values
+// decoded from the supplied binary data, which must have been produced by
+// [FenwickTree.MarshalBinary]. If an error is returned the fenwick tree is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (f *FenwickTree[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return f.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying fenwick tree while its contents are replaced. Exhibits the
+// same behavior as [FenwickTree.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (f *SyncedFenwickTree[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	return f.FenwickTree.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values in the fenwick tree are
+// printed in index order.
+func (f FenwickTree[T, U]) Format(state fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	state.Write([]byte("fenwickTree["))
+	for i := 0; i < len(f.tree); i++ {
+		v, _ := f.Get(i)
+		fmt.Fprintf(state, fmtStr, v)
+		if i+1 < len(f.tree) {
+			state.Write([]byte{' '})
+		}
+	}
+	state.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (f *FenwickTree[T, U]) String() string {
+	return fmt.Sprintf("%v", f)
+}
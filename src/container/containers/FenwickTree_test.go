@@ -0,0 +1,209 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestFenwickTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[FenwickTree[int, widgets.BuiltinInt]]
+	v, _ := NewFenwickTree[int, widgets.BuiltinInt](5)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedFenwickTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedFenwickTree[int, widgets.BuiltinInt]]
+	v, _ := NewSyncedFenwickTree[int, widgets.BuiltinInt](5)
+	widget = &v
+	_ = widget
+}
+
+func TestFenwickTreeNewErrors(t *testing.T) {
+	_, err := NewFenwickTree[int, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedFenwickTree[int, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	f, err := NewFenwickTree[int, widgets.BuiltinInt](0)
+	test.Nil(err, t)
+	test.Eq(0, f.Length(), t)
+	res, err := f.PrefixSum(0)
+	test.Nil(err, t)
+	test.Eq(0, res, t)
+}
+
+func TestFenwickTreeValInit(t *testing.T) {
+	for n := 0; n < 40; n++ {
+		vals := make([]int, n)
+		for i := range vals {
+			vals[i] = i*i - 3
+		}
+		f := FenwickTreeValInit[int, widgets.BuiltinInt](vals...)
+		test.Eq(n, f.Length(), t)
+		res, err := f.Vals().Collect()
+		test.Nil(err, t)
+		test.SlicesMatch[int](vals, res, t)
+		sum := 0
+		for i := 0; i <= n; i++ {
+			p, err := f.PrefixSum(i)
+			test.Nil(err, t)
+			test.Eq(sum, p, t)
+			if i < n {
+				sum += vals[i]
+			}
+		}
+	}
+}
+
+func TestFenwickTreeRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{1, 2, 7, 64, 100} {
+		exp := make([]int, n)
+		f, _ := NewFenwickTree[int, widgets.BuiltinInt](n)
+		for op := 0; op < 1000; op++ {
+			idx := r.Intn(n)
+			val := r.Intn(200) - 100
+			switch r.Intn(4) {
+			case 0:
+				exp[idx] += val
+				test.Nil(f.Add(idx, val), t)
+			case 1:
+				exp[idx] = val
+				test.Nil(f.Set(idx, val), t)
+			case 2:
+				res, err := f.Get(idx)
+				test.Nil(err, t)
+				test.Eq(exp[idx], res, t)
+			case 3:
+				start := r.Intn(n + 1)
+				end := start + r.Intn(n-start+1)
+				sum := 0
+				for i := start; i < end; i++ {
+					sum += exp[i]
+				}
+				res, err := f.RangeSum(start, end)
+				test.Nil(err, t)
+				test.Eq(sum, res, t)
+			}
+		}
+	}
+}
+
+func TestFenwickTreeFloat(t *testing.T) {
+	f := FenwickTreeValInit[float64, widgets.BuiltinFloat64](0.5, 1.5, 2, 4)
+	test.Nil(f.Add(1, 0.25), t)
+	res, err := f.RangeSum(1, 3)
+	test.Nil(err, t)
+	test.Eq(3.75, res, t)
+}
+
+func TestFenwickTreeIndexErrors(t *testing.T) {
+	f := FenwickTreeValInit[int, widgets.BuiltinInt](1, 2, 3)
+	_, err := f.Get(3)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.Get(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = f.Add(3, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = f.Set(-1, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.PrefixSum(4)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.PrefixSum(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.RangeSum(-1, 2)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.RangeSum(0, 4)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = f.RangeSum(2, 1)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	res, err := f.RangeSum(2, 2)
+	test.Nil(err, t)
+	test.Eq(0, res, t)
+	vals, _ := f.Vals().Collect()
+	test.SlicesMatch[int]([]int{1, 2, 3}, vals, t)
+}
+
+func TestFenwickTreeClear(t *testing.T) {
+	f := FenwickTreeValInit[int, widgets.BuiltinInt](1, 2, 3)
+	f.Clear()
+	test.Eq(3, f.Length(), t)
+	res, _ := f.PrefixSum(3)
+	test.Eq(0, res, t)
+	test.Nil(f.Add(2, 5), t)
+	res, _ = f.Get(2)
+	test.Eq(5, res, t)
+}
+
+func TestFenwickTreeEqHashFormat(t *testing.T) {
+	f := FenwickTreeValInit[int, widgets.BuiltinInt](1, 2, 3)
+	other, _ := NewFenwickTree[int, widgets.BuiltinInt](3)
+	test.False(f.Eq(&f, &other), t)
+	other.Add(0, 1)
+	other.Add(1, 2)
+	other.Add(2, 3)
+	test.True(f.Eq(&f, &other), t)
+	test.Eq(f.Hash(&f), other.Hash(&other), t)
+	shorter := FenwickTreeValInit[int, widgets.BuiltinInt](1, 2)
+	test.False(f.Eq(&f, &shorter), t)
+	test.Eq("fenwickTree[1 2 3]", f.String(), t)
+	test.Eq("fenwickTree[1 2 3]", fmt.Sprint(f), t)
+	f.Zero(&f)
+	test.Eq("fenwickTree[0 0 0]", f.String(), t)
+}
+
+func TestSyncedFenwickTreeConcurrent(t *testing.T) {
+	f, _ := NewSyncedFenwickTree[int, widgets.BuiltinInt](100)
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				f.Add(i%100, 1)
+				f.PrefixSum(50)
+				f.RangeSum(10, 20)
+			}
+		}()
+	}
+	wg.Wait()
+	res, err := f.PrefixSum(100)
+	test.Nil(err, t)
+	test.Eq(4000, res, t)
+	for i := 0; i < 100; i++ {
+		v, _ := f.Get(i)
+		test.Eq(40, v, t)
+	}
+}
+
+func TestFenwickTreeSerialization(t *testing.T) {
+	f := FenwickTreeValInit[int, widgets.BuiltinInt](5, -2, 7, 0, 9)
+	f.Add(3, 4)
+	data, err := json.Marshal(&f)
+	test.Nil(err, t)
+	test.Eq(`[5,-2,7,4,9]`, string(data), t)
+	for _, binary := range []bool{false, true} {
+		if binary {
+			data, err = f.MarshalBinary()
+			test.Nil(err, t)
+		}
+		res := FenwickTreeValInit[int, widgets.BuiltinInt](1)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(f.Eq(&f, &res), t)
+		sum, _ := res.RangeSum(1, 4)
+		test.Eq(9, sum, t)
+	}
+}
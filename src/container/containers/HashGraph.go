@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
 	"sync"
 	"unsafe"
@@ -192,6 +193,7 @@ func (h *hashGraphVertices[V, E, VI, EI]) deleteOp(
 		delete(graphImpl, vertexHash(deletedHash))
 	}
 
+	movedNodes := map[vertexHash]Vector[graphLink, *graphLink]{}
 	for iterHash, gNode := range graphImpl {
 		idx, offset := 0, 0
 		for i, gLink := range gNode {
@@ -210,10 +212,19 @@ func (h *hashGraphVertices[V, E, VI, EI]) deleteOp(
 		*numLinksPntr -= offset
 		if len(gNode) == 0 {
 			delete(graphImpl, iterHash)
+		} else if newHash, ok := updatedHashes[OldHashSetHash(iterHash)]; ok {
+			// The vertex this node belongs to was moved, the node has to be
+			// moved with it. It is re-added after iteration so it is not
+			// confused with a node that has not been visited yet.
+			delete(graphImpl, iterHash)
+			movedNodes[vertexHash(newHash)] = gNode
 		} else {
 			graphImpl[iterHash] = gNode
 		}
 	}
+	for newHash, gNode := range movedNodes {
+		graphImpl[newHash] = gNode
+	}
 }
 func (_ *hashGraphVertices[V, E, VI, EI]) clearOp() {
 	// intentional noop - no hashes to update after clear
@@ -1913,3 +1924,178 @@ func (_ *HashGraph[V, E, VI, EI]) Zero(other *HashGraph[V, E, VI, EI]) {
 func (_ *SyncedHashGraph[V, E, VI, EI]) Zero(other *SyncedHashGraph[V, E, VI, EI]) {
 	other.Clear()
 }
+
+// Checks that every link in the serialized graph refers to a vertex and edge
+// that is also in the serialized graph.
+func validateSerializedGraph[
+	V any,
+	E any,
+	VI widgets.BaseInterface[V],
+	EI widgets.BaseInterface[E],
+](s serializedGraph[V, E]) error {
+	vertices := HashSetValInit[V, VI](s.Vertices...)
+	edges := HashSetValInit[E, EI](s.Edges...)
+	for i := 0; i < len(s.Links); i++ {
+		if !vertices.ContainsPntr(&s.Links[i].From) {
+			return getVertexError[V](&s.Links[i].From)
+		}
+		if !vertices.ContainsPntr(&s.Links[i].To) {
+			return getVertexError[V](&s.Links[i].To)
+		}
+		if !edges.ContainsPntr(&s.Links[i].Edge) {
+			return getEdgeError[E](&s.Links[i].Edge)
+		}
+	}
+	return nil
+}
+
+func (g *HashGraph[V, E, VI, EI]) toSerialized() serializedGraph[V, E] {
+	if g.internalHashGraphImpl == nil {
+		return serializedGraph[V, E]{
+			Vertices: []V{},
+			Edges:    []E{},
+			Links:    []serializedLink[V, E]{},
+		}
+	}
+	rv := serializedGraph[V, E]{
+		Vertices: g.vertices.toSerialized(),
+		Edges:    g.edges.toSerialized(),
+		Links:    make([]serializedLink[V, E], 0, g.numLinks),
+	}
+	for vHash, gNode := range g.graph {
+		from, _ := g.vertices.GetFromHash(HashSetHash(vHash))
+		for _, gLink := range gNode {
+			to, _ := g.vertices.GetFromHash(HashSetHash(gLink.B))
+			e, _ := g.edges.GetFromHash(HashSetHash(gLink.A))
+			rv.Links = append(
+				rv.Links,
+				serializedLink[V, E]{From: from, To: to, Edge: e},
+			)
+		}
+	}
+	return rv
+}
+
+func (g *HashGraph[V, E, VI, EI]) fromSerialized(s serializedGraph[V, E]) error {
+	if err := validateSerializedGraph[V, E, VI, EI](s); err != nil {
+		return err
+	}
+	if g.internalHashGraphImpl == nil {
+		*g, _ = NewHashGraph[V, E, VI, EI](len(s.Vertices), len(s.Edges))
+	} else {
+		g.Clear()
+	}
+	g.AddVertices(s.Vertices...)
+	g.AddEdges(s.Edges...)
+	for i := 0; i < len(s.Links); i++ {
+		g.LinkPntr(&s.Links[i].From, &s.Links[i].To, &s.Links[i].Edge)
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the hash graph. All of the vertices
+// and edges are encoded, including those that are not part of any link, along
+// with a list of links. Each link is encoded as the vertex it starts at, the
+// vertex it ends at, and the edge that connects them. When decoding every link
+// must refer to a vertex and edge that is also in the encoded data, an error
+// will be returned if one does not.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashGraph[V, E, VI, EI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash graph and then calls
+// the underlying hash graphs [HashGraph.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashGraph[V, E, VI, EI]) MarshalJSON() ([]byte, error) {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashGraph.MarshalJSON()
+}
+
+// Description: Replaces the contents of the hash graph with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [HashGraph.MarshalJSON]. If an error is returned the hash graph is left
+// unchanged.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashGraph[V, E, VI, EI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	return g.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying hash graph while its contents are replaced. Exhibits the same
+// behavior as [HashGraph.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashGraph[V, E, VI, EI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.HashGraph.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the hash graph. The values
+// are laid out the same way as [HashGraph.MarshalJSON] and are encoded using
+// the [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashGraph[V, E, VI, EI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(g.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash graph and then calls
+// the underlying hash graphs [HashGraph.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashGraph[V, E, VI, EI]) MarshalBinary() ([]byte, error) {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashGraph.MarshalBinary()
+}
+
+// Description: Replaces the contents of the hash graph with the values decoded
+// from the supplied binary data, which must have been produced by
+// [HashGraph.MarshalBinary]. If an error is returned the hash graph is left
+// unchanged.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashGraph[V, E, VI, EI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	return g.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying hash graph while its contents are replaced. Exhibits the same
+// behavior as [HashGraph.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashGraph[V, E, VI, EI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.HashGraph.fromSerialized(decoded)
+}
@@ -37,6 +37,10 @@ func TestHashGraph_DynDirectedGraphInterfaceInterface(t *testing.T) {
 	tests.DynDirectedGraphInterfaceInterface(HashGraphToDirectedGraphInterfaceFactory, t)
 }
 
+func TestHashGraph_DynDirectedGraphInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDirectedGraphInterfaceSerializableInterface(HashGraphToDirectedGraphInterfaceFactory, t)
+}
+
 func TestHashGraph_DynDirectedGraphInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynDirectedGraphInterfaceStaticCapacityInterface(HashGraphToDirectedGraphInterfaceFactory, t)
 }
@@ -180,3 +184,11 @@ func TestHashGraph_DynDirectedGraphIsSuperset(t *testing.T) {
 func TestHashGraph_DynDirectedGraphIsSubset(t *testing.T) {
 	tests.DynDirectedGraphIsSubset(HashGraphToDirectedGraphInterfaceFactory, t)
 }
+
+func TestHashGraph_DynDirectedGraphInterfaceJSON(t *testing.T) {
+	tests.DynDirectedGraphInterfaceJSON(HashGraphToDirectedGraphInterfaceFactory, t)
+}
+
+func TestHashGraph_DynDirectedGraphInterfaceBinary(t *testing.T) {
+	tests.DynDirectedGraphInterfaceBinary(HashGraphToDirectedGraphInterfaceFactory, t)
+}
@@ -140,3 +140,16 @@ func TestHashGraphZero(t *testing.T) {
 	test.Eq(0, g1.edges.Length(), t)
 	test.Eq(0, len(g1.graph), t)
 }
+
+func TestHashGraphDeleteVertexWithCollisions(t *testing.T) {
+	g, err := NewHashGraph[int, int, badBuiltinInt, widgets.BuiltinInt](0, 0)
+	test.Nil(err, t)
+	test.Nil(g.AddVertices(0, 1, 2, 3), t)
+	test.Nil(g.AddEdges(0, 1), t)
+	test.Nil(g.Link(1, 3, 0), t)
+	test.Nil(g.Link(3, 2, 1), t)
+	test.Nil(g.DeleteVertex(1), t)
+	test.Eq(1, g.NumLinks(), t)
+	test.Eq(1, g.NumOutLinks(3), t)
+	test.True(g.ContainsLink(3, 2, 1), t)
+}
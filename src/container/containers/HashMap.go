@@ -1,7 +1,9 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
+	stditer "iter"
 	"sync"
 
 	"github.com/barbell-math/util/src/container/basic"
@@ -497,8 +499,8 @@ func (m *HashMap[K, V, KI, VI]) removeMultipleValues(h hash.Hash, v *V) {
 			if vw.Eq(v, &iterV.B) {
 				continue
 			}
+			// The moved pair is still in the map so it must not be zeroed.
 			m.internalHashMapImpl[curPos] = m.internalHashMapImpl[j]
-			m.zeroKVPair(j)
 			delete(m.internalHashMapImpl, j)
 			curPos = j
 		} else {
@@ -557,8 +559,8 @@ func (m *HashMap[K, V, KI, VI]) removeSingleValue(h hash.Hash) {
 			if kw.Hash(&iterV.A) > curPos {
 				continue
 			}
+			// The moved pair is still in the map so it must not be zeroed.
 			m.internalHashMapImpl[curPos] = m.internalHashMapImpl[j]
-			m.zeroKVPair(h)
 			delete(m.internalHashMapImpl, j)
 			curPos = j
 		} else {
@@ -622,6 +624,28 @@ func (m *SyncedHashMap[K, V, KI, VI]) Keys() iter.Iter[K] {
 	)
 }
 
+// Description: Returns a sequence over the keys in the hash map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [HashMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the hash map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedHashMap.Keys], so the hash map will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
 // Description: Returns an iterator that iterates over the values in the hash
 // map.
 //
@@ -649,6 +673,55 @@ func (m *SyncedHashMap[K, V, KI, VI]) Vals() iter.Iter[V] {
 	)
 }
 
+// Description: Returns a sequence over the values in the hash map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [HashMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the hash map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedHashMap.Vals], so the hash map will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the hash map that
+// can be used in a for-range loop.
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the hash map that
+// can be used in a for-range loop. The hash map will have a read lock the
+// entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.HashMap.pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	))
+}
+
+func (m *HashMap[K, V, KI, VI]) pairs() iter.Iter[basic.Pair[K, V]] {
+	return iter.MapVals[hash.Hash, basic.Pair[K, V]](m.internalHashMapImpl)
+}
+
 // Panics, a hash set is not addressable.
 func (m *HashMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
 	panic(getNonAddressablePanicText("hash map"))
@@ -767,6 +840,128 @@ func (_ *SyncedHashMap[K, V, KI, VI]) Zero(other *SyncedHashMap[K, V, KI, VI]) {
 	other.Clear()
 }
 
+func (m *HashMap[K, V, KI, VI]) toSerialized() []serializedKV[K, V] {
+	rv := make([]serializedKV[K, V], 0, len(m.internalHashMapImpl))
+	for _, v := range m.internalHashMapImpl {
+		rv = append(rv, serializedKV[K, V]{Key: v.A, Val: v.B})
+	}
+	return rv
+}
+
+func (m *HashMap[K, V, KI, VI]) fromSerialized(
+	kvs []serializedKV[K, V],
+) error {
+	m.Clear()
+	for _, kv := range kvs {
+		m.Emplace(basic.Pair[K, V]{A: kv.Key, B: kv.Val})
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the hash map. The hash map is
+// encoded as a list of key value objects in no particular order. If a key is
+// present more than once when decoding the last value is kept.
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash map and then calls the
+// underlying hash maps [HashMap.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.HashMap.MarshalJSON()
+}
+
+// Description: Replaces the contents of the hash map with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [HashMap.MarshalJSON]. If an error is returned the hash map is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying hash map while its contents are replaced. Exhibits the same
+// behavior as [HashMap.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.HashMap.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the hash map. The values
+// are laid out the same way as [HashMap.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash map and then calls the
+// underlying hash maps [HashMap.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.HashMap.MarshalBinary()
+}
+
+// Description: Replaces the contents of the hash map with the values decoded
+// from the supplied binary data, which must have been produced by
+// [HashMap.MarshalBinary]. If an error is returned the hash map is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (m *HashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying hash map while its contents are replaced. Exhibits the same
+// behavior as [HashMap.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedHashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.HashMap.fromSerialized(decoded)
+}
+
 // Implements the [fmt.Formatter] interface.
 func (m HashMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
 	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
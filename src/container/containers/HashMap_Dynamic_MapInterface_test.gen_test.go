@@ -61,6 +61,10 @@ func TestHashMap_DynMapInterfaceInterface(t *testing.T) {
 	tests.DynMapInterfaceInterface(HashMapToMapInterfaceFactory, t)
 }
 
+func TestHashMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(HashMapToMapInterfaceFactory, t)
+}
+
 func TestHashMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynMapInterfaceStaticCapacityInterface(HashMapToMapInterfaceFactory, t)
 }
@@ -124,3 +128,11 @@ func TestHashMap_DynMapInterfaceValPntrs(t *testing.T) {
 func TestHashMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
 	tests.HashDynMapInterfaceKeyedEq(HashMapToMapInterfaceFactory, t)
 }
+
+func TestHashMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(HashMapToMapInterfaceFactory, t)
+}
+
+func TestHashMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(HashMapToMapInterfaceFactory, t)
+}
@@ -2,6 +2,7 @@ package containers
 
 import (
 	"fmt"
+	"maps"
 	"strings"
 	"testing"
 
@@ -132,3 +133,74 @@ func TestHashMapString(t *testing.T) {
 	test.True(strings.Contains(res, "2:two"), t)
 	test.True(strings.Contains(res, "3:three"), t)
 }
+
+func TestHashMapDeleteDoesNotZeroMovedValues(t *testing.T) {
+	m, _ := NewHashMap[int, Vector[int, widgets.BuiltinInt], badBuiltinInt, *Vector[int, widgets.BuiltinInt]](0)
+	for i := 0; i < 6; i++ {
+		m.Emplace(basic.Pair[int, Vector[int, widgets.BuiltinInt]]{
+			i, Vector[int, widgets.BuiltinInt]{i, i},
+		})
+	}
+	test.Nil(m.Delete(0), t)
+	test.Eq(1, m.Pop(Vector[int, widgets.BuiltinInt]{1, 1}), t)
+	for i := 2; i < 6; i++ {
+		v, err := m.Get(i)
+		test.Nil(err, t)
+		test.SlicesMatch[int]([]int{i, i}, v, t)
+	}
+}
+
+func TestHashMapAll(t *testing.T) {
+	m, _ := NewHashMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m.Emplace(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{1, "one"},
+		basic.Pair[int, string]{2, "two"},
+	)
+	test.MapsMatch[int, string](
+		map[int]string{0: "zero", 1: "one", 2: "two"},
+		maps.Collect(m.All()),
+		t,
+	)
+	cntr := 0
+	for k, v := range m.All() {
+		exp, err := m.Get(k)
+		test.Nil(err, t)
+		test.Eq(exp, v, t)
+		cntr++
+		if cntr == 2 {
+			break
+		}
+	}
+	test.Eq(2, cntr, t)
+}
+
+func TestSyncedHashMapSeqBreakReleasesLock(t *testing.T) {
+	m, _ := NewSyncedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	for i := 0; i < 5; i++ {
+		m.Emplace(basic.Pair[int, int]{i, i})
+	}
+	for k, v := range m.All() {
+		test.Eq(k, v, t)
+		test.False(m.RWMutex.TryLock(), t)
+		break
+	}
+	test.True(m.RWMutex.TryLock(), t)
+	m.RWMutex.Unlock()
+	m.Emplace(basic.Pair[int, int]{5, 5})
+	for range m.KeysSeq() {
+		test.False(m.RWMutex.TryLock(), t)
+		break
+	}
+	test.True(m.RWMutex.TryLock(), t)
+	m.RWMutex.Unlock()
+	m.Emplace(basic.Pair[int, int]{6, 6})
+	for range m.ValsSeq() {
+		test.False(m.RWMutex.TryLock(), t)
+		break
+	}
+	test.True(m.RWMutex.TryLock(), t)
+	m.RWMutex.Unlock()
+	m.Emplace(basic.Pair[int, int]{7, 7})
+	test.Eq(8, m.Length(), t)
+}
@@ -1,7 +1,9 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
+	stditer "iter"
 	"sync"
 
 	"github.com/barbell-math/util/src/container/containerTypes"
@@ -185,6 +187,28 @@ func (h *SyncedHashSet[T, U]) Vals() iter.Iter[T] {
 	)
 }
 
+// Description: Returns a sequence over the values in the hash set that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [HashSet.Vals].
+//
+// Time Complexity: O(n)
+func (h *HashSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return h.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the hash set that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedHashSet.Vals], so the hash set will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (h *SyncedHashSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return h.Vals().Seq()
+}
+
 // Panics, hash sets are not addressable.
 func (h *HashSet[T, U]) ValPntrs() iter.Iter[*T] {
 	panic(getNonAddressablePanicText("hash set"))
@@ -856,6 +880,124 @@ func (_ *SyncedHashSet[T, U]) Zero(other *SyncedHashSet[T, U]) {
 	other.Clear()
 }
 
+func (h *HashSet[T, U]) toSerialized() []T {
+	rv := make([]T, 0, len(h.internalHashSetImpl))
+	for _, v := range h.internalHashSetImpl {
+		rv = append(rv, v)
+	}
+	return rv
+}
+
+func (h *HashSet[T, U]) fromSerialized(vals []T) error {
+	h.Clear()
+	h.AppendUnique(vals...)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the hash set. The hash set is
+// encoded as a list of its values in no particular order. Duplicate values are
+// ignored when decoding.
+//
+// Time Complexity: O(n)
+func (h *HashSet[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash set and then calls the
+// underlying hash sets [HashSet.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (h *SyncedHashSet[T, U]) MarshalJSON() ([]byte, error) {
+	h.RLock()
+	defer h.RUnlock()
+	return h.HashSet.MarshalJSON()
+}
+
+// Description: Replaces the contents of the hash set with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [HashSet.MarshalJSON]. If an error is returned the hash set is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (h *HashSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying hash set while its contents are replaced. Exhibits the same
+// behavior as [HashSet.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (h *SyncedHashSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	h.Lock()
+	defer h.Unlock()
+	return h.HashSet.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the hash set. The values
+// are laid out the same way as [HashSet.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (h *HashSet[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(h.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash set and then calls the
+// underlying hash sets [HashSet.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (h *SyncedHashSet[T, U]) MarshalBinary() ([]byte, error) {
+	h.RLock()
+	defer h.RUnlock()
+	return h.HashSet.MarshalBinary()
+}
+
+// Description: Replaces the contents of the hash set with the values decoded
+// from the supplied binary data, which must have been produced by
+// [HashSet.MarshalBinary]. If an error is returned the hash set is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (h *HashSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying hash set while its contents are replaced. Exhibits the same
+// behavior as [HashSet.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (h *SyncedHashSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	h.Lock()
+	defer h.Unlock()
+	return h.HashSet.fromSerialized(decoded)
+}
+
 // Implements the [fmt.Formatter] interface.
 func (h HashSet[T, U]) Format(f fmt.State, verb rune) {
 	fmtStr := string([]byte{'%', byte(verb)})
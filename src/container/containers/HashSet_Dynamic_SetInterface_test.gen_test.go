@@ -53,6 +53,10 @@ func TestHashSet_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(HashSetToSetInterfaceFactory, t)
 }
 
+func TestHashSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(HashSetToSetInterfaceFactory, t)
+}
+
 func TestHashSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(HashSetToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestHashSet_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestHashSet_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(HashSetToSetInterfaceFactory, t)
 }
+
+func TestHashSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(HashSetToSetInterfaceFactory, t)
+}
+
+func TestHashSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(HashSetToSetInterfaceFactory, t)
+}
@@ -2,6 +2,7 @@ package containers
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -201,3 +202,27 @@ func TestGetHashesAffectedByPop(t *testing.T) {
 		t,
 	)
 }
+
+func TestHashSetValsSeq(t *testing.T) {
+	s, _ := NewHashSet[int, widgets.BuiltinInt](0)
+	s.AppendUnique(0, 1, 2, 3)
+	test.SlicesMatchUnordered[int]([]int{0, 1, 2, 3}, slices.Collect(s.ValsSeq()), t)
+}
+
+func TestSyncedHashSetValsSeqBreakReleasesLock(t *testing.T) {
+	s, _ := NewSyncedHashSet[int, widgets.BuiltinInt](0)
+	s.AppendUnique(0, 1, 2, 3)
+	cntr := 0
+	for range s.ValsSeq() {
+		test.False(s.RWMutex.TryLock(), t)
+		cntr++
+		if cntr == 2 {
+			break
+		}
+	}
+	test.Eq(2, cntr, t)
+	test.True(s.RWMutex.TryLock(), t)
+	s.RWMutex.Unlock()
+	s.AppendUnique(4)
+	test.Eq(5, s.Length(), t)
+}
@@ -0,0 +1,1879 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	internalHashUndirectedGraphImpl[
+		V any,
+		E any,
+		VI widgets.BaseInterface[V],
+		EI widgets.BaseInterface[E],
+	] struct {
+		numLinks int
+		// Every link between two distinct vertices is stored in both
+		// directions. Links from a vertex to itself are only stored once.
+		graph HashGraph[V, E, VI, EI]
+	}
+
+	// A type to represent an arbitrary undirected graph with the specified
+	// vertex and edge types. The graph will maintain a set of vertices and
+	// edges that are linked together to make a graph. Links have no direction,
+	// a link between v1 and v2 is the same as a link between v2 and v1. The
+	// type constraints on the generics define the logic for for how specific
+	// operations, such as equality comparisons, will be handled. The graph
+	// will grow as edges and vertices are added.
+	HashUndirectedGraph[
+		V any,
+		E any,
+		VI widgets.BaseInterface[V],
+		EI widgets.BaseInterface[E],
+	] struct {
+		// By making this struct nothing more than a pointer to the true
+		// implementation it makes it so that it will have the same behavior as
+		// a map. This is important for consistency.
+		*internalHashUndirectedGraphImpl[V, E, VI, EI]
+	}
+
+	// A synchronized version of HashUndirectedGraph. All operations will be
+	// wrapped in the appropriate calls to the embedded RWMutex. A pointer to a
+	// RWMutex is embedded rather than a value to avoid copying the lock value.
+	SyncedHashUndirectedGraph[
+		V any,
+		E any,
+		VI widgets.BaseInterface[V],
+		EI widgets.BaseInterface[E],
+	] struct {
+		*sync.RWMutex
+		HashUndirectedGraph[V, E, VI, EI]
+	}
+)
+
+// Creates a new hash undirected graph initialized with enough memory to hold
+// the specified amount of vertices and edges. Both numVertices and numEdges
+// must be >=0, an error will be returned if either one violates that rule. If
+// either size is 0 then the associated map will be initialized with 0
+// elements.
+func NewHashUndirectedGraph[
+	V any,
+	E any,
+	VI widgets.BaseInterface[V],
+	EI widgets.BaseInterface[E],
+](numVertices int, numEdges int) (HashUndirectedGraph[V, E, VI, EI], error) {
+	g, err := NewHashGraph[V, E, VI, EI](numVertices, numEdges)
+	if err != nil {
+		return HashUndirectedGraph[V, E, VI, EI]{}, err
+	}
+	return HashUndirectedGraph[V, E, VI, EI]{
+		internalHashUndirectedGraphImpl: &internalHashUndirectedGraphImpl[
+			V, E, VI, EI,
+		]{graph: g},
+	}, nil
+}
+
+// Creates a new hash undirected graph initialized with enough memory to hold
+// the specified amount of vertices and edges. Both numVertices and numEdges
+// must be >=0, an error will be returned if either one violates that rule. If
+// either size is 0 then the associated map will be initialized with 0
+// elements. The underlying RWMutex value will be fully unlocked upon
+// initialization.
+func NewSyncedHashUndirectedGraph[
+	V any,
+	E any,
+	VI widgets.BaseInterface[V],
+	EI widgets.BaseInterface[E],
+](
+	numVertices int,
+	numEdges int,
+) (SyncedHashUndirectedGraph[V, E, VI, EI], error) {
+	rv, err := NewHashUndirectedGraph[V, E, VI, EI](numVertices, numEdges)
+	return SyncedHashUndirectedGraph[V, E, VI, EI]{
+		RWMutex:             &sync.RWMutex{},
+		HashUndirectedGraph: rv,
+	}, err
+}
+
+// Converts the supplied hash undirected graph to a synchronized hash
+// undirected graph. Beware: The original non-synced hash undirected graph will
+// remain useable.
+func (g *HashUndirectedGraph[V, E, VI, EI]) ToSynced() SyncedHashUndirectedGraph[V, E, VI, EI] {
+	return SyncedHashUndirectedGraph[V, E, VI, EI]{
+		RWMutex:             &sync.RWMutex{},
+		HashUndirectedGraph: *g,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) RUnlock() {}
+
+// The SyncedHashUndirectedGraph method to override the HashUndirectedGraph
+// pass through function and actually apply the mutex operation.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Lock() { g.RWMutex.Lock() }
+
+// The SyncedHashUndirectedGraph method to override the HashUndirectedGraph
+// pass through function and actually apply the mutex operation.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Unlock() { g.RWMutex.Unlock() }
+
+// The SyncedHashUndirectedGraph method to override the HashUndirectedGraph
+// pass through function and actually apply the mutex operation.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) RLock() { g.RWMutex.RLock() }
+
+// The SyncedHashUndirectedGraph method to override the HashUndirectedGraph
+// pass through function and actually apply the mutex operation.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) RUnlock() { g.RWMutex.RUnlock() }
+
+// Returns false, hash undirected graphs are not addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IsAddressable() bool { return false }
+
+// Returns false, a hash undirected graph is not synced.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IsSynced() bool { return false }
+
+// Returns true, a synced hash undirected graph is synced.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) IsSynced() bool { return true }
+
+// Description: Returns the number of edges in the graph.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) NumEdges() int {
+	return g.graph.NumEdges()
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.NumEdges] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) NumEdges() int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.NumEdges()
+}
+
+// Description: Returns the number of vertices in the graph.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) NumVertices() int {
+	return g.graph.NumVertices()
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.NumVertices] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) NumVertices() int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.NumVertices()
+}
+
+// Description: Returns the number of links in the graph. A link between v1 and
+// v2 is only counted once.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) NumLinks() int {
+	return g.numLinks
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.NumLinks] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) NumLinks() int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.NumLinks()
+}
+
+// Recounts the number of links in the graph from the internal directed graph.
+// Needed after operations that remove an unknown number of links.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) recountLinks() {
+	cntr := 0
+	for vHash, gNode := range g.graph.graph {
+		for _, gLink := range gNode {
+			cntr++
+			if gLink.B == vHash {
+				cntr++
+			}
+		}
+	}
+	g.numLinks = cntr / 2
+}
+
+// Description: Returns an iterator that iterates over the edges in the graph.
+//
+// Time Complexity: O(n), where n=num edges
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Edges() iter.Iter[E] {
+	return g.graph.Edges()
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.Edges] method such that a read lock will be placed on
+// the underlying hash undirected graph when the iterator is consumed. The hash
+// undirected graph will have a read lock the entire time the iteration is
+// being performed. The lock will not be applied until the iterator starts to
+// be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num edges
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Edges() iter.Iter[E] {
+	return g.HashUndirectedGraph.Edges().SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are not addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) EdgePntrs() iter.Iter[*E] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Description: Returns an iterator that iterates over the vertices in the
+// graph.
+//
+// Time Complexity: O(n), where n=num vertices
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Vertices() iter.Iter[V] {
+	return g.graph.Vertices()
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.Vertices] method such that a read lock will be placed
+// on the underlying hash undirected graph when the iterator is consumed. The
+// hash undirected graph will have a read lock the entire time the iteration is
+// being performed. The lock will not be applied until the iterator starts to
+// be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num vertices
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Vertices() iter.Iter[V] {
+	return g.HashUndirectedGraph.Vertices().SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are not addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) VerticePntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Description: Returns true if the supplied vertex is contained within the
+// graph. All equality comparisons are performed by the generic VI widget type
+// that the hash undirected graph was initialized with.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsVertex(v V) bool {
+	return g.graph.ContainsVertexPntr(&v)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsVertexPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsVertex(v V) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsVertexPntr(&v)
+}
+
+// Description: Returns true if the supplied vertex is contained within the
+// graph. All equality comparisons are performed by the generic VI widget type
+// that the hash undirected graph was initialized with.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsVertexPntr(v *V) bool {
+	return g.graph.ContainsVertexPntr(v)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsVertexPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsVertexPntr(v *V) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsVertexPntr(v)
+}
+
+// Description: Returns true if the supplied edge is contained within the
+// graph. All equality comparisons are performed by the generic EI widget type
+// that the hash undirected graph was initialized with.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsEdge(e E) bool {
+	return g.graph.ContainsEdgePntr(&e)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsEdgePntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsEdge(e E) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsEdgePntr(&e)
+}
+
+// Description: Returns true if the supplied edge is contained within the
+// graph. All equality comparisons are performed by the generic EI widget type
+// that the hash undirected graph was initialized with.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsEdgePntr(e *E) bool {
+	return g.graph.ContainsEdgePntr(e)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsEdgePntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsEdgePntr(e *E) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsEdgePntr(e)
+}
+
+// Description: Populates the supplied value with the vertex value that is in
+// the graph. This is useful when storing structs and the structs identity as
+// defined by the VI widget only depends on a subset of the structs fields.
+// Returns a value error if the value is not found in the graph.
+//
+// Time complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) GetVertex(v *V) error {
+	return g.graph.GetVertex(v)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.GetVertex] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) GetVertex(v *V) error {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.GetVertex(v)
+}
+
+// Description: Populates the supplied value with the edge value that is in the
+// graph. This is useful when storing structs and the structs identity as
+// defined by the EI widget only depends on a subset of the structs fields.
+// Returns a value error if the value is not found in the graph.
+//
+// Time complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) GetEdge(e *E) error {
+	return g.graph.GetEdge(e)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.GetEdge] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) GetEdge(e *E) error {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.GetEdge(e)
+}
+
+// Description: Returns true if the supplied edge links the supplied vertices.
+// The order of the vertices does not matter.
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsLink(v1 V, v2 V, e E) bool {
+	return g.graph.ContainsLinkPntr(&v1, &v2, &e)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsLinkPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsLink(v1 V, v2 V, e E) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsLinkPntr(&v1, &v2, &e)
+}
+
+// Description: Returns true if the supplied edge links the supplied vertices.
+// The order of the vertices does not matter.
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ContainsLinkPntr(v1 *V, v2 *V, e *E) bool {
+	return g.graph.ContainsLinkPntr(v1, v2, e)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ContainsLinkPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ContainsLinkPntr(v1 *V, v2 *V, e *E) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ContainsLinkPntr(v1, v2, e)
+}
+
+// Description: Returns the degree of the supplied vertex, which is the number
+// of links that touch the vertex. Following the usual convention, a link from
+// a vertex to itself adds two to the vertices degree. Returns 0 if the vertex
+// is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Degree(v V) int {
+	return g.DegreePntr(&v)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.DegreePntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Degree(v V) int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.DegreePntr(&v)
+}
+
+// Description: Returns the degree of the supplied vertex, which is the number
+// of links that touch the vertex. Following the usual convention, a link from
+// a vertex to itself adds two to the vertices degree. Returns 0 if the vertex
+// is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DegreePntr(v *V) int {
+	vHash, ok := g.graph.getVertexHash(v)
+	if !ok {
+		return 0
+	}
+	rv := 0
+	for _, gLink := range g.graph.graph[vHash] {
+		rv++
+		if gLink.B == vHash {
+			rv++
+		}
+	}
+	return rv
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.DegreePntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DegreePntr(v *V) int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.DegreePntr(v)
+}
+
+// Description: Returns an iterator that supplies all of the vertices that are
+// linked to the supplied vertex. If a vertex is linked to the supplied vertex
+// by several edges it will be returned several times. A vertex that is linked
+// to itself will be returned as one of its own neighbors. The iterator will
+// return an error if the vertex is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Neighbors(v V) iter.Iter[V] {
+	return g.graph.outVerticesImpl(&v)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.Neighbors] method such that a read lock will be placed
+// on the underlying hash undirected graph when the iterator is consumed. The
+// hash undirected graph will have a read lock the entire time the iteration is
+// being performed. The lock will not be applied until the iterator chain
+// starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Neighbors(v V) iter.Iter[V] {
+	return g.HashUndirectedGraph.graph.outVerticesImpl(&v).SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are non-addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) NeighborPntrs(v *V) iter.Iter[*V] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Description: Returns an iterator that supplies all of the edges that link
+// the supplied vertex to another vertex, or to itself. The iterator will
+// return an error if the vertex is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IncidentEdges(v V) iter.Iter[E] {
+	return g.graph.outEdgesImpl(&v)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.IncidentEdges] method such that a read lock will be
+// placed on the underlying hash undirected graph when the iterator is
+// consumed. The hash undirected graph will have a read lock the entire time
+// the iteration is being performed. The lock will not be applied until the
+// iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) IncidentEdges(v V) iter.Iter[E] {
+	return g.HashUndirectedGraph.graph.outEdgesImpl(&v).SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are non-addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IncidentEdgePntrs(v *V) iter.Iter[*E] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Description: Returns an iterator that supplies all of the edges that touch
+// the supplied vertex along with the vertex on the other end of each edge. The
+// iterator will return an error if the vertex is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IncidentEdgesAndNeighbors(
+	v V,
+) iter.Iter[basic.Pair[E, V]] {
+	return g.graph.outEdgesAndVerticesImpl(&v)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.IncidentEdgesAndNeighbors] method such that a read
+// lock will be placed on the underlying hash undirected graph when the
+// iterator is consumed. The hash undirected graph will have a read lock the
+// entire time the iteration is being performed. The lock will not be applied
+// until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on the vertex
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) IncidentEdgesAndNeighbors(
+	v V,
+) iter.Iter[basic.Pair[E, V]] {
+	return g.HashUndirectedGraph.graph.outEdgesAndVerticesImpl(&v).SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are non-addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IncidentEdgesAndNeighborPntrs(
+	v *V,
+) iter.Iter[basic.Pair[*E, *V]] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Description: Returns an iterator that supplies all of the edges that link
+// the two supplied vertices. The order of the vertices does not matter. The
+// iterator will return an error if either vertex is not in the graph.
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) EdgesBetween(v1 V, v2 V) iter.Iter[E] {
+	return g.graph.edgesBetweenImpl(&v1, &v2)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.EdgesBetween] method such that a read lock will be
+// placed on the underlying hash undirected graph when the iterator is
+// consumed. The hash undirected graph will have a read lock the entire time
+// the iteration is being performed. The lock will not be applied until the
+// iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n), where n=num of links on v1
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) EdgesBetween(v1 V, v2 V) iter.Iter[E] {
+	return g.HashUndirectedGraph.graph.edgesBetweenImpl(&v1, &v2).SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Panics, hash undirected graphs are non-addressable.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) EdgesBetweenPntr(v1 *V, v2 *V) iter.Iter[*E] {
+	panic(getNonAddressablePanicText("hash undirected graph"))
+}
+
+// Calls op with the vertex hashes of each connected component in the graph.
+// Iteration stops early if op returns false.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) componentsImpl(
+	op func(comp []vertexHash) bool,
+) {
+	visited := make(map[vertexHash]struct{}, g.graph.NumVertices())
+	for h := range g.graph.vertices.internalHashSetImpl {
+		if _, ok := visited[vertexHash(h)]; ok {
+			continue
+		}
+		visited[vertexHash(h)] = struct{}{}
+		comp := []vertexHash{vertexHash(h)}
+		for i := 0; i < len(comp); i++ {
+			for _, gLink := range g.graph.graph[comp[i]] {
+				if _, ok := visited[gLink.B]; !ok {
+					visited[gLink.B] = struct{}{}
+					comp = append(comp, gLink.B)
+				}
+			}
+		}
+		if !op(comp) {
+			return
+		}
+	}
+}
+
+// Description: Returns true if there is a path between the two supplied
+// vertices. A vertex is always connected to itself. Returns false if either
+// vertex is not in the graph.
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Connected(v1 V, v2 V) bool {
+	return g.ConnectedPntr(&v1, &v2)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ConnectedPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Connected(v1 V, v2 V) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ConnectedPntr(&v1, &v2)
+}
+
+// Description: Returns true if there is a path between the two supplied
+// vertices. A vertex is always connected to itself. Returns false if either
+// vertex is not in the graph.
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ConnectedPntr(v1 *V, v2 *V) bool {
+	var ok bool
+	var v1Hash, v2Hash vertexHash
+	if v1Hash, ok = g.graph.getVertexHash(v1); !ok {
+		return false
+	}
+	if v2Hash, ok = g.graph.getVertexHash(v2); !ok {
+		return false
+	}
+	visited := map[vertexHash]struct{}{v1Hash: {}}
+	queue := []vertexHash{v1Hash}
+	for i := 0; i < len(queue); i++ {
+		if queue[i] == v2Hash {
+			return true
+		}
+		for _, gLink := range g.graph.graph[queue[i]] {
+			if _, ok := visited[gLink.B]; !ok {
+				visited[gLink.B] = struct{}{}
+				queue = append(queue, gLink.B)
+			}
+		}
+	}
+	return false
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.ConnectedPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ConnectedPntr(v1 *V, v2 *V) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.ConnectedPntr(v1, v2)
+}
+
+// Description: Returns an iterator over the connected components of the graph.
+// Each component is returned as a slice of the vertices that are in it. Every
+// vertex in the graph will be in exactly one component, including vertices
+// with no links. The order of the components, and the order of the vertices
+// within each component, is unspecified. The components are computed the first
+// time the iterator is consumed.
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) ConnectedComponents() iter.Iter[[]V] {
+	var comps [][]V
+	initialized := false
+	i := -1
+	return func(f iter.IteratorFeedback) ([]V, error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if !initialized {
+			initialized = true
+			g.componentsImpl(func(comp []vertexHash) bool {
+				vals := make([]V, len(comp))
+				for j := 0; j < len(comp); j++ {
+					vals[j], _ = g.graph.vertices.GetFromHash(HashSetHash(comp[j]))
+				}
+				comps = append(comps, vals)
+				return true
+			})
+		}
+		i++
+		if i < len(comps) {
+			return comps[i], nil, true
+		}
+		return nil, nil, false
+	}
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [HashUndirectedGraph.ConnectedComponents] method such that a read lock will
+// be placed on the underlying hash undirected graph when the iterator is
+// consumed. The hash undirected graph will have a read lock the entire time
+// the iteration is being performed. The lock will not be applied until the
+// iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) ConnectedComponents() iter.Iter[[]V] {
+	return g.HashUndirectedGraph.ConnectedComponents().SetupTeardown(
+		func() error { g.RLock(); return nil },
+		func() error { g.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns the number of connected components in the graph.
+// Vertices with no links are each their own component.
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) NumConnectedComponents() int {
+	rv := 0
+	g.componentsImpl(func(comp []vertexHash) bool {
+		rv++
+		return true
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.NumConnectedComponents] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) NumConnectedComponents() int {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.NumConnectedComponents()
+}
+
+// Description: Adds edges to the graph without connecting them to any vertices.
+// Duplicate edges will be ignored. This method will never return an error.
+//
+// Time Complexity: O(n), where n=len(e)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) AddEdges(e ...E) error {
+	return g.graph.AddEdges(e...)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.AddEdges] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=len(e)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) AddEdges(e ...E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.AddEdges(e...)
+}
+
+// Description: Updates the supplied edge using the supplied operation. All
+// uniqueness constraints that are imposed on a set are imposed here as well.
+// This means that the updated value must compare equal to the original value
+// according to the EI widget and produce the same hash as the original value.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) UpdateEdge(
+	e E,
+	updateOp func(e *E),
+) error {
+	return g.graph.UpdateEdge(e, updateOp)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.UpdateEdge] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) UpdateEdge(
+	e E,
+	updateOp func(e *E),
+) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.UpdateEdge(e, updateOp)
+}
+
+// Description: Adds vertices to the graph, if they do not already exist
+// according to the hash and equals method on the vertex widget interface.
+// Non-unique vertices will not be added. This function will never return an
+// error.
+//
+// Time Complexity: O(n), where n=len(v)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) AddVertices(v ...V) error {
+	return g.graph.AddVertices(v...)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.AddVertices] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=len(v)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) AddVertices(v ...V) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.AddVertices(v...)
+}
+
+// Description: Updates the supplied vertex using the supplied operation. All
+// uniqueness constraints that are imposed on a set are imposed here as well.
+// This means that the updated value must compare equal to the original value
+// according to the VI widget and produce the same hash as the original value.
+//
+// Time Complexity: O(1)
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) UpdateVertex(
+	v V,
+	updateOp func(orig *V),
+) error {
+	return g.graph.UpdateVertex(v, updateOp)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.UpdateVertex] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) UpdateVertex(
+	v V,
+	updateOp func(orig *V),
+) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.UpdateVertex(v, updateOp)
+}
+
+// Description: Adds a link between an existing edge and vertices in the graph.
+// The edge and vertices must have been added to the graph prior to calling
+// this function or an error will be returned. The order of the vertices does
+// not matter. If a link already exists between the provided vertices with the
+// provided edge then no action will be taken and no error will be returned.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Link(v1 V, v2 V, e E) error {
+	return g.LinkPntr(&v1, &v2, &e)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then adds the link to the graph. Exhibits the same behavior as the non-synced
+// [HashUndirectedGraph.LinkPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Link(v1 V, v2 V, e E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.LinkPntr(&v1, &v2, &e)
+}
+
+// Description: Adds a link between an existing edge and vertices in the graph.
+// The edge and vertices must have been added to the graph prior to calling
+// this function or an error will be returned. The order of the vertices does
+// not matter. If a link already exists between the provided vertices with the
+// provided edge then no action will be taken and no error will be returned.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) LinkPntr(v1 *V, v2 *V, e *E) error {
+	if g.graph.ContainsLinkPntr(v1, v2, e) {
+		return nil
+	}
+	if err := g.graph.LinkPntr(v1, v2, e); err != nil {
+		return err
+	}
+	g.graph.LinkPntr(v2, v1, e)
+	g.numLinks++
+	return nil
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then adds the link to the graph. Exhibits the same behavior as the non-synced
+// [HashUndirectedGraph.LinkPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) LinkPntr(v1 *V, v2 *V, e *E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.LinkPntr(v1, v2, e)
+}
+
+// Description: Deletes a vertex from the graph, removing any links that
+// previously used the vertex. No edges will be deleted, meaning this operation
+// may result in orphaned edges.
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteVertex(v V) error {
+	return g.DeleteVertexPntr(&v)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then removes the vertex from the graph. Exhibits the same behavior as the
+// non-synced [HashUndirectedGraph.DeleteVertexPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteVertex(v V) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteVertexPntr(&v)
+}
+
+// Description: Deletes a vertex from the graph, removing any links that
+// previously used the vertex. No edges will be deleted, meaning this operation
+// may result in orphaned edges.
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteVertexPntr(v *V) error {
+	if err := g.graph.DeleteVertexPntr(v); err != nil {
+		return err
+	}
+	g.recountLinks()
+	return nil
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then removes the vertex from the graph. Exhibits the same behavior as the
+// non-synced [HashUndirectedGraph.DeleteVertexPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteVertexPntr(v *V) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteVertexPntr(v)
+}
+
+// Description: Deletes an edge from the graph, removing any links that
+// previously used the edge. No vertices will be deleted, meaning this
+// operation may result in orphaned vertices.
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteEdge(e E) error {
+	return g.DeleteEdgePntr(&e)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then removes the edge from the graph. Exhibits the same behavior as the
+// non-synced [HashUndirectedGraph.DeleteEdgePntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteEdge(e E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteEdgePntr(&e)
+}
+
+// Description: Deletes an edge from the graph, removing any links that
+// previously used the edge. No vertices will be deleted, meaning this
+// operation may result in orphaned vertices.
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteEdgePntr(e *E) error {
+	if err := g.graph.DeleteEdgePntr(e); err != nil {
+		return err
+	}
+	g.recountLinks()
+	return nil
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then removes the edge from the graph. Exhibits the same behavior as the
+// non-synced [HashUndirectedGraph.DeleteEdgePntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n), where n=num of links in the graph
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteEdgePntr(e *E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteEdgePntr(e)
+}
+
+// Description: Removes a link within the graph without removing the underlying
+// vertices or edge. The order of the vertices does not matter. This may leave
+// vertices with no links, and edges that don't correspond to any links. An
+// error will be returned if either vertex does not exist in the graph or if
+// the supplied edge does not exist in the graph.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteLink(v1 V, v2 V, e E) error {
+	return g.DeleteLinkPntr(&v1, &v2, &e)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.DeleteLinkPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteLink(v1 V, v2 V, e E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteLinkPntr(&v1, &v2, &e)
+}
+
+// Description: Removes a link within the graph without removing the underlying
+// vertices or edge. The order of the vertices does not matter. This may leave
+// vertices with no links, and edges that don't correspond to any links. An
+// error will be returned if either vertex does not exist in the graph or if
+// the supplied edge does not exist in the graph.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteLinkPntr(v1 *V, v2 *V, e *E) error {
+	found := g.graph.ContainsLinkPntr(v1, v2, e)
+	if err := g.graph.DeleteLinkPntr(v1, v2, e); err != nil {
+		return err
+	}
+	if found {
+		g.graph.DeleteLinkPntr(v2, v1, e)
+		g.numLinks--
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.DeleteLinkPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteLinkPntr(v1 *V, v2 *V, e *E) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteLinkPntr(v1, v2, e)
+}
+
+// Description: Removes all links between v1 and v2 without removing the
+// underlying vertices or edges. The order of the vertices does not matter.
+// This may leave vertices with no links, and edges that don't correspond to
+// any links. An error will be returned if either vertex does not exist in the
+// graph.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteLinks(v1 V, v2 V) error {
+	return g.DeleteLinksPntr(&v1, &v2)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.DeleteLinksPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteLinks(v1 V, v2 V) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteLinksPntr(&v1, &v2)
+}
+
+// Description: Removes all links between v1 and v2 without removing the
+// underlying vertices or edges. The order of the vertices does not matter.
+// This may leave vertices with no links, and edges that don't correspond to
+// any links. An error will be returned if either vertex does not exist in the
+// graph.
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) DeleteLinksPntr(v1 *V, v2 *V) error {
+	var ok bool
+	var v1Hash, v2Hash vertexHash
+	if v1Hash, ok = g.graph.getVertexHash(v1); !ok {
+		return getVertexError[V](v1)
+	}
+	if v2Hash, ok = g.graph.getVertexHash(v2); !ok {
+		return getVertexError[V](v2)
+	}
+	for _, gLink := range g.graph.graph[v1Hash] {
+		if gLink.B == v2Hash {
+			g.numLinks--
+		}
+	}
+	g.graph.DeleteLinksPntr(v1, v2)
+	g.graph.DeleteLinksPntr(v2, v1)
+	return nil
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying hash undirected graphs
+// [HashUndirectedGraph.DeleteLinksPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num of links on v1 and m=num of links on v2
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) DeleteLinksPntr(v1 *V, v2 *V) error {
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.DeleteLinksPntr(v1, v2)
+}
+
+// Description: Removes all edges, vertices, and links.
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num edges
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Clear() {
+	g.graph.Clear()
+	g.numLinks = 0
+}
+
+// Description: Places a write lock on the underlying hash undirected graph
+// before calling the underlying [HashUndirectedGraph.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where n=num vertices and m=num edges
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Clear() {
+	g.Lock()
+	defer g.Unlock()
+	g.HashUndirectedGraph.Clear()
+}
+
+// Calls op on every link in the graph. Links between two distinct vertices
+// are visited once from each side.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) forEachLink(
+	op func(v1 *V, v2 *V, e *E) bool,
+) bool {
+	for vHash, gNode := range g.graph.graph {
+		v1, _ := g.graph.vertices.GetFromHash(HashSetHash(vHash))
+		for _, gLink := range gNode {
+			v2, _ := g.graph.vertices.GetFromHash(HashSetHash(gLink.B))
+			e, _ := g.graph.edges.GetFromHash(HashSetHash(gLink.A))
+			if !op(&v1, &v2, &e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns true if all of the vertices and edges in this graph are in other.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) valsContainedIn(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	rv := true
+	g.graph.vertices.Vals().ForEach(
+		func(index int, val V) (iter.IteratorFeedback, error) {
+			if rv = other.ContainsVertexPntr(&val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	if !rv {
+		return false
+	}
+	g.graph.edges.Vals().ForEach(
+		func(index int, val E) (iter.IteratorFeedback, error) {
+			if rv = other.ContainsEdgePntr(&val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Returns true if the two supplied graphs are considered equal.
+// In order for two graphs to be equal they must have the same structure and
+// all of the corresponding vertices and edges must be equal as defined by the
+// Eq method of the supplied VI and EI widgets.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the methods on other. In big-O it might look something like this,
+// O(n*O(other.ContainsLink) + m*O(other.ContainsVertexPntr) + p*O(other.ContainsEdgePntr))
+// where:
+//   - n is the number of links in this graph
+//   - m is the number of vertices in this graph
+//   - p is the number of edges in this graph
+//   - O(other.ContainsLink) represents the time complexity of the ContainsLink method on other
+//   - O(other.ContainsVertexPntr) represents the time complexity of the ContainsVertexPntr method on other
+//   - O(other.ContainsEdgePntr) represents the time complexity of the ContainsEdgePntr method on other
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) KeyedEq(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	if !(g.NumEdges() == other.NumEdges() &&
+		g.NumVertices() == other.NumVertices() &&
+		g.NumLinks() == other.NumLinks()) {
+		return false
+	}
+	return g.IsSubset(other)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph [HashUndirectedGraph.KeyedEq]
+// method. Attempts to place a read lock on other but whether or not that
+// happens is implementation dependent.
+//
+// Lock Type: Read on this hash undirected graph, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the methods on other. In big-O it might look something like this,
+// O(n*O(other.ContainsLink) + m*O(other.ContainsVertexPntr) + p*O(other.ContainsEdgePntr))
+// where:
+//   - n is the number of links in this graph
+//   - m is the number of vertices in this graph
+//   - p is the number of edges in this graph
+//   - O(other.ContainsLink) represents the time complexity of the ContainsLink method on other
+//   - O(other.ContainsVertexPntr) represents the time complexity of the ContainsVertexPntr method on other
+//   - O(other.ContainsEdgePntr) represents the time complexity of the ContainsEdgePntr method on other
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) KeyedEq(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	g.RLock()
+	other.RLock()
+	defer g.RUnlock()
+	defer other.RUnlock()
+	return g.HashUndirectedGraph.KeyedEq(other)
+}
+
+// Description: Returns true if the two supplied graphs are considered equal.
+// Vertices in a graph are identified by their values, so there is no ordering
+// to ignore and this is equivalent to [HashUndirectedGraph.KeyedEq].
+//
+// Time Complexity: See [HashUndirectedGraph.KeyedEq].
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) UnorderedEq(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	return g.KeyedEq(other)
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.UnorderedEq] method. Attempts to place a read lock on
+// other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this hash undirected graph, read on other
+//
+// Time Complexity: See [HashUndirectedGraph.KeyedEq].
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) UnorderedEq(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	g.RLock()
+	other.RLock()
+	defer g.RUnlock()
+	defer other.RUnlock()
+	return g.HashUndirectedGraph.KeyedEq(other)
+}
+
+// Replaces the contents of this graph with the links that op selects from the
+// supplied graphs.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) setOpImpl(
+	sizeHint containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	sources []containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	op func(v1 *V, v2 *V, e *E) bool,
+) {
+	newG, err := NewHashUndirectedGraph[V, E, VI, EI](
+		sizeHint.NumVertices()/2, sizeHint.NumEdges()/2,
+	)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"An error occurred making a new hash undirected graph: %s", err,
+		))
+	}
+	for _, src := range sources {
+		addressableSafeUndirectedVerticesIter[V, E](src).ForEach(
+			func(index int, v1 *V) (iter.IteratorFeedback, error) {
+				addressableSafeIncidentEdgesAndNeighborsIter[V, E](src, *v1).ForEach(
+					func(
+						index int,
+						eAndV2 basic.Pair[*E, *V],
+					) (iter.IteratorFeedback, error) {
+						if op(v1, eAndV2.B, eAndV2.A) {
+							newG.AddEdges(*eAndV2.A)
+							newG.AddVertices(*v1, *eAndV2.B)
+							newG.LinkPntr(v1, eAndV2.B, eAndV2.A)
+						}
+						return iter.Continue, nil
+					},
+				)
+				return iter.Continue, nil
+			},
+		)
+	}
+	g.Clear()
+	*g = *newG.internalHashUndirectedGraphImpl
+}
+
+// Description: Takes the intersection of l and r and puts the result in this
+// graph. All values from this graph will be cleared before storing the new
+// result. Vertices and edges are compared using the supplied VI and EI
+// widgets.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsLinkPntr method on other. In big-O it might look something like
+// this, O(n*O(other.ContainsLinkPntr))), where n is the number of links in r
+// and O(other.ContainsLinkPntr) represents the time complexity of the
+// ContainsLinkPntr method on other.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Intersection(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.setOpImpl(
+		r,
+		[]containerTypes.UndirectedGraphComparisonsConstraint[V, E]{r},
+		func(v1 *V, v2 *V, e *E) bool { return l.ContainsLinkPntr(v1, v2, e) },
+	)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.Intersection] method. Attempts to place a read lock on
+// l and r but whether or not that happens is implementation dependent.
+//
+// Lock Type: Write on this hash undirected graph, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsLinkPntr method on other. In big-O it might look something like
+// this, O(n*O(other.ContainsLinkPntr))), where n is the number of links in r
+// and O(other.ContainsLinkPntr) represents the time complexity of the
+// ContainsLinkPntr method on other.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Intersection(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.Lock()
+	l.RLock()
+	r.RLock()
+	defer g.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	g.HashUndirectedGraph.Intersection(l, r)
+}
+
+// Description: Takes the union of l and r and puts the result in this graph.
+// All values from this graph will be cleared before storing the new result.
+// Vertices and edges are compared using the supplied VI and EI widgets.
+//
+// Time Complexity: O(n+m), where n is the number of links in r and m is the
+// number of links in l.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Union(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.setOpImpl(
+		r,
+		[]containerTypes.UndirectedGraphComparisonsConstraint[V, E]{r, l},
+		func(v1 *V, v2 *V, e *E) bool { return true },
+	)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph [HashUndirectedGraph.Union]
+// method. Attempts to place a read lock on l and r but whether or not that
+// happens is implementation dependent.
+//
+// Lock Type: Write on this hash undirected graph, read on l and r
+//
+// Time Complexity: O(n+m), where n is the number of links in r and m is the
+// number of links in l.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Union(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.Lock()
+	l.RLock()
+	r.RLock()
+	defer g.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	g.HashUndirectedGraph.Union(l, r)
+}
+
+// Description: Takes the difference of l and r and puts the result in this
+// graph. All values from this graph will be cleared before storing the new
+// result. Vertices and edges are compared using the supplied VI and EI
+// widgets.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsLinkPntr method on r. In big-O it might look something like
+// this, O(n*O(r.ContainsLinkPntr))), where n is the number of links in l and
+// O(r.ContainsLinkPntr) represents the time complexity of the ContainsLinkPntr
+// method on r.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) Difference(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.setOpImpl(
+		r,
+		[]containerTypes.UndirectedGraphComparisonsConstraint[V, E]{l},
+		func(v1 *V, v2 *V, e *E) bool { return !r.ContainsLinkPntr(v1, v2, e) },
+	)
+}
+
+// Description: Places a write lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.Difference] method. Attempts to place a read lock on l
+// and r but whether or not that happens is implementation dependent.
+//
+// Lock Type: Write on this hash undirected graph, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsLinkPntr method on r. In big-O it might look something like
+// this, O(n*O(r.ContainsLinkPntr))), where n is the number of links in l and
+// O(r.ContainsLinkPntr) represents the time complexity of the ContainsLinkPntr
+// method on r.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) Difference(
+	l containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+	r containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) {
+	g.Lock()
+	l.RLock()
+	r.RLock()
+	defer g.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	g.HashUndirectedGraph.Difference(l, r)
+}
+
+// Description: Returns true if this graph is a superset of other. In order for
+// this graph to be a superset of other, it must have all of others vertices,
+// edges, and links. It may have other vertices, edges, or links that are not
+// in other. All of the corresponding vertices and edges must be equal as
+// defined by the Eq method of the supplied VI and EI widgets.
+//
+// Time Complexity: O(n+m+p), where n is the number of links in other, m is the
+// number of vertices in other, and p is the number of edges in other.
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IsSuperset(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	if g.NumVertices() < other.NumVertices() ||
+		g.NumEdges() < other.NumEdges() ||
+		g.NumLinks() < other.NumLinks() {
+		return false
+	}
+
+	rv := true
+	addressableSafeUndirectedVerticesIter[V, E](other).ForEach(
+		func(index int, v *V) (iter.IteratorFeedback, error) {
+			if rv = g.ContainsVertexPntr(v); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	if !rv {
+		return false
+	}
+
+	addressableSafeUndirectedEdgesIter[V, E](other).ForEach(
+		func(index int, e *E) (iter.IteratorFeedback, error) {
+			if rv = g.ContainsEdgePntr(e); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	if !rv {
+		return false
+	}
+
+	addressableSafeUndirectedVerticesIter[V, E](other).ForEach(
+		func(index int, v1 *V) (iter.IteratorFeedback, error) {
+			addressableSafeIncidentEdgesAndNeighborsIter[V, E](other, *v1).ForEach(
+				func(index int, val basic.Pair[*E, *V]) (iter.IteratorFeedback, error) {
+					if rv = g.ContainsLinkPntr(v1, val.B, val.A); !rv {
+						return iter.Break, nil
+					}
+					return iter.Continue, nil
+				},
+			)
+			if !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.IsSuperset] method. Attempts to place a read lock on
+// other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this hash undirected graph, read on other
+//
+// Time Complexity: O(n+m+p), where n is the number of links in other, m is the
+// number of vertices in other, and p is the number of edges in other.
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) IsSuperset(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	g.RLock()
+	other.RLock()
+	defer g.RUnlock()
+	defer other.RUnlock()
+	return g.HashUndirectedGraph.IsSuperset(other)
+}
+
+// Description: Returns true if this graph is a subset of other. In order for
+// this graph to be a subset of other, other must have all of this graphs
+// vertices, edges, and links. Other may have other vertices, edges, or links
+// that are not in this graph. All of the corresponding vertices and edges must
+// be equal as defined by the Eq method of the supplied VI and EI widgets.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the methods on other. In big-O it might look something like this,
+// O(n*O(other.ContainsLink) + m*O(other.ContainsVertexPntr) + p*O(other.ContainsEdgePntr))
+// where:
+//   - n is the number of links in this graph
+//   - m is the number of vertices in this graph
+//   - p is the number of edges in this graph
+//   - O(other.ContainsLink) represents the time complexity of the ContainsLink method on other
+//   - O(other.ContainsVertexPntr) represents the time complexity of the ContainsVertexPntr method on other
+//   - O(other.ContainsEdgePntr) represents the time complexity of the ContainsEdgePntr method on other
+func (g *internalHashUndirectedGraphImpl[V, E, VI, EI]) IsSubset(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	if g.NumVertices() > other.NumVertices() ||
+		g.NumEdges() > other.NumEdges() ||
+		g.NumLinks() > other.NumLinks() {
+		return false
+	}
+	if !g.valsContainedIn(other) {
+		return false
+	}
+	return g.forEachLink(func(v1 *V, v2 *V, e *E) bool {
+		return other.ContainsLinkPntr(v1, v2, e)
+	})
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graph
+// [HashUndirectedGraph.IsSubset] method. Attempts to place a read lock on
+// other but whether or not that happens is implementation dependent.
+//
+// Lock Type: Read on this hash undirected graph, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the methods on other. In big-O it might look something like this,
+// O(n*O(other.ContainsLink) + m*O(other.ContainsVertexPntr) + p*O(other.ContainsEdgePntr))
+// where:
+//   - n is the number of links in this graph
+//   - m is the number of vertices in this graph
+//   - p is the number of edges in this graph
+//   - O(other.ContainsLink) represents the time complexity of the ContainsLink method on other
+//   - O(other.ContainsVertexPntr) represents the time complexity of the ContainsVertexPntr method on other
+//   - O(other.ContainsEdgePntr) represents the time complexity of the ContainsEdgePntr method on other
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) IsSubset(
+	other containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+) bool {
+	g.RLock()
+	other.RLock()
+	defer g.RUnlock()
+	defer other.RUnlock()
+	return g.HashUndirectedGraph.IsSubset(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [HashUndirectedGraph.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *HashUndirectedGraph[V, E, VI, EI]) Eq(
+	l *HashUndirectedGraph[V, E, VI, EI],
+	r *HashUndirectedGraph[V, E, VI, EI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to
+// [SyncedHashUndirectedGraph.KeyedEq]. Returns true if l==r, false otherwise.
+func (_ *SyncedHashUndirectedGraph[V, E, VI, EI]) Eq(
+	l *SyncedHashUndirectedGraph[V, E, VI, EI],
+	r *SyncedHashUndirectedGraph[V, E, VI, EI],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.HashUndirectedGraph.KeyedEq(r)
+}
+
+// A function that returns a hash of a hash undirected graph. To do this all of
+// the individual hashes that are produced from the elements of the graph are
+// combined in a way that maintains identity, making it so the hash will
+// represent the same equality operation that [HashUndirectedGraph.KeyedEq] and
+// [HashUndirectedGraph.Eq] provide.
+func (_ *HashUndirectedGraph[V, E, VI, EI]) Hash(
+	other *HashUndirectedGraph[V, E, VI, EI],
+) hash.Hash {
+	return other.graph.Hash(&other.graph)
+}
+
+// Places a read lock on the underlying hash undirected graph of other and then
+// calls others underlying hash undirected graphs [HashUndirectedGraph.Hash]
+// method.
+func (_ *SyncedHashUndirectedGraph[V, E, VI, EI]) Hash(
+	other *SyncedHashUndirectedGraph[V, E, VI, EI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.HashUndirectedGraph.Hash(&other.HashUndirectedGraph)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [HashUndirectedGraph.Clear].
+func (_ *HashUndirectedGraph[V, E, VI, EI]) Zero(
+	other *HashUndirectedGraph[V, E, VI, EI],
+) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedHashUndirectedGraph.Clear].
+func (_ *SyncedHashUndirectedGraph[V, E, VI, EI]) Zero(
+	other *SyncedHashUndirectedGraph[V, E, VI, EI],
+) {
+	other.Clear()
+}
+
+func (g *HashUndirectedGraph[V, E, VI, EI]) toSerialized() serializedGraph[V, E] {
+	if g.internalHashUndirectedGraphImpl == nil {
+		return serializedGraph[V, E]{
+			Vertices: []V{},
+			Edges:    []E{},
+			Links:    []serializedLink[V, E]{},
+		}
+	}
+	rv := serializedGraph[V, E]{
+		Vertices: g.graph.vertices.toSerialized(),
+		Edges:    g.graph.edges.toSerialized(),
+		Links:    make([]serializedLink[V, E], 0, g.numLinks),
+	}
+	for vHash, gNode := range g.graph.graph {
+		v1, _ := g.graph.vertices.GetFromHash(HashSetHash(vHash))
+		for _, gLink := range gNode {
+			// Links between two distinct vertices are stored from each side,
+			// only one of them needs to be encoded.
+			if gLink.B < vHash {
+				continue
+			}
+			v2, _ := g.graph.vertices.GetFromHash(HashSetHash(gLink.B))
+			e, _ := g.graph.edges.GetFromHash(HashSetHash(gLink.A))
+			rv.Links = append(
+				rv.Links,
+				serializedLink[V, E]{From: v1, To: v2, Edge: e},
+			)
+		}
+	}
+	return rv
+}
+
+func (g *HashUndirectedGraph[V, E, VI, EI]) fromSerialized(
+	s serializedGraph[V, E],
+) error {
+	if err := validateSerializedGraph[V, E, VI, EI](s); err != nil {
+		return err
+	}
+	if g.internalHashUndirectedGraphImpl == nil {
+		*g, _ = NewHashUndirectedGraph[V, E, VI, EI](
+			len(s.Vertices), len(s.Edges),
+		)
+	} else {
+		g.Clear()
+	}
+	g.AddVertices(s.Vertices...)
+	g.AddEdges(s.Edges...)
+	for i := 0; i < len(s.Links); i++ {
+		g.LinkPntr(&s.Links[i].From, &s.Links[i].To, &s.Links[i].Edge)
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the hash undirected graph. All of
+// the vertices and edges are encoded, including those that are not part of any
+// link, along with a list of links. Each link is encoded once as the two
+// vertices it connects and the edge that connects them. When decoding every
+// link must refer to a vertex and edge that is also in the encoded data, an
+// error will be returned if one does not.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashUndirectedGraph[V, E, VI, EI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graphs
+// [HashUndirectedGraph.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) MarshalJSON() ([]byte, error) {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.MarshalJSON()
+}
+
+// Description: Replaces the contents of the hash undirected graph with the
+// values decoded from the supplied JSON data, which must be in the format
+// produced by [HashUndirectedGraph.MarshalJSON]. If an error is returned the
+// hash undirected graph is left unchanged.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashUndirectedGraph[V, E, VI, EI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	return g.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying hash undirected graph while its contents are replaced.
+// Exhibits the same behavior as [HashUndirectedGraph.UnmarshalJSON]. The lock
+// is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the hash undirected graph.
+// The values are laid out the same way as [HashUndirectedGraph.MarshalJSON] and
+// are encoded using the [encoding/gob] package, so the contained values must be
+// encodable by gob.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashUndirectedGraph[V, E, VI, EI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(g.toSerialized())
+}
+
+// Description: Places a read lock on the underlying hash undirected graph and
+// then calls the underlying hash undirected graphs
+// [HashUndirectedGraph.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) MarshalBinary() ([]byte, error) {
+	g.RLock()
+	defer g.RUnlock()
+	return g.HashUndirectedGraph.MarshalBinary()
+}
+
+// Description: Replaces the contents of the hash undirected graph with the
+// values decoded from the supplied binary data, which must have been produced
+// by [HashUndirectedGraph.MarshalBinary]. If an error is returned the hash
+// undirected graph is left unchanged.
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *HashUndirectedGraph[V, E, VI, EI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	return g.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying hash undirected graph while its contents are replaced.
+// Exhibits the same behavior as [HashUndirectedGraph.UnmarshalBinary]. The lock
+// is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m+l), where n=num vertices, m=num edges, and l=num links
+func (g *SyncedHashUndirectedGraph[V, E, VI, EI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedGraph[V, E]](data)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.HashUndirectedGraph.fromSerialized(decoded)
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func HashUndirectedGraphToUndirectedGraphInterfaceFactory(capacity int) dynamicContainers.UndirectedGraph[int, int] {
+	v := generateHashUndirectedGraph(capacity)
+	var rv dynamicContainers.UndirectedGraph[int, int] = &v
+	return rv
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceSyncableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceSyncableInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceAddressableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceAddressableInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceClearInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceClearInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_ReadDynUndirectedGraphInterface(t *testing.T) {
+	tests.ReadDynUndirectedGraphInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_WriteDynUndirectedGraphInterface(t *testing.T) {
+	tests.WriteDynUndirectedGraphInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceSerializableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceSerializableInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceStaticCapacityInterface(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceContains(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceContains(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceEdgesAndVertices(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceEdgesAndVertices(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphLink(t *testing.T) {
+	tests.DynUndirectedGraphLink(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDegree(t *testing.T) {
+	tests.DynUndirectedGraphDegree(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphNeighbors(t *testing.T) {
+	tests.DynUndirectedGraphNeighbors(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphIncidentEdges(t *testing.T) {
+	tests.DynUndirectedGraphIncidentEdges(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphIncidentEdgesAndNeighbors(t *testing.T) {
+	tests.DynUndirectedGraphIncidentEdgesAndNeighbors(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphEdgesBetween(t *testing.T) {
+	tests.DynUndirectedGraphEdgesBetween(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDeleteLink(t *testing.T) {
+	tests.DynUndirectedGraphDeleteLink(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDeleteLinks(t *testing.T) {
+	tests.DynUndirectedGraphDeleteLinks(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDeleteVertex(t *testing.T) {
+	tests.DynUndirectedGraphDeleteVertex(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDeleteEdge(t *testing.T) {
+	tests.DynUndirectedGraphDeleteEdge(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphClear(t *testing.T) {
+	tests.DynUndirectedGraphClear(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphConnectedComponents(t *testing.T) {
+	tests.DynUndirectedGraphConnectedComponents(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphNumConnectedComponents(t *testing.T) {
+	tests.DynUndirectedGraphNumConnectedComponents(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphConnected(t *testing.T) {
+	tests.DynUndirectedGraphConnected(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphKeyedEq(t *testing.T) {
+	tests.DynUndirectedGraphKeyedEq(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphIntersection(t *testing.T) {
+	tests.DynUndirectedGraphIntersection(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphUnion(t *testing.T) {
+	tests.DynUndirectedGraphUnion(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphDifference(t *testing.T) {
+	tests.DynUndirectedGraphDifference(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphIsSubsetIsSuperset(t *testing.T) {
+	tests.DynUndirectedGraphIsSubsetIsSuperset(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceJSON(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceJSON(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestHashUndirectedGraph_DynUndirectedGraphInterfaceBinary(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceBinary(HashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
@@ -0,0 +1,98 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=HashUndirectedGraph -category=dynamic -interface=UndirectedGraph -genericDecl=[int,int] -factory=generateHashUndirectedGraph
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedHashUndirectedGraph -category=dynamic -interface=UndirectedGraph -genericDecl=[int,int] -factory=generateSyncedHashUndirectedGraph
+
+func generateHashUndirectedGraph(
+	capacity int,
+) HashUndirectedGraph[int, int, badBuiltinInt, badBuiltinInt] {
+	v, _ := NewHashUndirectedGraph[
+		int, int,
+		badBuiltinInt, badBuiltinInt,
+	](capacity, capacity)
+	return v
+}
+
+func generateSyncedHashUndirectedGraph(
+	capacity int,
+) SyncedHashUndirectedGraph[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	v, _ := NewSyncedHashUndirectedGraph[
+		int, int,
+		widgets.BuiltinInt, widgets.BuiltinInt,
+	](capacity, capacity)
+	return v
+}
+
+func TestHashUndirectedGraphWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[HashUndirectedGraph[
+		string, string,
+		widgets.BuiltinString, widgets.BuiltinString,
+	]]
+
+	v, _ := NewHashUndirectedGraph[
+		string, string,
+		widgets.BuiltinString, widgets.BuiltinString,
+	](0, 0)
+	widget = &v
+	_ = widget
+}
+
+func TestHashUndirectedGraphEq(t *testing.T) {
+	g1, err := NewHashUndirectedGraph[
+		string, int,
+		widgets.BuiltinString, widgets.BuiltinInt,
+	](0, 0)
+	test.Nil(err, t)
+	g2, err := NewHashUndirectedGraph[
+		string, int,
+		widgets.BuiltinString, widgets.BuiltinInt,
+	](0, 0)
+	test.Nil(err, t)
+
+	test.Nil(g1.AddVertices("one", "two", "three", "four"), t)
+	test.Nil(g1.AddEdges(1, 2, 3), t)
+	test.Nil(g1.Link("one", "two", 1), t)
+	test.Nil(g1.Link("two", "three", 2), t)
+	test.Nil(g1.Link("three", "four", 3), t)
+
+	test.Nil(g2.AddVertices("one", "two", "three", "four"), t)
+	test.Nil(g2.AddEdges(1, 2, 3), t)
+	test.Nil(g2.Link("two", "one", 1), t)
+	test.Nil(g2.Link("three", "two", 2), t)
+	test.Nil(g2.Link("four", "three", 3), t)
+
+	test.True(g1.Eq(&g1, &g2), t)
+	test.True(g1.Eq(&g2, &g1), t)
+	test.Eq(g1.Hash(&g1), g1.Hash(&g2), t)
+
+	g2.Link("four", "one", 1)
+
+	test.False(g1.Eq(&g1, &g2), t)
+	test.False(g1.Eq(&g2, &g1), t)
+}
+
+func TestHashUndirectedGraphNumLinksAfterDelete(t *testing.T) {
+	g, err := NewHashUndirectedGraph[
+		int, int,
+		widgets.BuiltinInt, widgets.BuiltinInt,
+	](0, 0)
+	test.Nil(err, t)
+	test.Nil(g.AddVertices(0, 1), t)
+	test.Nil(g.AddEdges(0), t)
+	test.Nil(g.Link(0, 1, 0), t)
+	test.Nil(g.Link(1, 1, 0), t)
+	test.Eq(2, g.NumLinks(), t)
+	test.Nil(g.DeleteLink(1, 0, 0), t)
+	test.Eq(1, g.NumLinks(), t)
+	test.Nil(g.DeleteLink(1, 1, 0), t)
+	test.Eq(0, g.NumLinks(), t)
+	test.Eq(0, g.Degree(0), t)
+	test.Eq(0, g.Degree(1), t)
+}
@@ -250,6 +250,90 @@ func (h *SyncedHookedHashSet[T, U]) Clear() {
 	h.SyncedHashSet.HashSet.Clear()
 }
 
+func (h *HookedHashSet[T, U]) fromSerialized(vals []T) error {
+	h.Clear()
+	h.AppendUnique(vals...)
+	return nil
+}
+
+func (h *SyncedHookedHashSet[T, U]) fromSerialized(vals []T) error {
+	h.hooks.clearOp()
+	h.SyncedHashSet.HashSet.Clear()
+	for _, v := range vals {
+		h.SyncedHashSet.HashSet.AppendUnique(v)
+		if vHash, ok := h.getHashPosition(&v); ok {
+			h.hooks.addOp(vHash)
+		}
+	}
+	return nil
+}
+
+// Description: Replaces the contents of the underlying hash set with the values
+// decoded from the supplied JSON data, calling the clearOp hook and then the
+// addOp hook for each value that is added. The data must be in the format
+// produced by [HashSet.MarshalJSON]. If an error is returned the set is left
+// unchanged and no hooks are called.
+//
+// Time Complexity: O(n)
+func (h *HookedHashSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying hash set while its contents are replaced. Exhibits the same
+// behavior as [HookedHashSet.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (h *SyncedHookedHashSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	h.Lock()
+	defer h.Unlock()
+	return h.fromSerialized(decoded)
+}
+
+// Description: Replaces the contents of the underlying hash set with the values
+// decoded from the supplied binary data, calling the clearOp hook and then the
+// addOp hook for each value that is added. The data must have been produced
+// by [HashSet.MarshalBinary]. If an error is returned the set is left
+// unchanged and no hooks are called.
+//
+// Time Complexity: O(n)
+func (h *HookedHashSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying hash set while its contents are replaced. Exhibits the
+// same behavior as [HookedHashSet.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (h *SyncedHookedHashSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	h.Lock()
+	defer h.Unlock()
+	return h.fromSerialized(decoded)
+}
+
 // An equality function that implements the [algo.widget.WidgetInterface]
 // interface. Returns true if l==r, false otherwise.
 func (_ *HookedHashSet[T, U]) Eq(
@@ -53,6 +53,10 @@ func TestHookedHashSet_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(HookedHashSetToSetInterfaceFactory, t)
 }
 
+func TestHookedHashSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(HookedHashSetToSetInterfaceFactory, t)
+}
+
 func TestHookedHashSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(HookedHashSetToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestHookedHashSet_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestHookedHashSet_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(HookedHashSetToSetInterfaceFactory, t)
 }
+
+func TestHookedHashSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(HookedHashSetToSetInterfaceFactory, t)
+}
+
+func TestHookedHashSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(HookedHashSetToSetInterfaceFactory, t)
+}
@@ -0,0 +1,896 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A half open interval, [Lo,Hi). Lo is included in the interval and Hi is
+	// not.
+	Interval[T any] struct {
+		Lo T `json:"lo"`
+		Hi T `json:"hi"`
+	}
+
+	// The value stored in each node of an interval tree. maxHi is the largest
+	// Hi value of all the intervals in the subtree rooted at the node.
+	intervalTreeVal[T any, V any] struct {
+		val   V
+		maxHi T
+	}
+
+	// Orders intervals by their Lo values, using their Hi values to break ties.
+	intervalWidget[T any, TI widgets.PartialOrderInterface[T]] struct{}
+
+	// A type to represent a map from half open intervals, [lo,hi), to values.
+	// Along with the usual map operations it can efficiently find all of the
+	// intervals that contain a point or that overlap another interval. The
+	// intervals are kept in sorted order and the tree is internally
+	// implemented with an augmented red-black tree. The type constraints on
+	// the generics define the logic for how value specific operations, such as
+	// ordering and equality comparisons, will be handled. Copies of an
+	// interval tree share the same underlying tree, the same as the builtin
+	// map type.
+	IntervalTree[
+		T any,
+		V any,
+		TI widgets.PartialOrderInterface[T],
+		VI widgets.BaseInterface[V],
+	] struct {
+		tree *rbTree[Interval[T], intervalTreeVal[T, V], intervalWidget[T, TI]]
+	}
+
+	// A synchronized version of IntervalTree. All operations will be wrapped
+	// in the appropriate calls to the embedded RWMutex. A pointer to a RWMutex
+	// is embedded rather than a value to avoid copying the lock value.
+	SyncedIntervalTree[
+		T any,
+		V any,
+		TI widgets.PartialOrderInterface[T],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		IntervalTree[T, V, TI, VI]
+	}
+)
+
+func (_ intervalWidget[T, TI]) Eq(l *Interval[T], r *Interval[T]) bool {
+	w := widgets.PartialOrder[T, TI]{}
+	return w.Eq(&l.Lo, &r.Lo) && w.Eq(&l.Hi, &r.Hi)
+}
+
+func (_ intervalWidget[T, TI]) Lt(l *Interval[T], r *Interval[T]) bool {
+	w := widgets.PartialOrder[T, TI]{}
+	if w.Lt(&l.Lo, &r.Lo) {
+		return true
+	} else if w.Eq(&l.Lo, &r.Lo) {
+		return w.Lt(&l.Hi, &r.Hi)
+	}
+	return false
+}
+
+func (_ intervalWidget[T, TI]) Hash(v *Interval[T]) hash.Hash {
+	w := widgets.PartialOrder[T, TI]{}
+	return w.Hash(&v.Lo).Combine(w.Hash(&v.Hi))
+}
+
+func (_ intervalWidget[T, TI]) Zero(v *Interval[T]) {
+	w := widgets.PartialOrder[T, TI]{}
+	w.Zero(&v.Lo)
+	w.Zero(&v.Hi)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (i Interval[T]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'[', '%', byte(verb), ',', ' ', '%', byte(verb), ')'})
+	fmt.Fprintf(f, fmtStr, i.Lo, i.Hi)
+}
+
+func newIntervalRBTree[
+	T any,
+	V any,
+	TI widgets.PartialOrderInterface[T],
+]() *rbTree[Interval[T], intervalTreeVal[T, V], intervalWidget[T, TI]] {
+	rv := newRBTree[Interval[T], intervalTreeVal[T, V], intervalWidget[T, TI]]()
+	rv.augment = func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) {
+		w := widgets.PartialOrder[T, TI]{}
+		n.val.maxHi = n.key.Hi
+		if n.left != nil && w.Lt(&n.val.maxHi, &n.left.val.maxHi) {
+			n.val.maxHi = n.left.val.maxHi
+		}
+		if n.right != nil && w.Lt(&n.val.maxHi, &n.right.val.maxHi) {
+			n.val.maxHi = n.right.val.maxHi
+		}
+	}
+	return rv
+}
+
+// Creates a new, empty, interval tree.
+func NewIntervalTree[
+	T any,
+	V any,
+	TI widgets.PartialOrderInterface[T],
+	VI widgets.BaseInterface[V],
+]() IntervalTree[T, V, TI, VI] {
+	return IntervalTree[T, V, TI, VI]{tree: newIntervalRBTree[T, V, TI]()}
+}
+
+// Creates a new, empty, synced interval tree. The underlying RWMutex value
+// will be fully unlocked upon initialization.
+func NewSyncedIntervalTree[
+	T any,
+	V any,
+	TI widgets.PartialOrderInterface[T],
+	VI widgets.BaseInterface[V],
+]() SyncedIntervalTree[T, V, TI, VI] {
+	return SyncedIntervalTree[T, V, TI, VI]{
+		RWMutex:      &sync.RWMutex{},
+		IntervalTree: NewIntervalTree[T, V, TI, VI](),
+	}
+}
+
+// Converts the supplied interval tree to a synchronized interval tree.
+// Beware: The original non-synced interval tree will remain useable.
+func (i *IntervalTree[T, V, TI, VI]) ToSynced() SyncedIntervalTree[T, V, TI, VI] {
+	return SyncedIntervalTree[T, V, TI, VI]{
+		RWMutex:      &sync.RWMutex{},
+		IntervalTree: *i,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (i *IntervalTree[T, V, TI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (i *IntervalTree[T, V, TI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (i *IntervalTree[T, V, TI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (i *IntervalTree[T, V, TI, VI]) RUnlock() {}
+
+// The SyncedIntervalTree method to override the IntervalTree pass through
+// function and actually apply the mutex operation.
+func (i *SyncedIntervalTree[T, V, TI, VI]) Lock() { i.RWMutex.Lock() }
+
+// The SyncedIntervalTree method to override the IntervalTree pass through
+// function and actually apply the mutex operation.
+func (i *SyncedIntervalTree[T, V, TI, VI]) Unlock() { i.RWMutex.Unlock() }
+
+// The SyncedIntervalTree method to override the IntervalTree pass through
+// function and actually apply the mutex operation.
+func (i *SyncedIntervalTree[T, V, TI, VI]) RLock() { i.RWMutex.RLock() }
+
+// The SyncedIntervalTree method to override the IntervalTree pass through
+// function and actually apply the mutex operation.
+func (i *SyncedIntervalTree[T, V, TI, VI]) RUnlock() { i.RWMutex.RUnlock() }
+
+// Returns false, interval trees are not synced.
+func (i *IntervalTree[T, V, TI, VI]) IsSynced() bool { return false }
+
+// Returns true, synced interval trees are synced.
+func (i *SyncedIntervalTree[T, V, TI, VI]) IsSynced() bool { return true }
+
+func checkInterval[T any, TI widgets.PartialOrderInterface[T]](
+	lo *T,
+	hi *T,
+) error {
+	w := widgets.PartialOrder[T, TI]{}
+	if !w.Lt(lo, hi) {
+		return getIntervalError[T](lo, hi)
+	}
+	return nil
+}
+
+// Description: Returns the number of intervals in the interval tree.
+//
+// Time Complexity: O(1)
+func (i *IntervalTree[T, V, TI, VI]) Length() int {
+	return i.tree.size
+}
+
+// Description: Places a read lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (i *SyncedIntervalTree[T, V, TI, VI]) Length() int {
+	i.RLock()
+	defer i.RUnlock()
+	return i.IntervalTree.Length()
+}
+
+// Description: Inserts the interval [lo,hi) into the interval tree with the
+// supplied value. If the interval is already in the interval tree its value is
+// replaced. Returns an error if lo is not < hi.
+//
+// Time Complexity: O(log(n))
+func (i *IntervalTree[T, V, TI, VI]) Insert(lo T, hi T, val V) error {
+	return i.insertImpl(&Interval[T]{Lo: lo, Hi: hi}, &val)
+}
+
+// Description: Places a write lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.Insert] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) Insert(lo T, hi T, val V) error {
+	i.Lock()
+	defer i.Unlock()
+	return i.IntervalTree.insertImpl(&Interval[T]{Lo: lo, Hi: hi}, &val)
+}
+
+func (i *IntervalTree[T, V, TI, VI]) insertImpl(k *Interval[T], v *V) error {
+	if err := checkInterval[T, TI](&k.Lo, &k.Hi); err != nil {
+		return err
+	}
+	if n := i.tree.find(k); n != nil {
+		vw := widgets.Base[V, VI]{}
+		vw.Zero(&n.val.val)
+		n.val.val = *v
+		return nil
+	}
+	i.tree.insert(k, &intervalTreeVal[T, V]{val: *v}, false)
+	return nil
+}
+
+// Description: Gets the value of the interval [lo,hi). Returns a
+// [containerTypes.KeyError] if the interval is not in the interval tree.
+//
+// Time Complexity: O(log(n))
+func (i *IntervalTree[T, V, TI, VI]) Get(lo T, hi T) (V, error) {
+	k := Interval[T]{Lo: lo, Hi: hi}
+	if n := i.tree.find(&k); n != nil {
+		return n.val.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[Interval[T]](&k)
+}
+
+// Description: Places a read lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) Get(lo T, hi T) (V, error) {
+	i.RLock()
+	defer i.RUnlock()
+	return i.IntervalTree.Get(lo, hi)
+}
+
+// Description: Returns true if the interval [lo,hi) is in the interval tree,
+// false otherwise. Only an interval with the exact same end points is
+// considered to be a match, overlapping intervals are not.
+//
+// Time Complexity: O(log(n))
+func (i *IntervalTree[T, V, TI, VI]) ContainsInterval(lo T, hi T) bool {
+	return i.tree.find(&Interval[T]{Lo: lo, Hi: hi}) != nil
+}
+
+// Description: Places a read lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.ContainsInterval] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) ContainsInterval(lo T, hi T) bool {
+	i.RLock()
+	defer i.RUnlock()
+	return i.IntervalTree.ContainsInterval(lo, hi)
+}
+
+func (i *IntervalTree[T, V, TI, VI]) zeroNode(
+	k *Interval[T],
+	v *intervalTreeVal[T, V],
+) {
+	kw := widgets.PartialOrder[T, TI]{}
+	vw := widgets.Base[V, VI]{}
+	kw.Zero(&k.Lo)
+	kw.Zero(&k.Hi)
+	kw.Zero(&v.maxHi)
+	vw.Zero(&v.val)
+}
+
+// Description: Deletes the interval [lo,hi) from the interval tree. Returns a
+// [containerTypes.KeyError] if the interval is not in the interval tree.
+//
+// Time Complexity: O(log(n))
+func (i *IntervalTree[T, V, TI, VI]) Delete(lo T, hi T) error {
+	k := Interval[T]{Lo: lo, Hi: hi}
+	if !i.tree.remove(&k, i.zeroNode) {
+		return getKeyError[Interval[T]](&k)
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) Delete(lo T, hi T) error {
+	i.Lock()
+	defer i.Unlock()
+	return i.IntervalTree.Delete(lo, hi)
+}
+
+// Description: Clears all intervals from the interval tree.
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) Clear() {
+	if i.tree == nil {
+		i.tree = newIntervalRBTree[T, V, TI]()
+		return
+	}
+	i.tree.clear(i.zeroNode)
+}
+
+// Description: Places a write lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) Clear() {
+	i.Lock()
+	defer i.Unlock()
+	i.IntervalTree.Clear()
+}
+
+// Returns an iterator that lazily iterates over the nodes, in sorted order, of
+// all the intervals that have a Hi value > lo and a Lo value that makes
+// inRange return true. inRange must return true for a prefix of the sorted
+// intervals. Subtrees whose maxHi is <= lo are never visited.
+func (i *IntervalTree[T, V, TI, VI]) query(
+	lo *T,
+	inRange func(nodeLo *T) bool,
+) iter.Iter[*rbNode[Interval[T], intervalTreeVal[T, V]]] {
+	w := widgets.PartialOrder[T, TI]{}
+	var stack []*rbNode[Interval[T], intervalTreeVal[T, V]]
+	initialized := false
+	pushLeft := func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) {
+		for ; n != nil && w.Lt(lo, &n.val.maxHi); n = n.left {
+			stack = append(stack, n)
+		}
+	}
+	return func(
+		f iter.IteratorFeedback,
+	) (*rbNode[Interval[T], intervalTreeVal[T, V]], error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if !initialized {
+			pushLeft(i.tree.root)
+			initialized = true
+		}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !inRange(&n.key.Lo) {
+				stack = stack[:0]
+				break
+			}
+			pushLeft(n.right)
+			if w.Lt(lo, &n.key.Hi) {
+				return n, nil, true
+			}
+		}
+		return nil, nil, false
+	}
+}
+
+func (i *IntervalTree[T, V, TI, VI]) nodesToPairs(
+	nodes iter.Iter[*rbNode[Interval[T], intervalTreeVal[T, V]]],
+) iter.Iter[basic.Pair[Interval[T], V]] {
+	return iter.Map[
+		*rbNode[Interval[T], intervalTreeVal[T, V]],
+		basic.Pair[Interval[T], V],
+	](
+		nodes,
+		func(
+			index int,
+			val *rbNode[Interval[T], intervalTreeVal[T, V]],
+		) (basic.Pair[Interval[T], V], error) {
+			return basic.Pair[Interval[T], V]{A: val.key, B: val.val.val}, nil
+		},
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the intervals in
+// the interval tree that contain the supplied point, along with their values.
+// An interval [lo,hi) contains a point if lo<=point<hi. The intervals will be
+// returned in sorted order. The tree is walked lazily, so stopping the
+// iteration early will not visit the remaining intervals.
+//
+// Time Complexity: O(min(n, (m+1)*log(n))), where m is the number of
+// intervals that contain the point
+func (i *IntervalTree[T, V, TI, VI]) Stab(
+	point T,
+) iter.Iter[basic.Pair[Interval[T], V]] {
+	w := widgets.PartialOrder[T, TI]{}
+	return i.nodesToPairs(i.query(
+		&point,
+		func(nodeLo *T) bool { return !w.Lt(&point, nodeLo) },
+	))
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [IntervalTree.Stab] method such that a read lock will be placed on the
+// underlying interval tree when the iterator is consumed. The interval tree
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(min(n, (m+1)*log(n))), where m is the number of
+// intervals that contain the point
+func (i *SyncedIntervalTree[T, V, TI, VI]) Stab(
+	point T,
+) iter.Iter[basic.Pair[Interval[T], V]] {
+	return i.IntervalTree.Stab(point).SetupTeardown(
+		func() error { i.RLock(); return nil },
+		func() error { i.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the intervals in
+// the interval tree that overlap the interval [lo,hi), along with their
+// values. Two intervals overlap if they share at least one point, so
+// intervals that only touch end to end, such as [1,2) and [2,3), do not
+// overlap. The intervals will be returned in sorted order. The tree is walked
+// lazily, so stopping the iteration early will not visit the remaining
+// intervals. The iterator will return an error if lo is not < hi.
+//
+// Time Complexity: O(min(n, (m+1)*log(n))), where m is the number of
+// overlapping intervals
+func (i *IntervalTree[T, V, TI, VI]) Overlaps(
+	lo T,
+	hi T,
+) iter.Iter[basic.Pair[Interval[T], V]] {
+	if err := checkInterval[T, TI](&lo, &hi); err != nil {
+		return iter.ValElem[basic.Pair[Interval[T], V]](
+			basic.Pair[Interval[T], V]{}, err, 1,
+		)
+	}
+	w := widgets.PartialOrder[T, TI]{}
+	return i.nodesToPairs(i.query(
+		&lo,
+		func(nodeLo *T) bool { return w.Lt(nodeLo, &hi) },
+	))
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [IntervalTree.Overlaps] method such that a read lock will be placed on the
+// underlying interval tree when the iterator is consumed. The interval tree
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(min(n, (m+1)*log(n))), where m is the number of
+// overlapping intervals
+func (i *SyncedIntervalTree[T, V, TI, VI]) Overlaps(
+	lo T,
+	hi T,
+) iter.Iter[basic.Pair[Interval[T], V]] {
+	return i.IntervalTree.Overlaps(lo, hi).SetupTeardown(
+		func() error { i.RLock(); return nil },
+		func() error { i.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the intervals in
+// the interval tree in sorted order. Intervals are sorted by their Lo values,
+// with ties broken by their Hi values.
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) Keys() iter.Iter[Interval[T]] {
+	return iter.Map[*rbNode[Interval[T], intervalTreeVal[T, V]], Interval[T]](
+		i.tree.nodes(nil, nil),
+		func(
+			index int,
+			val *rbNode[Interval[T], intervalTreeVal[T, V]],
+		) (Interval[T], error) {
+			return val.key, nil
+		},
+	)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [IntervalTree.Keys] method such that a read lock will be placed on the
+// underlying interval tree when the iterator is consumed. The interval tree
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) Keys() iter.Iter[Interval[T]] {
+	return i.IntervalTree.Keys().SetupTeardown(
+		func() error { i.RLock(); return nil },
+		func() error { i.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the interval tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [IntervalTree.Keys].
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) KeysSeq() stditer.Seq[Interval[T]] {
+	return i.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the interval tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedIntervalTree.Keys], so the interval tree will
+// have a read lock the entire time the loop is running. The lock is released
+// once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) KeysSeq() stditer.Seq[Interval[T]] {
+	return i.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the
+// interval tree. The values will be returned in the order of their intervals.
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) Vals() iter.Iter[V] {
+	return iter.Map[*rbNode[Interval[T], intervalTreeVal[T, V]], V](
+		i.tree.nodes(nil, nil),
+		func(
+			index int,
+			val *rbNode[Interval[T], intervalTreeVal[T, V]],
+		) (V, error) {
+			return val.val.val, nil
+		},
+	)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [IntervalTree.Vals] method such that a read lock will be placed on the
+// underlying interval tree when the iterator is consumed. The interval tree
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) Vals() iter.Iter[V] {
+	return i.IntervalTree.Vals().SetupTeardown(
+		func() error { i.RLock(); return nil },
+		func() error { i.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the interval tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [IntervalTree.Vals].
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) ValsSeq() stditer.Seq[V] {
+	return i.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the interval tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedIntervalTree.Vals], so the interval tree will
+// have a read lock the entire time the loop is running. The lock is released
+// once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) ValsSeq() stditer.Seq[V] {
+	return i.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the interval tree
+// that can be used in a for-range loop. The key value pairs are returned in
+// sorted order.
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) All() stditer.Seq2[Interval[T], V] {
+	return iter.PairSeq(i.Pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the interval tree
+// that can be used in a for-range loop. The interval tree will have a read lock
+// the entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) All() stditer.Seq2[Interval[T], V] {
+	return iter.PairSeq(i.Pairs())
+}
+
+// Description: Returns an iterator that iterates over all of the intervals and
+// their values in the interval tree in sorted order.
+//
+// Time Complexity: O(n)
+func (i *IntervalTree[T, V, TI, VI]) Pairs() iter.Iter[basic.Pair[Interval[T], V]] {
+	return i.nodesToPairs(i.tree.nodes(nil, nil))
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [IntervalTree.Pairs] method such that a read lock will be placed on the
+// underlying interval tree when the iterator is consumed. The interval tree
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (i *SyncedIntervalTree[T, V, TI, VI]) Pairs() iter.Iter[basic.Pair[Interval[T], V]] {
+	return i.IntervalTree.Pairs().SetupTeardown(
+		func() error { i.RLock(); return nil },
+		func() error { i.RUnlock(); return nil },
+	)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two interval trees are equal if they contain the same intervals
+// and each interval maps to equal values. Returns true if l==r, false
+// otherwise.
+//
+// Time Complexity: O(n*log(n))
+func (_ *IntervalTree[T, V, TI, VI]) Eq(
+	l *IntervalTree[T, V, TI, VI],
+	r *IntervalTree[T, V, TI, VI],
+) bool {
+	if l.tree.size != r.tree.size {
+		return false
+	}
+	rv := true
+	vw := widgets.Base[V, VI]{}
+	l.tree.inOrder(func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) bool {
+		otherN := r.tree.find(&n.key)
+		rv = (otherN != nil && vw.Eq(&n.val.val, &otherN.val.val))
+		return rv
+	})
+	return rv
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// interval trees [IntervalTree.Eq] method. Returns true if l==r, false
+// otherwise.
+func (_ *SyncedIntervalTree[T, V, TI, VI]) Eq(
+	l *SyncedIntervalTree[T, V, TI, VI],
+	r *SyncedIntervalTree[T, V, TI, VI],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.IntervalTree.Eq(&l.IntervalTree, &r.IntervalTree)
+}
+
+// A function that returns a hash of an interval tree. To do this all of the
+// individual hashes that are produced from the intervals and values of the
+// interval tree are combined in sorted order, making it so the hash will
+// represent the same equality operation that [IntervalTree.Eq] provides.
+func (_ *IntervalTree[T, V, TI, VI]) Hash(
+	other *IntervalTree[T, V, TI, VI],
+) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	kw := intervalWidget[T, TI]{}
+	vw := widgets.Base[V, VI]{}
+	other.tree.inOrder(func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) bool {
+		iterH := kw.Hash(&n.key).Combine(vw.Hash(&n.val.val))
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.Combine(iterH)
+		}
+		return true
+	})
+	return rv
+}
+
+// Places a read lock on the underlying interval tree of other and then calls
+// others underlying interval trees [IntervalTree.Hash] method.
+func (_ *SyncedIntervalTree[T, V, TI, VI]) Hash(
+	other *SyncedIntervalTree[T, V, TI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.IntervalTree.Hash(&other.IntervalTree)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [IntervalTree.Clear].
+func (_ *IntervalTree[T, V, TI, VI]) Zero(other *IntervalTree[T, V, TI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedIntervalTree.Clear].
+func (_ *SyncedIntervalTree[T, V, TI, VI]) Zero(
+	other *SyncedIntervalTree[T, V, TI, VI],
+) {
+	other.Clear()
+}
+
+func (i *IntervalTree[T, V, TI, VI]) toSerialized() []serializedKV[Interval[T], V] {
+	rv := []serializedKV[Interval[T], V]{}
+	if i.tree == nil {
+		return rv
+	}
+	rv = make([]serializedKV[Interval[T], V], 0, i.tree.size)
+	i.tree.inOrder(func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) bool {
+		rv = append(
+			rv, serializedKV[Interval[T], V]{Key: n.key, Val: n.val.val},
+		)
+		return true
+	})
+	return rv
+}
+
+func (i *IntervalTree[T, V, TI, VI]) fromSerialized(
+	kvs []serializedKV[Interval[T], V],
+) error {
+	for j := 0; j < len(kvs); j++ {
+		if err := checkInterval[T, TI](&kvs[j].Key.Lo, &kvs[j].Key.Hi); err != nil {
+			return err
+		}
+	}
+	i.Clear()
+	for j := 0; j < len(kvs); j++ {
+		i.insertImpl(&kvs[j].Key, &kvs[j].Val)
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the interval tree. The interval
+// tree is encoded as a list of key value objects in sorted order, where each
+// key is an object holding the lo and hi values of an interval. Decoding
+// returns an error if any interval has a lo value that is not < its hi value.
+//
+// Time Complexity: O(n*log(n))
+func (i *IntervalTree[T, V, TI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.toSerialized())
+}
+
+// Description: Places a read lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) MarshalJSON() ([]byte, error) {
+	i.RLock()
+	defer i.RUnlock()
+	return i.IntervalTree.MarshalJSON()
+}
+
+// Description: Replaces the contents of the interval tree with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [IntervalTree.MarshalJSON]. If an error is returned the interval tree is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (i *IntervalTree[T, V, TI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[Interval[T], V]](data)
+	if err != nil {
+		return err
+	}
+	return i.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying interval tree while its contents are replaced. Exhibits the
+// same behavior as [IntervalTree.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[Interval[T], V]](data)
+	if err != nil {
+		return err
+	}
+	i.Lock()
+	defer i.Unlock()
+	return i.IntervalTree.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the interval tree. The
+// values are laid out the same way as [IntervalTree.MarshalJSON] and are
+// encoded using the [encoding/gob] package, so the contained values must be
+// encodable by gob.
+//
+// Time Complexity: O(n*log(n))
+func (i *IntervalTree[T, V, TI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(i.toSerialized())
+}
+
+// Description: Places a read lock on the underlying interval tree and then
+// calls the underlying interval trees [IntervalTree.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) MarshalBinary() ([]byte, error) {
+	i.RLock()
+	defer i.RUnlock()
+	return i.IntervalTree.MarshalBinary()
+}
+
+// Description: Replaces the contents of the interval tree with the values
+// decoded from the supplied binary data, which must have been produced by
+// [IntervalTree.MarshalBinary]. If an error is returned the interval tree is
+// left unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (i *IntervalTree[T, V, TI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[Interval[T], V]](data)
+	if err != nil {
+		return err
+	}
+	return i.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying interval tree while its contents are replaced. Exhibits the
+// same behavior as [IntervalTree.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (i *SyncedIntervalTree[T, V, TI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[Interval[T], V]](data)
+	if err != nil {
+		return err
+	}
+	i.Lock()
+	defer i.Unlock()
+	return i.IntervalTree.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (i IntervalTree[T, V, TI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("intervalTree["))
+	cntr := 0
+	i.tree.inOrder(func(n *rbNode[Interval[T], intervalTreeVal[T, V]]) bool {
+		fmt.Fprintf(f, fmtStr, n.key, n.val.val)
+		cntr++
+		if cntr < i.tree.size {
+			f.Write([]byte{' '})
+		}
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (i *IntervalTree[T, V, TI, VI]) String() string {
+	return fmt.Sprintf("%v", i)
+}
@@ -0,0 +1,287 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestIntervalTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[IntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]]
+	v := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedIntervalTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]]
+	v := NewSyncedIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+// Checks that every node in the tree holds the largest hi value of its
+// subtree.
+func checkIntervalTreeMaxHi(
+	n *rbNode[Interval[int], intervalTreeVal[int, int]],
+	t *testing.T,
+) int {
+	if n == nil {
+		return -1 << 62
+	}
+	exp := max(n.key.Hi, checkIntervalTreeMaxHi(n.left, t))
+	exp = max(exp, checkIntervalTreeMaxHi(n.right, t))
+	test.Eq(exp, n.val.maxHi, t)
+	return exp
+}
+
+func intervalTreeBruteForce(
+	exp map[Interval[int]]int,
+	keep func(k Interval[int]) bool,
+) []basic.Pair[Interval[int], int] {
+	rv := []basic.Pair[Interval[int], int]{}
+	for k, v := range exp {
+		if keep(k) {
+			rv = append(rv, basic.Pair[Interval[int], int]{A: k, B: v})
+		}
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		if rv[i].A.Lo != rv[j].A.Lo {
+			return rv[i].A.Lo < rv[j].A.Lo
+		}
+		return rv[i].A.Hi < rv[j].A.Hi
+	})
+	return rv
+}
+
+func TestIntervalTreeRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	it := NewIntervalTree[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	exp := map[Interval[int]]int{}
+	for op := 0; op < 5000; op++ {
+		lo := r.Intn(200)
+		hi := lo + 1 + r.Intn(30)
+		k := Interval[int]{Lo: lo, Hi: hi}
+		switch r.Intn(5) {
+		case 0, 1:
+			test.Nil(it.Insert(lo, hi, op), t)
+			exp[k] = op
+		case 2:
+			err := it.Delete(lo, hi)
+			if _, ok := exp[k]; ok {
+				test.Nil(err, t)
+				delete(exp, k)
+			} else {
+				test.ContainsError(containerTypes.KeyError, err, t)
+			}
+		case 3:
+			p := r.Intn(240)
+			res, err := it.Stab(p).Collect()
+			test.Nil(err, t)
+			test.SlicesMatch[basic.Pair[Interval[int], int]](
+				intervalTreeBruteForce(exp, func(k Interval[int]) bool {
+					return k.Lo <= p && p < k.Hi
+				}),
+				res, t,
+			)
+		case 4:
+			res, err := it.Overlaps(lo, hi).Collect()
+			test.Nil(err, t)
+			test.SlicesMatch[basic.Pair[Interval[int], int]](
+				intervalTreeBruteForce(exp, func(k Interval[int]) bool {
+					return k.Lo < hi && lo < k.Hi
+				}),
+				res, t,
+			)
+		}
+		test.Eq(len(exp), it.Length(), t)
+		if op%100 == 0 {
+			checkIntervalTreeMaxHi(it.tree.root, t)
+		}
+	}
+	res, err := it.Pairs().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[Interval[int], int]](
+		intervalTreeBruteForce(exp, func(k Interval[int]) bool { return true }),
+		res, t,
+	)
+}
+
+func TestIntervalTreeInsertGetDelete(t *testing.T) {
+	it := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	test.Nil(it.Insert(1, 5, "a"), t)
+	test.Nil(it.Insert(1, 3, "b"), t)
+	test.Nil(it.Insert(1, 5, "c"), t)
+	test.Eq(2, it.Length(), t)
+	v, err := it.Get(1, 5)
+	test.Nil(err, t)
+	test.Eq("c", v, t)
+	test.True(it.ContainsInterval(1, 3), t)
+	test.False(it.ContainsInterval(1, 4), t)
+	_, err = it.Get(1, 4)
+	test.ContainsError(containerTypes.KeyError, err, t)
+
+	err = it.Insert(3, 3, "d")
+	test.ContainsError(customerr.InvalidValue, err, t)
+	err = it.Insert(4, 3, "d")
+	test.ContainsError(customerr.InvalidValue, err, t)
+	test.Eq(2, it.Length(), t)
+
+	test.Nil(it.Delete(1, 5), t)
+	test.ContainsError(containerTypes.KeyError, it.Delete(1, 5), t)
+	keys, _ := it.Keys().Collect()
+	test.SlicesMatch[Interval[int]]([]Interval[int]{{Lo: 1, Hi: 3}}, keys, t)
+	vals, _ := it.Vals().Collect()
+	test.SlicesMatch[string]([]string{"b"}, vals, t)
+}
+
+func TestIntervalTreeHalfOpen(t *testing.T) {
+	it := NewIntervalTree[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	it.Insert(1, 2, 0)
+	it.Insert(2, 3, 1)
+	res, _ := it.Stab(2).Collect()
+	test.SlicesMatch[basic.Pair[Interval[int], int]](
+		[]basic.Pair[Interval[int], int]{{A: Interval[int]{Lo: 2, Hi: 3}, B: 1}},
+		res, t,
+	)
+	res, _ = it.Overlaps(0, 1).Collect()
+	test.Eq(0, len(res), t)
+	res, _ = it.Overlaps(3, 4).Collect()
+	test.Eq(0, len(res), t)
+	res, _ = it.Overlaps(1, 3).Collect()
+	test.Eq(2, len(res), t)
+	_, err := it.Overlaps(2, 2).Collect()
+	test.ContainsError(customerr.InvalidValue, err, t)
+}
+
+func TestIntervalTreeEarlyStop(t *testing.T) {
+	it := NewIntervalTree[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 100; i++ {
+		it.Insert(i, i+50, i)
+	}
+	res, err := it.Overlaps(60, 61).Take(3).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[Interval[int], int]](
+		[]basic.Pair[Interval[int], int]{
+			{A: Interval[int]{Lo: 11, Hi: 61}, B: 11},
+			{A: Interval[int]{Lo: 12, Hi: 62}, B: 12},
+			{A: Interval[int]{Lo: 13, Hi: 63}, B: 13},
+		},
+		res, t,
+	)
+}
+
+func TestIntervalTreeTime(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int, min int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+	it := NewIntervalTree[time.Time, string, widgets.Time, widgets.BuiltinString]()
+	test.Nil(it.Insert(at(9, 0), at(10, 0), "standup"), t)
+	test.Nil(it.Insert(at(9, 30), at(11, 0), "review"), t)
+	test.Nil(it.Insert(at(13, 0), at(14, 0), "lunch"), t)
+
+	conflicts, err := it.Overlaps(at(10, 0), at(13, 0)).Collect()
+	test.Nil(err, t)
+	test.Eq(1, len(conflicts), t)
+	test.Eq("review", conflicts[0].B, t)
+
+	// The same instant in a different location is the same point in time.
+	est := at(9, 45).In(time.FixedZone("EST", -5*60*60))
+	vals, err := it.Stab(est).Collect()
+	test.Nil(err, t)
+	test.Eq(2, len(vals), t)
+	test.Eq("standup", vals[0].B, t)
+	test.Eq("review", vals[1].B, t)
+
+	v, err := it.Get(at(13, 0).In(time.FixedZone("EST", -5*60*60)), at(14, 0))
+	test.Nil(err, t)
+	test.Eq("lunch", v, t)
+	test.Nil(it.Delete(at(9, 0), at(10, 0)), t)
+	vals, _ = it.Stab(at(9, 15)).Collect()
+	test.Eq(0, len(vals), t)
+}
+
+func TestIntervalTreeEqHashFormat(t *testing.T) {
+	l := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	r := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	test.True(l.Eq(&l, &r), t)
+	l.Insert(1, 2, "a")
+	l.Insert(0, 5, "b")
+	test.False(l.Eq(&l, &r), t)
+	r.Insert(0, 5, "b")
+	r.Insert(1, 2, "a")
+	test.True(l.Eq(&l, &r), t)
+	test.Eq(l.Hash(&l), r.Hash(&r), t)
+	r.Insert(1, 2, "c")
+	test.False(l.Eq(&l, &r), t)
+	test.Eq("intervalTree[[0, 5):b [1, 2):a]", l.String(), t)
+	test.Eq("intervalTree[[0, 5):b [1, 2):a]", fmt.Sprint(l), t)
+	l.Zero(&l)
+	test.Eq(0, l.Length(), t)
+	test.Eq("intervalTree[]", l.String(), t)
+}
+
+func TestSyncedIntervalTreeConcurrent(t *testing.T) {
+	it := NewSyncedIntervalTree[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				lo := j*1000 + i
+				it.Insert(lo, lo+10, i)
+				it.Stab(lo + 5).Collect()
+				it.Overlaps(lo, lo+1).Collect()
+				if i%2 == 0 {
+					it.Delete(lo, lo+10)
+				}
+			}
+		}(j)
+	}
+	wg.Wait()
+	test.Eq(500, it.Length(), t)
+}
+
+func TestIntervalTreeSerialization(t *testing.T) {
+	it := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	it.Insert(1, 3, "a")
+	it.Insert(0, 10, "b")
+	data, err := json.Marshal(&it)
+	test.Nil(err, t)
+	test.Eq(
+		`[{"key":{"lo":0,"hi":10},"val":"b"},{"key":{"lo":1,"hi":3},"val":"a"}]`,
+		string(data), t,
+	)
+	for _, binary := range []bool{false, true} {
+		if binary {
+			data, err = it.MarshalBinary()
+			test.Nil(err, t)
+		}
+		res := NewIntervalTree[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+		res.Insert(5, 6, "c")
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(it.Eq(&it, &res), t)
+		vals, _ := res.Stab(2).Collect()
+		test.Eq(2, len(vals), t)
+	}
+
+	err = json.Unmarshal([]byte(`[{"key":{"lo":3,"hi":3},"val":"x"}]`), &it)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	test.Eq(2, it.Length(), t)
+}
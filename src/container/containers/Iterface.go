@@ -1,9 +1,15 @@
 package containers
 
 import (
+	"errors"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
 	"github.com/barbell-math/util/src/container/dynamicContainers"
 	"github.com/barbell-math/util/src/container/staticContainers"
+	"github.com/barbell-math/util/src/customerr"
 	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
 )
 
 // This function is a producer.
@@ -105,3 +111,219 @@ func Unique[T any](
 		return iter.Continue, nil
 	})
 }
+
+// This function is an intermediary.
+//
+// Distinct will only pass on the first occurrence of each value from it's
+// parent iterator, all later duplicate values are skipped. Values are
+// considered duplicates if they are equal according to the U widget, which is
+// also used to hash the values. The values that have been seen are kept in an
+// internal set, meaning memory usage grows with the number of distinct values.
+// The set is released once the break action is received. An error will stop
+// iteration.
+func Distinct[T any, U widgets.BaseInterface[T]](i iter.Iter[T]) iter.Iter[T] {
+	seen, _ := NewHashSet[T, U](0)
+	return i.Next(
+		func(index int, val T, status iter.IteratorFeedback) (iter.IteratorFeedback, T, error) {
+			if status == iter.Break {
+				seen.Clear()
+				return iter.Break, val, nil
+			}
+			if seen.ContainsPntr(&val) {
+				return iter.Iterate, val, nil
+			}
+			seen.AppendUnique(val)
+			return iter.Continue, val, nil
+		},
+	)
+}
+
+// This function is a consumer.
+//
+// GroupBy will consume all values from it's parent iterator and group them in
+// the supplied map (m). The key operation (keyOp) function determines which
+// group each value belongs to and the group operation (groupOp) function adds
+// the value to it's group. The group operation function is given a pointer to
+// the groups current value, which will be the zero value of V the first time a
+// key is seen, allowing values to be accumulated in any way. For example,
+// values can be appended to a slice or summed. Any groups that are already
+// present in the map will be added to. An error from the parent iterator, the
+// key operation function, or the group operation function will stop
+// iteration.
+func GroupBy[T any, K any, V any](
+	i iter.Iter[T],
+	m dynamicContainers.Map[K, V],
+	keyOp func(val T) (K, error),
+	groupOp func(group *V, val T) error,
+) error {
+	return i.ForEach(func(index int, val T) (iter.IteratorFeedback, error) {
+		k, err := keyOp(val)
+		if err != nil {
+			return iter.Break, err
+		}
+		group, err := m.Get(k)
+		if err != nil && !errors.Is(err, containerTypes.KeyError) {
+			return iter.Break, err
+		}
+		if err := groupOp(&group, val); err != nil {
+			return iter.Break, err
+		}
+		return iter.Continue, m.Emplace(basic.Pair[K, V]{A: k, B: group})
+	})
+}
+
+// This function is a consumer.
+//
+// CountBy will consume all values from it's parent iterator and count the
+// number of values that belong to each group in the supplied map (m). The key
+// operation (keyOp) function determines which group each value belongs to.
+// Any counts that are already present in the map will be added to. An error
+// from the parent iterator or the key operation function will stop iteration.
+func CountBy[T any, K any](
+	i iter.Iter[T],
+	m dynamicContainers.Map[K, int],
+	keyOp func(val T) (K, error),
+) error {
+	return GroupBy(i, m, keyOp, func(group *int, val T) error {
+		(*group)++
+		return nil
+	})
+}
+
+// This function is an intermediary.
+//
+// Sorted will pass on the values from it's parent iterator in ascending order
+// as defined by the U widget. The sort is stable, so values that are equal
+// keep the order they were received in. Sorting requires every value, so the
+// parent iterator is consumed in it's entirety and buffered the first time a
+// value is requested. An error from the parent iterator will be returned
+// before any values are passed on and iteration will stop.
+func Sorted[T any, U widgets.PartialOrderInterface[T]](
+	i iter.Iter[T],
+) iter.Iter[T] {
+	var vals Vector[T, U]
+	started, done := false, false
+	idx := 0
+	return func(f iter.IteratorFeedback) (T, error, bool) {
+		var tmp T
+		if f == iter.Break {
+			vals = nil
+			_, err, _ := i(f)
+			return tmp, err, false
+		}
+		if done {
+			return tmp, nil, false
+		}
+		if !started {
+			started = true
+			for {
+				next, err, cont := i(f)
+				if err != nil {
+					done = true
+					return tmp, err, false
+				}
+				if !cont {
+					break
+				}
+				vals = append(vals, next)
+			}
+			VectorStableSort[T, U](&vals)
+		}
+		if idx >= len(vals) {
+			done = true
+			return tmp, nil, false
+		}
+		idx++
+		return vals[idx-1], nil, true
+	}
+}
+
+// This function is a consumer.
+//
+// MinMax will consume all values from it's parent iterator and return the
+// smallest and largest values as defined by the U widget. If several values are
+// equal to the smallest or largest value then the first one that was received
+// will be returned. If the parent iterator does not produce any values then an
+// error will be returned. An error from the parent iterator will stop iteration.
+func MinMax[T any, U widgets.PartialOrderInterface[T]](
+	i iter.Iter[T],
+) (T, T, error) {
+	w := widgets.PartialOrder[T, U]{}
+	var lo, hi T
+	found := false
+	err := i.ForEach(func(index int, val T) (iter.IteratorFeedback, error) {
+		if !found {
+			lo, hi, found = val, val, true
+		} else if w.Lt(&val, &lo) {
+			lo = val
+		} else if w.Lt(&hi, &val) {
+			hi = val
+		}
+		return iter.Continue, nil
+	})
+	if err != nil {
+		var tmp T
+		return tmp, tmp, err
+	}
+	if !found {
+		return lo, hi, getEmptyError()
+	}
+	return lo, hi, nil
+}
+
+// This function is a consumer.
+//
+// Min will consume all values from it's parent iterator and return the
+// smallest value as defined by the U widget. See [MinMax] for more details.
+func Min[T any, U widgets.PartialOrderInterface[T]](i iter.Iter[T]) (T, error) {
+	rv, _, err := MinMax[T, U](i)
+	return rv, err
+}
+
+// This function is a consumer.
+//
+// Max will consume all values from it's parent iterator and return the
+// largest value as defined by the U widget. See [MinMax] for more details.
+func Max[T any, U widgets.PartialOrderInterface[T]](i iter.Iter[T]) (T, error) {
+	_, rv, err := MinMax[T, U](i)
+	return rv, err
+}
+
+// This function is a consumer.
+//
+// TopK will consume all values from it's parent iterator and return the k
+// largest values as defined by the U widget, sorted from largest to smallest.
+// If the parent iterator produces less than k values then all of the values
+// will be returned. Only k values are kept at any one time in a min-heap, so
+// memory usage does not grow with the number of values that are consumed. To
+// get the k smallest values use a widget that reverses the order. k must be
+// >=0, an error will be returned if it is not and the parent iterator will be
+// stopped without consuming any values. An error from the parent iterator will
+// stop iteration.
+func TopK[T any, U widgets.PartialOrderInterface[T]](
+	i iter.Iter[T],
+	k int,
+) ([]T, error) {
+	if k < 0 {
+		return nil, customerr.AppendError(getSizeError(k), i.Stop())
+	}
+	w := widgets.PartialOrder[T, U]{}
+	q, _ := NewPriorityQueue[T, U](k, false)
+	err := i.ForEach(func(index int, val T) (iter.IteratorFeedback, error) {
+		if q.Length() < k {
+			q.Push(val)
+		} else if front, err := q.PeekPntrFront(); err == nil && w.Lt(front, &val) {
+			q.PopFront()
+			q.Push(val)
+		}
+		return iter.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]T, q.Length())
+	for j := len(rv) - 1; j >= 0; j-- {
+		rv[j], _ = q.PopFront()
+	}
+	return rv, nil
+}
@@ -1,10 +1,17 @@
 package containers
 
 import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
 	"testing"
 
+	"github.com/barbell-math/util/src/container/basic"
 	"github.com/barbell-math/util/src/container/containerTypes"
 	"github.com/barbell-math/util/src/container/staticContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
 	"github.com/barbell-math/util/src/iter"
 	"github.com/barbell-math/util/src/test"
 	"github.com/barbell-math/util/src/widgets"
@@ -169,3 +176,301 @@ func TestUniqueErrorOnDup(t *testing.T) {
 	test.Eq(1, s.Length(), t)
 	test.True(s.Contains(1), t)
 }
+
+func TestDistinct(t *testing.T) {
+	res, err := Distinct[int, widgets.BuiltinInt](iter.NoElem[int]()).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = Distinct[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{3, 1, 3, 2, 1, 1, 4, 2}),
+	).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{3, 1, 2, 4}, res, t)
+	res, err = Distinct[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{3, 1, 3, 2, 1, 1, 4, 2}),
+	).Take(2).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{3, 1}, res, t)
+}
+
+func TestDistinctParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	teardownRan := false
+	res, err := Distinct[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{1, 1, 2, 3}).Next(
+			func(index int, val int, status iter.IteratorFeedback) (iter.IteratorFeedback, int, error) {
+				if val == 3 {
+					return iter.Break, val, expectedError
+				}
+				return iter.Continue, val, nil
+			},
+		).Teardown(func() error {
+			teardownRan = true
+			return nil
+		}),
+	).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.SlicesMatch[int]([]int{1, 2}, res, t)
+	test.True(teardownRan, t)
+}
+
+func TestGroupBy(t *testing.T) {
+	m, _ := NewHashMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	err := GroupBy[int, int, string](
+		iter.Range(0, 10, 1),
+		&m,
+		func(val int) (int, error) { return val % 3, nil },
+		func(group *string, val int) error {
+			*group += fmt.Sprint(val)
+			return nil
+		},
+	)
+	test.Nil(err, t)
+	test.Eq(3, m.Length(), t)
+	for k, exp := range map[int]string{0: "0369", 1: "147", 2: "258"} {
+		v, err := m.Get(k)
+		test.Nil(err, t)
+		test.Eq(exp, v, t)
+	}
+
+	// Existing groups are added to.
+	err = GroupBy[int, int, string](
+		iter.SliceElems([]int{10, 11}),
+		&m,
+		func(val int) (int, error) { return val % 3, nil },
+		func(group *string, val int) error {
+			*group += fmt.Sprint(val)
+			return nil
+		},
+	)
+	test.Nil(err, t)
+	v, err := m.Get(1)
+	test.Nil(err, t)
+	test.Eq("14710", v, t)
+	v, err = m.Get(2)
+	test.Nil(err, t)
+	test.Eq("25811", v, t)
+}
+
+func TestGroupByErrors(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	m, _ := NewHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	err := GroupBy[int, int, int](
+		iter.Range(0, 10, 1),
+		&m,
+		func(val int) (int, error) {
+			if val == 5 {
+				return 0, expectedError
+			}
+			return val % 2, nil
+		},
+		func(group *int, val int) error {
+			*group += val
+			return nil
+		},
+	)
+	test.ContainsError(expectedError, err, t)
+	v, _ := m.Get(0)
+	test.Eq(0+2+4, v, t)
+	v, _ = m.Get(1)
+	test.Eq(1+3, v, t)
+
+	m.Clear()
+	err = GroupBy[int, int, int](
+		iter.Range(0, 10, 1),
+		&m,
+		func(val int) (int, error) { return val % 2, nil },
+		func(group *int, val int) error {
+			if val == 3 {
+				return expectedError
+			}
+			*group += val
+			return nil
+		},
+	)
+	test.ContainsError(expectedError, err, t)
+	v, _ = m.Get(0)
+	test.Eq(0+2, v, t)
+	v, _ = m.Get(1)
+	test.Eq(1, v, t)
+}
+
+func TestCountBy(t *testing.T) {
+	m, _ := NewHashMap[string, int, widgets.BuiltinString, widgets.BuiltinInt](0)
+	err := CountBy[string, string](
+		iter.SliceElems([]string{"a", "bb", "c", "dd", "eee", "f"}),
+		&m,
+		func(val string) (string, error) { return fmt.Sprint(len(val)), nil },
+	)
+	test.Nil(err, t)
+	test.Eq(3, m.Length(), t)
+	for k, exp := range map[string]int{"1": 3, "2": 2, "3": 1} {
+		v, err := m.Get(k)
+		test.Nil(err, t)
+		test.Eq(exp, v, t)
+	}
+	_, err = m.Get("4")
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestSorted(t *testing.T) {
+	res, err := Sorted[int, widgets.BuiltinInt](iter.NoElem[int]()).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	vals := make([]int, 500)
+	for i := range vals {
+		vals[i] = rand.Intn(100)
+	}
+	res, err = Sorted[int, widgets.BuiltinInt](iter.SliceElems(vals)).Collect()
+	test.Nil(err, t)
+	exp := slices.Clone(vals)
+	slices.Sort(exp)
+	test.SlicesMatch[int](exp, res, t)
+}
+
+func TestSortedIsStable(t *testing.T) {
+	// Pairs are only ordered by their first value.
+	res, err := Sorted[basic.Pair[int, int], pairFirstWidget](iter.SliceElems(
+		[]basic.Pair[int, int]{{A: 2, B: 0}, {A: 1, B: 1}, {A: 2, B: 2}, {A: 1, B: 3}},
+	)).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[int, int]](
+		[]basic.Pair[int, int]{{A: 1, B: 1}, {A: 1, B: 3}, {A: 2, B: 0}, {A: 2, B: 2}},
+		res, t,
+	)
+}
+
+func TestSortedParentCleanup(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	teardownCalls := 0
+	res, err := Sorted[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{3, 2, 1}).Teardown(func() error {
+			teardownCalls++
+			return nil
+		}),
+	).Take(2).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{1, 2}, res, t)
+	test.Eq(1, teardownCalls, t)
+
+	res, err = Sorted[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{3, 2, 1}).Next(
+			func(index int, val int, status iter.IteratorFeedback) (iter.IteratorFeedback, int, error) {
+				if val == 1 {
+					return iter.Break, val, expectedError
+				}
+				return iter.Continue, val, nil
+			},
+		),
+	).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.Eq(0, len(res), t)
+}
+
+// A widget for pairs that only compares the first value of the pair.
+type pairFirstWidget struct{}
+
+func (p pairFirstWidget) Eq(l *basic.Pair[int, int], r *basic.Pair[int, int]) bool {
+	return l.A == r.A
+}
+func (p pairFirstWidget) Lt(l *basic.Pair[int, int], r *basic.Pair[int, int]) bool {
+	return l.A < r.A
+}
+func (p pairFirstWidget) Hash(v *basic.Pair[int, int]) hash.Hash {
+	return widgets.BuiltinInt{}.Hash(&v.A)
+}
+func (p pairFirstWidget) Zero(v *basic.Pair[int, int]) {
+	*v = basic.Pair[int, int]{}
+}
+
+func TestMinMax(t *testing.T) {
+	_, _, err := MinMax[int, widgets.BuiltinInt](iter.NoElem[int]())
+	test.ContainsError(containerTypes.Empty, err, t)
+	_, err = Min[int, widgets.BuiltinInt](iter.NoElem[int]())
+	test.ContainsError(containerTypes.Empty, err, t)
+	_, err = Max[int, widgets.BuiltinInt](iter.NoElem[int]())
+	test.ContainsError(containerTypes.Empty, err, t)
+
+	lo, hi, err := MinMax[int, widgets.BuiltinInt](iter.SliceElems([]int{5}))
+	test.Nil(err, t)
+	test.Eq(5, lo, t)
+	test.Eq(5, hi, t)
+	lo, hi, err = MinMax[int, widgets.BuiltinInt](
+		iter.SliceElems([]int{5, 3, 9, -2, 7, 9, -2}),
+	)
+	test.Nil(err, t)
+	test.Eq(-2, lo, t)
+	test.Eq(9, hi, t)
+	lo, err = Min[int, widgets.BuiltinInt](iter.SliceElems([]int{5, 3, 9}))
+	test.Nil(err, t)
+	test.Eq(3, lo, t)
+	hi, err = Max[int, widgets.BuiltinInt](iter.SliceElems([]int{5, 3, 9}))
+	test.Nil(err, t)
+	test.Eq(9, hi, t)
+
+	// The first of several equal values is returned.
+	pLo, pHi, err := MinMax[basic.Pair[int, int], pairFirstWidget](iter.SliceElems(
+		[]basic.Pair[int, int]{{A: 1, B: 0}, {A: 2, B: 1}, {A: 1, B: 2}, {A: 2, B: 3}},
+	))
+	test.Nil(err, t)
+	test.Eq(basic.Pair[int, int]{A: 1, B: 0}, pLo, t)
+	test.Eq(basic.Pair[int, int]{A: 2, B: 1}, pHi, t)
+}
+
+func TestMinMaxParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	_, _, err := MinMax[int, widgets.BuiltinInt](iter.ValElem(1, expectedError, 1))
+	test.ContainsError(expectedError, err, t)
+}
+
+func TestTopK(t *testing.T) {
+	_, err := TopK[int, widgets.BuiltinInt](iter.SliceElems([]int{1}), -1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	res, err := TopK[int, widgets.BuiltinInt](iter.SliceElems([]int{1, 2}), 0)
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = TopK[int, widgets.BuiltinInt](iter.SliceElems([]int{2, 1}), 5)
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{2, 1}, res, t)
+
+	vals := make([]int, 1000)
+	for i := range vals {
+		vals[i] = rand.Intn(10000)
+	}
+	exp := slices.Clone(vals)
+	slices.Sort(exp)
+	slices.Reverse(exp)
+	for _, k := range []int{1, 5, 50, 1000} {
+		res, err = TopK[int, widgets.BuiltinInt](iter.SliceElems(vals), k)
+		test.Nil(err, t)
+		test.SlicesMatch[int](exp[:k], res, t)
+	}
+}
+
+func TestTopKParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	res, err := TopK[int, widgets.BuiltinInt](iter.ValElem(1, expectedError, 1), 3)
+	test.ContainsError(expectedError, err, t)
+	test.Eq(0, len(res), t)
+}
+
+func TestTopKBadSizeStopsParent(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	breakReceived := false
+	parentCalled := false
+	_, err := TopK[int, widgets.BuiltinInt](
+		iter.Iter[int](func(f iter.IteratorFeedback) (int, error, bool) {
+			if f == iter.Break {
+				breakReceived = true
+				return 0, expectedError, false
+			}
+			parentCalled = true
+			return 1, nil, true
+		}),
+		-1,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.ContainsError(expectedError, err, t)
+	test.True(breakReceived, t)
+	test.False(parentCalled, t)
+}
@@ -0,0 +1,477 @@
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	cacheLineSize = 64
+
+	// The number of times a blocking operation will yield to the scheduler
+	// before it starts sleeping between attempts.
+	mpmcQueueMaxSpins = 64
+	// The longest a blocking operation will sleep between attempts.
+	mpmcQueueMaxSleep = time.Millisecond
+)
+
+type (
+	mpmcQueueCell[T any] struct {
+		// The sequence number is what coordinates producers and consumers.
+		// When seq==2*pos the cell is free to be written by the producer that
+		// claimed pos, when seq==2*pos+1 the cell holds the value for pos and
+		// is free to be read by the consumer that claimed pos. The positions
+		// are doubled so the two states never collide, even when the capacity
+		// of the queue is one.
+		seq atomic.Uint64
+		// Values are stored behind an atomic pointer so that peeking at a cell
+		// can never race with a producer that is overwriting it.
+		val atomic.Pointer[T]
+	}
+
+	mpmcQueueImpl[T any] struct {
+		_          [cacheLineSize]byte
+		enqueuePos atomic.Uint64
+		_          [cacheLineSize - 8]byte
+		dequeuePos atomic.Uint64
+		_          [cacheLineSize - 8]byte
+		cells      []mpmcQueueCell[T]
+	}
+
+	// A lock-free, bounded, multi-producer multi-consumer queue. Any number of
+	// goroutines can push and pop values concurrently without ever acquiring a
+	// mutex, making it better suited than a [SyncedCircularBuffer] for
+	// producer/consumer pipelines where many threads contend for the same
+	// queue. The capacity of the queue is fixed when it is created. Like a
+	// [CircularBuffer] this struct acts as a header that points to the actual
+	// data, so copies of a queue will share the same underlying values.
+	//
+	// The [MPMCQueue.PushBack] and [MPMCQueue.PopFront] methods never block,
+	// returning an error if the queue is full or empty respectively. The
+	// [MPMCQueue.BlockingPushBack] and [MPMCQueue.BlockingPopFront] methods
+	// wait until the operation can be performed or the supplied context is
+	// cancelled.
+	MPMCQueue[T any] struct {
+		q *mpmcQueueImpl[T]
+	}
+)
+
+func newMPMCQueueImpl[T any](capacity int) *mpmcQueueImpl[T] {
+	rv := &mpmcQueueImpl[T]{cells: make([]mpmcQueueCell[T], capacity)}
+	for i := 0; i < capacity; i++ {
+		rv.cells[i].seq.Store(2 * uint64(i))
+	}
+	return rv
+}
+
+// Creates a new MPMCQueue that can hold up to capacity values. Capacity must
+// be >= 0, an error will be returned if it is not.
+func NewMPMCQueue[T any](capacity int) (MPMCQueue[T], error) {
+	if capacity < 0 {
+		return MPMCQueue[T]{}, getSizeError(capacity)
+	}
+	return MPMCQueue[T]{q: newMPMCQueueImpl[T](capacity)}, nil
+}
+
+// Creates a new MPMCQueue and populates it with the supplied values. The queue
+// will have len(vals) capacity.
+func MPMCQueueValInit[T any](vals ...T) MPMCQueue[T] {
+	rv, _ := NewMPMCQueue[T](len(vals))
+	rv.PushBack(vals...)
+	return rv
+}
+
+// A empty pass through function that performs no action. The queue is lock
+// free so there is no lock to acquire. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (c *MPMCQueue[T]) Lock() {}
+
+// A empty pass through function that performs no action. The queue is lock
+// free so there is no lock to release. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (c *MPMCQueue[T]) Unlock() {}
+
+// A empty pass through function that performs no action. The queue is lock
+// free so there is no lock to acquire. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (c *MPMCQueue[T]) RLock() {}
+
+// A empty pass through function that performs no action. The queue is lock
+// free so there is no lock to release. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (c *MPMCQueue[T]) RUnlock() {}
+
+// Returns false, a MPMC queue is not addressable. Values may be popped and
+// overwritten by other goroutines at any time.
+func (c *MPMCQueue[T]) IsAddressable() bool { return false }
+
+// Returns true, a MPMC queue is safe to use from multiple goroutines.
+func (c *MPMCQueue[T]) IsSynced() bool { return true }
+
+// Description: Returns the capacity of the queue.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) Capacity() int {
+	return len(c.q.cells)
+}
+
+// Description: Returns the number of values in the queue. If other goroutines
+// are modifying the queue the returned value is only a snapshot and may be
+// out of date by the time it is used.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) Length() int {
+	// The dequeue position is loaded first. Both positions only ever increase
+	// and the dequeue position never passes the enqueue position, so this
+	// ordering guarantees the difference is never negative.
+	deq := c.q.dequeuePos.Load()
+	enq := c.q.enqueuePos.Load()
+	return min(int(enq-deq), len(c.q.cells))
+}
+
+// Description: Returns true if the queue has reached its capacity, false
+// otherwise. If other goroutines are modifying the queue the returned value is
+// only a snapshot and may be out of date by the time it is used.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) Full() bool {
+	return c.Length() == len(c.q.cells)
+}
+
+func (c *MPMCQueue[T]) tryPushBack(v *T) bool {
+	if len(c.q.cells) == 0 {
+		return false
+	}
+	tmp := *v
+	pos := c.q.enqueuePos.Load()
+	for {
+		cell := &c.q.cells[pos%uint64(len(c.q.cells))]
+		seq := cell.seq.Load()
+		if dif := int64(seq - 2*pos); dif == 0 {
+			if c.q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				cell.val.Store(&tmp)
+				cell.seq.Store(2*pos + 1)
+				return true
+			}
+			pos = c.q.enqueuePos.Load()
+		} else if dif < 0 {
+			// The cell still holds a value from the previous lap that has not
+			// been consumed, the queue is full.
+			return false
+		} else {
+			pos = c.q.enqueuePos.Load()
+		}
+	}
+}
+
+func (c *MPMCQueue[T]) tryPopFront(rv *T) bool {
+	if len(c.q.cells) == 0 {
+		return false
+	}
+	pos := c.q.dequeuePos.Load()
+	for {
+		cell := &c.q.cells[pos%uint64(len(c.q.cells))]
+		seq := cell.seq.Load()
+		if dif := int64(seq - (2*pos + 1)); dif == 0 {
+			if c.q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				*rv = *cell.val.Load()
+				// The pointer is cleared so the value can be garbage collected
+				// before the cell is reused.
+				cell.val.Store(nil)
+				cell.seq.Store(2 * (pos + uint64(len(c.q.cells))))
+				return true
+			}
+			pos = c.q.dequeuePos.Load()
+		} else if dif < 0 {
+			// The cell has not been written to yet, the queue is empty.
+			return false
+		} else {
+			pos = c.q.dequeuePos.Load()
+		}
+	}
+}
+
+// Description: Pushes the supplied values to the back of the queue in the
+// order they are given. This method never blocks. If the queue becomes full
+// before all of the values are pushed an error is returned and the remaining
+// values are not added. Values pushed by other goroutines may be interleaved
+// with the supplied values.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *MPMCQueue[T]) PushBack(v ...T) error {
+	for i := 0; i < len(v); i++ {
+		if !c.tryPushBack(&v[i]) {
+			return getFullError(len(c.q.cells))
+		}
+	}
+	return nil
+}
+
+// Description: Pushes the supplied values to the back of the queue, popping
+// values from the front of the queue as necessary to make room for them. If
+// the queue has a capacity of zero no values will be added.
+//
+// Time Complexity: O(m), where m=len(vals)
+func (c *MPMCQueue[T]) ForcePushBack(v ...T) {
+	if len(c.q.cells) == 0 {
+		return
+	}
+	var tmp T
+	for i := 0; i < len(v); i++ {
+		for !c.tryPushBack(&v[i]) {
+			c.tryPopFront(&tmp)
+		}
+	}
+}
+
+// Description: Pushes the supplied values to the back of the queue in the
+// order they are given, waiting for space to become available whenever the
+// queue is full. If ctx is cancelled before all of the values are pushed the
+// context's error is returned and the remaining values are not added.
+//
+// Time Complexity: O(m), where m=len(vals), not counting time spent waiting
+func (c *MPMCQueue[T]) BlockingPushBack(ctx context.Context, v ...T) error {
+	for i := 0; i < len(v); i++ {
+		var b mpmcQueueBackoff
+		for !c.tryPushBack(&v[i]) {
+			if err := b.wait(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Description: Removes and returns the value at the front of the queue. This
+// method never blocks. If the queue is empty an error is returned.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) PopFront() (T, error) {
+	var rv T
+	if !c.tryPopFront(&rv) {
+		return rv, getEmptyError()
+	}
+	return rv, nil
+}
+
+// Description: Removes and returns the value at the front of the queue,
+// waiting for a value to become available if the queue is empty. If ctx is
+// cancelled before a value is available the context's error is returned.
+//
+// Time Complexity: O(1), not counting time spent waiting
+func (c *MPMCQueue[T]) BlockingPopFront(ctx context.Context) (T, error) {
+	var rv T
+	var b mpmcQueueBackoff
+	for !c.tryPopFront(&rv) {
+		if err := b.wait(ctx); err != nil {
+			return rv, err
+		}
+	}
+	return rv, nil
+}
+
+// Description: Returns the value at the front of the queue if one is present.
+// If the queue has no elements then an error is returned. The value is not
+// removed, so it may be popped by another goroutine at any time.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) PeekFront() (T, error) {
+	if v := c.peekImpl(); v != nil {
+		return *v, nil
+	}
+	var tmp T
+	return tmp, getIndexOutOfBoundsError(0, 0, 0)
+}
+
+// Description: Returns a pointer to a copy of the value at the front of the
+// queue if one is present. If the queue has no elements then an error is
+// returned. A MPMC queue is not addressable, so modifying the value through
+// the returned pointer will not modify the value in the queue.
+//
+// Time Complexity: O(1)
+func (c *MPMCQueue[T]) PeekPntrFront() (*T, error) {
+	if v := c.peekImpl(); v != nil {
+		tmp := *v
+		return &tmp, nil
+	}
+	return nil, getIndexOutOfBoundsError(0, 0, 0)
+}
+
+func (c *MPMCQueue[T]) peekImpl() *T {
+	if len(c.q.cells) == 0 {
+		return nil
+	}
+	for {
+		pos := c.q.dequeuePos.Load()
+		cell := &c.q.cells[pos%uint64(len(c.q.cells))]
+		seq := cell.seq.Load()
+		if dif := int64(seq - (2*pos + 1)); dif < 0 {
+			return nil
+		} else if dif > 0 {
+			continue
+		}
+		v := cell.val.Load()
+		// If the dequeue position has not moved then the value could not have
+		// been popped, and therefore could not have been overwritten, between
+		// reading the sequence number and reading the value.
+		if v != nil && c.q.dequeuePos.Load() == pos {
+			return v
+		}
+	}
+}
+
+// Description: Removes all values from the queue. Values that are pushed by
+// other goroutines while the queue is being cleared may or may not be removed.
+//
+// Time Complexity: O(n)
+func (c *MPMCQueue[T]) Clear() {
+	var tmp T
+	for c.tryPopFront(&tmp) {
+	}
+}
+
+// Returns a snapshot of the values in the queue, starting at the front. The
+// snapshot is only guaranteed to be consistent if no other goroutines are
+// modifying the queue.
+func (c *MPMCQueue[T]) snapshot() []T {
+	deq := c.q.dequeuePos.Load()
+	enq := c.q.enqueuePos.Load()
+	rv := make([]T, 0, min(int(enq-deq), len(c.q.cells)))
+	for pos := deq; pos < enq && len(rv) < len(c.q.cells); pos++ {
+		cell := &c.q.cells[pos%uint64(len(c.q.cells))]
+		if cell.seq.Load() != 2*pos+1 {
+			break
+		}
+		v := cell.val.Load()
+		if v == nil {
+			break
+		}
+		rv = append(rv, *v)
+	}
+	return rv
+}
+
+type mpmcQueueBackoff struct {
+	spins int
+	sleep time.Duration
+}
+
+// Waits before the next attempt of a blocking operation, first by yielding to
+// the scheduler and then by sleeping for exponentially longer durations. The
+// context's error is returned if it is cancelled.
+func (b *mpmcQueueBackoff) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if b.spins < mpmcQueueMaxSpins {
+		b.spins++
+		runtime.Gosched()
+		return nil
+	}
+	if b.sleep == 0 {
+		b.sleep = time.Microsecond
+	} else {
+		b.sleep = min(2*b.sleep, mpmcQueueMaxSleep)
+	}
+	t := time.NewTimer(b.sleep)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func (c *MPMCQueue[T]) toSerialized() serializedCircularBuffer[T] {
+	return serializedCircularBuffer[T]{
+		Capacity: len(c.q.cells),
+		Vals:     c.snapshot(),
+	}
+}
+
+func (c *MPMCQueue[T]) fromSerialized(s serializedCircularBuffer[T]) error {
+	if s.Capacity < 0 {
+		return getSizeError(s.Capacity)
+	}
+	if len(s.Vals) > s.Capacity {
+		return getFullError(s.Capacity)
+	}
+	q := MPMCQueue[T]{q: newMPMCQueueImpl[T](s.Capacity)}
+	q.PushBack(s.Vals...)
+	c.q = q.q
+	return nil
+}
+
+// Description: Returns the JSON encoding of the queue. The capacity of the
+// queue is encoded along with its values, starting at the front. The encoding
+// is only guaranteed to be consistent if no other goroutines are modifying the
+// queue.
+//
+// Time Complexity: O(n)
+func (c *MPMCQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.toSerialized())
+}
+
+// Description: Replaces the contents of the queue with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [MPMCQueue.MarshalJSON]. If an error is returned the queue is left
+// unchanged. This method must not be called while other goroutines are using
+// the queue.
+//
+// Time Complexity: O(n)
+func (c *MPMCQueue[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Description: Returns the binary encoding of the queue. The capacity of the
+// queue is encoded along with its values, starting at the front. The encoding
+// is only guaranteed to be consistent if no other goroutines are modifying the
+// queue.
+//
+// Time Complexity: O(n)
+func (c *MPMCQueue[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c.toSerialized())
+}
+
+// Description: Replaces the contents of the queue with the values decoded
+// from the supplied binary data, which must be in the format produced by
+// [MPMCQueue.MarshalBinary]. If an error is returned the queue is left
+// unchanged. This method must not be called while other goroutines are using
+// the queue.
+//
+// Time Complexity: O(n)
+func (c *MPMCQueue[T]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedCircularBuffer[T]](data)
+	if err != nil {
+		return err
+	}
+	return c.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (c MPMCQueue[T]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("mpmcQueue["))
+	vals := c.snapshot()
+	for i := 0; i < len(vals); i++ {
+		fmt.Fprintf(f, fmtStr, vals[i])
+		if i+1 < len(vals) {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (c *MPMCQueue[T]) String() string {
+	return fmt.Sprintf("%v", c)
+}
@@ -0,0 +1,90 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/staticContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func MPMCQueueToQueueInterfaceFactory(capacity int) staticContainers.Queue[int] {
+	v := generateMPMCQueue(capacity)
+	var rv staticContainers.Queue[int] = &v
+	return rv
+}
+
+func TestMPMCQueue_StaticQueueInterfaceStaticCapacity(t *testing.T) {
+	tests.StaticQueueInterfaceStaticCapacity(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceLengthInterface(t *testing.T) {
+	tests.StaticQueueInterfaceLengthInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceCapacityInterface(t *testing.T) {
+	tests.StaticQueueInterfaceCapacityInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceClearInterface(t *testing.T) {
+	tests.StaticQueueInterfaceClearInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceFirstElemReadInterface(t *testing.T) {
+	tests.StaticQueueInterfaceFirstElemReadInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceFirstElemDeleteInterface(t *testing.T) {
+	tests.StaticQueueInterfaceFirstElemDeleteInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceLastElemWriteInterface(t *testing.T) {
+	tests.StaticQueueInterfaceLastElemWriteInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_ReadStaticQueueInterface(t *testing.T) {
+	tests.ReadStaticQueueInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_WriteStaticQueueInterface(t *testing.T) {
+	tests.WriteStaticQueueInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceInterface(t *testing.T) {
+	tests.StaticQueueInterfaceInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticQueueInterfaceSerializableInterface(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceClear(t *testing.T) {
+	tests.StaticQueueInterfaceClear(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfacePeekPntrFront(t *testing.T) {
+	tests.StaticQueueInterfacePeekPntrFront(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfacePeekFront(t *testing.T) {
+	tests.StaticQueueInterfacePeekFront(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfacePopFront(t *testing.T) {
+	tests.StaticQueueInterfacePopFront(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfacePushBack(t *testing.T) {
+	tests.StaticQueueInterfacePushBack(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceForcePushBack(t *testing.T) {
+	tests.StaticQueueInterfaceForcePushBack(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceJSON(t *testing.T) {
+	tests.StaticQueueInterfaceJSON(MPMCQueueToQueueInterfaceFactory, t)
+}
+
+func TestMPMCQueue_StaticQueueInterfaceBinary(t *testing.T) {
+	tests.StaticQueueInterfaceBinary(MPMCQueueToQueueInterfaceFactory, t)
+}
@@ -0,0 +1,217 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=MPMCQueue -category=static -interface=Queue -genericDecl=[int] -factory=generateMPMCQueue
+
+func generateMPMCQueue(capacity int) MPMCQueue[int] {
+	q, _ := NewMPMCQueue[int](capacity)
+	return q
+}
+
+func TestMPMCQueueNewErrors(t *testing.T) {
+	_, err := NewMPMCQueue[int](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	q, err := NewMPMCQueue[int](0)
+	test.Nil(err, t)
+	test.ContainsError(containerTypes.Full, q.PushBack(1), t)
+	q.ForcePushBack(1)
+	_, err = q.PopFront()
+	test.ContainsError(containerTypes.Empty, err, t)
+	test.True(q.Full(), t)
+}
+
+func TestMPMCQueueValInit(t *testing.T) {
+	q := MPMCQueueValInit[int](1, 2, 3)
+	test.Eq(3, q.Capacity(), t)
+	test.Eq(3, q.Length(), t)
+	test.True(q.Full(), t)
+	for i := 1; i <= 3; i++ {
+		v, err := q.PopFront()
+		test.Nil(err, t)
+		test.Eq(i, v, t)
+	}
+}
+
+func TestMPMCQueueWrapsAround(t *testing.T) {
+	q, _ := NewMPMCQueue[int](3)
+	for i := 0; i < 100; i++ {
+		test.Nil(q.PushBack(i, i+1), t)
+		v, err := q.PeekFront()
+		test.Nil(err, t)
+		test.Eq(i, v, t)
+		v, err = q.PopFront()
+		test.Nil(err, t)
+		test.Eq(i, v, t)
+		v, err = q.PopFront()
+		test.Nil(err, t)
+		test.Eq(i+1, v, t)
+		test.Eq(0, q.Length(), t)
+	}
+}
+
+func TestMPMCQueuePartialPushBack(t *testing.T) {
+	q, _ := NewMPMCQueue[int](3)
+	test.ContainsError(containerTypes.Full, q.PushBack(1, 2, 3, 4), t)
+	test.Eq(3, q.Length(), t)
+	test.Eq("mpmcQueue[1 2 3]", q.String(), t)
+}
+
+func TestMPMCQueueBlockingPopFrontCancelled(t *testing.T) {
+	q, _ := NewMPMCQueue[int](2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := q.BlockingPopFront(ctx)
+	test.ContainsError(context.DeadlineExceeded, err, t)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	test.Nil(q.PushBack(1), t)
+	_, err = q.BlockingPopFront(ctx)
+	test.Nil(err, t)
+}
+
+func TestMPMCQueueBlockingPushBackCancelled(t *testing.T) {
+	q, _ := NewMPMCQueue[int](2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := q.BlockingPushBack(ctx, 1, 2, 3)
+	test.ContainsError(context.DeadlineExceeded, err, t)
+	test.Eq(2, q.Length(), t)
+	v, err := q.PeekFront()
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+}
+
+func TestMPMCQueueBlockingHandoff(t *testing.T) {
+	q, _ := NewMPMCQueue[int](1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			v, err := q.BlockingPopFront(context.Background())
+			test.Nil(err, t)
+			test.Eq(i, v, t)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		test.Nil(q.BlockingPushBack(context.Background(), i), t)
+	}
+	<-done
+	test.Eq(0, q.Length(), t)
+}
+
+func TestMPMCQueueFormat(t *testing.T) {
+	q, _ := NewMPMCQueue[int](5)
+	test.Eq("mpmcQueue[]", fmt.Sprintf("%v", q), t)
+	q.ForcePushBack(1, 2, 3, 4, 5, 6)
+	test.Eq("mpmcQueue[2 3 4 5 6]", fmt.Sprintf("%v", q), t)
+	test.Eq("mpmcQueue[2 3 4 5 6]", q.String(), t)
+}
+
+// Meant to be run with the race detector enabled. Every value that is pushed
+// must be popped exactly once.
+func TestMPMCQueueConcurrentStress(t *testing.T) {
+	const numProducers = 8
+	const numConsumers = 8
+	const perProducer = 2000
+	q, _ := NewMPMCQueue[int](16)
+	var producers sync.WaitGroup
+	var consumers sync.WaitGroup
+	seen := make([][]int, numConsumers)
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := 0; i < numConsumers; i++ {
+		consumers.Add(1)
+		go func(i int) {
+			defer consumers.Done()
+			for {
+				v, err := q.BlockingPopFront(ctx)
+				if err != nil {
+					return
+				}
+				seen[i] = append(seen[i], v)
+				q.PeekFront()
+				q.Length()
+			}
+		}(i)
+	}
+	for i := 0; i < numProducers; i++ {
+		producers.Add(1)
+		go func(i int) {
+			defer producers.Done()
+			for j := 0; j < perProducer; j++ {
+				if j%2 == 0 {
+					test.Nil(
+						q.BlockingPushBack(context.Background(), i*perProducer+j),
+						t,
+					)
+				} else {
+					for q.PushBack(i*perProducer+j) != nil {
+					}
+				}
+			}
+		}(i)
+	}
+	producers.Wait()
+	for q.Length() > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	consumers.Wait()
+
+	counts := make([]int, numProducers*perProducer)
+	for i := 0; i < numConsumers; i++ {
+		last := make([]int, numProducers)
+		for j := range last {
+			last[j] = -1
+		}
+		for _, v := range seen[i] {
+			counts[v]++
+			// Values from a single producer must be popped in the order they
+			// were pushed.
+			test.True(v%perProducer > last[v/perProducer], t)
+			last[v/perProducer] = v % perProducer
+		}
+	}
+	for i := 0; i < len(counts); i++ {
+		test.Eq(1, counts[i], t)
+	}
+}
+
+func benchmarkQueueParallelHelper(b *testing.B, q interface {
+	PushBack(v ...int) error
+	PopFront() (int, error)
+}) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				q.PushBack(i)
+			} else {
+				q.PopFront()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncedCircularBufferParallel(b *testing.B) {
+	q, _ := NewSyncedCircularBuffer[int, widgets.BuiltinInt](1024)
+	benchmarkQueueParallelHelper(b, &q)
+}
+
+func BenchmarkMPMCQueueParallel(b *testing.B) {
+	q, _ := NewMPMCQueue[int](1024)
+	benchmarkQueueParallelHelper(b, &q)
+}
@@ -0,0 +1,956 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a map where a single key can be associated with many
+	// values. Internally a hash map from keys to vectors of values is
+	// maintained, along with the total number of key value pairs. The values
+	// for a key are kept in the order that they were added and the same value
+	// may be associated with a key more than once. Keys that no longer have any
+	// values associated with them are removed from the multi map. The type
+	// constraints on the generics define the logic for how value specific
+	// operations, such as equality comparisons, will be handled.
+	MultiMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		vals   HashMap[K, Vector[V, VI], KI, *Vector[V, VI]]
+		length int
+	}
+
+	// A synchronized version of MultiMap. All operations will be wrapped in the
+	// appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedMultiMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		MultiMap[K, V, KI, VI]
+	}
+)
+
+// Creates a new multi map initialized with enough memory to hold size keys.
+// Size must be >= 0, an error will be returned if it is not. If size is 0 the
+// map will be initialized with 0 keys.
+func NewMultiMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](size int) (MultiMap[K, V, KI, VI], error) {
+	if size < 0 {
+		return MultiMap[K, V, KI, VI]{}, getSizeError(size)
+	}
+	vals, _ := NewHashMap[K, Vector[V, VI], KI, *Vector[V, VI]](size)
+	return MultiMap[K, V, KI, VI]{vals: vals}, nil
+}
+
+// Creates a new synced multi map initialized with enough memory to hold size
+// keys. Size must be >= 0, an error will be returned if it is not. If size is 0
+// the map will be initialized with 0 keys. The underlying RWMutex value will be
+// fully unlocked upon initialization.
+func NewSyncedMultiMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](size int) (SyncedMultiMap[K, V, KI, VI], error) {
+	rv, err := NewMultiMap[K, V, KI, VI](size)
+	return SyncedMultiMap[K, V, KI, VI]{
+		RWMutex:  &sync.RWMutex{},
+		MultiMap: rv,
+	}, err
+}
+
+// Converts the supplied multi map to a synchronized multi map. Beware: The
+// original non-synced multi map will remain useable.
+func (m *MultiMap[K, V, KI, VI]) ToSynced() SyncedMultiMap[K, V, KI, VI] {
+	return SyncedMultiMap[K, V, KI, VI]{
+		RWMutex:  &sync.RWMutex{},
+		MultiMap: *m,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (m *MultiMap[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (m *MultiMap[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (m *MultiMap[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (m *MultiMap[K, V, KI, VI]) RUnlock() {}
+
+// The SyncedMultiMap method to override the MultiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedMultiMap[K, V, KI, VI]) Lock() { m.RWMutex.Lock() }
+
+// The SyncedMultiMap method to override the MultiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedMultiMap[K, V, KI, VI]) Unlock() { m.RWMutex.Unlock() }
+
+// The SyncedMultiMap method to override the MultiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedMultiMap[K, V, KI, VI]) RLock() { m.RWMutex.RLock() }
+
+// The SyncedMultiMap method to override the MultiMap pass through function and
+// actually apply the mutex operation.
+func (m *SyncedMultiMap[K, V, KI, VI]) RUnlock() { m.RWMutex.RUnlock() }
+
+// Returns false, multi maps are not addressable.
+func (m *MultiMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, a multi map is not synced.
+func (m *MultiMap[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced multi map is synced.
+func (m *SyncedMultiMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the total number of key value pairs in the multi map.
+//
+// Time Complexity: O(1)
+func (m *MultiMap[K, V, KI, VI]) Length() int {
+	return m.length
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedMultiMap[K, V, KI, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.Length()
+}
+
+// Description: Returns the number of unique keys in the multi map.
+//
+// Time Complexity: O(1)
+func (m *MultiMap[K, V, KI, VI]) NumKeys() int {
+	return m.vals.Length()
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.NumKeys] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedMultiMap[K, V, KI, VI]) NumKeys() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.NumKeys()
+}
+
+// Description: Returns the number of values that are associated with the
+// supplied key. Returns 0 if the key is not in the multi map.
+//
+// Time Complexity: O(1)
+func (m *MultiMap[K, V, KI, VI]) Count(k K) int {
+	if h, ok := m.vals.getHashPosition(&k); ok {
+		return len(m.vals.internalHashMapImpl[h].B)
+	}
+	return 0
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Count] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedMultiMap[K, V, KI, VI]) Count(k K) int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.Count(k)
+}
+
+// Description: ContainsKey will return true if the supplied key is in the
+// multi map, false otherwise. All equality comparisons are performed by the
+// generic KI widget type that the multi map was initialized with.
+//
+// Time Complexity: O(1)
+func (m *MultiMap[K, V, KI, VI]) ContainsKey(k K) bool {
+	_, found := m.vals.getHashPosition(&k)
+	return found
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.ContainsKey] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedMultiMap[K, V, KI, VI]) ContainsKey(k K) bool {
+	m.RLock()
+	defer m.RUnlock()
+	_, found := m.vals.getHashPosition(&k)
+	return found
+}
+
+// Description: ContainsPair will return true if the supplied value is
+// associated with the supplied key, false otherwise. All equality comparisons
+// are performed by the generic KI and VI widget types that the multi map was
+// initialized with.
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *MultiMap[K, V, KI, VI]) ContainsPair(k K, v V) bool {
+	if h, ok := m.vals.getHashPosition(&k); ok {
+		vals := m.vals.internalHashMapImpl[h].B
+		return vals.ContainsPntr(&v)
+	}
+	return false
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.ContainsPair] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *SyncedMultiMap[K, V, KI, VI]) ContainsPair(k K, v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.ContainsPair(k, v)
+}
+
+// Description: Contains will return true if the supplied value is associated
+// with any key in the multi map, false otherwise. All equality comparisons are
+// performed by the generic VI widget type that the multi map was initialized
+// with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *MultiMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedMultiMap[K, V, KI, VI]) Contains(v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is
+// associated with any key in the multi map, false otherwise. All equality
+// comparisons are performed by the generic VI widget type that the multi map
+// was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *MultiMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	for _, iterV := range m.vals.internalHashMapImpl {
+		if iterV.B.ContainsPntr(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedMultiMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.ContainsPntr(v)
+}
+
+// Description: Returns an iterator that iterates over the values that are
+// associated with the supplied key in the order that they were added. If the
+// key is not in the multi map the returned iterator will not produce any
+// values. The key is not looked up until the iterator starts to be consumed.
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *MultiMap[K, V, KI, VI]) Get(k K) iter.Iter[V] {
+	return m.getImpl(&k)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [MultiMap.Get] method such that a read lock will be placed on the underlying
+// multi map when the iterator is consumed. The multi map will have a read lock
+// the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *SyncedMultiMap[K, V, KI, VI]) Get(k K) iter.Iter[V] {
+	return m.MultiMap.getImpl(&k).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+func (m *MultiMap[K, V, KI, VI]) getImpl(k *K) iter.Iter[V] {
+	var vals iter.Iter[V]
+	return func(f iter.IteratorFeedback) (V, error, bool) {
+		if vals == nil {
+			if h, ok := m.vals.getHashPosition(k); ok {
+				vals = iter.SliceElems[V](m.vals.internalHashMapImpl[h].B)
+			} else {
+				vals = iter.NoElem[V]()
+			}
+		}
+		return vals(f)
+	}
+}
+
+// Description: Returns an iterator that iterates over the keys of the multi
+// map. Each key will only be produced once regardless of how many values are
+// associated with it.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.vals.Keys()
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [MultiMap.Keys] method such that a read lock will be placed on the underlying
+// multi map when the iterator is consumed. The multi map will have a read lock
+// the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.MultiMap.Keys().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the multi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [MultiMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the multi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedMultiMap.Keys], so the multi map will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over all of the values in the
+// multi map. The values associated with a single key will be produced
+// together in the order they were added, but the order of the keys is not
+// defined.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return iter.Map[basic.Pair[K, V], V](
+		m.pairs(),
+		func(index int, val basic.Pair[K, V]) (V, error) { return val.B, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [MultiMap.Vals] method such that a read lock will be placed on the underlying
+// multi map when the iterator is consumed. The multi map will have a read lock
+// the entire time the iteration is being performed. The lock will not be
+// applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return m.MultiMap.Vals().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the multi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [MultiMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the multi map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedMultiMap.Vals], so the multi map will have a read
+// lock the entire time the loop is running. The lock is released once the loop
+// ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the multi map
+// that can be used in a for-range loop. A key is yielded once for every value
+// that is associated with it.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the multi map
+// that can be used in a for-range loop. The multi map will have a read lock the
+// entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.MultiMap.pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	))
+}
+
+// Returns an iterator over every key value pair in the multi map, flattening
+// the values that are associated with each key.
+func (m *MultiMap[K, V, KI, VI]) pairs() iter.Iter[basic.Pair[K, V]] {
+	pairs := iter.MapVals[hash.Hash, basic.Pair[K, Vector[V, VI]]](
+		m.vals.internalHashMapImpl,
+	)
+	var cur basic.Pair[K, Vector[V, VI]]
+	i := 0
+	return func(f iter.IteratorFeedback) (basic.Pair[K, V], error, bool) {
+		var tmp basic.Pair[K, V]
+		if f == iter.Break {
+			_, err, _ := pairs(iter.Break)
+			return tmp, err, false
+		}
+		for i >= len(cur.B) {
+			next, err, cont := pairs(f)
+			if err != nil || !cont {
+				return tmp, err, false
+			}
+			cur, i = next, 0
+		}
+		i++
+		return basic.Pair[K, V]{A: cur.A, B: cur.B[i-1]}, nil, true
+	}
+}
+
+// Panics, a multi map is not addressable.
+func (m *MultiMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("multi map"))
+}
+
+// Description: Adds the supplied key value pairs to the multi map. The values
+// are appended to the end of the values that are already associated with each
+// key, meaning that adding a pair never replaces an existing value. The same
+// value may be associated with a key more than once. The pairs will be added
+// in the order that they are given.
+//
+// Time Complexity: O(m), where m=len(kvPairs)
+func (m *MultiMap[K, V, KI, VI]) Add(kvPairs ...basic.Pair[K, V]) error {
+	return m.addImpl(kvPairs)
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Add] implementation method. The
+// [MultiMap.Add] method is not called directly to avoid copying the kvPairs
+// varargs twice, which could be expensive with a large types for the K or V
+// generics or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m=len(kvPairs)
+func (m *SyncedMultiMap[K, V, KI, VI]) Add(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.addImpl(kvPairs)
+}
+
+func (m *MultiMap[K, V, KI, VI]) addImpl(kvPairs []basic.Pair[K, V]) error {
+	for i := 0; i < len(kvPairs); i++ {
+		if h, found := m.vals.getHashPosition(&kvPairs[i].A); found {
+			// The pair is re-assigned directly rather than through
+			// HashMap.Emplace because emplace would zero the old vector, which
+			// shares its backing array with the new one.
+			p := m.vals.internalHashMapImpl[h]
+			p.B = append(p.B, kvPairs[i].B)
+			m.vals.internalHashMapImpl[h] = p
+		} else {
+			m.vals.Emplace(basic.Pair[K, Vector[V, VI]]{
+				A: kvPairs[i].A,
+				B: Vector[V, VI]{kvPairs[i].B},
+			})
+		}
+		m.length++
+	}
+	return nil
+}
+
+// Description: Pop will remove all occurrences of the supplied value from the
+// multi map, regardless of which key they are associated with. Any keys that
+// are left without values will be removed. The number of removed key value
+// pairs is returned. All equality comparisons are performed by the generic VI
+// widget type that the multi map was initialized with.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) Pop(v V) int {
+	return m.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Pop] implementation method. The
+// [MultiMap.Pop] method is not called directly to avoid copying the v argument
+// twice, which could be expensive with a large type for the V generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) Pop(v V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.popImpl(&v)
+}
+
+// Description: PopPntr will remove all occurrences of the supplied value from
+// the multi map, regardless of which key they are associated with. Any keys
+// that are left without values will be removed. The number of removed key
+// value pairs is returned. All equality comparisons are performed by the
+// generic VI widget type that the multi map was initialized with.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) PopPntr(v *V) int {
+	return m.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.PopPntr] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.popImpl(v)
+}
+
+func (m *MultiMap[K, V, KI, VI]) popImpl(v *V) int {
+	rv := 0
+	emptyKeys := []K{}
+	for h, iterV := range m.vals.internalHashMapImpl {
+		if num := iterV.B.PopPntr(v); num > 0 {
+			rv += num
+			m.vals.internalHashMapImpl[h] = iterV
+			if len(iterV.B) == 0 {
+				emptyKeys = append(emptyKeys, iterV.A)
+			}
+		}
+	}
+	// Keys are removed after iterating because removing a key can move other
+	// keys within their collision chains.
+	for i := 0; i < len(emptyKeys); i++ {
+		m.vals.deleteImpl(&emptyKeys[i])
+	}
+	m.length -= rv
+	return rv
+}
+
+// Description: Deletes the supplied key and all of the values associated with
+// it. Returns a [containerTypes.KeyError] if the key is not found in the multi
+// map.
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *MultiMap[K, V, KI, VI]) Delete(k K) error {
+	return m.deleteImpl(&k)
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *SyncedMultiMap[K, V, KI, VI]) Delete(k K) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.deleteImpl(&k)
+}
+
+func (m *MultiMap[K, V, KI, VI]) deleteImpl(k *K) error {
+	if h, found := m.vals.getHashPosition(k); found {
+		m.length -= len(m.vals.internalHashMapImpl[h].B)
+		m.vals.removeSingleValue(h)
+		return nil
+	}
+	return getKeyError[K](k)
+}
+
+// Description: Deletes a single occurrence of the supplied key value pair. If
+// the value is associated with the key more than once only the first
+// occurrence is removed. If the key is left without any values it is removed.
+// Returns a [containerTypes.KeyError] if the key is not found in the multi map
+// and a [containerTypes.ValueError] if the value is not associated with the
+// key.
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *MultiMap[K, V, KI, VI]) DeletePair(k K, v V) error {
+	return m.deletePairImpl(&k, &v)
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.DeletePair] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m), where m is the number of values associated with the
+// key
+func (m *SyncedMultiMap[K, V, KI, VI]) DeletePair(k K, v V) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.deletePairImpl(&k, &v)
+}
+
+func (m *MultiMap[K, V, KI, VI]) deletePairImpl(k *K, v *V) error {
+	h, found := m.vals.getHashPosition(k)
+	if !found {
+		return getKeyError[K](k)
+	}
+	p := m.vals.internalHashMapImpl[h]
+	idx, found := p.B.KeyOfPntr(v)
+	if !found {
+		return getValueError[V](v)
+	}
+	if len(p.B) == 1 {
+		m.vals.removeSingleValue(h)
+	} else {
+		p.B.Delete(idx)
+		m.vals.internalHashMapImpl[h] = p
+	}
+	m.length--
+	return nil
+}
+
+// Description: Clears all keys and values from the multi map.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) Clear() {
+	m.vals.Clear()
+	m.length = 0
+}
+
+// Description: Places a write lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.MultiMap.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Returns true if l and r have the same keys and each key is
+// associated with the same values the same number of times, false otherwise.
+// The order that the values were added in is not considered.
+func (_ *MultiMap[K, V, KI, VI]) Eq(
+	l *MultiMap[K, V, KI, VI],
+	r *MultiMap[K, V, KI, VI],
+) bool {
+	if l.length != r.length || l.vals.Length() != r.vals.Length() {
+		return false
+	}
+	for _, lV := range l.vals.internalHashMapImpl {
+		rH, found := r.vals.getHashPosition(&lV.A)
+		if !found || !multiMapValsEq(lV.B, r.vals.internalHashMapImpl[rH].B) {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// [MultiMap.Eq] method.
+func (_ *SyncedMultiMap[K, V, KI, VI]) Eq(
+	l *SyncedMultiMap[K, V, KI, VI],
+	r *SyncedMultiMap[K, V, KI, VI],
+) bool {
+	r.RLock()
+	l.RLock()
+	defer r.RUnlock()
+	defer l.RUnlock()
+	return l.MultiMap.Eq(&l.MultiMap, &r.MultiMap)
+}
+
+func multiMapValsEq[V any, VI widgets.BaseInterface[V]](
+	l Vector[V, VI],
+	r Vector[V, VI],
+) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	counts, _ := NewHashMap[V, int, VI, widgets.BuiltinInt](len(l))
+	for i := 0; i < len(l); i++ {
+		cnt, _ := counts.Get(l[i])
+		counts.Emplace(basic.Pair[V, int]{A: l[i], B: cnt + 1})
+	}
+	for i := 0; i < len(r); i++ {
+		cnt, err := counts.Get(r[i])
+		if err != nil || cnt == 0 {
+			return false
+		}
+		counts.Emplace(basic.Pair[V, int]{A: r[i], B: cnt - 1})
+	}
+	return true
+}
+
+// A function that returns a hash of a multi map. The hashes of the values
+// associated with each key are summed so that the order the values were added
+// in does not change the hash while still accounting for values that are
+// associated with a key more than once. This makes the hash represent the same
+// equality operation that [MultiMap.Eq] provides.
+func (_ *MultiMap[K, V, KI, VI]) Hash(other *MultiMap[K, V, KI, VI]) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	kw := widgets.Base[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	for _, iterV := range other.vals.internalHashMapImpl {
+		var valsH hash.Hash
+		for i := 0; i < len(iterV.B); i++ {
+			valsH += vw.Hash(&iterV.B[i])
+		}
+		iterH := kw.Hash(&iterV.A).Combine(valsH)
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.CombineUnordered(iterH)
+		}
+	}
+	return rv
+}
+
+// Places a read lock on the underlying multi map of other and then calls
+// others underlying multi maps [MultiMap.Hash] method.
+func (_ *SyncedMultiMap[K, V, KI, VI]) Hash(
+	other *SyncedMultiMap[K, V, KI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.MultiMap.Hash(&other.MultiMap)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [MultiMap.Clear].
+func (_ *MultiMap[K, V, KI, VI]) Zero(other *MultiMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedMultiMap.Clear].
+func (_ *SyncedMultiMap[K, V, KI, VI]) Zero(other *SyncedMultiMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+func (m *MultiMap[K, V, KI, VI]) toSerialized() []serializedKV[K, []V] {
+	rv := make([]serializedKV[K, []V], 0, m.vals.Length())
+	for _, v := range m.vals.internalHashMapImpl {
+		rv = append(rv, serializedKV[K, []V]{Key: v.A, Val: []V(v.B)})
+	}
+	return rv
+}
+
+func (m *MultiMap[K, V, KI, VI]) fromSerialized(
+	kvs []serializedKV[K, []V],
+) error {
+	m.Clear()
+	for _, kv := range kvs {
+		for _, v := range kv.Val {
+			m.addImpl([]basic.Pair[K, V]{{A: kv.Key, B: v}})
+		}
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the multi map. The multi map is
+// encoded as a list of objects in no particular order, where each object
+// contains a key and the list of values associated with it. If a key is
+// present more than once when decoding the values are combined.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.MarshalJSON()
+}
+
+// Description: Replaces the contents of the multi map with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [MultiMap.MarshalJSON]. If an error is returned the multi map is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, []V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying multi map while its contents are replaced. Exhibits the same
+// behavior as [MultiMap.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, []V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the multi map. The values
+// are laid out the same way as [MultiMap.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying multi map and then calls
+// the underlying multi maps [MultiMap.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.MultiMap.MarshalBinary()
+}
+
+// Description: Replaces the contents of the multi map with the values decoded
+// from the supplied binary data, which must have been produced by
+// [MultiMap.MarshalBinary]. If an error is returned the multi map is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (m *MultiMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, []V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying multi map while its contents are replaced. Exhibits the
+// same behavior as [MultiMap.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedMultiMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, []V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.MultiMap.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m MultiMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("multiMap["))
+	cntr := 0
+	for _, v := range m.vals.internalHashMapImpl {
+		fmt.Fprintf(f, fmtStr, v.A)
+		f.Write([]byte(":["))
+		for i := 0; i < len(v.B); i++ {
+			fmt.Fprintf(f, fmtStr, v.B[i])
+			if i+1 < len(v.B) {
+				f.Write([]byte{' '})
+			}
+		}
+		f.Write([]byte{']'})
+		cntr++
+		if cntr < len(m.vals.internalHashMapImpl) {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *MultiMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
@@ -0,0 +1,126 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func MultiMapToMultiMapInterfaceFactory(capacity int) dynamicContainers.MultiMap[int, int] {
+	v := generateMultiMap(capacity)
+	var rv dynamicContainers.MultiMap[int, int] = &v
+	return rv
+}
+
+func TestMultiMap_DynMultiMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceSyncableInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceAddressableInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceLengthInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceClearInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceReadOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceReadMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceReadMultiKeyedOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceWriteMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceWriteMultiKeyedOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteKeyedOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceDeleteMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteMultiKeyedOpsInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_ReadDynMultiMapInterface(t *testing.T) {
+	tests.ReadDynMultiMapInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_WriteDynMultiMapInterface(t *testing.T) {
+	tests.WriteDynMultiMapInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceSerializableInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceStaticCapacityInterface(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceAdd(t *testing.T) {
+	tests.DynMultiMapInterfaceAdd(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceGet(t *testing.T) {
+	tests.DynMultiMapInterfaceGet(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceContainsKey(t *testing.T) {
+	tests.DynMultiMapInterfaceContainsKey(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceContains(t *testing.T) {
+	tests.DynMultiMapInterfaceContains(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfacePop(t *testing.T) {
+	tests.DynMultiMapInterfacePop(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceDelete(t *testing.T) {
+	tests.DynMultiMapInterfaceDelete(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceDeletePair(t *testing.T) {
+	tests.DynMultiMapInterfaceDeletePair(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceClear(t *testing.T) {
+	tests.DynMultiMapInterfaceClear(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceKeys(t *testing.T) {
+	tests.DynMultiMapInterfaceKeys(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceVals(t *testing.T) {
+	tests.DynMultiMapInterfaceVals(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMultiMapInterfaceValPntrs(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceJSON(t *testing.T) {
+	tests.DynMultiMapInterfaceJSON(MultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestMultiMap_DynMultiMapInterfaceBinary(t *testing.T) {
+	tests.DynMultiMapInterfaceBinary(MultiMapToMultiMapInterfaceFactory, t)
+}
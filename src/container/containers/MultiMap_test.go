@@ -0,0 +1,213 @@
+package containers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=MultiMap -category=dynamic -interface=MultiMap -genericDecl=[int,int] -factory=generateMultiMap
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedMultiMap -category=dynamic -interface=MultiMap -genericDecl=[int,int] -factory=generateSyncedMultiMap
+
+func generateMultiMap(capacity int) MultiMap[int, int, badBuiltinInt, widgets.BuiltinInt] {
+	m, _ := NewMultiMap[int, int, badBuiltinInt, widgets.BuiltinInt](capacity)
+	return m
+}
+
+func generateSyncedMultiMap(capacity int) SyncedMultiMap[
+	int,
+	int,
+	badBuiltinInt,
+	widgets.BuiltinInt,
+] {
+	m, _ := NewSyncedMultiMap[int, int, badBuiltinInt, widgets.BuiltinInt](capacity)
+	return m
+}
+
+func TestMultiMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[MultiMap[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v, _ := NewMultiMap[string, string, widgets.BuiltinString, widgets.BuiltinString](0)
+	widget = &v
+	_ = widget
+}
+
+func TestMultiMapNewError(t *testing.T) {
+	_, err := NewMultiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedMultiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestMultiMapRandomOps(t *testing.T) {
+	m, _ := NewMultiMap[int, int, badBuiltinInt, widgets.BuiltinInt](0)
+	op := map[int][]int{}
+	for i := 0; i < 3000; i++ {
+		k, v := (i*7919)%23, (i*104729)%7
+		switch i % 5 {
+		case 0, 1, 2:
+			m.Add(basic.Pair[int, int]{k, v})
+			op[k] = append(op[k], v)
+		case 3:
+			err := m.DeletePair(k, v)
+			idx := -1
+			for j, iterV := range op[k] {
+				if iterV == v {
+					idx = j
+					break
+				}
+			}
+			test.Eq(idx >= 0, err == nil, t)
+			if idx >= 0 {
+				op[k] = append(op[k][:idx], op[k][idx+1:]...)
+				if len(op[k]) == 0 {
+					delete(op, k)
+				}
+			}
+		case 4:
+			if i%3 == 0 {
+				_, ok := op[k]
+				test.Eq(ok, m.Delete(k) == nil, t)
+				delete(op, k)
+			}
+		}
+		total := 0
+		for _, vals := range op {
+			total += len(vals)
+		}
+		test.Eq(total, m.Length(), t)
+		test.Eq(len(op), m.NumKeys(), t)
+	}
+	for k, vals := range op {
+		got, err := m.Get(k).Collect()
+		test.Nil(err, t)
+		test.SlicesMatch[int](vals, got, t)
+	}
+}
+
+func TestMultiMapEq(t *testing.T) {
+	m1, _ := NewMultiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m2, _ := NewMultiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Add(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{0, "nil"},
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{1, "one"},
+	)
+	m2.Add(
+		basic.Pair[int, string]{1, "one"},
+		basic.Pair[int, string]{0, "nil"},
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{0, "zero"},
+	)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.True(m2.Eq(&m1, &m2), t)
+	test.Eq(m1.Hash(&m1), m2.Hash(&m2), t)
+	m2.DeletePair(0, "zero")
+	m2.Add(basic.Pair[int, string]{0, "nil"})
+	test.False(m1.Eq(&m1, &m2), t)
+	test.False(m2.Eq(&m1, &m2), t)
+	test.Neq(m1.Hash(&m1), m2.Hash(&m2), t)
+	m2.Delete(0)
+	test.False(m1.Eq(&m1, &m2), t)
+}
+
+func TestMultiMapZero(t *testing.T) {
+	m1, _ := NewMultiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Add(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{0, "nil"},
+	)
+	m1.Zero(&m1)
+	test.Eq(0, m1.Length(), t)
+	test.Eq(0, m1.NumKeys(), t)
+}
+
+func TestMultiMapFormat(t *testing.T) {
+	m1, _ := NewMultiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Add(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{0, "nil"},
+		basic.Pair[int, string]{1, "one"},
+	)
+	for _, res := range []string{fmt.Sprintf("%v", m1), m1.String()} {
+		test.Eq(len("multiMap[0:[zero nil] 1:[one]]"), len(res), t)
+		test.True(strings.HasPrefix(res, "multiMap["), t)
+		test.True(strings.Contains(res, "0:[zero nil]"), t)
+		test.True(strings.Contains(res, "1:[one]"), t)
+	}
+}
+
+func TestMultiMapSerializationFormat(t *testing.T) {
+	m1, _ := NewMultiMap[int, string, widgets.BuiltinInt, widgets.BuiltinString](0)
+	m1.Add(
+		basic.Pair[int, string]{0, "zero"},
+		basic.Pair[int, string]{0, "nil"},
+	)
+	data, err := m1.MarshalJSON()
+	test.Nil(err, t)
+	test.Eq(`[{"key":0,"val":["zero","nil"]}]`, string(data), t)
+	test.Nil(m1.UnmarshalJSON(
+		[]byte(`[{"key":1,"val":["a"]},{"key":2,"val":[]},{"key":1,"val":["b"]}]`),
+	), t)
+	test.Eq(2, m1.Length(), t)
+	test.Eq(1, m1.NumKeys(), t)
+	vals, _ := m1.Get(1).Collect()
+	test.SlicesMatch[string]([]string{"a", "b"}, vals, t)
+}
+
+func TestSyncedMultiMapConcurrentAdd(t *testing.T) {
+	m, _ := NewSyncedMultiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				m.Add(basic.Pair[int, int]{i % 10, g})
+				m.Get(i % 10).Collect()
+				m.Count(i % 10)
+			}
+		}(g)
+	}
+	wg.Wait()
+	test.Eq(2000, m.Length(), t)
+	test.Eq(10, m.NumKeys(), t)
+	for i := 0; i < 10; i++ {
+		test.Eq(200, m.Count(i), t)
+	}
+}
+
+func TestMultiMapAll(t *testing.T) {
+	m, _ := NewMultiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	m.Add(
+		basic.Pair[int, int]{0, 1},
+		basic.Pair[int, int]{0, 2},
+		basic.Pair[int, int]{1, 3},
+		basic.Pair[int, int]{0, 1},
+	)
+	op := map[int][]int{}
+	for k, v := range m.All() {
+		op[k] = append(op[k], v)
+	}
+	test.Eq(2, len(op), t)
+	test.SlicesMatch[int]([]int{1, 2, 1}, op[0], t)
+	test.SlicesMatch[int]([]int{3}, op[1], t)
+}
+
+func TestSyncedMultiMapAllBreakReleasesLock(t *testing.T) {
+	m, _ := NewSyncedMultiMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0)
+	m.Add(basic.Pair[int, int]{0, 1}, basic.Pair[int, int]{0, 2})
+	for range m.All() {
+		test.False(m.RWMutex.TryLock(), t)
+		break
+	}
+	test.True(m.RWMutex.TryLock(), t)
+	m.RWMutex.Unlock()
+	test.Nil(m.Add(basic.Pair[int, int]{1, 3}), t)
+}
@@ -0,0 +1,1041 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a map that dynamically grows as key value pairs are
+	// added. The map keeps its keys in sorted order and is internally
+	// implemented with a red-black tree. The type constraints on the generics
+	// define the logic for how value specific operations, such as ordering and
+	// equality comparisons, will be handled. Copies of an ordered map share
+	// the same underlying tree, the same as the builtin map type.
+	OrderedMap[
+		K any,
+		V any,
+		KI widgets.PartialOrderInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		tree *rbTree[K, V, KI]
+	}
+
+	// A synchronized version of OrderedMap. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedOrderedMap[
+		K any,
+		V any,
+		KI widgets.PartialOrderInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		OrderedMap[K, V, KI, VI]
+	}
+)
+
+// Creates a new, empty, ordered map.
+func NewOrderedMap[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+]() OrderedMap[K, V, KI, VI] {
+	return OrderedMap[K, V, KI, VI]{tree: newRBTree[K, V, KI]()}
+}
+
+// Creates a new, empty, synced ordered map. The underlying RWMutex value will
+// be fully unlocked upon initialization.
+func NewSyncedOrderedMap[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+]() SyncedOrderedMap[K, V, KI, VI] {
+	return SyncedOrderedMap[K, V, KI, VI]{
+		RWMutex:    &sync.RWMutex{},
+		OrderedMap: NewOrderedMap[K, V, KI, VI](),
+	}
+}
+
+// Creates a new ordered map and populates it with the supplied values. If
+// there are duplicated keys in the supplied slice the last key-value pair will
+// be what is in the returned map.
+func OrderedMapValInit[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V]) OrderedMap[K, V, KI, VI] {
+	rv := NewOrderedMap[K, V, KI, VI]()
+	rv.Emplace(vals...)
+	return rv
+}
+
+// Creates a new synced ordered map and populates it with the supplied values.
+// If there are duplicated keys in the supplied slice the last key-value pair
+// will be what is in the returned map.
+func SyncedOrderedMapValInit[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V]) SyncedOrderedMap[K, V, KI, VI] {
+	rv := NewSyncedOrderedMap[K, V, KI, VI]()
+	rv.Emplace(vals...)
+	return rv
+}
+
+// Converts the supplied map to a synchronized map. Beware: The original
+// non-synced map will remain useable.
+func (m *OrderedMap[K, V, KI, VI]) ToSynced() SyncedOrderedMap[K, V, KI, VI] {
+	return SyncedOrderedMap[K, V, KI, VI]{
+		RWMutex:    &sync.RWMutex{},
+		OrderedMap: *m,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *OrderedMap[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *OrderedMap[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *OrderedMap[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *OrderedMap[K, V, KI, VI]) RUnlock() {}
+
+// The SyncedOrderedMap method to override the OrderedMap pass through function
+// and actually apply the mutex operation.
+func (m *SyncedOrderedMap[K, V, KI, VI]) Lock() { m.RWMutex.Lock() }
+
+// The SyncedOrderedMap method to override the OrderedMap pass through function
+// and actually apply the mutex operation.
+func (m *SyncedOrderedMap[K, V, KI, VI]) Unlock() { m.RWMutex.Unlock() }
+
+// The SyncedOrderedMap method to override the OrderedMap pass through function
+// and actually apply the mutex operation.
+func (m *SyncedOrderedMap[K, V, KI, VI]) RLock() { m.RWMutex.RLock() }
+
+// The SyncedOrderedMap method to override the OrderedMap pass through function
+// and actually apply the mutex operation.
+func (m *SyncedOrderedMap[K, V, KI, VI]) RUnlock() { m.RWMutex.RUnlock() }
+
+// Returns false, ordered maps are not addressable.
+func (m *OrderedMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, an ordered map is not synced.
+func (m *OrderedMap[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced ordered map is synced.
+func (m *SyncedOrderedMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the number of elements in the ordered map.
+//
+// Time Complexity: O(1)
+func (m *OrderedMap[K, V, KI, VI]) Length() int {
+	return m.tree.size
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Length()
+}
+
+// Description: Contains will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the ordered map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *OrderedMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Contains(v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the ordered map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *OrderedMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedOrderedMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.ContainsPntr(v)
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the ordered map.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Get(k K) (V, error) {
+	if n := m.tree.find(&k); n != nil {
+		return n.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Get(k K) (V, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Get(k)
+}
+
+// Panics, ordered maps are not addressable.
+func (m *OrderedMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("ordered map"))
+}
+
+// Description: KeyOf will return the smallest key that maps to the supplied
+// value. If the value is not found then the returned key will be a zero
+// initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *OrderedMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.KeyOf] implementation method. The
+// [OrderedMap.KeyOf] method is not called directly to avoid copying the val
+// variable twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedOrderedMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: KeyOfPntr will return the smallest key that maps to the
+// supplied value. If the value is not found then the returned key will be a
+// zero initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *OrderedMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.KeyOfPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedOrderedMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) keyOfImpl(k *K, v *V) bool {
+	found := false
+	w := widgets.Base[V, VI]{}
+	m.tree.inOrder(func(n *rbNode[K, V]) bool {
+		if w.Eq(v, &n.val) {
+			*k = n.key
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the map. Stops setting values as soon as an error is
+// encountered. Note that the key will be updated as well.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *OrderedMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	return m.setImpl(kvPairs)
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Set] implementaiton method. The
+// [OrderedMap.Set] method is not called directly to avoid copying the vals
+// varargs twice, which could be expensive with a large types for the K or V
+// generics or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.setImpl(kvPairs)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) setImpl(kvPairs []basic.Pair[K, V]) error {
+	for i := 0; i < len(kvPairs); i++ {
+		if n := m.tree.find(&kvPairs[i].A); n != nil {
+			m.zeroKVPair(&n.key, &n.val)
+			n.key = kvPairs[i].A
+			n.val = kvPairs[i].B
+		} else {
+			return getKeyError[K](&kvPairs[i].A)
+		}
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the ordered map if
+// they do not exist and will set they keys value if it already exists in the
+// ordered map. The values will be inserted in the order that they are given.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *OrderedMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	for i := 0; i < len(vals); i++ {
+		if n := m.tree.find(&vals[i].A); n != nil {
+			m.zeroKVPair(&n.key, &n.val)
+			n.key = vals[i].A
+			n.val = vals[i].B
+		} else {
+			m.tree.insert(&vals[i].A, &vals[i].B, false)
+		}
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Emplace] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.Emplace(vals...)
+}
+
+// Description: Pop will remove all occurrences of val in the map. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with.
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *OrderedMap[K, V, KI, VI]) Pop(v V) int {
+	return m.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Pop] implementation method. The
+// [OrderedMap.Pop] method is not called directly to avoid copying the v
+// argument twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *SyncedOrderedMap[K, V, KI, VI]) Pop(v V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.popImpl(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the map. All
+// equality comparisons are performed by the generic VI widget type that the
+// map was initialized with.
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *OrderedMap[K, V, KI, VI]) PopPntr(v *V) int {
+	return m.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.PopPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *SyncedOrderedMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.popImpl(v)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) popImpl(v *V) int {
+	vw := widgets.Base[V, VI]{}
+	keys := []K{}
+	m.tree.inOrder(func(n *rbNode[K, V]) bool {
+		if vw.Eq(v, &n.val) {
+			keys = append(keys, n.key)
+		}
+		return true
+	})
+	for i := 0; i < len(keys); i++ {
+		m.tree.remove(&keys[i], m.zeroKVPair)
+	}
+	return len(keys)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) zeroKVPair(k *K, v *V) {
+	kw := widgets.PartialOrder[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	kw.Zero(k)
+	vw.Zero(v)
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the ordered map.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Delete(k K) error {
+	return m.deleteImpl(&k)
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Delete(k K) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.deleteImpl(&k)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) deleteImpl(k *K) error {
+	if !m.tree.remove(k, m.zeroKVPair) {
+		return getKeyError[K](k)
+	}
+	return nil
+}
+
+// Description: Clears all values from the ordered map.
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) Clear() {
+	if m.tree == nil {
+		m.tree = newRBTree[K, V, KI]()
+		return
+	}
+	m.tree.clear(m.zeroKVPair)
+}
+
+// Description: Places a write lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.OrderedMap.Clear()
+}
+
+// Description: Returns the key value pair with the smallest key in the ordered
+// map. Returns a [containerTypes.Empty] error if the map is empty.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Min() (basic.Pair[K, V], error) {
+	if m.tree.root == nil {
+		return basic.Pair[K, V]{}, getEmptyError()
+	}
+	n := m.tree.root.min()
+	return basic.Pair[K, V]{A: n.key, B: n.val}, nil
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Min] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Min() (basic.Pair[K, V], error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Min()
+}
+
+// Description: Returns the key value pair with the largest key in the ordered
+// map. Returns a [containerTypes.Empty] error if the map is empty.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Max() (basic.Pair[K, V], error) {
+	if m.tree.root == nil {
+		return basic.Pair[K, V]{}, getEmptyError()
+	}
+	n := m.tree.root.max()
+	return basic.Pair[K, V]{A: n.key, B: n.val}, nil
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Max] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Max() (basic.Pair[K, V], error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Max()
+}
+
+// Description: Returns the key value pair with the largest key that is less
+// than or equal to the supplied key. Returns a [containerTypes.KeyError] if no
+// such key exists.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Floor(k K) (basic.Pair[K, V], error) {
+	if n := m.tree.floor(&k); n != nil {
+		return basic.Pair[K, V]{A: n.key, B: n.val}, nil
+	}
+	return basic.Pair[K, V]{}, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Floor] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Floor(k K) (basic.Pair[K, V], error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Floor(k)
+}
+
+// Description: Returns the key value pair with the smallest key that is
+// greater than or equal to the supplied key. Returns a
+// [containerTypes.KeyError] if no such key exists.
+//
+// Time Complexity: O(log(n))
+func (m *OrderedMap[K, V, KI, VI]) Ceiling(k K) (basic.Pair[K, V], error) {
+	if n := m.tree.ceiling(&k); n != nil {
+		return basic.Pair[K, V]{A: n.key, B: n.val}, nil
+	}
+	return basic.Pair[K, V]{}, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.Ceiling] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) Ceiling(k K) (basic.Pair[K, V], error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.Ceiling(k)
+}
+
+// Description: Returns an iterator that iterates over the keys of the ordered
+// map in sorted order.
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return iter.Map[*rbNode[K, V], K](
+		m.tree.nodes(nil, nil),
+		func(index int, val *rbNode[K, V]) (K, error) { return val.key, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedMap.Keys] method such that a read lock will be placed on the
+// underlying ordered map when the iterator is consumed. The ordered map will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return m.OrderedMap.Keys().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the ordered map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [OrderedMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the ordered map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedOrderedMap.Keys], so the ordered map will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the
+// ordered map. The values will be returned in the order of their keys.
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return iter.Map[*rbNode[K, V], V](
+		m.tree.nodes(nil, nil),
+		func(index int, val *rbNode[K, V]) (V, error) { return val.val, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedMap.Vals] method such that a read lock will be placed on the
+// underlying ordered map when the iterator is consumed. The ordered map will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return m.OrderedMap.Vals().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the ordered map that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [OrderedMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the ordered map that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedOrderedMap.Vals], so the ordered map will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the ordered map
+// that can be used in a for-range loop. The key value pairs are returned in
+// sorted order.
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.Pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the ordered map
+// that can be used in a for-range loop. The ordered map will have a read lock
+// the entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.Pairs())
+}
+
+// Panics, ordered maps are not addressable.
+func (m *OrderedMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("ordered map"))
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the ordered map in sorted order.
+//
+// Time Complexity: O(n)
+func (m *OrderedMap[K, V, KI, VI]) Pairs() iter.Iter[basic.Pair[K, V]] {
+	return m.rangeImpl(nil, nil)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedMap.Pairs] method such that a read lock will be placed on the
+// underlying ordered map when the iterator is consumed. The ordered map will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedOrderedMap[K, V, KI, VI]) Pairs() iter.Iter[basic.Pair[K, V]] {
+	return m.OrderedMap.Pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the ordered map with keys in the range [start, end) in sorted
+// order. The tree is walked lazily, so stopping the iteration early will not
+// visit the remaining keys.
+//
+// Time Complexity: O(log(n)+m), where m is the number of keys in the range
+func (m *OrderedMap[K, V, KI, VI]) Range(
+	start K,
+	end K,
+) iter.Iter[basic.Pair[K, V]] {
+	return m.rangeImpl(&start, &end)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedMap.Range] method such that a read lock will be placed on the
+// underlying ordered map when the iterator is consumed. The ordered map will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n)+m), where m is the number of keys in the range
+func (m *SyncedOrderedMap[K, V, KI, VI]) Range(
+	start K,
+	end K,
+) iter.Iter[basic.Pair[K, V]] {
+	return m.OrderedMap.Range(start, end).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+func (m *OrderedMap[K, V, KI, VI]) rangeImpl(
+	start *K,
+	end *K,
+) iter.Iter[basic.Pair[K, V]] {
+	return iter.Map[*rbNode[K, V], basic.Pair[K, V]](
+		m.tree.nodes(start, end),
+		func(index int, val *rbNode[K, V]) (basic.Pair[K, V], error) {
+			return basic.Pair[K, V]{A: val.key, B: val.val}, nil
+		},
+	)
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *OrderedMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	if m.tree.size != other.Length() {
+		return false
+	}
+	rv := true
+	vw := widgets.Base[V, VI]{}
+	m.tree.inOrder(func(n *rbNode[K, V]) bool {
+		otherV, err := addressableSafeGet[K, V](other, n.key)
+		rv = (err == nil && vw.Eq(&n.val, otherV))
+		return rv
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered map [OrderedMap.KeyedEq] method. Attempts to place a
+// read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on this ordered map, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *SyncedOrderedMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.RLock()
+	other.RLock()
+	defer m.RUnlock()
+	defer other.RUnlock()
+	return m.OrderedMap.KeyedEq(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [OrderedMap.KeyedEq]. Returns
+// true if l==r, false otherwise.
+func (_ *OrderedMap[K, V, KI, VI]) Eq(
+	l *OrderedMap[K, V, KI, VI],
+	r *OrderedMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedOrderedMap.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *SyncedOrderedMap[K, V, KI, VI]) Eq(
+	l *SyncedOrderedMap[K, V, KI, VI],
+	r *SyncedOrderedMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of an ordered map. To do this all of the
+// individual hashes that are produced from the key value pairs of the ordered
+// map are combined in key order, making it so the hash will represent the same
+// equality operation that [OrderedMap.KeyedEq] and [OrderedMap.Eq] provide.
+func (_ *OrderedMap[K, V, KI, VI]) Hash(
+	other *OrderedMap[K, V, KI, VI],
+) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	kw := widgets.PartialOrder[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	other.tree.inOrder(func(n *rbNode[K, V]) bool {
+		iterH := kw.Hash(&n.key).Combine(vw.Hash(&n.val))
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.Combine(iterH)
+		}
+		return true
+	})
+	return rv
+}
+
+// Places a read lock on the underlying ordered map of other and then calls
+// others underlying ordered maps [OrderedMap.Hash] method.
+func (_ *SyncedOrderedMap[K, V, KI, VI]) Hash(
+	other *SyncedOrderedMap[K, V, KI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.OrderedMap.Hash(&other.OrderedMap)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [OrderedMap.Clear].
+func (_ *OrderedMap[K, V, KI, VI]) Zero(other *OrderedMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedOrderedMap.Clear].
+func (_ *SyncedOrderedMap[K, V, KI, VI]) Zero(
+	other *SyncedOrderedMap[K, V, KI, VI],
+) {
+	other.Clear()
+}
+
+func (m *OrderedMap[K, V, KI, VI]) toSerialized() []serializedKV[K, V] {
+	rv := []serializedKV[K, V]{}
+	if m.tree == nil {
+		return rv
+	}
+	rv = make([]serializedKV[K, V], 0, m.tree.size)
+	m.tree.inOrder(func(n *rbNode[K, V]) bool {
+		rv = append(rv, serializedKV[K, V]{Key: n.key, Val: n.val})
+		return true
+	})
+	return rv
+}
+
+func (m *OrderedMap[K, V, KI, VI]) fromSerialized(
+	kvs []serializedKV[K, V],
+) error {
+	m.Clear()
+	for _, kv := range kvs {
+		m.Emplace(basic.Pair[K, V]{A: kv.Key, B: kv.Val})
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the ordered map. The ordered map is
+// encoded as a list of key value objects in sorted key order. If a key is
+// present more than once when decoding the last value is kept.
+//
+// Time Complexity: O(n*log(n))
+func (m *OrderedMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered maps [OrderedMap.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.MarshalJSON()
+}
+
+// Description: Replaces the contents of the ordered map with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [OrderedMap.MarshalJSON]. If an error is returned the ordered map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *OrderedMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying ordered map while its contents are replaced. Exhibits the same
+// behavior as [OrderedMap.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the ordered map. The values
+// are laid out the same way as [OrderedMap.MarshalJSON] and are encoded using
+// the [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n*log(n))
+func (m *OrderedMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying ordered map and then calls
+// the underlying ordered maps [OrderedMap.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.OrderedMap.MarshalBinary()
+}
+
+// Description: Replaces the contents of the ordered map with the values decoded
+// from the supplied binary data, which must have been produced by
+// [OrderedMap.MarshalBinary]. If an error is returned the ordered map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *OrderedMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying ordered map while its contents are replaced. Exhibits the same
+// behavior as [OrderedMap.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SyncedOrderedMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.OrderedMap.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m OrderedMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("orderedMap["))
+	cntr := 0
+	m.tree.inOrder(func(n *rbNode[K, V]) bool {
+		fmt.Fprintf(f, fmtStr, n.key, n.val)
+		cntr++
+		if cntr < m.tree.size {
+			f.Write([]byte{' '})
+		}
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *OrderedMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func OrderedMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateOrderedMap(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestOrderedMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(OrderedMapToMapInterfaceFactory, t)
+}
+
+func TestOrderedMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(OrderedMapToMapInterfaceFactory, t)
+}
@@ -0,0 +1,239 @@
+package containers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=OrderedMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateOrderedMap
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedOrderedMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateSyncedOrderedMap
+
+func generateOrderedMap(capacity int) OrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	return NewOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+}
+
+func generateSyncedOrderedMap(capacity int) SyncedOrderedMap[
+	int,
+	int,
+	widgets.BuiltinInt,
+	widgets.BuiltinInt,
+] {
+	return NewSyncedOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+}
+
+func orderedMapTestVals() []basic.Pair[int, string] {
+	return []basic.Pair[int, string]{
+		{A: 3, B: "three"},
+		{A: 0, B: "zero"},
+		{A: 2, B: "two"},
+		{A: 1, B: "one"},
+	}
+}
+
+func TestOrderedMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[OrderedMap[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v := NewOrderedMap[string, string, widgets.BuiltinString, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func TestOrderedMapEq(t *testing.T) {
+	m1 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	m2 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.True(m2.Eq(&m1, &m2), t)
+	m2.Delete(0)
+	test.False(m1.Eq(&m1, &m2), t)
+	test.False(m2.Eq(&m1, &m2), t)
+}
+
+func TestOrderedMapHash(t *testing.T) {
+	m1 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	m2 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.Eq(m1.Hash(&m1), m2.Hash(&m2), t)
+	m2.Set(basic.Pair[int, string]{A: 0, B: "nil"})
+	test.Neq(m1.Hash(&m1), m2.Hash(&m2), t)
+	h := m1.Hash(&m1)
+	for i := 0; i < 100; i++ {
+		test.Eq(h, m1.Hash(&m1), t)
+	}
+}
+
+func TestOrderedMapZero(t *testing.T) {
+	m1 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	m1.Zero(&m1)
+	test.Eq(0, m1.Length(), t)
+	test.True(m1.tree.root == nil, t)
+}
+
+func TestOrderedMapFormat(t *testing.T) {
+	m1 := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.Eq(
+		"orderedMap[0:zero 1:one 2:two 3:three]", fmt.Sprintf("%v", m1), t,
+	)
+	test.Eq(
+		"orderedMap[0:zero 1:one 2:two 3:three]", fmt.Sprintf("%v", &m1), t,
+	)
+	test.Eq("orderedMap[0:zero 1:one 2:two 3:three]", m1.String(), t)
+}
+
+func TestOrderedMapCopiesShareState(t *testing.T) {
+	m1 := NewOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	m2 := m1
+	m1.Emplace(basic.Pair[int, int]{A: 1, B: 1})
+	test.Eq(1, m2.Length(), t)
+	v, err := m2.Get(1)
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+}
+
+func TestOrderedMapSortedIteration(t *testing.T) {
+	m := NewOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 999; i >= 0; i-- {
+		m.Emplace(basic.Pair[int, int]{A: (i * 7) % 1000, B: i})
+	}
+	test.Eq(1000, m.Length(), t)
+	keys, err := m.Keys().Collect()
+	test.Nil(err, t)
+	test.Eq(1000, len(keys), t)
+	for i := 0; i < len(keys); i++ {
+		test.Eq(i, keys[i], t)
+	}
+}
+
+func TestOrderedMapMinMax(t *testing.T) {
+	m := NewOrderedMap[int, string, widgets.BuiltinInt, widgets.BuiltinString]()
+	_, err := m.Min()
+	test.ContainsError(containerTypes.Empty, err, t)
+	_, err = m.Max()
+	test.ContainsError(containerTypes.Empty, err, t)
+	m.Emplace(orderedMapTestVals()...)
+	p, err := m.Min()
+	test.Nil(err, t)
+	test.Eq(basic.Pair[int, string]{A: 0, B: "zero"}, p, t)
+	p, err = m.Max()
+	test.Nil(err, t)
+	test.Eq(basic.Pair[int, string]{A: 3, B: "three"}, p, t)
+}
+
+func TestOrderedMapFloorCeiling(t *testing.T) {
+	m := NewOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 10; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i * 10, B: i})
+	}
+	p, err := m.Floor(25)
+	test.Nil(err, t)
+	test.Eq(20, p.A, t)
+	p, err = m.Floor(30)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	_, err = m.Floor(-1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	p, err = m.Ceiling(25)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	p, err = m.Ceiling(30)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	_, err = m.Ceiling(91)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestOrderedMapRange(t *testing.T) {
+	m := NewOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 10; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i * 10, B: i})
+	}
+	res, err := m.Range(15, 55).Collect()
+	test.Nil(err, t)
+	test.Eq(4, len(res), t)
+	for i := 0; i < len(res); i++ {
+		test.Eq(basic.Pair[int, int]{A: (i + 2) * 10, B: i + 2}, res[i], t)
+	}
+	res, err = m.Range(20, 50).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	for i := 0; i < len(res); i++ {
+		test.Eq(basic.Pair[int, int]{A: (i + 2) * 10, B: i + 2}, res[i], t)
+	}
+	res, err = m.Range(50, 20).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = m.Range(-10, 1000).Collect()
+	test.Nil(err, t)
+	test.Eq(10, len(res), t)
+	cntr := 0
+	err = m.Range(0, 100).ForEach(
+		func(index int, val basic.Pair[int, int]) (iter.IteratorFeedback, error) {
+			cntr++
+			if cntr == 3 {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	test.Nil(err, t)
+	test.Eq(3, cntr, t)
+}
+
+func TestOrderedMapPairs(t *testing.T) {
+	m := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	res, err := m.Pairs().Collect()
+	test.Nil(err, t)
+	exp := []basic.Pair[int, string]{
+		{A: 0, B: "zero"}, {A: 1, B: "one"}, {A: 2, B: "two"}, {A: 3, B: "three"},
+	}
+	test.Eq(len(exp), len(res), t)
+	for i := 0; i < len(exp); i++ {
+		test.Eq(exp[i], res[i], t)
+	}
+}
+
+func TestSyncedOrderedMapRangeLock(t *testing.T) {
+	m := NewSyncedOrderedMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 10; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i, B: i})
+	}
+	err := m.Range(0, 5).ForEach(
+		func(index int, val basic.Pair[int, int]) (iter.IteratorFeedback, error) {
+			test.False(m.RWMutex.TryLock(), t)
+			return iter.Continue, nil
+		},
+	)
+	test.Nil(err, t)
+	test.True(m.RWMutex.TryLock(), t)
+	m.RWMutex.Unlock()
+}
+
+func TestOrderedMapAll(t *testing.T) {
+	m := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	keys, vals := []int{}, []string{}
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+	test.SlicesMatch[int]([]int{0, 1, 2, 3}, keys, t)
+	test.SlicesMatch[string]([]string{"zero", "one", "two", "three"}, vals, t)
+}
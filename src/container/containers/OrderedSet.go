@@ -0,0 +1,1004 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a set that dynamically grows as values are added.
+	// The set will maintain uniqueness and keeps its values in sorted order. It
+	// is internally implemented with a red-black tree. The type constraints on
+	// the generics define the logic for how value specific operations, such as
+	// ordering and equality comparisons, will be handled. Copies of an ordered
+	// set share the same underlying tree.
+	OrderedSet[T any, U widgets.PartialOrderInterface[T]] struct {
+		tree *rbTree[T, struct{}, U]
+	}
+
+	// A synchronized version of OrderedSet. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedOrderedSet[T any, U widgets.PartialOrderInterface[T]] struct {
+		*sync.RWMutex
+		OrderedSet[T, U]
+	}
+)
+
+// Creates a new, empty, ordered set.
+func NewOrderedSet[T any, U widgets.PartialOrderInterface[T]]() OrderedSet[T, U] {
+	return OrderedSet[T, U]{tree: newRBTree[T, struct{}, U]()}
+}
+
+// Creates a new, empty, synced ordered set. The underlying RWMutex value will
+// be fully unlocked upon initialization.
+func NewSyncedOrderedSet[
+	T any,
+	U widgets.PartialOrderInterface[T],
+]() SyncedOrderedSet[T, U] {
+	return SyncedOrderedSet[T, U]{
+		RWMutex:    &sync.RWMutex{},
+		OrderedSet: NewOrderedSet[T, U](),
+	}
+}
+
+// Creates a new ordered set and populates it with the supplied values.
+// Duplicate values will be ignored.
+func OrderedSetValInit[T any, U widgets.PartialOrderInterface[T]](
+	vals []T,
+) OrderedSet[T, U] {
+	rv := NewOrderedSet[T, U]()
+	rv.AppendUnique(vals...)
+	return rv
+}
+
+// Creates a new synced ordered set and populates it with the supplied values.
+// Duplicate values will be ignored.
+func SyncedOrderedSetValInit[T any, U widgets.PartialOrderInterface[T]](
+	vals []T,
+) SyncedOrderedSet[T, U] {
+	rv := NewSyncedOrderedSet[T, U]()
+	rv.AppendUnique(vals...)
+	return rv
+}
+
+// Converts the supplied set to a synchronized set. Beware: The original
+// non-synced set will remain useable.
+func (s *OrderedSet[T, U]) ToSynced() SyncedOrderedSet[T, U] {
+	return SyncedOrderedSet[T, U]{
+		RWMutex:    &sync.RWMutex{},
+		OrderedSet: *s,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (s *OrderedSet[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (s *OrderedSet[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (s *OrderedSet[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (s *OrderedSet[T, U]) RUnlock() {}
+
+// The SyncedOrderedSet method to override the OrderedSet pass through function
+// and actually apply the mutex operation.
+func (s *SyncedOrderedSet[T, U]) Lock() { s.RWMutex.Lock() }
+
+// The SyncedOrderedSet method to override the OrderedSet pass through function
+// and actually apply the mutex operation.
+func (s *SyncedOrderedSet[T, U]) Unlock() { s.RWMutex.Unlock() }
+
+// The SyncedOrderedSet method to override the OrderedSet pass through function
+// and actually apply the mutex operation.
+func (s *SyncedOrderedSet[T, U]) RLock() { s.RWMutex.RLock() }
+
+// The SyncedOrderedSet method to override the OrderedSet pass through function
+// and actually apply the mutex operation.
+func (s *SyncedOrderedSet[T, U]) RUnlock() { s.RWMutex.RUnlock() }
+
+// Returns false, ordered sets are not addressable.
+func (s *OrderedSet[T, U]) IsAddressable() bool { return false }
+
+// Returns false, an ordered set is not synced.
+func (s *OrderedSet[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced ordered set is synced.
+func (s *SyncedOrderedSet[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of values in the ordered set.
+//
+// Time Complexity: O(1)
+func (s *OrderedSet[T, U]) Length() int {
+	return s.tree.size
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered set's [OrderedSet.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (s *SyncedOrderedSet[T, U]) Length() int {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.Length()
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// ordered set in sorted order.
+//
+// Time Complexity: O(n)
+func (s *OrderedSet[T, U]) Vals() iter.Iter[T] {
+	return s.rangeImpl(nil, nil)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedSet.Vals] method such that a read lock will be placed on the
+// underlying ordered set when iterator is consumed. The ordered set will have a
+// read lock the entire time the iteration is being performed. The lock will not
+// be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (s *SyncedOrderedSet[T, U]) Vals() iter.Iter[T] {
+	return s.OrderedSet.Vals().SetupTeardown(
+		func() error { s.RLock(); return nil },
+		func() error { s.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the ordered set that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [OrderedSet.Vals].
+//
+// Time Complexity: O(n)
+func (s *OrderedSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return s.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the ordered set that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedOrderedSet.Vals], so the ordered set will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (s *SyncedOrderedSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return s.Vals().Seq()
+}
+
+// Panics, ordered sets are not addressable.
+func (s *OrderedSet[T, U]) ValPntrs() iter.Iter[*T] {
+	panic(getNonAddressablePanicText("ordered set"))
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// ordered set that are in the range [start, end) in sorted order. The tree is
+// walked lazily, so stopping the iteration early will not visit the remaining
+// values.
+//
+// Time Complexity: O(log(n)+m), where m is the number of values in the range
+func (s *OrderedSet[T, U]) Range(start T, end T) iter.Iter[T] {
+	return s.rangeImpl(&start, &end)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [OrderedSet.Range] method such that a read lock will be placed on the
+// underlying ordered set when iterator is consumed. The ordered set will have a
+// read lock the entire time the iteration is being performed. The lock will not
+// be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n)+m), where m is the number of values in the range
+func (s *SyncedOrderedSet[T, U]) Range(start T, end T) iter.Iter[T] {
+	return s.OrderedSet.Range(start, end).SetupTeardown(
+		func() error { s.RLock(); return nil },
+		func() error { s.RUnlock(); return nil },
+	)
+}
+
+func (s *OrderedSet[T, U]) rangeImpl(start *T, end *T) iter.Iter[T] {
+	return iter.Map[*rbNode[T, struct{}], T](
+		s.tree.nodes(start, end),
+		func(index int, val *rbNode[T, struct{}]) (T, error) {
+			return val.key, nil
+		},
+	)
+}
+
+// Description: Populates the supplied value with the value that is in the
+// container. This is useful when storing structs and the structs identity as
+// defined by the U widget only depends on a subset of the structs fields. This
+// function allows for getting the entire value based on just the part of the
+// struct that defines it's identity. Returns a value error if the value is not
+// found in the set.
+//
+// Time complexity: O(log(n))
+func (s *OrderedSet[T, U]) GetUnique(v *T) error {
+	if n := s.tree.find(v); n != nil {
+		*v = n.key
+		return nil
+	}
+	return getValueError[T](v)
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.GetUnique] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) GetUnique(v *T) error {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.GetUnique(v)
+}
+
+// Description: Contains will return true if the supplied value is in the
+// ordered set, false otherwise. All comparisons are performed by the generic U
+// widget type that the ordered set was initialized with.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Contains(v T) bool {
+	return s.tree.find(&v) != nil
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Contains(v T) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// ordered set, false otherwise. All comparisons are performed by the generic U
+// widget type that the ordered set was initialized with.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) ContainsPntr(v *T) bool {
+	return s.tree.find(v) != nil
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) ContainsPntr(v *T) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.ContainsPntr(v)
+}
+
+// Description: AppendUnique will append the supplied values to the ordered set
+// if they are not already present in the ordered set (unique). Non-unique
+// values will not be appended. This function will never return an error.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (s *OrderedSet[T, U]) AppendUnique(vals ...T) error {
+	var tmp struct{}
+	for i := 0; i < len(vals); i++ {
+		s.tree.insert(&vals[i], &tmp, false)
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying ordered set and then
+// calls the underlying ordered sets [OrderedSet.AppendUnique] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (s *SyncedOrderedSet[T, U]) AppendUnique(vals ...T) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.AppendUnique(vals...)
+}
+
+// Description: updates the supplied value in the underlying ordered set,
+// assuming that it is present in the ordered set already. The updated value
+// must compare equal to the original value. If this rule is broken then an
+// update violation error will be returned. This method is useful when you are
+// storing struct values and want to update a field that is not utilized when
+// ordering or comparing for equality. If the value is not found then a value
+// error will be returned.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) UpdateUnique(orig T, updateOp func(orig *T)) error {
+	return s.updateOp(&orig, updateOp)
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.UpdateUnique] implementation method.
+// The [OrderedSet.UpdateUnique] method is not called directly to avoid copying
+// the supplied value, which could be expensive with a large type for the T
+// generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) UpdateUnique(
+	orig T,
+	updateOp func(orig *T),
+) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.updateOp(&orig, updateOp)
+}
+
+func (s *OrderedSet[T, U]) updateOp(orig *T, updateOp func(orig *T)) error {
+	w := widgets.PartialOrder[T, U]{}
+	n := s.tree.find(orig)
+	if n == nil {
+		return getValueError[T](orig)
+	}
+	newValue := n.key
+	updateOp(&newValue)
+	if !w.Eq(&newValue, orig) {
+		return getUpdateViolationEqError[T](&newValue, orig)
+	}
+	n.key = newValue
+	return nil
+}
+
+// Description: Pop will remove all occurrences of val in the ordered set. All
+// comparisons are performed by the generic U widget type that the ordered set
+// was initialized with.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Pop(v T) int {
+	return s.PopPntr(&v)
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.PopPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Pop(v T) int {
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.PopPntr(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the ordered set.
+// All comparisons are performed by the generic U widget type that the ordered
+// set was initialized with.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) PopPntr(v *T) int {
+	if s.tree.remove(v, s.zeroVal) {
+		return 1
+	}
+	return 0
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.PopPntr] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) PopPntr(v *T) int {
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.PopPntr(v)
+}
+
+func (s *OrderedSet[T, U]) zeroVal(v *T, _ *struct{}) {
+	w := widgets.PartialOrder[T, U]{}
+	w.Zero(v)
+}
+
+// Description: Clears all values from the ordered set.
+//
+// Time Complexity: O(n)
+func (s *OrderedSet[T, U]) Clear() {
+	if s.tree == nil {
+		s.tree = newRBTree[T, struct{}, U]()
+		return
+	}
+	s.tree.clear(s.zeroVal)
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (s *SyncedOrderedSet[T, U]) Clear() {
+	s.Lock()
+	defer s.Unlock()
+	s.OrderedSet.Clear()
+}
+
+// Description: Returns the smallest value in the ordered set. Returns a
+// [containerTypes.Empty] error if the set is empty.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Min() (T, error) {
+	if s.tree.root == nil {
+		var tmp T
+		return tmp, getEmptyError()
+	}
+	return s.tree.root.min().key, nil
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Min] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Min() (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.Min()
+}
+
+// Description: Returns the largest value in the ordered set. Returns a
+// [containerTypes.Empty] error if the set is empty.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Max() (T, error) {
+	if s.tree.root == nil {
+		var tmp T
+		return tmp, getEmptyError()
+	}
+	return s.tree.root.max().key, nil
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Max] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Max() (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.Max()
+}
+
+// Description: Returns the largest value in the ordered set that is less than
+// or equal to the supplied value. Returns a [containerTypes.ValueError] if no
+// such value exists.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Floor(v T) (T, error) {
+	if n := s.tree.floor(&v); n != nil {
+		return n.key, nil
+	}
+	var tmp T
+	return tmp, getValueError[T](&v)
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Floor] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Floor(v T) (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.Floor(v)
+}
+
+// Description: Returns the smallest value in the ordered set that is greater
+// than or equal to the supplied value. Returns a [containerTypes.ValueError] if
+// no such value exists.
+//
+// Time Complexity: O(log(n))
+func (s *OrderedSet[T, U]) Ceiling(v T) (T, error) {
+	if n := s.tree.ceiling(&v); n != nil {
+		return n.key, nil
+	}
+	var tmp T
+	return tmp, getValueError[T](&v)
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Ceiling] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedOrderedSet[T, U]) Ceiling(v T) (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.Ceiling(v)
+}
+
+// Description: Returns true if the elements in s are all contained in other and
+// the elements of other are all contained in s, regardless of position. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O it might look something like this,
+// O(n*O(other.ContainsPntr))), where O(other.ContainsPntr) represents the time
+// complexity of the ContainsPntr method on other with m values.
+func (s *OrderedSet[T, U]) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	if s.tree.size != other.Length() {
+		return false
+	}
+	rv := true
+	s.tree.inOrder(func(n *rbNode[T, struct{}]) bool {
+		rv = other.ContainsPntr(&n.key)
+		return rv
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.UnorderedEq] method. Attempts to
+// place a read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on this ordered set, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O it might look something like this,
+// O(n*O(other.ContainsPntr))), where O(other.ContainsPntr) represents the time
+// complexity of the ContainsPntr method on other with m values.
+func (s *SyncedOrderedSet[T, U]) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	s.RLock()
+	other.RLock()
+	defer s.RUnlock()
+	defer other.RUnlock()
+	return s.OrderedSet.UnorderedEq(other)
+}
+
+// Description: Populates the ordered set with the intersection of values from
+// the l and r containers. This ordered set will be cleared before storing the
+// result. When clearing, the new resulting set will be stored in a new tree,
+// so copies of this ordered set will not see the result.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on l. In big-O terms it may look somewhat like this:
+// O(n*log(n)*O(l.ContainsPntr)), where n is the number of values in r.
+func (s *OrderedSet[T, U]) Intersection(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	newS := NewOrderedSet[T, U]()
+	addressableSafeValIter[T](r).ForEach(
+		func(index int, val *T) (iter.IteratorFeedback, error) {
+			if l.ContainsPntr(val) {
+				newS.AppendUnique(*val)
+			}
+			return iter.Continue, nil
+		},
+	)
+	s.Clear()
+	*s = newS
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Intersection] method. Attempts to
+// place a read lock on l and r but whether or not that happens is
+// implementation dependent.
+//
+// Lock Type: Write on this ordered set, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on l. In big-O terms it may look somewhat like this:
+// O(n*log(n)*O(l.ContainsPntr)), where n is the number of values in r.
+func (s *SyncedOrderedSet[T, U]) Intersection(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	s.Lock()
+	l.RLock()
+	r.RLock()
+	defer s.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	s.OrderedSet.Intersection(l, r)
+}
+
+// Description: Populates the ordered set with the union of values from the l
+// and r containers. This ordered set will be cleared before storing the
+// result. When clearing, the new resulting set will be stored in a new tree,
+// so copies of this ordered set will not see the result.
+//
+// Time Complexity: O((n+m)*log(n+m)), where n is the number of values in l and
+// m is the number of values in r.
+func (s *OrderedSet[T, U]) Union(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	newS := NewOrderedSet[T, U]()
+	op := func(index int, val *T) (iter.IteratorFeedback, error) {
+		newS.AppendUnique(*val)
+		return iter.Continue, nil
+	}
+	addressableSafeValIter[T](l).ForEach(op)
+	addressableSafeValIter[T](r).ForEach(op)
+	s.Clear()
+	*s = newS
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Union] method. Attempts to place a
+// read lock on l and r but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Write on this ordered set, read on l and r
+//
+// Time Complexity: O((n+m)*log(n+m)), where n is the number of values in l and
+// m is the number of values in r.
+func (s *SyncedOrderedSet[T, U]) Union(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	s.Lock()
+	l.RLock()
+	r.RLock()
+	defer s.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	s.OrderedSet.Union(l, r)
+}
+
+// Description: Populates the ordered set with the result of taking the
+// difference of r from l. This ordered set will be cleared before storing the
+// result. When clearing, the new resulting set will be stored in a new tree,
+// so copies of this ordered set will not see the result.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on r. In big-O terms it may look somewhat like this:
+// O(n*log(n)*O(r.ContainsPntr)), where n is the number of values in l.
+func (s *OrderedSet[T, U]) Difference(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	newS := NewOrderedSet[T, U]()
+	addressableSafeValIter[T](l).ForEach(
+		func(index int, val *T) (iter.IteratorFeedback, error) {
+			if !r.ContainsPntr(val) {
+				newS.AppendUnique(*val)
+			}
+			return iter.Continue, nil
+		},
+	)
+	s.Clear()
+	*s = newS
+}
+
+// Description: Places a write lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.Difference] method. Attempts to place
+// a read lock on l and r but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Write on this ordered set, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on r. In big-O terms it may look somewhat like this:
+// O(n*log(n)*O(r.ContainsPntr)), where n is the number of values in l.
+func (s *SyncedOrderedSet[T, U]) Difference(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	s.Lock()
+	l.RLock()
+	r.RLock()
+	defer s.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	s.OrderedSet.Difference(l, r)
+}
+
+// Description: Returns true if this ordered set is a superset to other.
+//
+// Time Complexity: O(m*log(n)), where n is the number of values in this
+// ordered set and m is the number of values in other.
+func (s *OrderedSet[T, U]) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	rv := (s.tree.size >= other.Length())
+	if !rv {
+		return false
+	}
+	addressableSafeValIter[T](other).ForEach(
+		func(index int, val *T) (iter.IteratorFeedback, error) {
+			if rv = s.ContainsPntr(val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.IsSuperset] method. Attempts to place
+// a read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on this ordered set, read on other
+//
+// Time Complexity: O(m*log(n)), where n is the number of values in this
+// ordered set and m is the number of values in other.
+func (s *SyncedOrderedSet[T, U]) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	s.RLock()
+	other.RLock()
+	defer s.RUnlock()
+	defer other.RUnlock()
+	return s.OrderedSet.IsSuperset(other)
+}
+
+// Description: Returns true if this ordered set is a subset to other.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O terms it may look somewhat like
+// this: O(n*O(other.ContainsPntr)), where n is the number of elements in the
+// current ordered set.
+func (s *OrderedSet[T, U]) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	rv := (s.tree.size <= other.Length())
+	if !rv {
+		return false
+	}
+	s.tree.inOrder(func(n *rbNode[T, struct{}]) bool {
+		rv = other.ContainsPntr(&n.key)
+		return rv
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.IsSubset] method. Attempts to place a
+// read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on this ordered set, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O terms it may look somewhat like
+// this: O(n*O(other.ContainsPntr)), where n is the number of elements in the
+// current ordered set.
+func (s *SyncedOrderedSet[T, U]) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	s.RLock()
+	other.RLock()
+	defer s.RUnlock()
+	defer other.RUnlock()
+	return s.OrderedSet.IsSubset(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [OrderedSet.UnorderedEq].
+// Returns true if l==r, false otherwise.
+func (_ *OrderedSet[T, U]) Eq(l *OrderedSet[T, U], r *OrderedSet[T, U]) bool {
+	return l.UnorderedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedOrderedSet.UnorderedEq].
+// Returns true if l==r, false otherwise.
+func (_ *SyncedOrderedSet[T, U]) Eq(
+	l *SyncedOrderedSet[T, U],
+	r *SyncedOrderedSet[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.OrderedSet.UnorderedEq(&r.OrderedSet)
+}
+
+// A function that returns a hash of an ordered set. To do this all of the
+// individual hashes that are produced from the elements of the ordered set are
+// combined in sorted order, making it so the hash will represent the same
+// equality operation that [OrderedSet.UnorderedEq] and [OrderedSet.Eq]
+// provide.
+func (_ *OrderedSet[T, U]) Hash(other *OrderedSet[T, U]) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	w := widgets.PartialOrder[T, U]{}
+	other.tree.inOrder(func(n *rbNode[T, struct{}]) bool {
+		if cntr == 0 {
+			rv = w.Hash(&n.key)
+			cntr++
+		} else {
+			rv = rv.Combine(w.Hash(&n.key))
+		}
+		return true
+	})
+	return rv
+}
+
+// Places a read lock on the underlying ordered set of other and then calls
+// others underlying ordered set [OrderedSet.Hash] method.
+func (_ *SyncedOrderedSet[T, U]) Hash(other *SyncedOrderedSet[T, U]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.OrderedSet.Hash(&other.OrderedSet)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [OrderedSet.Clear].
+func (_ *OrderedSet[T, U]) Zero(other *OrderedSet[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedOrderedSet.Clear].
+func (_ *SyncedOrderedSet[T, U]) Zero(other *SyncedOrderedSet[T, U]) {
+	other.Clear()
+}
+
+func (s *OrderedSet[T, U]) toSerialized() []T {
+	if s.tree == nil {
+		return []T{}
+	}
+	rv, _ := s.Vals().Collect()
+	return rv
+}
+
+func (s *OrderedSet[T, U]) fromSerialized(vals []T) error {
+	s.Clear()
+	s.AppendUnique(vals...)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the ordered set. The ordered set is
+// encoded as a list of its values in sorted order. Duplicate values are ignored
+// when decoding.
+//
+// Time Complexity: O(n*log(n))
+func (s *OrderedSet[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toSerialized())
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedOrderedSet[T, U]) MarshalJSON() ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.MarshalJSON()
+}
+
+// Description: Replaces the contents of the ordered set with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [OrderedSet.MarshalJSON]. If an error is returned the ordered set is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (s *OrderedSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return s.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying ordered set while its contents are replaced. Exhibits the same
+// behavior as [OrderedSet.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedOrderedSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the ordered set. The values
+// are laid out the same way as [OrderedSet.MarshalJSON] and are encoded using
+// the [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n*log(n))
+func (s *OrderedSet[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(s.toSerialized())
+}
+
+// Description: Places a read lock on the underlying ordered set and then calls
+// the underlying ordered sets [OrderedSet.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedOrderedSet[T, U]) MarshalBinary() ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.OrderedSet.MarshalBinary()
+}
+
+// Description: Replaces the contents of the ordered set with the values decoded
+// from the supplied binary data, which must have been produced by
+// [OrderedSet.MarshalBinary]. If an error is returned the ordered set is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (s *OrderedSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return s.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying ordered set while its contents are replaced. Exhibits the same
+// behavior as [OrderedSet.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedOrderedSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return s.OrderedSet.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (s OrderedSet[T, U]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("orderedSet["))
+	cntr := 0
+	s.tree.inOrder(func(n *rbNode[T, struct{}]) bool {
+		fmt.Fprintf(f, fmtStr, n.key)
+		cntr++
+		if cntr < s.tree.size {
+			f.Write([]byte{' '})
+		}
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (s *OrderedSet[T, U]) String() string {
+	return fmt.Sprintf("%v", s)
+}
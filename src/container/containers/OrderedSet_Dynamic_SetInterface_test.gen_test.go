@@ -0,0 +1,134 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func OrderedSetToSetInterfaceFactory(capacity int) dynamicContainers.Set[int] {
+	v := generateOrderedSet(capacity)
+	var rv dynamicContainers.Set[int] = &v
+	return rv
+}
+
+func TestOrderedSet_DynSetInterfaceSyncableInterface(t *testing.T) {
+	tests.DynSetInterfaceSyncableInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceAddressableInterface(t *testing.T) {
+	tests.DynSetInterfaceAddressableInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceLengthInterface(t *testing.T) {
+	tests.DynSetInterfaceLengthInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceClearInterface(t *testing.T) {
+	tests.DynSetInterfaceClearInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceWriteUniqueOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceWriteUniqueOpsInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceReadOpsInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceDeleteOpsInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_ReadDynSetInterface(t *testing.T) {
+	tests.ReadDynSetInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_WriteDynSetInterface(t *testing.T) {
+	tests.WriteDynSetInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceInterface(t *testing.T) {
+	tests.DynSetInterfaceInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynSetInterfaceStaticCapacityInterface(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceVals(t *testing.T) {
+	tests.DynSetInterfaceVals(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceValPntrs(t *testing.T) {
+	tests.DynSetInterfaceValPntrs(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceContainsPntr(t *testing.T) {
+	tests.DynSetInterfaceContainsPntr(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceGetUnique(t *testing.T) {
+	tests.DynSetInterfaceGetUnique(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceContains(t *testing.T) {
+	tests.DynSetInterfaceContains(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceClear(t *testing.T) {
+	tests.DynSetInterfaceClear(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceAppendUnique(t *testing.T) {
+	tests.DynSetInterfaceAppendUnique(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceUpdateUnique(t *testing.T) {
+	tests.DynSetInterfaceUpdateUnique(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfacePop(t *testing.T) {
+	tests.DynSetInterfacePop(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfacePopPntr(t *testing.T) {
+	tests.DynSetInterfacePopPntr(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceUnorderedEq(t *testing.T) {
+	tests.DynSetInterfaceUnorderedEq(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceIntersection(t *testing.T) {
+	tests.DynSetInterfaceIntersection(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceUnion(t *testing.T) {
+	tests.DynSetInterfaceUnion(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceDifference(t *testing.T) {
+	tests.DynSetInterfaceDifference(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceIsSuperset(t *testing.T) {
+	tests.DynSetInterfaceIsSuperset(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceIsSubset(t *testing.T) {
+	tests.DynSetInterfaceIsSubset(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(OrderedSetToSetInterfaceFactory, t)
+}
+
+func TestOrderedSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(OrderedSetToSetInterfaceFactory, t)
+}
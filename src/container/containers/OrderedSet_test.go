@@ -0,0 +1,169 @@
+package containers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=OrderedSet -category=dynamic -interface=Set -genericDecl=[int] -factory=generateOrderedSet
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedOrderedSet -category=dynamic -interface=Set -genericDecl=[int] -factory=generateSyncedOrderedSet
+
+func generateOrderedSet(capacity int) OrderedSet[int, widgets.BuiltinInt] {
+	return NewOrderedSet[int, widgets.BuiltinInt]()
+}
+
+func generateSyncedOrderedSet(capacity int) SyncedOrderedSet[int, widgets.BuiltinInt] {
+	return NewSyncedOrderedSet[int, widgets.BuiltinInt]()
+}
+
+// Checks that the supplied tree satisfies the left leaning red-black tree
+// invariants. Fails the test if any of the invariants are violated.
+func checkRBTree[K any, V any, KI widgets.PartialOrderInterface[K]](
+	tree *rbTree[K, V, KI],
+	t *testing.T,
+) {
+	var op func(n *rbNode[K, V]) int
+	op = func(n *rbNode[K, V]) int {
+		if n == nil {
+			return 1
+		}
+		test.False(n.right.isRed(), t)
+		if n.isRed() {
+			test.False(n.left.isRed(), t)
+		}
+		if n.left != nil {
+			test.Eq(-1, tree.cmp(&n.left.key, &n.key), t)
+		}
+		if n.right != nil {
+			test.Eq(1, tree.cmp(&n.right.key, &n.key), t)
+		}
+		l := op(n.left)
+		r := op(n.right)
+		test.Eq(l, r, t)
+		if n.isRed() {
+			return l
+		}
+		return l + 1
+	}
+	test.False(tree.root.isRed(), t)
+	op(tree.root)
+	cnt := 0
+	tree.inOrder(func(n *rbNode[K, V]) bool { cnt++; return true })
+	test.Eq(tree.size, cnt, t)
+}
+
+func TestOrderedSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[OrderedSet[string, widgets.BuiltinString]]
+	v := NewOrderedSet[string, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func TestOrderedSetEquality(t *testing.T) {
+	s1 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{0, 1, 2, 3, 4})
+	s2 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{4, 3, 2, 1, 0})
+	test.True(s1.Eq(&s1, &s2), t)
+	s2.AppendUnique(5)
+	test.False(s1.Eq(&s1, &s2), t)
+}
+
+func TestOrderedSetHash(t *testing.T) {
+	s1 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{0, 1, 2, 3, 4})
+	s2 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{4, 3, 2, 1, 0})
+	test.Eq(s1.Hash(&s1), s2.Hash(&s2), t)
+	s2.AppendUnique(5)
+	test.Neq(s1.Hash(&s1), s2.Hash(&s2), t)
+}
+
+func TestOrderedSetZero(t *testing.T) {
+	s1 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{0, 1, 2, 3, 4})
+	s1.Zero(&s1)
+	test.Eq(0, s1.Length(), t)
+	test.True(s1.tree.root == nil, t)
+}
+
+func TestOrderedSetFormat(t *testing.T) {
+	s1 := OrderedSetValInit[int, widgets.BuiltinInt]([]int{3, 1, 2, 0})
+	test.Eq("orderedSet[0 1 2 3]", fmt.Sprintf("%v", s1), t)
+	test.Eq("orderedSet[0 1 2 3]", fmt.Sprintf("%v", &s1), t)
+	test.Eq("orderedSet[0 1 2 3]", s1.String(), t)
+}
+
+func TestOrderedSetMinMaxFloorCeiling(t *testing.T) {
+	s := NewOrderedSet[int, widgets.BuiltinInt]()
+	_, err := s.Min()
+	test.ContainsError(containerTypes.Empty, err, t)
+	_, err = s.Max()
+	test.ContainsError(containerTypes.Empty, err, t)
+	s.AppendUnique(50, 10, 40, 20, 30)
+	v, err := s.Min()
+	test.Nil(err, t)
+	test.Eq(10, v, t)
+	v, err = s.Max()
+	test.Nil(err, t)
+	test.Eq(50, v, t)
+	v, err = s.Floor(35)
+	test.Nil(err, t)
+	test.Eq(30, v, t)
+	_, err = s.Floor(5)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	v, err = s.Ceiling(35)
+	test.Nil(err, t)
+	test.Eq(40, v, t)
+	_, err = s.Ceiling(55)
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestOrderedSetRange(t *testing.T) {
+	s := NewOrderedSet[int, widgets.BuiltinInt]()
+	for i := 0; i < 100; i++ {
+		s.AppendUnique(i)
+	}
+	res, err := s.Range(10, 20).Collect()
+	test.Nil(err, t)
+	test.Eq(10, len(res), t)
+	for i := 0; i < len(res); i++ {
+		test.Eq(i+10, res[i], t)
+	}
+}
+
+func TestOrderedSetRandomOps(t *testing.T) {
+	s := NewOrderedSet[int, widgets.BuiltinInt]()
+	ref := map[int]struct{}{}
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, ok := ref[v]
+			delete(ref, v)
+			if ok {
+				test.Eq(1, s.Pop(v), t)
+			} else {
+				test.Eq(0, s.Pop(v), t)
+			}
+		} else {
+			ref[v] = struct{}{}
+			s.AppendUnique(v)
+		}
+		if i%100 == 0 {
+			checkRBTree(s.tree, t)
+		}
+	}
+	checkRBTree(s.tree, t)
+	test.Eq(len(ref), s.Length(), t)
+	prev := -1
+	for v := range ref {
+		test.True(s.Contains(v), t)
+	}
+	s.Vals().ForEach(func(index int, val int) (iter.IteratorFeedback, error) {
+		test.True(val > prev, t)
+		prev = val
+		return iter.Continue, nil
+	})
+}
@@ -0,0 +1,817 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"math/bits"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+const (
+	persistentHashMapBits = 5
+	persistentHashMapMask = 1<<persistentHashMapBits - 1
+)
+
+type (
+	// An entry in a hash array mapped trie node. An entry either points to a
+	// sub node or holds all of the key value pairs whose keys have the hash
+	// that is stored in the entry.
+	persistentHashMapEntry[K any, V any] struct {
+		hash   hash.Hash
+		child  *persistentHashMapNode[K, V]
+		bucket []basic.Pair[K, V]
+	}
+
+	// A node in a hash array mapped trie. The bitmap records which of the 32
+	// possible slots are in use and entries only holds the slots that are in
+	// use, in order. Nodes are never modified once they are part of a trie.
+	persistentHashMapNode[K any, V any] struct {
+		bitmap  uint32
+		entries []persistentHashMapEntry[K, V]
+	}
+
+	// A type to represent an immutable map. Every operation that would modify
+	// the map instead returns a new map, leaving the original unchanged. The
+	// new map shares as much of its internal structure with the original map as
+	// possible, making it cheap to keep old versions around. Internally this is
+	// implemented with a hash array mapped trie, giving O(log(n)) lookups,
+	// inserts, and deletes. Because a persistent hash map can never be modified
+	// it is safe to share between any number of concurrent readers without any
+	// locking. The type constraints on the generics define the logic for how
+	// value specific operations, such as equality comparisons, will be handled.
+	PersistentHashMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		root   *persistentHashMapNode[K, V]
+		length int
+	}
+)
+
+// Returns the bit that represents the slot for h at the supplied shift and the
+// index of that slot in the nodes entries.
+func (n *persistentHashMapNode[K, V]) slot(h hash.Hash, shift int) (uint32, int) {
+	bit := uint32(1) << ((uint64(h) >> shift) & persistentHashMapMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// Returns a copy of the node with the entry at idx replaced.
+func (n *persistentHashMapNode[K, V]) withEntry(
+	idx int,
+	e persistentHashMapEntry[K, V],
+) *persistentHashMapNode[K, V] {
+	entries := make([]persistentHashMapEntry[K, V], len(n.entries))
+	copy(entries, n.entries)
+	entries[idx] = e
+	return &persistentHashMapNode[K, V]{bitmap: n.bitmap, entries: entries}
+}
+
+// Returns a copy of the node with e inserted at idx and bit added to the
+// bitmap.
+func (n *persistentHashMapNode[K, V]) withInsertedEntry(
+	bit uint32,
+	idx int,
+	e persistentHashMapEntry[K, V],
+) *persistentHashMapNode[K, V] {
+	entries := make([]persistentHashMapEntry[K, V], len(n.entries)+1)
+	copy(entries, n.entries[:idx])
+	entries[idx] = e
+	copy(entries[idx+1:], n.entries[idx:])
+	return &persistentHashMapNode[K, V]{bitmap: n.bitmap | bit, entries: entries}
+}
+
+// Returns a copy of the node with the entry at idx and bit removed. Returns nil
+// if the node would be empty.
+func (n *persistentHashMapNode[K, V]) withoutEntry(
+	bit uint32,
+	idx int,
+) *persistentHashMapNode[K, V] {
+	if len(n.entries) == 1 {
+		return nil
+	}
+	entries := make([]persistentHashMapEntry[K, V], len(n.entries)-1)
+	copy(entries, n.entries[:idx])
+	copy(entries[idx:], n.entries[idx+1:])
+	return &persistentHashMapNode[K, V]{bitmap: n.bitmap &^ bit, entries: entries}
+}
+
+// Creates a node that holds both of the supplied entries, which must have
+// different hashes.
+func newPersistentHashMapNode[K any, V any](
+	l persistentHashMapEntry[K, V],
+	r persistentHashMapEntry[K, V],
+	shift int,
+) *persistentHashMapNode[K, V] {
+	lSlot := (uint64(l.hash) >> shift) & persistentHashMapMask
+	rSlot := (uint64(r.hash) >> shift) & persistentHashMapMask
+	if lSlot == rSlot {
+		return &persistentHashMapNode[K, V]{
+			bitmap: uint32(1) << lSlot,
+			entries: []persistentHashMapEntry[K, V]{{
+				child: newPersistentHashMapNode(l, r, shift+persistentHashMapBits),
+			}},
+		}
+	}
+	if lSlot > rSlot {
+		l, r = r, l
+	}
+	return &persistentHashMapNode[K, V]{
+		bitmap:  uint32(1)<<lSlot | uint32(1)<<rSlot,
+		entries: []persistentHashMapEntry[K, V]{l, r},
+	}
+}
+
+// Calls op with each bucket in the trie, stopping early if op returns false.
+func (n *persistentHashMapNode[K, V]) walk(
+	op func(bucket []basic.Pair[K, V]) bool,
+) bool {
+	for _, iterV := range n.entries {
+		if iterV.child != nil {
+			if !iterV.child.walk(op) {
+				return false
+			}
+		} else if !op(iterV.bucket) {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new, empty, persistent hash map.
+func NewPersistentHashMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+]() PersistentHashMap[K, V, KI, VI] {
+	return PersistentHashMap[K, V, KI, VI]{}
+}
+
+// Creates a new persistent hash map that holds the supplied key value pairs.
+// If a key is supplied multiple times the last value for that key is kept.
+func PersistentHashMapValInit[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](vals ...basic.Pair[K, V]) PersistentHashMap[K, V, KI, VI] {
+	rv := PersistentHashMap[K, V, KI, VI]{}
+	return rv.Emplace(vals...)
+}
+
+// A empty pass through function that performs no action. A persistent hash map
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *PersistentHashMap[K, V, KI, VI]) Lock() {}
+
+// A empty pass through function that performs no action. A persistent hash map
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *PersistentHashMap[K, V, KI, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. A persistent hash map
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *PersistentHashMap[K, V, KI, VI]) RLock() {}
+
+// A empty pass through function that performs no action. A persistent hash map
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *PersistentHashMap[K, V, KI, VI]) RUnlock() {}
+
+// Returns false, persistent hash maps are not addressable. Giving out pointers
+// to values would allow values shared between versions to be modified.
+func (m *PersistentHashMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns false, a persistent hash map has no lock. Persistent hash maps are
+// still safe to read concurrently because they are never modified.
+func (m *PersistentHashMap[K, V, KI, VI]) IsSynced() bool { return false }
+
+// Description: Returns the number of key value pairs in the map.
+//
+// Time Complexity: O(1)
+func (m *PersistentHashMap[K, V, KI, VI]) Length() int {
+	return m.length
+}
+
+func (m *PersistentHashMap[K, V, KI, VI]) find(k *K) (*basic.Pair[K, V], bool) {
+	kw := widgets.Base[K, KI]{}
+	h := kw.Hash(k)
+	n := m.root
+	for shift := 0; n != nil; shift += persistentHashMapBits {
+		bit, idx := n.slot(h, shift)
+		if n.bitmap&bit == 0 {
+			return nil, false
+		}
+		e := &n.entries[idx]
+		if e.child != nil {
+			n = e.child
+			continue
+		}
+		if e.hash != h {
+			return nil, false
+		}
+		for i := 0; i < len(e.bucket); i++ {
+			if kw.Eq(k, &e.bucket[i].A) {
+				return &e.bucket[i], true
+			}
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// Description: Gets the value that is associated with the supplied key.
+// Returns a key error if the key is not found in the map.
+//
+// Time Complexity: O(log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) Get(k K) (V, error) {
+	if p, found := m.find(&k); found {
+		return p.B, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Panics, persistent hash maps are not addressable.
+func (m *PersistentHashMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("persistent hash map"))
+}
+
+// Description: Returns true if the supplied key is in the map, false
+// otherwise.
+//
+// Time Complexity: O(log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) ContainsKey(k K) bool {
+	_, found := m.find(&k)
+	return found
+}
+
+// Description: Contains will return true if the supplied value is in the map,
+// false otherwise. All equality comparisons are performed by the generic VI
+// widget type that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *PersistentHashMap[K, V, KI, VI]) Contains(v V) bool {
+	var tmp K
+	return m.keyOfImpl(&v, &tmp)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the generic
+// VI widget type that the map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *PersistentHashMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return m.keyOfImpl(v, &tmp)
+}
+
+// Description: KeyOf will return the key of the first occurrence of the
+// supplied value in the map. If the value is not found then the returned key
+// will be a zero initialized key value and the boolean flag will be set to
+// false. If the value is found then the boolean flag will be set to true. All
+// equality comparisons are performed by the generic VI widget type that the
+// map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *PersistentHashMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	var rv K
+	found := m.keyOfImpl(&v, &rv)
+	return rv, found
+}
+
+// Description: KeyOfPntr will return the key of the first occurrence of the
+// supplied value in the map. If the value is not found then the returned key
+// will be a zero initialized key value and the boolean flag will be set to
+// false. If the value is found then the boolean flag will be set to true. All
+// equality comparisons are performed by the generic VI widget type that the
+// map was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *PersistentHashMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	var rv K
+	found := m.keyOfImpl(v, &rv)
+	return rv, found
+}
+
+func (m *PersistentHashMap[K, V, KI, VI]) keyOfImpl(v *V, k *K) bool {
+	if m.root == nil {
+		return false
+	}
+	w := widgets.Base[V, VI]{}
+	return !m.root.walk(func(bucket []basic.Pair[K, V]) bool {
+		for i := 0; i < len(bucket); i++ {
+			if w.Eq(v, &bucket[i].B) {
+				*k = bucket[i].A
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Returns a copy of the node with kv added to it. If the key is already in the
+// node its value is replaced. If the key is not in the node and mustExist is
+// true the node is returned unchanged. Returns whether the key was already
+// present.
+func (m *PersistentHashMap[K, V, KI, VI]) set(
+	n *persistentHashMapNode[K, V],
+	shift int,
+	h hash.Hash,
+	kv *basic.Pair[K, V],
+	mustExist bool,
+) (*persistentHashMapNode[K, V], bool) {
+	bit, idx := n.slot(h, shift)
+	if n.bitmap&bit == 0 {
+		if !mustExist {
+			return n.withInsertedEntry(bit, idx, persistentHashMapEntry[K, V]{
+				hash: h, bucket: []basic.Pair[K, V]{*kv},
+			}), false
+		}
+		return n, false
+	}
+	e := n.entries[idx]
+	if e.child != nil {
+		child, found := m.set(e.child, shift+persistentHashMapBits, h, kv, mustExist)
+		if child == e.child {
+			return n, found
+		}
+		return n.withEntry(idx, persistentHashMapEntry[K, V]{child: child}), found
+	}
+	if e.hash != h {
+		if !mustExist {
+			return n.withEntry(idx, persistentHashMapEntry[K, V]{
+				child: newPersistentHashMapNode(
+					e,
+					persistentHashMapEntry[K, V]{
+						hash: h, bucket: []basic.Pair[K, V]{*kv},
+					},
+					shift+persistentHashMapBits,
+				),
+			}), false
+		}
+		return n, false
+	}
+	kw := widgets.Base[K, KI]{}
+	for i := 0; i < len(e.bucket); i++ {
+		if kw.Eq(&kv.A, &e.bucket[i].A) {
+			bucket := make([]basic.Pair[K, V], len(e.bucket))
+			copy(bucket, e.bucket)
+			bucket[i] = *kv
+			return n.withEntry(
+				idx, persistentHashMapEntry[K, V]{hash: h, bucket: bucket},
+			), true
+		}
+	}
+	if mustExist {
+		return n, false
+	}
+	bucket := make([]basic.Pair[K, V], len(e.bucket)+1)
+	copy(bucket, e.bucket)
+	bucket[len(e.bucket)] = *kv
+	return n.withEntry(
+		idx, persistentHashMapEntry[K, V]{hash: h, bucket: bucket},
+	), false
+}
+
+// Returns a copy of the node with k removed from it. Returns nil if the node
+// would be empty. Returns whether the key was found.
+func (m *PersistentHashMap[K, V, KI, VI]) delete(
+	n *persistentHashMapNode[K, V],
+	shift int,
+	h hash.Hash,
+	k *K,
+) (*persistentHashMapNode[K, V], bool) {
+	bit, idx := n.slot(h, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	e := n.entries[idx]
+	if e.child != nil {
+		child, found := m.delete(e.child, shift+persistentHashMapBits, h, k)
+		if !found {
+			return n, false
+		}
+		if child == nil {
+			return n.withoutEntry(bit, idx), true
+		}
+		if len(child.entries) == 1 && child.entries[0].child == nil {
+			// Pull single buckets up so the trie does not hold long chains of
+			// nodes that only have one entry.
+			return n.withEntry(idx, child.entries[0]), true
+		}
+		return n.withEntry(idx, persistentHashMapEntry[K, V]{child: child}), true
+	}
+	if e.hash != h {
+		return n, false
+	}
+	kw := widgets.Base[K, KI]{}
+	for i := 0; i < len(e.bucket); i++ {
+		if kw.Eq(k, &e.bucket[i].A) {
+			if len(e.bucket) == 1 {
+				return n.withoutEntry(bit, idx), true
+			}
+			bucket := make([]basic.Pair[K, V], 0, len(e.bucket)-1)
+			bucket = append(bucket, e.bucket[:i]...)
+			bucket = append(bucket, e.bucket[i+1:]...)
+			return n.withEntry(
+				idx, persistentHashMapEntry[K, V]{hash: h, bucket: bucket},
+			), true
+		}
+	}
+	return n, false
+}
+
+// Description: Returns a new map with the values at the specified keys set to
+// the supplied values. Returns an error if a key is not in the map, in which
+// case the original map is returned.
+//
+// Time Complexity: O(m*log(n)), where m=len(kvPairs)
+func (m *PersistentHashMap[K, V, KI, VI]) Set(
+	kvPairs ...basic.Pair[K, V],
+) (PersistentHashMap[K, V, KI, VI], error) {
+	kw := widgets.Base[K, KI]{}
+	rv := *m
+	for i := 0; i < len(kvPairs); i++ {
+		found := false
+		if rv.root != nil {
+			rv.root, found = m.set(
+				rv.root, 0, kw.Hash(&kvPairs[i].A), &kvPairs[i], true,
+			)
+		}
+		if !found {
+			return *m, getKeyError[K](&kvPairs[i].A)
+		}
+	}
+	return rv, nil
+}
+
+// Description: Returns a new map with the supplied key value pairs added to
+// it. If a key already exists in the map its value will be replaced with the
+// supplied value.
+//
+// Time Complexity: O(m*log(n)), where m=len(kvPairs)
+func (m *PersistentHashMap[K, V, KI, VI]) Emplace(
+	kvPairs ...basic.Pair[K, V],
+) PersistentHashMap[K, V, KI, VI] {
+	kw := widgets.Base[K, KI]{}
+	rv := *m
+	for i := 0; i < len(kvPairs); i++ {
+		h := kw.Hash(&kvPairs[i].A)
+		if rv.root == nil {
+			rv.root = &persistentHashMapNode[K, V]{}
+		}
+		var found bool
+		if rv.root, found = m.set(rv.root, 0, h, &kvPairs[i], false); !found {
+			rv.length++
+		}
+	}
+	return rv
+}
+
+// Description: Returns a new map with the key value pair that has the supplied
+// key removed. Returns a key error if the key is not in the map, in which case
+// the original map is returned.
+//
+// Time Complexity: O(log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) Delete(
+	k K,
+) (PersistentHashMap[K, V, KI, VI], error) {
+	if m.root == nil {
+		return *m, getKeyError[K](&k)
+	}
+	kw := widgets.Base[K, KI]{}
+	root, found := m.delete(m.root, 0, kw.Hash(&k), &k)
+	if !found {
+		return *m, getKeyError[K](&k)
+	}
+	return PersistentHashMap[K, V, KI, VI]{root: root, length: m.length - 1}, nil
+}
+
+// Description: Returns a new map with all key value pairs that have the
+// supplied value removed, along with the number of key value pairs that were
+// removed. All equality comparisons are performed by the generic VI widget
+// type that the map was initialized with.
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) Pop(
+	v V,
+) (PersistentHashMap[K, V, KI, VI], int) {
+	if m.root == nil {
+		return *m, 0
+	}
+	w := widgets.Base[V, VI]{}
+	keys := []K{}
+	m.root.walk(func(bucket []basic.Pair[K, V]) bool {
+		for i := 0; i < len(bucket); i++ {
+			if w.Eq(&v, &bucket[i].B) {
+				keys = append(keys, bucket[i].A)
+			}
+		}
+		return true
+	})
+	rv := *m
+	for _, iterK := range keys {
+		rv, _ = rv.Delete(iterK)
+	}
+	return rv, len(keys)
+}
+
+// Description: Returns a new mutable [HashMap] that holds a copy of the key
+// value pairs in the persistent hash map.
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) ToHashMap() HashMap[K, V, KI, VI] {
+	rv, _ := NewHashMap[K, V, KI, VI](m.length)
+	if m.root != nil {
+		m.root.walk(func(bucket []basic.Pair[K, V]) bool {
+			rv.Emplace(bucket...)
+			return true
+		})
+	}
+	return rv
+}
+
+// Returns an iterator over pointers to the key value pairs in the trie. The
+// pointers must not be handed out to users of the map.
+func (m *PersistentHashMap[K, V, KI, VI]) pairs() iter.Iter[*basic.Pair[K, V]] {
+	type frame struct {
+		n   *persistentHashMapNode[K, V]
+		idx int
+	}
+	stack := []frame{}
+	if m.root != nil {
+		stack = append(stack, frame{n: m.root})
+	}
+	var bucket []basic.Pair[K, V]
+	bucketIdx := 0
+	return func(f iter.IteratorFeedback) (*basic.Pair[K, V], error, bool) {
+		for f != iter.Break && bucketIdx >= len(bucket) && len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.idx >= len(top.n.entries) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			e := &top.n.entries[top.idx]
+			top.idx++
+			if e.child != nil {
+				stack = append(stack, frame{n: e.child})
+			} else {
+				bucket, bucketIdx = e.bucket, 0
+			}
+		}
+		if f != iter.Break && bucketIdx < len(bucket) {
+			bucketIdx++
+			return &bucket[bucketIdx-1], nil, true
+		}
+		return nil, nil, false
+	}
+}
+
+// Description: Returns an iterator that iterates over the keys of the map.
+// Because the map can never change no lock is needed while iterating.
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	pairs := m.pairs()
+	return func(f iter.IteratorFeedback) (K, error, bool) {
+		if p, _, ok := pairs(f); ok {
+			return p.A, nil, true
+		}
+		var tmp K
+		return tmp, nil, false
+	}
+}
+
+// Description: Returns a sequence over the keys in the persistent hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [PersistentHashMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the map.
+// Because the map can never change no lock is needed while iterating.
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	pairs := m.pairs()
+	return func(f iter.IteratorFeedback) (V, error, bool) {
+		if p, _, ok := pairs(f); ok {
+			return p.B, nil, true
+		}
+		var tmp V
+		return tmp, nil, false
+	}
+}
+
+// Description: Returns a sequence over the values in the persistent hash map
+// that can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq]
+// on the iterator returned by [PersistentHashMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the persistent
+// hash map that can be used in a for-range loop.
+//
+// Time Complexity: O(n)
+func (m *PersistentHashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(iter.Map[*basic.Pair[K, V], basic.Pair[K, V]](
+		m.pairs(),
+		func(index int, val *basic.Pair[K, V]) (basic.Pair[K, V], error) {
+			return *val, nil
+		},
+	))
+}
+
+// Panics, persistent hash maps are not addressable.
+func (m *PersistentHashMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("persistent hash map"))
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *PersistentHashMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	if m.length != other.Length() {
+		return false
+	}
+	if m.root == nil {
+		return true
+	}
+	vw := widgets.Base[V, VI]{}
+	return m.root.walk(func(bucket []basic.Pair[K, V]) bool {
+		for i := 0; i < len(bucket); i++ {
+			otherV, err := addressableSafeGet[K, V](other, bucket[i].A)
+			if err != nil || !vw.Eq(&bucket[i].B, otherV) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [PersistentHashMap.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *PersistentHashMap[K, V, KI, VI]) Eq(
+	l *PersistentHashMap[K, V, KI, VI],
+	r *PersistentHashMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a persistent hash map. The hash is
+// calculated the same way as [HashMap.Hash], so a persistent hash map and a
+// hash map with the same key value pairs will have the same hash.
+func (_ *PersistentHashMap[K, V, KI, VI]) Hash(
+	other *PersistentHashMap[K, V, KI, VI],
+) hash.Hash {
+	var rv hash.Hash
+	if other.root == nil {
+		return rv
+	}
+	kw := widgets.Base[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	first := true
+	other.root.walk(func(bucket []basic.Pair[K, V]) bool {
+		for i := 0; i < len(bucket); i++ {
+			iterH := kw.Hash(&bucket[i].A).Combine(vw.Hash(&bucket[i].B))
+			if first {
+				rv = iterH
+				first = false
+			} else {
+				rv = rv.CombineUnordered(iterH)
+			}
+		}
+		return true
+	})
+	return rv
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface]
+// interface. Sets other to an empty persistent hash map. Any other versions
+// that share structure with other are not affected.
+func (_ *PersistentHashMap[K, V, KI, VI]) Zero(
+	other *PersistentHashMap[K, V, KI, VI],
+) {
+	*other = PersistentHashMap[K, V, KI, VI]{}
+}
+
+func (m *PersistentHashMap[K, V, KI, VI]) toSerialized() []serializedKV[K, V] {
+	rv := make([]serializedKV[K, V], 0, m.length)
+	m.pairs().ForEach(
+		func(index int, val *basic.Pair[K, V]) (iter.IteratorFeedback, error) {
+			rv = append(rv, serializedKV[K, V]{Key: val.A, Val: val.B})
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+func (m *PersistentHashMap[K, V, KI, VI]) fromSerialized(
+	kvs []serializedKV[K, V],
+) PersistentHashMap[K, V, KI, VI] {
+	pairs := make([]basic.Pair[K, V], len(kvs))
+	for i := 0; i < len(kvs); i++ {
+		pairs[i] = basic.Pair[K, V]{A: kvs[i].Key, B: kvs[i].Val}
+	}
+	return PersistentHashMapValInit[K, V, KI, VI](pairs...)
+}
+
+// Description: Returns the JSON encoding of the persistent hash map. The
+// persistent hash map is encoded as a list of key value objects in no
+// particular order. If a key is present more than once when decoding the last
+// value is kept.
+//
+// Time Complexity: O(n*log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Sets the persistent hash map to a new persistent hash map that holds the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [PersistentHashMap.MarshalJSON]. Any other versions that share structure with the
+// persistent hash map are not affected. If an error is returned the persistent hash map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	*m = m.fromSerialized(decoded)
+	return nil
+}
+
+// Description: Returns a compact binary encoding of the persistent hash map. The values
+// are laid out the same way as [PersistentHashMap.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n*log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Sets the persistent hash map to a new persistent hash map that holds the values
+// decoded from the supplied binary data, which must have been produced by
+// [PersistentHashMap.MarshalBinary]. Any other versions that share structure with the
+// persistent hash map are not affected. If an error is returned the persistent hash map is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (m *PersistentHashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	*m = m.fromSerialized(decoded)
+	return nil
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m PersistentHashMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("persistentHashMap["))
+	if m.root != nil {
+		cntr := 0
+		m.root.walk(func(bucket []basic.Pair[K, V]) bool {
+			for _, iterV := range bucket {
+				fmt.Fprintf(f, fmtStr, iterV.A, iterV.B)
+				if cntr++; cntr < m.length {
+					f.Write([]byte{' '})
+				}
+			}
+			return true
+		})
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *PersistentHashMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
@@ -0,0 +1,303 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestPersistentHashMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[PersistentHashMap[string, int, widgets.BuiltinString, widgets.BuiltinInt]]
+	v := NewPersistentHashMap[string, int, widgets.BuiltinString, widgets.BuiltinInt]()
+	widget = &v
+	_ = widget
+}
+
+func TestPersistentHashMapReadInterface(t *testing.T) {
+	var container dynamicContainers.ReadMap[int, int]
+	v := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	container = &v
+	_ = container
+}
+
+func checkPersistentHashMap[KI widgets.BaseInterface[int]](
+	m PersistentHashMap[int, int, KI, widgets.BuiltinInt],
+	exp map[int]int,
+	t *testing.T,
+) {
+	test.Eq(len(exp), m.Length(), t)
+	for k, v := range exp {
+		val, err := m.Get(k)
+		test.Nil(err, t)
+		test.Eq(v, val, t)
+		test.True(m.ContainsKey(k), t)
+	}
+	keys, err := m.Keys().Collect()
+	test.Nil(err, t)
+	test.Eq(len(exp), len(keys), t)
+	for _, k := range keys {
+		_, ok := exp[k]
+		test.True(ok, t)
+	}
+	vals, err := m.Vals().Collect()
+	test.Nil(err, t)
+	test.Eq(len(exp), len(vals), t)
+}
+
+func TestPersistentHashMapEmplace(t *testing.T) {
+	m := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	exp := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		m = m.Emplace(basic.Pair[int, int]{i, i * 2})
+		exp[i] = i * 2
+	}
+	checkPersistentHashMap(m, exp, t)
+	m2 := m.Emplace(basic.Pair[int, int]{1, -1}, basic.Pair[int, int]{-1, 1})
+	test.Eq(5001, m2.Length(), t)
+	val, _ := m2.Get(1)
+	test.Eq(-1, val, t)
+	checkPersistentHashMap(m, exp, t)
+	_, err := m.Get(-1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.False(m.ContainsKey(-1), t)
+}
+
+func TestPersistentHashMapValInit(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 4},
+		basic.Pair[int, int]{1, 5},
+	)
+	checkPersistentHashMap(m, map[int]int{1: 5, 3: 4}, t)
+}
+
+func TestPersistentHashMapSet(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 4},
+	)
+	m2, err := m.Set(basic.Pair[int, int]{1, 3})
+	test.Nil(err, t)
+	checkPersistentHashMap(m2, map[int]int{1: 3, 3: 4}, t)
+	checkPersistentHashMap(m, map[int]int{1: 2, 3: 4}, t)
+	m3, err := m2.Set(basic.Pair[int, int]{3, 5}, basic.Pair[int, int]{2, 5})
+	test.ContainsError(containerTypes.KeyError, err, t)
+	checkPersistentHashMap(m3, map[int]int{1: 3, 3: 4}, t)
+	e := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	_, err = e.Set(basic.Pair[int, int]{3, 5})
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestPersistentHashMapDelete(t *testing.T) {
+	m := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	exp := map[int]int{}
+	for i := 0; i < 1000; i++ {
+		m = m.Emplace(basic.Pair[int, int]{i, i})
+		exp[i] = i
+	}
+	orig := m
+	for i := 0; i < 1000; i += 2 {
+		var err error
+		m, err = m.Delete(i)
+		test.Nil(err, t)
+		delete(exp, i)
+	}
+	checkPersistentHashMap(m, exp, t)
+	_, err := m.Delete(0)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.Eq(1000, orig.Length(), t)
+	for i := 1; i < 1000; i += 2 {
+		m, err = m.Delete(i)
+		test.Nil(err, t)
+	}
+	test.Eq(0, m.Length(), t)
+	test.True(m.root == nil, t)
+	_, err = m.Delete(0)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestPersistentHashMapCollisions(t *testing.T) {
+	m := NewPersistentHashMap[int, int, badBuiltinInt, widgets.BuiltinInt]()
+	exp := map[int]int{}
+	for i := 0; i < 100; i++ {
+		m = m.Emplace(basic.Pair[int, int]{i, i + 1})
+		exp[i] = i + 1
+	}
+	checkPersistentHashMap(m, exp, t)
+	m2, err := m.Set(basic.Pair[int, int]{4, 0})
+	test.Nil(err, t)
+	exp2 := map[int]int{}
+	for k, v := range exp {
+		exp2[k] = v
+	}
+	exp2[4] = 0
+	checkPersistentHashMap(m2, exp2, t)
+	for i := 0; i < 100; i += 3 {
+		m, err = m.Delete(i)
+		test.Nil(err, t)
+		delete(exp, i)
+	}
+	checkPersistentHashMap(m, exp, t)
+	_, err = m.Delete(0)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestPersistentHashMapRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	m := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	exp := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		// Large keys make sure that all the bits of the hash are used
+		k := r.Intn(300) << (r.Intn(8) * 8)
+		if r.Intn(3) == 0 {
+			var err error
+			m, err = m.Delete(k)
+			_, ok := exp[k]
+			test.Eq(ok, err == nil, t)
+			delete(exp, k)
+		} else {
+			m = m.Emplace(basic.Pair[int, int]{k, i})
+			exp[k] = i
+		}
+	}
+	checkPersistentHashMap(m, exp, t)
+}
+
+func TestPersistentHashMapPop(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 2},
+		basic.Pair[int, int]{4, 5},
+	)
+	m2, n := m.Pop(2)
+	test.Eq(2, n, t)
+	checkPersistentHashMap(m2, map[int]int{4: 5}, t)
+	m2, n = m2.Pop(2)
+	test.Eq(0, n, t)
+	test.Eq(1, m2.Length(), t)
+	test.Eq(3, m.Length(), t)
+}
+
+func TestPersistentHashMapContains(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 4},
+	)
+	test.True(m.Contains(2), t)
+	test.False(m.Contains(3), t)
+	v := 4
+	test.True(m.ContainsPntr(&v), t)
+	k, found := m.KeyOf(4)
+	test.True(found, t)
+	test.Eq(3, k, t)
+	k, found = m.KeyOfPntr(&v)
+	test.True(found, t)
+	test.Eq(3, k, t)
+	_, found = m.KeyOf(1)
+	test.False(found, t)
+	test.Panics(func() { m.GetPntr(1) }, t)
+	test.Panics(func() { m.ValPntrs() }, t)
+	test.False(m.IsAddressable(), t)
+	test.False(m.IsSynced(), t)
+}
+
+func TestPersistentHashMapEqHash(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 4},
+	)
+	m2 := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{3, 4},
+		basic.Pair[int, int]{1, 2},
+	)
+	test.True(m.Eq(&m, &m2), t)
+	test.Eq(m.Hash(&m), m2.Hash(&m2), t)
+	hm := m.ToHashMap()
+	test.True(m.KeyedEq(&hm), t)
+	test.True(hm.KeyedEq(&m), t)
+	test.Eq(hm.Hash(&hm), m.Hash(&m), t)
+	m3, _ := m2.Set(basic.Pair[int, int]{1, 3})
+	test.False(m.Eq(&m, &m3), t)
+	test.Neq(m.Hash(&m), m3.Hash(&m3), t)
+	m.Zero(&m3)
+	test.Eq(0, m3.Length(), t)
+	test.Eq(2, m2.Length(), t)
+}
+
+func TestPersistentHashMapFormat(t *testing.T) {
+	m := PersistentHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		basic.Pair[int, int]{1, 2},
+		basic.Pair[int, int]{3, 4},
+	)
+	test.Eq("persistentHashMap[1:2 3:4]", m.String(), t)
+	test.Eq("persistentHashMap[1:2 3:4]", fmt.Sprint(m), t)
+	e := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	test.Eq("persistentHashMap[]", e.String(), t)
+}
+
+func TestPersistentHashMapConcurrentReaders(t *testing.T) {
+	m := NewPersistentHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 1000; i++ {
+		m = m.Emplace(basic.Pair[int, int]{i, i})
+	}
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			local := m
+			for i := 0; i < 1000; i++ {
+				val, err := m.Get(i)
+				test.Nil(err, t)
+				test.Eq(i, val, t)
+				local, _ = local.Delete(i)
+				local = local.Emplace(basic.Pair[int, int]{-i, j})
+			}
+			test.Eq(1000, m.Length(), t)
+			test.Eq(1000, local.Length(), t)
+		}(j)
+	}
+	wg.Wait()
+}
+
+func TestPersistentHashMapSerialization(t *testing.T) {
+	vals := make([]basic.Pair[int, int], 1000)
+	for i := range vals {
+		vals[i] = basic.Pair[int, int]{A: i, B: i * 2}
+	}
+	m := PersistentHashMapValInit[int, int, badBuiltinInt, widgets.BuiltinInt](
+		vals...,
+	)
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = m.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&m)
+		}
+		test.Nil(err, t)
+
+		old := PersistentHashMapValInit[int, int, badBuiltinInt, widgets.BuiltinInt](
+			basic.Pair[int, int]{A: -1, B: -1},
+		)
+		res := old
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(m.Eq(&m, &res), t)
+		test.False(res.ContainsKey(-1), t)
+		test.Eq(1, old.Length(), t)
+		test.True(old.ContainsKey(-1), t)
+	}
+}
@@ -0,0 +1,1090 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+const (
+	persistentVectorBits  = 5
+	persistentVectorWidth = 1 << persistentVectorBits
+	// The number of extra search steps that are allowed when looking up an
+	// index in a relaxed node. Used when rebalancing nodes after a concat.
+	persistentVectorExtras = 2
+	// Nodes with more than persistentVectorWidth-persistentVectorInvariant
+	// slots are considered full enough that they are never redistributed when
+	// rebalancing.
+	persistentVectorInvariant = 1
+)
+
+type (
+	// A node in a relaxed radix balanced tree. Leaf nodes only have vals,
+	// internal nodes only have children and sizes. Sizes holds the cumulative
+	// number of values in each child so that nodes do not need to be full.
+	// Nodes are never modified once they are part of a tree.
+	persistentVectorNode[T any] struct {
+		children []*persistentVectorNode[T]
+		sizes    []int
+		vals     []T
+	}
+
+	// A type to represent an immutable vector. Every operation that would
+	// modify the vector instead returns a new vector, leaving the original
+	// unchanged. The new vector shares as much of its internal structure with
+	// the original vector as possible, making it cheap to keep old versions
+	// around. Internally this is implemented with a relaxed radix balanced
+	// tree, giving O(log(n)) indexing, updates, appends, slicing, and
+	// concatenation. Because a persistent vector can never be modified it is
+	// safe to share between any number of concurrent readers without any
+	// locking. The type constraints on the generics define the logic for how
+	// value specific operations, such as equality comparisons, will be
+	// handled.
+	PersistentVector[T any, U widgets.BaseInterface[T]] struct {
+		root   *persistentVectorNode[T]
+		height int
+		length int
+	}
+)
+
+func (n *persistentVectorNode[T]) isLeaf() bool {
+	return n.children == nil
+}
+
+func (n *persistentVectorNode[T]) size() int {
+	if n.isLeaf() {
+		return len(n.vals)
+	}
+	return n.sizes[len(n.sizes)-1]
+}
+
+func (n *persistentVectorNode[T]) numSlots() int {
+	if n.isLeaf() {
+		return len(n.vals)
+	}
+	return len(n.children)
+}
+
+// Returns the index of the child that holds idx and the index of the value
+// within that child. Each child of a node at height h holds at most 32^h values
+// so idx>>(5*h) is a lower bound on the index of the child, leaving only a few
+// steps of linear search.
+func (n *persistentVectorNode[T]) childFor(idx int, height int) (int, int) {
+	i := min(idx>>(persistentVectorBits*height), len(n.children)-1)
+	for n.sizes[i] <= idx {
+		i++
+	}
+	if i > 0 {
+		idx -= n.sizes[i-1]
+	}
+	return i, idx
+}
+
+func newPersistentVectorInternal[T any](
+	children []*persistentVectorNode[T],
+) *persistentVectorNode[T] {
+	sizes := make([]int, len(children))
+	total := 0
+	for i, iterV := range children {
+		total += iterV.size()
+		sizes[i] = total
+	}
+	return &persistentVectorNode[T]{children: children, sizes: sizes}
+}
+
+// Builds a balanced tree from the supplied values. The values are copied.
+func persistentVectorFromSlice[T any](
+	vals []T,
+) (*persistentVectorNode[T], int) {
+	if len(vals) == 0 {
+		return nil, 0
+	}
+	level := make(
+		[]*persistentVectorNode[T], 0,
+		(len(vals)+persistentVectorWidth-1)/persistentVectorWidth,
+	)
+	for i := 0; i < len(vals); i += persistentVectorWidth {
+		end := min(i+persistentVectorWidth, len(vals))
+		leaf := make([]T, end-i)
+		copy(leaf, vals[i:end])
+		level = append(level, &persistentVectorNode[T]{vals: leaf})
+	}
+	height := 0
+	for len(level) > 1 {
+		next := make(
+			[]*persistentVectorNode[T], 0,
+			(len(level)+persistentVectorWidth-1)/persistentVectorWidth,
+		)
+		for i := 0; i < len(level); i += persistentVectorWidth {
+			end := min(i+persistentVectorWidth, len(level))
+			next = append(next, newPersistentVectorInternal(level[i:end:end]))
+		}
+		level = next
+		height++
+	}
+	return level[0], height
+}
+
+// Returns a copy of the node with the value at idx replaced.
+func persistentVectorSet[T any](
+	n *persistentVectorNode[T],
+	height int,
+	idx int,
+	val T,
+) *persistentVectorNode[T] {
+	if n.isLeaf() {
+		vals := make([]T, len(n.vals))
+		copy(vals, n.vals)
+		vals[idx] = val
+		return &persistentVectorNode[T]{vals: vals}
+	}
+	i, subIdx := n.childFor(idx, height)
+	children := make([]*persistentVectorNode[T], len(n.children))
+	copy(children, n.children)
+	children[i] = persistentVectorSet(n.children[i], height-1, subIdx, val)
+	return &persistentVectorNode[T]{children: children, sizes: n.sizes}
+}
+
+// Returns a copy of the node with val appended to its right most leaf. If the
+// node has no room left the node is returned unchanged along with a new node
+// of the same height that holds val.
+func persistentVectorPushBack[T any](
+	n *persistentVectorNode[T],
+	height int,
+	val T,
+) (*persistentVectorNode[T], *persistentVectorNode[T]) {
+	if n.isLeaf() {
+		if len(n.vals) < persistentVectorWidth {
+			vals := make([]T, len(n.vals)+1)
+			copy(vals, n.vals)
+			vals[len(n.vals)] = val
+			return &persistentVectorNode[T]{vals: vals}, nil
+		}
+		return n, &persistentVectorNode[T]{vals: []T{val}}
+	}
+	last := len(n.children) - 1
+	child, overflow := persistentVectorPushBack(n.children[last], height-1, val)
+	if overflow == nil {
+		children := make([]*persistentVectorNode[T], len(n.children))
+		copy(children, n.children)
+		children[last] = child
+		sizes := make([]int, len(n.sizes))
+		copy(sizes, n.sizes)
+		sizes[last]++
+		return &persistentVectorNode[T]{children: children, sizes: sizes}, nil
+	}
+	if len(n.children) < persistentVectorWidth {
+		children := make([]*persistentVectorNode[T], len(n.children)+1)
+		copy(children, n.children)
+		children[last+1] = overflow
+		sizes := make([]int, len(n.sizes)+1)
+		copy(sizes, n.sizes)
+		sizes[last+1] = n.sizes[last] + 1
+		return &persistentVectorNode[T]{children: children, sizes: sizes}, nil
+	}
+	return n, newPersistentVectorInternal([]*persistentVectorNode[T]{overflow})
+}
+
+// Returns a node that holds the first num values of n. Num must be > 0 and <=
+// the size of n.
+func persistentVectorTake[T any](
+	n *persistentVectorNode[T],
+	height int,
+	num int,
+) *persistentVectorNode[T] {
+	if num == n.size() {
+		return n
+	}
+	if n.isLeaf() {
+		return &persistentVectorNode[T]{vals: n.vals[:num:num]}
+	}
+	i, subNum := n.childFor(num-1, height)
+	children := make([]*persistentVectorNode[T], i+1)
+	copy(children, n.children[:i])
+	children[i] = persistentVectorTake(n.children[i], height-1, subNum+1)
+	sizes := make([]int, i+1)
+	copy(sizes, n.sizes[:i])
+	sizes[i] = num
+	return &persistentVectorNode[T]{children: children, sizes: sizes}
+}
+
+// Returns a node that holds all but the first num values of n. Num must be >= 0
+// and < the size of n.
+func persistentVectorDrop[T any](
+	n *persistentVectorNode[T],
+	height int,
+	num int,
+) *persistentVectorNode[T] {
+	if num == 0 {
+		return n
+	}
+	if n.isLeaf() {
+		return &persistentVectorNode[T]{vals: n.vals[num:]}
+	}
+	i, subNum := n.childFor(num, height)
+	children := make([]*persistentVectorNode[T], len(n.children)-i)
+	children[0] = persistentVectorDrop(n.children[i], height-1, subNum)
+	copy(children[1:], n.children[i+1:])
+	return newPersistentVectorInternal(children)
+}
+
+// Removes any root nodes that only have a single child.
+func persistentVectorShrink[T any](
+	n *persistentVectorNode[T],
+	height int,
+) (*persistentVectorNode[T], int) {
+	for height > 0 && len(n.children) == 1 {
+		n = n.children[0]
+		height--
+	}
+	return n, height
+}
+
+// Concatenates the two trees, rebalancing the nodes along the seam between
+// them so that lookups remain O(log(n)).
+func persistentVectorConcat[T any](
+	l *persistentVectorNode[T],
+	lHeight int,
+	r *persistentVectorNode[T],
+	rHeight int,
+) (*persistentVectorNode[T], int) {
+	if l == nil {
+		return r, rHeight
+	}
+	if r == nil {
+		return l, lHeight
+	}
+	return persistentVectorShrink(
+		persistentVectorConcatSubTree(l, lHeight, r, rHeight),
+		max(lHeight, rHeight)+1,
+	)
+}
+
+// Returns a node with a height one greater than the tallest of l and r that
+// holds all the values from l followed by all the values from r. The returned
+// node will have either one or two children.
+func persistentVectorConcatSubTree[T any](
+	l *persistentVectorNode[T],
+	lHeight int,
+	r *persistentVectorNode[T],
+	rHeight int,
+) *persistentVectorNode[T] {
+	if lHeight > rHeight {
+		last := len(l.children) - 1
+		mid := persistentVectorConcatSubTree(
+			l.children[last], lHeight-1, r, rHeight,
+		)
+		return persistentVectorRebalance(
+			l.children[:last], mid.children, nil, lHeight-1,
+		)
+	} else if lHeight < rHeight {
+		mid := persistentVectorConcatSubTree(
+			l, lHeight, r.children[0], rHeight-1,
+		)
+		return persistentVectorRebalance(
+			nil, mid.children, r.children[1:], rHeight-1,
+		)
+	} else if lHeight == 0 {
+		if len(l.vals)+len(r.vals) <= persistentVectorWidth {
+			vals := make([]T, len(l.vals)+len(r.vals))
+			copy(vals, l.vals)
+			copy(vals[len(l.vals):], r.vals)
+			return newPersistentVectorInternal(
+				[]*persistentVectorNode[T]{{vals: vals}},
+			)
+		}
+		return newPersistentVectorInternal([]*persistentVectorNode[T]{l, r})
+	}
+	last := len(l.children) - 1
+	mid := persistentVectorConcatSubTree(
+		l.children[last], lHeight-1, r.children[0], rHeight-1,
+	)
+	return persistentVectorRebalance(
+		l.children[:last], mid.children, r.children[1:], lHeight-1,
+	)
+}
+
+// Redistributes the slots of the supplied nodes, all of which are at the
+// supplied height, so that there are no more than persistentVectorExtras more
+// nodes than are strictly needed. The result is packed into one or two nodes
+// that are wrapped in a node that is two levels above the supplied height.
+func persistentVectorRebalance[T any](
+	l []*persistentVectorNode[T],
+	mid []*persistentVectorNode[T],
+	r []*persistentVectorNode[T],
+	height int,
+) *persistentVectorNode[T] {
+	all := make([]*persistentVectorNode[T], 0, len(l)+len(mid)+len(r))
+	all = append(all, l...)
+	all = append(all, mid...)
+	all = append(all, r...)
+
+	counts := make([]int, len(all))
+	total := 0
+	for i, iterV := range all {
+		counts[i] = iterV.numSlots()
+		total += counts[i]
+	}
+	optimal := (total + persistentVectorWidth - 1) / persistentVectorWidth
+	numNodes := len(counts)
+	for i := 0; numNodes > optimal+persistentVectorExtras; i-- {
+		for counts[i] > persistentVectorWidth-persistentVectorInvariant {
+			i++
+		}
+		// Found a short node, spread its slots over the following nodes
+		remaining := counts[i]
+		for remaining > 0 {
+			newCount := min(remaining+counts[i+1], persistentVectorWidth)
+			remaining = remaining + counts[i+1] - newCount
+			counts[i] = newCount
+			i++
+		}
+		copy(counts[i:numNodes-1], counts[i+1:numNodes])
+		numNodes--
+	}
+	counts = counts[:numNodes]
+
+	if numNodes != len(all) {
+		all = persistentVectorRedistribute(all, counts)
+	}
+	if len(all) <= persistentVectorWidth {
+		return newPersistentVectorInternal([]*persistentVectorNode[T]{
+			newPersistentVectorInternal(all),
+		})
+	}
+	return newPersistentVectorInternal([]*persistentVectorNode[T]{
+		newPersistentVectorInternal(all[:persistentVectorWidth:persistentVectorWidth]),
+		newPersistentVectorInternal(all[persistentVectorWidth:]),
+	})
+}
+
+// Creates new nodes that have the supplied number of slots, filling them in
+// order with the slots from the supplied nodes. Nodes that already have the
+// correct number of slots are reused.
+func persistentVectorRedistribute[T any](
+	nodes []*persistentVectorNode[T],
+	counts []int,
+) []*persistentVectorNode[T] {
+	rv := make([]*persistentVectorNode[T], 0, len(counts))
+	nodeIdx, offset := 0, 0
+	for _, c := range counts {
+		if offset == 0 && nodes[nodeIdx].numSlots() == c {
+			rv = append(rv, nodes[nodeIdx])
+			nodeIdx++
+			continue
+		}
+		if nodes[nodeIdx].isLeaf() {
+			vals := make([]T, 0, c)
+			for len(vals) < c {
+				src := nodes[nodeIdx].vals[offset:]
+				num := min(c-len(vals), len(src))
+				vals = append(vals, src[:num]...)
+				if offset += num; offset == len(nodes[nodeIdx].vals) {
+					nodeIdx, offset = nodeIdx+1, 0
+				}
+			}
+			rv = append(rv, &persistentVectorNode[T]{vals: vals})
+		} else {
+			children := make([]*persistentVectorNode[T], 0, c)
+			for len(children) < c {
+				src := nodes[nodeIdx].children[offset:]
+				num := min(c-len(children), len(src))
+				children = append(children, src[:num]...)
+				if offset += num; offset == len(nodes[nodeIdx].children) {
+					nodeIdx, offset = nodeIdx+1, 0
+				}
+			}
+			rv = append(rv, newPersistentVectorInternal(children))
+		}
+	}
+	return rv
+}
+
+// Calls op with each leaf in order, stopping early if op returns false.
+func (n *persistentVectorNode[T]) walk(op func(vals []T) bool) bool {
+	if n.isLeaf() {
+		return op(n.vals)
+	}
+	for _, iterV := range n.children {
+		if !iterV.walk(op) {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new, empty, persistent vector.
+func NewPersistentVector[
+	T any,
+	U widgets.BaseInterface[T],
+]() PersistentVector[T, U] {
+	return PersistentVector[T, U]{}
+}
+
+// Creates a new persistent vector that holds the supplied values. The values
+// are copied.
+func PersistentVectorValInit[
+	T any,
+	U widgets.BaseInterface[T],
+](vals ...T) PersistentVector[T, U] {
+	root, height := persistentVectorFromSlice(vals)
+	return PersistentVector[T, U]{root: root, height: height, length: len(vals)}
+}
+
+func (v *PersistentVector[T, U]) fromTree(
+	root *persistentVectorNode[T],
+	height int,
+) PersistentVector[T, U] {
+	if root == nil {
+		return PersistentVector[T, U]{}
+	}
+	return PersistentVector[T, U]{root: root, height: height, length: root.size()}
+}
+
+// A empty pass through function that performs no action. A persistent vector
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (v *PersistentVector[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. A persistent vector
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (v *PersistentVector[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. A persistent vector
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (v *PersistentVector[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. A persistent vector
+// is never modified so it never needs to be locked. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (v *PersistentVector[T, U]) RUnlock() {}
+
+// Returns false, persistent vectors are not addressable. Giving out pointers
+// to values would allow values shared between versions to be modified.
+func (v *PersistentVector[T, U]) IsAddressable() bool { return false }
+
+// Returns false, a persistent vector has no lock. Persistent vectors are still
+// safe to read concurrently because they are never modified.
+func (v *PersistentVector[T, U]) IsSynced() bool { return false }
+
+// Description: Returns the number of elements in the vector.
+//
+// Time Complexity: O(1)
+func (v *PersistentVector[T, U]) Length() int {
+	return v.length
+}
+
+// Description: Returns the number of elements in the vector. A persistent
+// vector has no spare capacity, a new version is created every time a value is
+// added.
+//
+// Time Complexity: O(1)
+func (v *PersistentVector[T, U]) Capacity() int {
+	return v.length
+}
+
+// Description: Gets the value at the specified index. Returns an error if the
+// index is >= the length of the vector.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) Get(idx int) (T, error) {
+	if idx < 0 || idx >= v.length {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(idx, 0, v.length)
+	}
+	n := v.root
+	for h := v.height; h > 0; h-- {
+		var i int
+		i, idx = n.childFor(idx, h)
+		n = n.children[i]
+	}
+	return n.vals[idx], nil
+}
+
+// Panics, persistent vectors are not addressable.
+func (v *PersistentVector[T, U]) GetPntr(idx int) (*T, error) {
+	panic(getNonAddressablePanicText("persistent vector"))
+}
+
+// Description: Returns the value at index 0 if one is present. If the vector
+// has no elements then an error is returned.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) PeekFront() (T, error) {
+	if v.length == 0 {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(0, 0, v.length)
+	}
+	return v.Get(0)
+}
+
+// Description: Returns the value at the last index if one is present. If the
+// vector has no elements then an error is returned.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) PeekBack() (T, error) {
+	if v.length == 0 {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(0, 0, v.length)
+	}
+	return v.Get(v.length - 1)
+}
+
+// Description: Contains will return true if the supplied value is in the
+// vector, false otherwise. All equality comparisons are performed by the
+// generic U widget type that the vector was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (v *PersistentVector[T, U]) Contains(val T) bool {
+	_, found := v.KeyOfPntr(&val)
+	return found
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// vector, false otherwise. All equality comparisons are performed by the
+// generic U widget type that the vector was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (v *PersistentVector[T, U]) ContainsPntr(val *T) bool {
+	_, found := v.KeyOfPntr(val)
+	return found
+}
+
+// Description: KeyOf will return the index of the first occurrence of the
+// supplied value in the vector. If the value is not found then the returned
+// index will be -1 and the boolean flag will be set to false. If the value is
+// found then the boolean flag will be set to true. All equality comparisons are
+// performed by the generic U widget type that the vector was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (v *PersistentVector[T, U]) KeyOf(val T) (int, bool) {
+	return v.KeyOfPntr(&val)
+}
+
+// Description: KeyOfPntr will return the index of the first occurrence of the
+// supplied value in the vector. If the value is not found then the returned
+// index will be -1 and the boolean flag will be set to false. If the value is
+// found then the boolean flag will be set to true. All equality comparisons are
+// performed by the generic U widget type that the vector was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (v *PersistentVector[T, U]) KeyOfPntr(val *T) (int, bool) {
+	if v.root == nil {
+		return -1, false
+	}
+	w := widgets.Base[T, U]{}
+	rv := -1
+	base := 0
+	v.root.walk(func(vals []T) bool {
+		for i := 0; i < len(vals); i++ {
+			if w.Eq(val, &vals[i]) {
+				rv = base + i
+				return false
+			}
+		}
+		base += len(vals)
+		return true
+	})
+	return rv, rv >= 0
+}
+
+// Description: Returns a new vector with the values at the specified indexes
+// set to the supplied values. Returns an error if any index is >= the length of
+// the vector, in which case the original vector is returned.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (v *PersistentVector[T, U]) Set(
+	vals ...basic.Pair[int, T],
+) (PersistentVector[T, U], error) {
+	rv := *v
+	for _, iterV := range vals {
+		if iterV.A < 0 || iterV.A >= v.length {
+			return *v, getIndexOutOfBoundsError(iterV.A, 0, v.length)
+		}
+		rv.root = persistentVectorSet(rv.root, rv.height, iterV.A, iterV.B)
+	}
+	return rv, nil
+}
+
+// Description: Returns a new vector with the supplied values appended to the
+// end of the vector.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (v *PersistentVector[T, U]) Append(vals ...T) PersistentVector[T, U] {
+	if len(vals) > persistentVectorWidth {
+		// Building a tree and concatenating it avoids copying the path to the
+		// last leaf once per value.
+		other := PersistentVectorValInit[T, U](vals...)
+		return v.Concat(&other)
+	}
+	rv := *v
+	for _, iterV := range vals {
+		if rv.root == nil {
+			rv.root = &persistentVectorNode[T]{vals: []T{iterV}}
+		} else if root, overflow := persistentVectorPushBack(
+			rv.root, rv.height, iterV,
+		); overflow == nil {
+			rv.root = root
+		} else {
+			rv.root = newPersistentVectorInternal(
+				[]*persistentVectorNode[T]{root, overflow},
+			)
+			rv.height++
+		}
+		rv.length++
+	}
+	return rv
+}
+
+// Description: Returns a new vector that contains all the values in this
+// vector followed by all the values in other.
+//
+// Time Complexity: O(log(n+m)), where m is the length of other
+func (v *PersistentVector[T, U]) Concat(
+	other *PersistentVector[T, U],
+) PersistentVector[T, U] {
+	return v.fromTree(persistentVectorConcat(
+		v.root, v.height, other.root, other.height,
+	))
+}
+
+// Splits the vector into two trees, the first holding the values before idx
+// and the second holding the values at and after idx.
+func (v *PersistentVector[T, U]) split(idx int) (
+	*persistentVectorNode[T], int,
+	*persistentVectorNode[T], int,
+) {
+	var l, r *persistentVectorNode[T]
+	var lHeight, rHeight int
+	if idx > 0 {
+		l, lHeight = persistentVectorShrink(
+			persistentVectorTake(v.root, v.height, idx), v.height,
+		)
+	}
+	if idx < v.length {
+		r, rHeight = persistentVectorShrink(
+			persistentVectorDrop(v.root, v.height, idx), v.height,
+		)
+	}
+	return l, lHeight, r, rHeight
+}
+
+// Description: Returns a new vector that holds the values in the index range
+// [start,end). Returns an error if the start index is < 0, the end index is >
+// the length of the vector, or the end index is < the start index.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) Slice(
+	start int,
+	end int,
+) (PersistentVector[T, U], error) {
+	if start < 0 {
+		return PersistentVector[T, U]{}, getIndexOutOfBoundsError(start, 0, v.length)
+	}
+	if end > v.length {
+		return PersistentVector[T, U]{}, getIndexOutOfBoundsError(end, 0, v.length)
+	}
+	if end < start {
+		return PersistentVector[T, U]{}, getStartEndIndexError(start, end)
+	}
+	if start == end {
+		return PersistentVector[T, U]{}, nil
+	}
+	root, height := persistentVectorShrink(
+		persistentVectorTake(v.root, v.height, end), v.height,
+	)
+	return v.fromTree(persistentVectorShrink(
+		persistentVectorDrop(root, height, start), height,
+	)), nil
+}
+
+// Description: Returns a new vector with the supplied values inserted into the
+// vector. The values will be inserted in the order that they are given, so
+// each index is relative to the vector produced by the previous insertion.
+// Returns an error if any index is > the length of the vector, in which case
+// the original vector is returned.
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (v *PersistentVector[T, U]) Insert(
+	vals ...basic.Pair[int, T],
+) (PersistentVector[T, U], error) {
+	rv := *v
+	for _, iterV := range vals {
+		var err error
+		if rv, err = rv.InsertSequential(iterV.A, iterV.B); err != nil {
+			return *v, err
+		}
+	}
+	return rv, nil
+}
+
+// Description: Returns a new vector with the supplied values inserted at the
+// supplied index, keeping the values in the order they are given. Returns an
+// error if the index is > the length of the vector.
+//
+// Time Complexity: O(m+log(n)), where m=len(vals)
+func (v *PersistentVector[T, U]) InsertSequential(
+	idx int,
+	vals ...T,
+) (PersistentVector[T, U], error) {
+	if idx < 0 || idx > v.length {
+		return *v, getIndexOutOfBoundsError(idx, 0, v.length)
+	}
+	if idx == v.length {
+		return v.Append(vals...), nil
+	}
+	l, lHeight, r, rHeight := v.split(idx)
+	mid, midHeight := persistentVectorFromSlice(vals)
+	l, lHeight = persistentVectorConcat(l, lHeight, mid, midHeight)
+	return v.fromTree(persistentVectorConcat(l, lHeight, r, rHeight)), nil
+}
+
+// Description: Returns a new vector with the value at the supplied index
+// removed. Returns an error if the index is >= the length of the vector.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) Delete(idx int) (PersistentVector[T, U], error) {
+	if idx < 0 || idx >= v.length {
+		return *v, getIndexOutOfBoundsError(idx, 0, v.length)
+	}
+	return v.DeleteSequential(idx, idx+1)
+}
+
+// Description: Returns a new vector with the values in the index range
+// [start,end) removed. Returns an error if the start index is < 0, the end
+// index is > the length of the vector, or the end index is < the start index.
+// If the start index is equal to the end index the range is empty and the
+// original vector is returned unchanged.
+//
+// Time Complexity: O(log(n))
+func (v *PersistentVector[T, U]) DeleteSequential(
+	start int,
+	end int,
+) (PersistentVector[T, U], error) {
+	if start < 0 {
+		return *v, getIndexOutOfBoundsError(start, 0, v.length)
+	}
+	if end > v.length {
+		return *v, getIndexOutOfBoundsError(end, 0, v.length)
+	}
+	if end < start {
+		return *v, getStartEndIndexError(start, end)
+	}
+	if start == end {
+		return *v, nil
+	}
+	l, lHeight, _, _ := v.split(start)
+	_, _, r, rHeight := v.split(end)
+	return v.fromTree(persistentVectorConcat(l, lHeight, r, rHeight)), nil
+}
+
+// Description: Returns a new mutable [Vector] that holds a copy of the values
+// in the persistent vector.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) ToVector() Vector[T, U] {
+	rv := make(Vector[T, U], 0, v.length)
+	if v.root != nil {
+		v.root.walk(func(vals []T) bool {
+			rv = append(rv, vals...)
+			return true
+		})
+	}
+	return rv
+}
+
+// Description: Returns an iterator that iterates over the values in the vector.
+// Because the vector can never change no lock is needed while iterating.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) Vals() iter.Iter[T] {
+	type frame struct {
+		n   *persistentVectorNode[T]
+		idx int
+	}
+	stack := []frame{}
+	if v.root != nil {
+		stack = append(stack, frame{n: v.root})
+	}
+	var leaf []T
+	leafIdx := 0
+	return func(f iter.IteratorFeedback) (T, error, bool) {
+		for f != iter.Break && leafIdx >= len(leaf) && len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.n.isLeaf() {
+				leaf, leafIdx = top.n.vals, 0
+				stack = stack[:len(stack)-1]
+			} else if top.idx < len(top.n.children) {
+				top.idx++
+				stack = append(stack, frame{n: top.n.children[top.idx-1]})
+			} else {
+				stack = stack[:len(stack)-1]
+			}
+		}
+		if f != iter.Break && leafIdx < len(leaf) {
+			leafIdx++
+			return leaf[leafIdx-1], nil, true
+		}
+		var tmp T
+		return tmp, nil, false
+	}
+}
+
+// Description: Returns a sequence over the values in the persistent vector that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [PersistentVector.Vals].
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) ValsSeq() stditer.Seq[T] {
+	return v.Vals().Seq()
+}
+
+// Panics, persistent vectors are not addressable.
+func (v *PersistentVector[T, U]) ValPntrs() iter.Iter[*T] {
+	panic(getNonAddressablePanicText("persistent vector"))
+}
+
+// Description: Returns an iterator that iterates over the keys (indexes) of the
+// vector.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) Keys() iter.Iter[int] {
+	return iter.Range[int](0, v.length, 1)
+}
+
+// Description: Returns a sequence over the keys in the persistent vector that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [PersistentVector.Keys].
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) KeysSeq() stditer.Seq[int] {
+	return v.Keys().Seq()
+}
+
+// Description: Returns true if the elements in v are all contained in other and
+// the elements of other are all contained in v, regardless of position. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O it might look something like this,
+// O(n*O(other.ContainsPntr))), where O(other.ContainsPntr) represents the time
+// complexity of the ContainsPntr method on other with m values.
+func (v *PersistentVector[T, U]) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	return v.length == other.Length() && v.IsSubset(other)
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (v *PersistentVector[T, U]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[int, T],
+) bool {
+	if v.length != other.Length() {
+		return false
+	}
+	if v.root == nil {
+		return true
+	}
+	w := widgets.Base[T, U]{}
+	idx := 0
+	return v.root.walk(func(vals []T) bool {
+		for i := 0; i < len(vals); i++ {
+			otherV, err := addressableSafeGet[int, T](other, idx)
+			if err != nil || !w.Eq(otherV, &vals[i]) {
+				return false
+			}
+			idx++
+		}
+		return true
+	})
+}
+
+// Description: Returns true if this vector is a superset to other.
+//
+// Time Complexity: O(n*m), where n is the number of values in this vector and
+// m is the number of values in other.
+func (v *PersistentVector[T, U]) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	rv := (v.length >= other.Length())
+	if !rv {
+		return false
+	}
+	addressableSafeValIter[T](other).ForEach(
+		func(index int, val *T) (iter.IteratorFeedback, error) {
+			if rv = v.ContainsPntr(val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Returns true if this vector is a subset to other.
+//
+// Time Complexity: Dependent on the ContainsPntr method of other. In big-O
+// terms it may look somwthing like this: O(n*O(other.ContainsPntr)), where n is
+// the number of elements in the current vector and other.ContainsPntr
+// represents the time complexity of the containsPntr method on other.
+func (v *PersistentVector[T, U]) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	if v.length > other.Length() {
+		return false
+	}
+	if v.root == nil {
+		return true
+	}
+	return v.root.walk(func(vals []T) bool {
+		for i := 0; i < len(vals); i++ {
+			if !other.ContainsPntr(&vals[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// An equality function that implements the [widget.Base] interface.
+// Internally this is equivalent to [PersistentVector.KeyedEq]. Returns true if
+// l==r, false otherwise.
+func (_ *PersistentVector[T, U]) Eq(
+	l *PersistentVector[T, U],
+	r *PersistentVector[T, U],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a persistent vector to implement the
+// [widget.Base]. The hash is calculated the same way as [Vector.Hash], so a
+// persistent vector and a vector with the same values will have the same
+// hash.
+func (_ *PersistentVector[T, U]) Hash(other *PersistentVector[T, U]) hash.Hash {
+	var rv hash.Hash
+	if other.root == nil {
+		return rv
+	}
+	w := widgets.Base[T, U]{}
+	first := true
+	other.root.walk(func(vals []T) bool {
+		for i := 0; i < len(vals); i++ {
+			if first {
+				rv = w.Hash(&vals[i])
+				first = false
+			} else {
+				rv = rv.Combine(w.Hash(&vals[i]))
+			}
+		}
+		return true
+	})
+	return rv
+}
+
+// An zero function that implements the [widget.Base] interface. Sets other to
+// an empty persistent vector. Any other versions that share structure with
+// other are not affected.
+func (_ *PersistentVector[T, U]) Zero(other *PersistentVector[T, U]) {
+	*other = PersistentVector[T, U]{}
+}
+
+func (v *PersistentVector[T, U]) toSerialized() []T {
+	rv := make([]T, 0, v.length)
+	if v.root != nil {
+		v.root.walk(func(vals []T) bool {
+			rv = append(rv, vals...)
+			return true
+		})
+	}
+	return rv
+}
+
+// Description: Returns the JSON encoding of the persistent vector. The
+// persistent vector is encoded as a list of its values, in order. The internal
+// structure of the vector is not encoded.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.toSerialized())
+}
+
+// Description: Sets the persistent vector to a new persistent vector that holds the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [PersistentVector.MarshalJSON]. Any other versions that share structure with the
+// persistent vector are not affected. If an error is returned the persistent vector is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	*v = PersistentVectorValInit[T, U](decoded...)
+	return nil
+}
+
+// Description: Returns a compact binary encoding of the persistent vector. The values
+// are laid out the same way as [PersistentVector.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(v.toSerialized())
+}
+
+// Description: Sets the persistent vector to a new persistent vector that holds the values
+// decoded from the supplied binary data, which must have been produced by
+// [PersistentVector.MarshalBinary]. Any other versions that share structure with the
+// persistent vector are not affected. If an error is returned the persistent vector is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (v *PersistentVector[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	*v = PersistentVectorValInit[T, U](decoded...)
+	return nil
+}
+
+// Implements the [fmt.Formatter] interface.
+func (v PersistentVector[T, U]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("persistentVec["))
+	if v.root != nil {
+		cntr := 0
+		v.root.walk(func(vals []T) bool {
+			for _, iterV := range vals {
+				fmt.Fprintf(f, fmtStr, iterV)
+				if cntr++; cntr < v.length {
+					f.Write([]byte{' '})
+				}
+			}
+			return true
+		})
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (v *PersistentVector[T, U]) String() string {
+	return fmt.Sprintf("%v", v)
+}
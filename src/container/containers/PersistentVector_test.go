@@ -0,0 +1,418 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestPersistentVectorWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[PersistentVector[string, widgets.BuiltinString]]
+	v := NewPersistentVector[string, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func TestPersistentVectorReadInterface(t *testing.T) {
+	var container dynamicContainers.ReadVector[int]
+	v := NewPersistentVector[int, widgets.BuiltinInt]()
+	container = &v
+	_ = container
+}
+
+// Checks that every node in the tree has a valid number of slots, that all
+// leaves are at height 0, and that the size tables are correct.
+func checkPersistentVectorNode[T any](
+	n *persistentVectorNode[T],
+	height int,
+	t *testing.T,
+) int {
+	test.True(n.numSlots() > 0, t)
+	test.True(n.numSlots() <= persistentVectorWidth, t)
+	if height == 0 {
+		test.True(n.isLeaf(), t)
+		return len(n.vals)
+	}
+	test.False(n.isLeaf(), t)
+	test.Eq(len(n.children), len(n.sizes), t)
+	total := 0
+	for i, iterV := range n.children {
+		total += checkPersistentVectorNode(iterV, height-1, t)
+		test.Eq(total, n.sizes[i], t)
+	}
+	return total
+}
+
+func checkPersistentVector(
+	v PersistentVector[int, widgets.BuiltinInt],
+	exp []int,
+	t *testing.T,
+) {
+	test.Eq(len(exp), v.Length(), t)
+	if v.root != nil {
+		test.Eq(len(exp), checkPersistentVectorNode(v.root, v.height, t), t)
+		// The tree should never be much taller than a perfectly balanced tree
+		minHeight := int(math.Ceil(math.Log(float64(len(exp))) / math.Log(32)))
+		test.True(v.height <= minHeight, t)
+	} else {
+		test.Eq(0, len(exp), t)
+	}
+	for i, iterV := range exp {
+		val, err := v.Get(i)
+		test.Nil(err, t)
+		test.Eq(iterV, val, t)
+	}
+	vals, err := v.Vals().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](exp, vals, t)
+}
+
+func TestPersistentVectorAppend(t *testing.T) {
+	v := NewPersistentVector[int, widgets.BuiltinInt]()
+	exp := []int{}
+	for i := 0; i < 2000; i++ {
+		v = v.Append(i)
+		exp = append(exp, i)
+	}
+	checkPersistentVector(v, exp, t)
+	test.Eq(2, v.height, t)
+	vals := make([]int, 1500)
+	for i := range vals {
+		vals[i] = -i
+	}
+	v2 := v.Append(vals...)
+	checkPersistentVector(v2, append(exp, vals...), t)
+	checkPersistentVector(v, exp, t)
+}
+
+func TestPersistentVectorValInit(t *testing.T) {
+	vals := make([]int, 33*32+5)
+	for i := range vals {
+		vals[i] = i
+	}
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](vals...)
+	checkPersistentVector(v, vals, t)
+	vals[0] = 100
+	val, _ := v.Get(0)
+	test.Eq(0, val, t)
+	checkPersistentVector(
+		PersistentVectorValInit[int, widgets.BuiltinInt](), []int{}, t,
+	)
+}
+
+func TestPersistentVectorGetErrors(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	_, err := v.Get(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = v.Get(3)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	e := NewPersistentVector[int, widgets.BuiltinInt]()
+	_, err = e.Get(0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = e.PeekFront()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = e.PeekBack()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	front, err := v.PeekFront()
+	test.Nil(err, t)
+	test.Eq(1, front, t)
+	back, err := v.PeekBack()
+	test.Nil(err, t)
+	test.Eq(3, back, t)
+	test.Panics(func() { v.GetPntr(0) }, t)
+	test.Panics(func() { v.ValPntrs() }, t)
+	test.False(v.IsAddressable(), t)
+	test.False(v.IsSynced(), t)
+}
+
+func TestPersistentVectorSet(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](0, 1, 2, 3)
+	v2, err := v.Set(basic.Pair[int, int]{0, 10}, basic.Pair[int, int]{3, 13})
+	test.Nil(err, t)
+	checkPersistentVector(v2, []int{10, 1, 2, 13}, t)
+	checkPersistentVector(v, []int{0, 1, 2, 3}, t)
+	v3, err := v2.Set(basic.Pair[int, int]{1, 11}, basic.Pair[int, int]{4, 14})
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	checkPersistentVector(v3, []int{10, 1, 2, 13}, t)
+}
+
+func TestPersistentVectorInsertDelete(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](0, 1, 2)
+	v2, err := v.InsertSequential(1, 5, 6)
+	test.Nil(err, t)
+	checkPersistentVector(v2, []int{0, 5, 6, 1, 2}, t)
+	v2, err = v2.Insert(basic.Pair[int, int]{0, 7}, basic.Pair[int, int]{6, 8})
+	test.Nil(err, t)
+	checkPersistentVector(v2, []int{7, 0, 5, 6, 1, 2, 8}, t)
+	_, err = v2.InsertSequential(8, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = v2.Insert(basic.Pair[int, int]{0, 1}, basic.Pair[int, int]{-1, 1})
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+
+	v3, err := v2.Delete(0)
+	test.Nil(err, t)
+	checkPersistentVector(v3, []int{0, 5, 6, 1, 2, 8}, t)
+	v3, err = v3.DeleteSequential(1, 3)
+	test.Nil(err, t)
+	checkPersistentVector(v3, []int{0, 1, 2, 8}, t)
+	_, err = v3.Delete(4)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = v3.DeleteSequential(2, 1)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	_, err = v3.DeleteSequential(-1, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = v3.DeleteSequential(1, 5)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	v4, err := v3.DeleteSequential(2, 2)
+	test.Nil(err, t)
+	checkPersistentVector(v4, []int{0, 1, 2, 8}, t)
+	v4, err = v3.DeleteSequential(4, 4)
+	test.Nil(err, t)
+	checkPersistentVector(v4, []int{0, 1, 2, 8}, t)
+	v3, err = v3.DeleteSequential(0, 4)
+	test.Nil(err, t)
+	checkPersistentVector(v3, []int{}, t)
+	checkPersistentVector(v, []int{0, 1, 2}, t)
+}
+
+func TestPersistentVectorSliceConcat(t *testing.T) {
+	vals := make([]int, 3000)
+	for i := range vals {
+		vals[i] = i
+	}
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](vals...)
+	s, err := v.Slice(100, 2500)
+	test.Nil(err, t)
+	checkPersistentVector(s, vals[100:2500], t)
+	s, err = v.Slice(5, 5)
+	test.Nil(err, t)
+	checkPersistentVector(s, []int{}, t)
+	_, err = v.Slice(5, 4)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	_, err = v.Slice(0, 3001)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+
+	l, _ := v.Slice(0, 1000)
+	r, _ := v.Slice(1000, 3000)
+	c := l.Concat(&r)
+	checkPersistentVector(c, vals, t)
+	small := PersistentVectorValInit[int, widgets.BuiltinInt](-1, -2)
+	c = small.Concat(&v)
+	checkPersistentVector(c, append([]int{-1, -2}, vals...), t)
+	c = v.Concat(&small)
+	checkPersistentVector(c, append(append([]int{}, vals...), -1, -2), t)
+}
+
+func TestPersistentVectorRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	v := NewPersistentVector[int, widgets.BuiltinInt]()
+	exp := []int{}
+	versions := []PersistentVector[int, widgets.BuiltinInt]{}
+	expVersions := [][]int{}
+	for i := 0; i < 2000; i++ {
+		// Copy so the previously saved version is not modified
+		exp = append([]int{}, exp...)
+		switch op := r.Intn(7); {
+		case op == 0 || len(exp) == 0:
+			vals := make([]int, r.Intn(70))
+			for j := range vals {
+				vals[j] = r.Int()
+			}
+			v = v.Append(vals...)
+			exp = append(exp, vals...)
+		case op == 1:
+			idx, val := r.Intn(len(exp)), r.Int()
+			v, _ = v.Set(basic.Pair[int, int]{idx, val})
+			exp[idx] = val
+		case op == 2:
+			idx := r.Intn(len(exp) + 1)
+			vals := make([]int, r.Intn(40))
+			for j := range vals {
+				vals[j] = r.Int()
+			}
+			var err error
+			v, err = v.InsertSequential(idx, vals...)
+			test.Nil(err, t)
+			exp = append(exp[:idx], append(vals, exp[idx:]...)...)
+		case op == 3:
+			start := r.Intn(len(exp))
+			end := start + 1 + r.Intn(min(len(exp)-start, 50))
+			var err error
+			v, err = v.DeleteSequential(start, end)
+			test.Nil(err, t)
+			exp = append(exp[:start], exp[end:]...)
+		case op == 4:
+			start := r.Intn(len(exp))
+			end := start + r.Intn(len(exp)-start+1)
+			var err error
+			v, err = v.Slice(start, end)
+			test.Nil(err, t)
+			exp = append([]int{}, exp[start:end]...)
+		case op == 5:
+			other := versions[r.Intn(len(versions))]
+			v = v.Concat(&other)
+			exp = append(exp, other.ToVector()...)
+		default:
+			v = v.Concat(&v)
+			exp = append(exp, exp...)
+		}
+		if len(exp) > 20000 {
+			v, _ = v.Slice(0, 1000)
+			exp = exp[:1000]
+		}
+		versions = append(versions, v)
+		expVersions = append(expVersions, exp)
+		if i%50 == 0 {
+			checkPersistentVector(v, exp, t)
+		}
+	}
+	for i := range versions {
+		checkPersistentVector(versions[i], expVersions[i], t)
+	}
+}
+
+func TestPersistentVectorContains(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3, 2)
+	test.True(v.Contains(2), t)
+	test.False(v.Contains(4), t)
+	val := 3
+	test.True(v.ContainsPntr(&val), t)
+	idx, found := v.KeyOf(2)
+	test.True(found, t)
+	test.Eq(1, idx, t)
+	idx, found = v.KeyOfPntr(&val)
+	test.True(found, t)
+	test.Eq(2, idx, t)
+	idx, found = v.KeyOf(5)
+	test.False(found, t)
+	test.Eq(-1, idx, t)
+	keys, err := v.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2, 3}, keys, t)
+}
+
+func TestPersistentVectorComparisons(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	other := VectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	test.True(v.KeyedEq(&other), t)
+	test.True(v.UnorderedEq(&other), t)
+	test.True(v.IsSubset(&other), t)
+	test.True(v.IsSuperset(&other), t)
+	other = VectorValInit[int, widgets.BuiltinInt](3, 2, 1)
+	test.False(v.KeyedEq(&other), t)
+	test.True(v.UnorderedEq(&other), t)
+	other = VectorValInit[int, widgets.BuiltinInt](1, 2)
+	test.False(v.KeyedEq(&other), t)
+	test.False(v.UnorderedEq(&other), t)
+	test.False(v.IsSubset(&other), t)
+	test.True(v.IsSuperset(&other), t)
+	other = VectorValInit[int, widgets.BuiltinInt](1, 2, 3, 4)
+	test.True(v.IsSubset(&other), t)
+	test.False(v.IsSuperset(&other), t)
+	hm := HashSetValInit[int, widgets.BuiltinInt](1, 2, 3)
+	test.True(v.UnorderedEq(&hm), t)
+}
+
+func TestPersistentVectorEqHashZero(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	v2 := NewPersistentVector[int, widgets.BuiltinInt]()
+	v2 = v2.Append(1, 2, 3)
+	test.True(v.Eq(&v, &v2), t)
+	test.Eq(v.Hash(&v), v2.Hash(&v2), t)
+	vec := VectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	test.Eq(vec.Hash(&vec), v.Hash(&v), t)
+	v3 := v2.Append(4)
+	test.False(v.Eq(&v, &v3), t)
+	test.Neq(v.Hash(&v), v3.Hash(&v3), t)
+	v.Zero(&v3)
+	test.Eq(0, v3.Length(), t)
+	test.Eq(3, v2.Length(), t)
+}
+
+func TestPersistentVectorFormat(t *testing.T) {
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	test.Eq("persistentVec[1 2 3]", v.String(), t)
+	test.Eq("persistentVec[1 2 3]", fmt.Sprint(v), t)
+	e := NewPersistentVector[int, widgets.BuiltinInt]()
+	test.Eq("persistentVec[]", e.String(), t)
+}
+
+func TestPersistentVectorValsBreak(t *testing.T) {
+	vals := make([]int, 100)
+	for i := range vals {
+		vals[i] = i
+	}
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](vals...)
+	res, err := v.Vals().Take(40).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](vals[:40], res, t)
+}
+
+func TestPersistentVectorConcurrentReaders(t *testing.T) {
+	v := NewPersistentVector[int, widgets.BuiltinInt]()
+	for i := 0; i < 1000; i++ {
+		v = v.Append(i)
+	}
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			local := v
+			for i := 0; i < 1000; i++ {
+				val, err := v.Get(i)
+				test.Nil(err, t)
+				test.Eq(i, val, t)
+				local, _ = local.Set(basic.Pair[int, int]{i, -j})
+			}
+			test.Eq(1000, v.Length(), t)
+			test.True(local.Contains(-j), t)
+		}(j)
+	}
+	wg.Wait()
+	checkPersistentVector(v, v.ToVector(), t)
+}
+
+func TestPersistentVectorSerialization(t *testing.T) {
+	vals := make([]int, 33*32+5)
+	for i := range vals {
+		vals[i] = i
+	}
+	v := PersistentVectorValInit[int, widgets.BuiltinInt](vals...)
+	for _, binary := range []bool{false, true} {
+		var data []byte
+		var err error
+		if binary {
+			data, err = v.MarshalBinary()
+		} else {
+			data, err = json.Marshal(&v)
+		}
+		test.Nil(err, t)
+
+		old := PersistentVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+		res := old
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		checkPersistentVector(res, vals, t)
+		checkPersistentVector(old, []int{1, 2, 3}, t)
+		test.NotNil(json.Unmarshal([]byte(`{"not": "valid"`), &res), t)
+		checkPersistentVector(res, vals, t)
+	}
+
+	empty := NewPersistentVector[int, widgets.BuiltinInt]()
+	data, err := json.Marshal(&empty)
+	test.Nil(err, t)
+	test.Eq("[]", string(data), t)
+}
@@ -0,0 +1,859 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A handle to a value in a priority queue. A handle is returned when a
+	// value is pushed with [PriorityQueue.Push] and can be used later to change
+	// the priority of the value with [PriorityQueue.DecreaseKey]. A handle is
+	// invalidated once the value it refers to is popped from the queue or the
+	// queue is cleared.
+	PriorityQueueHandle[T any] struct {
+		val T
+		idx int
+	}
+
+	// A binary heap that orders its values using the U widget. In the default
+	// min-heap mode the value at the front of the queue is the smallest value
+	// according to the widgets Lt method. In max-heap mode the Lt method is
+	// reversed and the value at the front of the queue is the largest value.
+	// Values that compare equal are not guaranteed to be popped in the order
+	// they were pushed.
+	PriorityQueue[T any, U widgets.PartialOrderInterface[T]] struct {
+		vals    []*PriorityQueueHandle[T]
+		maxHeap bool
+	}
+
+	// A synchronized version of PriorityQueue. All operations will be wrapped
+	// in the appropriate calls the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedPriorityQueue[T any, U widgets.PartialOrderInterface[T]] struct {
+		*sync.RWMutex
+		PriorityQueue[T, U]
+	}
+)
+
+// Returns the value that the handle refers to.
+func (h *PriorityQueueHandle[T]) Val() T {
+	return h.val
+}
+
+// Creates a new priority queue initialized with enough memory to hold size
+// elements. Size must be >= 0, an error will be returned if it is not. If
+// maxHeap is true then the largest value will be at the front of the queue,
+// otherwise the smallest value will be at the front of the queue.
+func NewPriorityQueue[T any, U widgets.PartialOrderInterface[T]](
+	size int,
+	maxHeap bool,
+) (PriorityQueue[T, U], error) {
+	if size < 0 {
+		return PriorityQueue[T, U]{}, getSizeError(size)
+	}
+	return PriorityQueue[T, U]{
+		vals:    make([]*PriorityQueueHandle[T], 0, size),
+		maxHeap: maxHeap,
+	}, nil
+}
+
+// Creates a new synced priority queue initialized with enough memory to hold
+// size elements. Size must be >= 0, an error will be returned if it is not.
+// The underlying RWMutex value will be fully unlocked upon initialization.
+func NewSyncedPriorityQueue[T any, U widgets.PartialOrderInterface[T]](
+	size int,
+	maxHeap bool,
+) (SyncedPriorityQueue[T, U], error) {
+	rv, err := NewPriorityQueue[T, U](size, maxHeap)
+	return SyncedPriorityQueue[T, U]{
+		RWMutex:       &sync.RWMutex{},
+		PriorityQueue: rv,
+	}, err
+}
+
+// Creates a new priority queue and populates it with the supplied values. The
+// queue is built in O(n) time rather than pushing each value individually.
+func PriorityQueueValInit[T any, U widgets.PartialOrderInterface[T]](
+	maxHeap bool,
+	vals ...T,
+) PriorityQueue[T, U] {
+	rv, _ := NewPriorityQueue[T, U](len(vals), maxHeap)
+	rv.Heapify(iter.SliceElems[T](vals))
+	return rv
+}
+
+// Creates a new synced priority queue and populates it with the supplied
+// values. The queue is built in O(n) time rather than pushing each value
+// individually.
+func SyncedPriorityQueueValInit[T any, U widgets.PartialOrderInterface[T]](
+	maxHeap bool,
+	vals ...T,
+) SyncedPriorityQueue[T, U] {
+	rv, _ := NewSyncedPriorityQueue[T, U](len(vals), maxHeap)
+	rv.Heapify(iter.SliceElems[T](vals))
+	return rv
+}
+
+// Converts the supplied priority queue to a synchronized priority queue.
+// Beware: The original non-synced priority queue will remain useable.
+func (q *PriorityQueue[T, U]) ToSynced() SyncedPriorityQueue[T, U] {
+	return SyncedPriorityQueue[T, U]{
+		RWMutex:       &sync.RWMutex{},
+		PriorityQueue: *q,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (q *PriorityQueue[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (q *PriorityQueue[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (q *PriorityQueue[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (q *PriorityQueue[T, U]) RUnlock() {}
+
+// The SyncedPriorityQueue method to override the PriorityQueue pass through
+// function and actually apply the mutex operation.
+func (q *SyncedPriorityQueue[T, U]) Lock() { q.RWMutex.Lock() }
+
+// The SyncedPriorityQueue method to override the PriorityQueue pass through
+// function and actually apply the mutex operation.
+func (q *SyncedPriorityQueue[T, U]) Unlock() { q.RWMutex.Unlock() }
+
+// The SyncedPriorityQueue method to override the PriorityQueue pass through
+// function and actually apply the mutex operation.
+func (q *SyncedPriorityQueue[T, U]) RLock() { q.RWMutex.RLock() }
+
+// The SyncedPriorityQueue method to override the PriorityQueue pass through
+// function and actually apply the mutex operation.
+func (q *SyncedPriorityQueue[T, U]) RUnlock() { q.RWMutex.RUnlock() }
+
+// Returns true, a priority queue is addressable. Note that modifying a value
+// through a pointer in a way that changes its ordering will corrupt the heap,
+// use [PriorityQueue.DecreaseKey] to change the priority of a value.
+func (q *PriorityQueue[T, U]) IsAddressable() bool { return true }
+
+// Returns false, a priority queue is not synced.
+func (q *PriorityQueue[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced priority queue is synced.
+func (q *SyncedPriorityQueue[T, U]) IsSynced() bool { return true }
+
+// Returns true if the priority queue is a max-heap, false if it is a min-heap.
+func (q *PriorityQueue[T, U]) IsMaxHeap() bool { return q.maxHeap }
+
+// Description: Returns the number of values in the priority queue.
+//
+// Time Complexity: O(1)
+func (q *PriorityQueue[T, U]) Length() int {
+	return len(q.vals)
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (q *SyncedPriorityQueue[T, U]) Length() int {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.Length()
+}
+
+// Description: Returns the capacity of the priority queue.
+//
+// Time Complexity: O(1)
+func (q *PriorityQueue[T, U]) Capacity() int {
+	return cap(q.vals)
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.Capacity] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (q *SyncedPriorityQueue[T, U]) Capacity() int {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.Capacity()
+}
+
+// Returns true if the value at index i should be closer to the front of the
+// queue than the value at index j.
+func (q *PriorityQueue[T, U]) less(i int, j int) bool {
+	w := widgets.PartialOrder[T, U]{}
+	if q.maxHeap {
+		return w.Lt(&q.vals[j].val, &q.vals[i].val)
+	}
+	return w.Lt(&q.vals[i].val, &q.vals[j].val)
+}
+
+func (q *PriorityQueue[T, U]) swap(i int, j int) {
+	q.vals[i], q.vals[j] = q.vals[j], q.vals[i]
+	q.vals[i].idx = i
+	q.vals[j].idx = j
+}
+
+func (q *PriorityQueue[T, U]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T, U]) siftDown(i int) {
+	for {
+		smallest := i
+		l, r := 2*i+1, 2*i+2
+		if l < len(q.vals) && q.less(l, smallest) {
+			smallest = l
+		}
+		if r < len(q.vals) && q.less(r, smallest) {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// Description: Returns the value at the front of the priority queue if one is
+// present. If the priority queue has no elements then an error is returned.
+//
+// Time Complexity: O(1)
+func (q *PriorityQueue[T, U]) PeekFront() (T, error) {
+	if len(q.vals) > 0 {
+		return q.vals[0].val, nil
+	}
+	var tmp T
+	return tmp, getIndexOutOfBoundsError(0, 0, len(q.vals))
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PeekFront] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (q *SyncedPriorityQueue[T, U]) PeekFront() (T, error) {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.PeekFront()
+}
+
+// Description: Returns a pointer to the value at the front of the priority
+// queue if one is present. If the priority queue has no elements then an error
+// is returned.
+//
+// Time Complexity: O(1)
+func (q *PriorityQueue[T, U]) PeekPntrFront() (*T, error) {
+	if len(q.vals) > 0 {
+		return &q.vals[0].val, nil
+	}
+	return nil, getIndexOutOfBoundsError(0, 0, len(q.vals))
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PeekPntrFront] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (q *SyncedPriorityQueue[T, U]) PeekPntrFront() (*T, error) {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.PeekPntrFront()
+}
+
+// Description: An alias for [PriorityQueue.PeekFront].
+//
+// Time Complexity: O(1)
+func (q *PriorityQueue[T, U]) Peek() (T, error) {
+	return q.PeekFront()
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PeekFront] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (q *SyncedPriorityQueue[T, U]) Peek() (T, error) {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.PeekFront()
+}
+
+// Description: Removes and returns the value at the front of the priority
+// queue. Returns an error if the priority queue has no elements.
+//
+// Time Complexity: O(log(n))
+func (q *PriorityQueue[T, U]) PopFront() (T, error) {
+	var rv T
+	return rv, q.popImpl(&rv)
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PopFront] implementation
+// method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (q *SyncedPriorityQueue[T, U]) PopFront() (T, error) {
+	q.Lock()
+	defer q.Unlock()
+	var rv T
+	return rv, q.PriorityQueue.popImpl(&rv)
+}
+
+// Description: An alias for [PriorityQueue.PopFront].
+//
+// Time Complexity: O(log(n))
+func (q *PriorityQueue[T, U]) Pop() (T, error) {
+	var rv T
+	return rv, q.popImpl(&rv)
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PopFront] implementation
+// method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (q *SyncedPriorityQueue[T, U]) Pop() (T, error) {
+	q.Lock()
+	defer q.Unlock()
+	var rv T
+	return rv, q.PriorityQueue.popImpl(&rv)
+}
+
+func (q *PriorityQueue[T, U]) popImpl(rv *T) error {
+	if len(q.vals) == 0 {
+		return getEmptyError()
+	}
+	last := len(q.vals) - 1
+	q.swap(0, last)
+	h := q.vals[last]
+	q.vals[last] = nil
+	q.vals = q.vals[:last]
+	q.siftDown(0)
+	*rv = h.val
+	h.idx = -1
+	w := widgets.PartialOrder[T, U]{}
+	w.Zero(&h.val)
+	return nil
+}
+
+// Description: Pushes the supplied value into the priority queue and returns
+// a handle that can be used to later change the values priority.
+//
+// Time Complexity: O(log(n))
+func (q *PriorityQueue[T, U]) Push(v T) *PriorityQueueHandle[T] {
+	return q.pushImpl(&v)
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.Push] implementation
+// method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (q *SyncedPriorityQueue[T, U]) Push(v T) *PriorityQueueHandle[T] {
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.pushImpl(&v)
+}
+
+func (q *PriorityQueue[T, U]) pushImpl(v *T) *PriorityQueueHandle[T] {
+	h := &PriorityQueueHandle[T]{val: *v, idx: len(q.vals)}
+	q.vals = append(q.vals, h)
+	q.siftUp(h.idx)
+	return h
+}
+
+// Description: Pushes the supplied values into the priority queue. Values will
+// be placed in the queue according to their priority, not the order they are
+// given. This function will never return an error.
+//
+// Time Complexity: O(m*log(n+m)), where m=len(vals)
+func (q *PriorityQueue[T, U]) PushBack(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		q.pushImpl(&vals[i])
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PushBack] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n+m)), where m=len(vals)
+func (q *SyncedPriorityQueue[T, U]) PushBack(vals ...T) error {
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.PushBack(vals...)
+}
+
+// Description: Pushes the supplied values into the priority queue. Has the
+// same behavior as [PriorityQueue.PushBack] because the queue grows as needed.
+//
+// Time Complexity: O(m*log(n+m)), where m=len(vals)
+func (q *PriorityQueue[T, U]) ForcePushBack(vals ...T) {
+	q.PushBack(vals...)
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.PushBack] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n+m)), where m=len(vals)
+func (q *SyncedPriorityQueue[T, U]) ForcePushBack(vals ...T) {
+	q.Lock()
+	defer q.Unlock()
+	q.PriorityQueue.PushBack(vals...)
+}
+
+// Description: Adds all of the values from the supplied iterator to the
+// priority queue and then restores the heap property in a single pass. This is
+// more efficient than pushing each value individually. If the iterator returns
+// an error then the values that were consumed before the error will remain in
+// the queue and the error will be returned.
+//
+// Time Complexity: O(n+m), where m is the number of values in the iterator
+func (q *PriorityQueue[T, U]) Heapify(i iter.Iter[T]) error {
+	err := i.ForEach(func(index int, val T) (iter.IteratorFeedback, error) {
+		q.vals = append(q.vals, &PriorityQueueHandle[T]{
+			val: val, idx: len(q.vals),
+		})
+		return iter.Continue, nil
+	})
+	for j := len(q.vals)/2 - 1; j >= 0; j-- {
+		q.siftDown(j)
+	}
+	return err
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.Heapify] method. The
+// lock will be held while the iterator is consumed.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m), where m is the number of values in the iterator
+func (q *SyncedPriorityQueue[T, U]) Heapify(i iter.Iter[T]) error {
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.Heapify(i)
+}
+
+func (q *PriorityQueue[T, U]) validHandle(h *PriorityQueueHandle[T]) bool {
+	return h != nil && h.idx >= 0 && h.idx < len(q.vals) && q.vals[h.idx] == h
+}
+
+// Description: Replaces the value that the supplied handle refers to with
+// newVal and moves it towards the front of the queue as needed. In min-heap
+// mode newVal must not be greater than the current value, in max-heap mode
+// newVal must not be less than the current value. If newVal would move the
+// value away from the front of the queue, or if the handle does not refer to a
+// value in this queue, then an error will be returned and the queue will be
+// left unchanged.
+//
+// Time Complexity: O(log(n))
+func (q *PriorityQueue[T, U]) DecreaseKey(
+	h *PriorityQueueHandle[T],
+	newVal T,
+) error {
+	return q.decreaseKeyImpl(h, &newVal)
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.DecreaseKey]
+// implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (q *SyncedPriorityQueue[T, U]) DecreaseKey(
+	h *PriorityQueueHandle[T],
+	newVal T,
+) error {
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.decreaseKeyImpl(h, &newVal)
+}
+
+func (q *PriorityQueue[T, U]) decreaseKeyImpl(
+	h *PriorityQueueHandle[T],
+	newVal *T,
+) error {
+	if !q.validHandle(h) {
+		return customerr.Wrap(
+			customerr.InvalidValue,
+			"The supplied handle does not refer to a value in the priority queue.",
+		)
+	}
+	w := widgets.PartialOrder[T, U]{}
+	if (q.maxHeap && w.Lt(newVal, &h.val)) || (!q.maxHeap && w.Lt(&h.val, newVal)) {
+		return customerr.WrapValueList(
+			customerr.InvalidValue,
+			"The new value would move the value away from the front of the queue.",
+			[]customerr.WrapListVal{
+				{ItemName: "Orig Value", Item: h.val},
+				{ItemName: "New Value ", Item: *newVal},
+			},
+		)
+	}
+	h.val = *newVal
+	q.siftUp(h.idx)
+	return nil
+}
+
+// Description: Clears all values from the priority queue. All handles that
+// were returned from the queue will be invalidated.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) Clear() {
+	w := widgets.PartialOrder[T, U]{}
+	for _, h := range q.vals {
+		w.Zero(&h.val)
+		h.idx = -1
+	}
+	q.vals = nil
+}
+
+// Description: Places a write lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) Clear() {
+	q.Lock()
+	defer q.Unlock()
+	q.PriorityQueue.Clear()
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// priority queue. The values are not returned in priority order, they are
+// returned in the order they are stored in the underlying heap.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) Vals() iter.Iter[T] {
+	return iter.SequentialElems[T](
+		len(q.vals),
+		func(i int) (T, error) { return q.vals[i].val, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [PriorityQueue.Vals] method such that a read lock will be placed on the
+// underlying priority queue when the iterator is consumed. The priority queue
+// will have a read lock the entire time the iteration is being performed. The
+// lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) Vals() iter.Iter[T] {
+	return q.PriorityQueue.Vals().SetupTeardown(
+		func() error { q.RLock(); return nil },
+		func() error { q.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the priority queue that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [PriorityQueue.Vals].
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) ValsSeq() stditer.Seq[T] {
+	return q.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the priority queue that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedPriorityQueue.Vals], so the priority queue will
+// have a read lock the entire time the loop is running. The lock is released
+// once the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) ValsSeq() stditer.Seq[T] {
+	return q.Vals().Seq()
+}
+
+// Returns a copy of the priority queue that does not share any handles with
+// the original.
+func (q *PriorityQueue[T, U]) clone() PriorityQueue[T, U] {
+	rv := PriorityQueue[T, U]{
+		vals:    make([]*PriorityQueueHandle[T], len(q.vals)),
+		maxHeap: q.maxHeap,
+	}
+	for i, h := range q.vals {
+		rv.vals[i] = &PriorityQueueHandle[T]{val: h.val, idx: i}
+	}
+	return rv
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Returns true if l and r are both min or max heaps and would
+// return equal values in the same order if they were both emptied, false
+// otherwise.
+//
+// Time Complexity: O(n*log(n))
+func (_ *PriorityQueue[T, U]) Eq(
+	l *PriorityQueue[T, U],
+	r *PriorityQueue[T, U],
+) bool {
+	if len(l.vals) != len(r.vals) || l.maxHeap != r.maxHeap {
+		return false
+	}
+	w := widgets.PartialOrder[T, U]{}
+	lc, rc := l.clone(), r.clone()
+	for lc.Length() > 0 {
+		lv, _ := lc.PopFront()
+		rv, _ := rc.PopFront()
+		if !w.Eq(&lv, &rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// priority queues [PriorityQueue.Eq] method.
+func (_ *SyncedPriorityQueue[T, U]) Eq(
+	l *SyncedPriorityQueue[T, U],
+	r *SyncedPriorityQueue[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.PriorityQueue.Eq(&l.PriorityQueue, &r.PriorityQueue)
+}
+
+// A function that returns a hash of a priority queue to implement the
+// [algo.widget.WidgetInterface]. The hashes of the individual values are
+// combined in an order independent way because the layout of the underlying
+// heap depends on the order values were pushed, making it so the hash will
+// represent the same equality operation that [PriorityQueue.Eq] provides.
+func (_ *PriorityQueue[T, U]) Hash(other *PriorityQueue[T, U]) hash.Hash {
+	var rv hash.Hash
+	w := widgets.PartialOrder[T, U]{}
+	for i, h := range other.vals {
+		if i == 0 {
+			rv = w.Hash(&h.val)
+		} else {
+			rv = rv.CombineUnordered(w.Hash(&h.val))
+		}
+	}
+	return rv
+}
+
+// Places a read lock on the underlying priority queue of other and then calls
+// others underlying priority queues [PriorityQueue.Hash] method. Implements
+// the [algo.widget.WidgetInterface].
+func (_ *SyncedPriorityQueue[T, U]) Hash(
+	other *SyncedPriorityQueue[T, U],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.PriorityQueue.Hash(&other.PriorityQueue)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [PriorityQueue.Clear].
+func (_ *PriorityQueue[T, U]) Zero(other *PriorityQueue[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedPriorityQueue.Clear].
+func (_ *SyncedPriorityQueue[T, U]) Zero(other *SyncedPriorityQueue[T, U]) {
+	other.Clear()
+}
+
+type serializedPriorityQueue[T any] struct {
+	MaxHeap bool `json:"maxHeap"`
+	Vals    []T  `json:"vals"`
+}
+
+func (q *PriorityQueue[T, U]) toSerialized() serializedPriorityQueue[T] {
+	rv := serializedPriorityQueue[T]{
+		MaxHeap: q.maxHeap,
+		Vals:    make([]T, len(q.vals)),
+	}
+	for i, h := range q.vals {
+		rv.Vals[i] = h.val
+	}
+	return rv
+}
+
+func (q *PriorityQueue[T, U]) fromSerialized(
+	s serializedPriorityQueue[T],
+) error {
+	q.Clear()
+	q.maxHeap = s.MaxHeap
+	q.Heapify(iter.SliceElems[T](s.Vals))
+	return nil
+}
+
+// Description: Returns the JSON encoding of the priority queue. The values are
+// encoded in the order they are stored in the underlying heap along with the
+// heap mode of the queue. All handles that were returned from the queue will be
+// invalidated when decoding.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.toSerialized())
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) MarshalJSON() ([]byte, error) {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.MarshalJSON()
+}
+
+// Description: Replaces the contents of the priority queue with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [PriorityQueue.MarshalJSON]. If an error is returned the priority queue is
+// left unchanged.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedPriorityQueue[T]](data)
+	if err != nil {
+		return err
+	}
+	return q.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying priority queue while its contents are replaced. Exhibits the
+// same behavior as [PriorityQueue.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedPriorityQueue[T]](data)
+	if err != nil {
+		return err
+	}
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the priority queue. The
+// values are laid out the same way as [PriorityQueue.MarshalJSON] and are
+// encoded using the [encoding/gob] package, so the contained values must be
+// encodable by gob.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(q.toSerialized())
+}
+
+// Description: Places a read lock on the underlying priority queue and then
+// calls the underlying priority queues [PriorityQueue.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) MarshalBinary() ([]byte, error) {
+	q.RLock()
+	defer q.RUnlock()
+	return q.PriorityQueue.MarshalBinary()
+}
+
+// Description: Replaces the contents of the priority queue with the values
+// decoded from the supplied binary data, which must have been produced by
+// [PriorityQueue.MarshalBinary]. If an error is returned the priority queue is
+// left unchanged.
+//
+// Time Complexity: O(n)
+func (q *PriorityQueue[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedPriorityQueue[T]](data)
+	if err != nil {
+		return err
+	}
+	return q.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying priority queue while its contents are replaced. Exhibits the
+// same behavior as [PriorityQueue.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (q *SyncedPriorityQueue[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedPriorityQueue[T]](data)
+	if err != nil {
+		return err
+	}
+	q.Lock()
+	defer q.Unlock()
+	return q.PriorityQueue.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values are printed in priority
+// order.
+func (q PriorityQueue[T, U]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("priorityQueue["))
+	c := q.clone()
+	for c.Length() > 0 {
+		v, _ := c.PopFront()
+		fmt.Fprintf(f, fmtStr, v)
+		if c.Length() > 0 {
+			f.Write([]byte{' '})
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (q *PriorityQueue[T, U]) String() string {
+	return fmt.Sprintf("%v", q)
+}
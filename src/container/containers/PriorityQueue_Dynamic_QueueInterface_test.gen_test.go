@@ -0,0 +1,98 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func PriorityQueueToQueueInterfaceFactory(capacity int) dynamicContainers.Queue[int] {
+	v := generatePriorityQueue(capacity)
+	var rv dynamicContainers.Queue[int] = &v
+	return rv
+}
+
+func TestPriorityQueue_DynQueueInterfaceSyncableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSyncableInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceAddressableInterface(t *testing.T) {
+	tests.DynQueueInterfaceAddressableInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceLengthInterface(t *testing.T) {
+	tests.DynQueueInterfaceLengthInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceCapacityInterface(t *testing.T) {
+	tests.DynQueueInterfaceCapacityInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceClearInterface(t *testing.T) {
+	tests.DynQueueInterfaceClearInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceFirstElemReadInterface(t *testing.T) {
+	tests.DynQueueInterfaceFirstElemReadInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceFirstElemDeleteInterface(t *testing.T) {
+	tests.DynQueueInterfaceFirstElemDeleteInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceLastElemWriteInterface(t *testing.T) {
+	tests.DynQueueInterfaceLastElemWriteInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_ReadDynQueueInterface(t *testing.T) {
+	tests.ReadDynQueueInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_WriteDynQueueInterface(t *testing.T) {
+	tests.WriteDynQueueInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceInterface(t *testing.T) {
+	tests.DynQueueInterfaceInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSerializableInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynQueueInterfaceStaticCapacityInterface(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceClear(t *testing.T) {
+	tests.DynQueueInterfaceClear(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfacePeekPntrFront(t *testing.T) {
+	tests.DynQueueInterfacePeekPntrFront(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfacePeekFront(t *testing.T) {
+	tests.DynQueueInterfacePeekFront(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfacePopFront(t *testing.T) {
+	tests.DynQueueInterfacePopFront(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfacePushBack(t *testing.T) {
+	tests.DynQueueInterfacePushBack(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceForcePushBack(t *testing.T) {
+	tests.DynQueueInterfaceForcePushBack(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceJSON(t *testing.T) {
+	tests.DynQueueInterfaceJSON(PriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestPriorityQueue_DynQueueInterfaceBinary(t *testing.T) {
+	tests.DynQueueInterfaceBinary(PriorityQueueToQueueInterfaceFactory, t)
+}
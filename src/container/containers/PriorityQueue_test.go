@@ -0,0 +1,196 @@
+package containers
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=PriorityQueue -category=dynamic -interface=Queue -genericDecl=[int] -factory=generatePriorityQueue
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedPriorityQueue -category=dynamic -interface=Queue -genericDecl=[int] -factory=generateSyncedPriorityQueue
+
+func generatePriorityQueue(capacity int) PriorityQueue[int, widgets.BuiltinInt] {
+	v, _ := NewPriorityQueue[int, widgets.BuiltinInt](capacity, false)
+	return v
+}
+
+func generateSyncedPriorityQueue(capacity int) SyncedPriorityQueue[int, widgets.BuiltinInt] {
+	v, _ := NewSyncedPriorityQueue[int, widgets.BuiltinInt](capacity, false)
+	return v
+}
+
+func TestPriorityQueueWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[PriorityQueue[string, widgets.BuiltinString]]
+	v, _ := NewPriorityQueue[string, widgets.BuiltinString](0, false)
+	widget = &v
+	_ = widget
+}
+
+func TestNewPriorityQueueErrors(t *testing.T) {
+	_, err := NewPriorityQueue[int, widgets.BuiltinInt](-1, false)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	q, err := NewPriorityQueue[int, widgets.BuiltinInt](5, true)
+	test.Nil(err, t)
+	test.Eq(5, q.Capacity(), t)
+	test.True(q.IsMaxHeap(), t)
+}
+
+func TestPriorityQueueMinHeap(t *testing.T) {
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	q.PushBack(5, 3, 8, 1, 9, 2, 2)
+	v, err := q.Peek()
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+	for _, exp := range []int{1, 2, 2, 3, 5, 8, 9} {
+		v, err = q.Pop()
+		test.Nil(err, t)
+		test.Eq(exp, v, t)
+	}
+	_, err = q.Pop()
+	test.ContainsError(containerTypes.Empty, err, t)
+	_, err = q.Peek()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestPriorityQueueMaxHeap(t *testing.T) {
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, true)
+	q.PushBack(5, 3, 8, 1, 9, 2, 2)
+	for _, exp := range []int{9, 8, 5, 3, 2, 2, 1} {
+		v, err := q.Pop()
+		test.Nil(err, t)
+		test.Eq(exp, v, t)
+	}
+}
+
+func TestPriorityQueueHeapify(t *testing.T) {
+	vals := make([]int, 100)
+	for i := range vals {
+		vals[i] = rand.Intn(50)
+	}
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	q.Push(-1)
+	err := q.Heapify(iter.SliceElems[int](vals))
+	test.Nil(err, t)
+	test.Eq(101, q.Length(), t)
+	exp := append([]int{-1}, vals...)
+	sort.Ints(exp)
+	for i := 0; i < len(exp); i++ {
+		v, _ := q.Pop()
+		test.Eq(exp[i], v, t)
+	}
+}
+
+func TestPriorityQueueValInit(t *testing.T) {
+	q := PriorityQueueValInit[int, widgets.BuiltinInt](true, 1, 4, 2, 3)
+	test.Eq(4, q.Length(), t)
+	for _, exp := range []int{4, 3, 2, 1} {
+		v, _ := q.Pop()
+		test.Eq(exp, v, t)
+	}
+	s := SyncedPriorityQueueValInit[int, widgets.BuiltinInt](false, 1, 4, 2, 3)
+	v, _ := s.Peek()
+	test.Eq(1, v, t)
+}
+
+func TestPriorityQueueDecreaseKey(t *testing.T) {
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	handles := make([]*PriorityQueueHandle[int], 10)
+	for i := 0; i < 10; i++ {
+		handles[i] = q.Push(i + 10)
+	}
+	test.Nil(q.DecreaseKey(handles[7], 0), t)
+	test.Eq(0, handles[7].Val(), t)
+	v, _ := q.Peek()
+	test.Eq(0, v, t)
+	err := q.DecreaseKey(handles[3], 100)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	test.Eq(13, handles[3].Val(), t)
+	test.Nil(q.DecreaseKey(handles[3], 13), t)
+
+	v, _ = q.Pop()
+	test.Eq(0, v, t)
+	err = q.DecreaseKey(handles[7], -1)
+	test.ContainsError(customerr.InvalidValue, err, t)
+
+	other, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	err = other.DecreaseKey(handles[1], 0)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	err = q.DecreaseKey(nil, 0)
+	test.ContainsError(customerr.InvalidValue, err, t)
+
+	q.Clear()
+	err = q.DecreaseKey(handles[1], 0)
+	test.ContainsError(customerr.InvalidValue, err, t)
+}
+
+func TestPriorityQueueDecreaseKeyMaxHeap(t *testing.T) {
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, true)
+	h := q.Push(1)
+	q.PushBack(5, 6, 7)
+	err := q.DecreaseKey(h, 0)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	test.Nil(q.DecreaseKey(h, 10), t)
+	v, _ := q.Pop()
+	test.Eq(10, v, t)
+}
+
+func TestPriorityQueueEq(t *testing.T) {
+	q1 := PriorityQueueValInit[int, widgets.BuiltinInt](false, 3, 1, 2)
+	q2, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	q2.PushBack(1, 2, 3)
+	test.True(q1.Eq(&q1, &q2), t)
+	test.Eq(3, q1.Length(), t)
+	test.Eq(3, q2.Length(), t)
+	q2.Push(4)
+	test.False(q1.Eq(&q1, &q2), t)
+	q3 := PriorityQueueValInit[int, widgets.BuiltinInt](true, 3, 1, 2)
+	test.False(q1.Eq(&q1, &q3), t)
+}
+
+func TestPriorityQueueHash(t *testing.T) {
+	q1 := PriorityQueueValInit[int, widgets.BuiltinInt](false, 3, 1, 2)
+	q2, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	q2.PushBack(1, 2, 3)
+	test.Eq(q1.Hash(&q1), q2.Hash(&q2), t)
+	q2.Push(4)
+	test.Neq(q1.Hash(&q1), q2.Hash(&q2), t)
+}
+
+func TestPriorityQueueZero(t *testing.T) {
+	q := PriorityQueueValInit[int, widgets.BuiltinInt](false, 3, 1, 2)
+	q.Zero(&q)
+	test.Eq(0, q.Length(), t)
+	test.Eq(0, q.Capacity(), t)
+}
+
+func TestPriorityQueueFormat(t *testing.T) {
+	q := PriorityQueueValInit[int, widgets.BuiltinInt](false, 3, 1, 2)
+	test.Eq("priorityQueue[1 2 3]", fmt.Sprintf("%v", q), t)
+	test.Eq(3, q.Length(), t)
+}
+
+func TestPriorityQueueRandomOps(t *testing.T) {
+	q, _ := NewPriorityQueue[int, widgets.BuiltinInt](0, false)
+	ref := []int{}
+	for i := 0; i < 5000; i++ {
+		if rand.Intn(3) > 0 || len(ref) == 0 {
+			v := rand.Intn(1000)
+			q.Push(v)
+			ref = append(ref, v)
+			sort.Ints(ref)
+		} else {
+			v, err := q.Pop()
+			test.Nil(err, t)
+			test.Eq(ref[0], v, t)
+			ref = ref[1:]
+		}
+		test.Eq(len(ref), q.Length(), t)
+	}
+}
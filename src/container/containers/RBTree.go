@@ -0,0 +1,337 @@
+package containers
+
+import (
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	rbNode[K any, V any] struct {
+		key   K
+		val   V
+		left  *rbNode[K, V]
+		right *rbNode[K, V]
+		red   bool
+	}
+
+	// A left leaning red-black tree. This is the shared implementation behind
+	// the ordered containers. All ordering operations are performed by the KI
+	// widget. Two keys are considered to be the same key if the KI widgets Eq
+	// method returns true.
+	rbTree[K any, V any, KI widgets.PartialOrderInterface[K]] struct {
+		root *rbNode[K, V]
+		size int
+		// An optional function that is called on every node whose subtree
+		// changes, after its children have been updated. Used to maintain
+		// information about each subtree in the nodes of the subtree.
+		augment func(n *rbNode[K, V])
+	}
+)
+
+func newRBTree[K any, V any, KI widgets.PartialOrderInterface[K]]() *rbTree[K, V, KI] {
+	return &rbTree[K, V, KI]{}
+}
+
+// Returns -1 if l<r, 0 if l==r, and 1 if l>r.
+func (t *rbTree[K, V, KI]) cmp(l *K, r *K) int {
+	w := widgets.PartialOrder[K, KI]{}
+	if w.Lt(l, r) {
+		return -1
+	} else if w.Eq(l, r) {
+		return 0
+	}
+	return 1
+}
+
+func (n *rbNode[K, V]) isRed() bool {
+	return n != nil && n.red
+}
+
+func (t *rbTree[K, V, KI]) update(n *rbNode[K, V]) {
+	if t.augment != nil {
+		t.augment(n)
+	}
+}
+
+func (t *rbTree[K, V, KI]) rotateLeft(n *rbNode[K, V]) *rbNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	t.update(n)
+	t.update(x)
+	return x
+}
+
+func (t *rbTree[K, V, KI]) rotateRight(n *rbNode[K, V]) *rbNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	t.update(n)
+	t.update(x)
+	return x
+}
+
+func (n *rbNode[K, V]) flipColors() {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+func (t *rbTree[K, V, KI]) fixUp(n *rbNode[K, V]) *rbNode[K, V] {
+	if n.right.isRed() && !n.left.isRed() {
+		n = t.rotateLeft(n)
+	}
+	if n.left.isRed() && n.left.left.isRed() {
+		n = t.rotateRight(n)
+	}
+	if n.left.isRed() && n.right.isRed() {
+		n.flipColors()
+	}
+	t.update(n)
+	return n
+}
+
+func (t *rbTree[K, V, KI]) moveRedLeft(n *rbNode[K, V]) *rbNode[K, V] {
+	n.flipColors()
+	if n.right.left.isRed() {
+		n.right = t.rotateRight(n.right)
+		n = t.rotateLeft(n)
+		n.flipColors()
+	}
+	return n
+}
+
+func (t *rbTree[K, V, KI]) moveRedRight(n *rbNode[K, V]) *rbNode[K, V] {
+	n.flipColors()
+	if n.left.left.isRed() {
+		n = t.rotateRight(n)
+		n.flipColors()
+	}
+	return n
+}
+
+func (n *rbNode[K, V]) min() *rbNode[K, V] {
+	for ; n.left != nil; n = n.left {
+	}
+	return n
+}
+
+func (n *rbNode[K, V]) max() *rbNode[K, V] {
+	for ; n.right != nil; n = n.right {
+	}
+	return n
+}
+
+func (t *rbTree[K, V, KI]) find(k *K) *rbNode[K, V] {
+	for n := t.root; n != nil; {
+		switch t.cmp(k, &n.key) {
+		case -1:
+			n = n.left
+		case 1:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Inserts the supplied key value pair into the tree. If the key is already
+// present in the tree then the key and value will only be replaced if
+// overwrite is true. Returns true if a new node was added to the tree.
+func (t *rbTree[K, V, KI]) insert(k *K, v *V, overwrite bool) bool {
+	added := false
+	var op func(n *rbNode[K, V]) *rbNode[K, V]
+	op = func(n *rbNode[K, V]) *rbNode[K, V] {
+		if n == nil {
+			added = true
+			rv := &rbNode[K, V]{key: *k, val: *v, red: true}
+			t.update(rv)
+			return rv
+		}
+		switch t.cmp(k, &n.key) {
+		case -1:
+			n.left = op(n.left)
+		case 1:
+			n.right = op(n.right)
+		default:
+			if overwrite {
+				n.key = *k
+				n.val = *v
+			}
+		}
+		return t.fixUp(n)
+	}
+	t.root = op(t.root)
+	t.root.red = false
+	if added {
+		t.size++
+	}
+	return added
+}
+
+func (t *rbTree[K, V, KI]) deleteMin(n *rbNode[K, V]) *rbNode[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !n.left.isRed() && !n.left.left.isRed() {
+		n = t.moveRedLeft(n)
+	}
+	n.left = t.deleteMin(n.left)
+	return t.fixUp(n)
+}
+
+// Removes the node with the supplied key from the tree. Returns false if the
+// key was not present in the tree. The zero function is called on the key and
+// value of the removed node before it is removed.
+func (t *rbTree[K, V, KI]) remove(k *K, zero func(k *K, v *V)) bool {
+	if t.find(k) == nil {
+		return false
+	}
+	var op func(n *rbNode[K, V]) *rbNode[K, V]
+	op = func(n *rbNode[K, V]) *rbNode[K, V] {
+		if t.cmp(k, &n.key) < 0 {
+			if !n.left.isRed() && !n.left.left.isRed() {
+				n = t.moveRedLeft(n)
+			}
+			n.left = op(n.left)
+		} else {
+			if n.left.isRed() {
+				n = t.rotateRight(n)
+			}
+			if t.cmp(k, &n.key) == 0 && n.right == nil {
+				zero(&n.key, &n.val)
+				return nil
+			}
+			if !n.right.isRed() && !n.right.left.isRed() {
+				n = t.moveRedRight(n)
+			}
+			if t.cmp(k, &n.key) == 0 {
+				zero(&n.key, &n.val)
+				m := n.right.min()
+				n.key = m.key
+				n.val = m.val
+				n.right = t.deleteMin(n.right)
+			} else {
+				n.right = op(n.right)
+			}
+		}
+		return t.fixUp(n)
+	}
+	if !t.root.left.isRed() && !t.root.right.isRed() {
+		t.root.red = true
+	}
+	t.root = op(t.root)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+	return true
+}
+
+// Returns the node with the largest key that is <= the supplied key. Returns
+// nil if no such node exists.
+func (t *rbTree[K, V, KI]) floor(k *K) *rbNode[K, V] {
+	var rv *rbNode[K, V]
+	for n := t.root; n != nil; {
+		switch t.cmp(k, &n.key) {
+		case -1:
+			n = n.left
+		case 1:
+			rv = n
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return rv
+}
+
+// Returns the node with the smallest key that is >= the supplied key. Returns
+// nil if no such node exists.
+func (t *rbTree[K, V, KI]) ceiling(k *K) *rbNode[K, V] {
+	var rv *rbNode[K, V]
+	for n := t.root; n != nil; {
+		switch t.cmp(k, &n.key) {
+		case -1:
+			rv = n
+			n = n.left
+		case 1:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return rv
+}
+
+// Calls the supplied function on every node in the tree in order. Iteration
+// stops early if op returns false.
+func (t *rbTree[K, V, KI]) inOrder(op func(n *rbNode[K, V]) bool) {
+	stack := []*rbNode[K, V]{}
+	for n := t.root; n != nil || len(stack) > 0; {
+		for ; n != nil; n = n.left {
+			stack = append(stack, n)
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !op(n) {
+			return
+		}
+		n = n.right
+	}
+}
+
+// Returns an iterator that iterates over the nodes in the tree in order. The
+// start and end values are optional, a nil value means that side of the range
+// is unbounded. The range is inclusive of start and exclusive of end. The
+// iterator is lazy, no nodes are visited until the iterator is consumed.
+func (t *rbTree[K, V, KI]) nodes(start *K, end *K) iter.Iter[*rbNode[K, V]] {
+	var stack []*rbNode[K, V]
+	initialized := false
+	pushLeft := func(n *rbNode[K, V]) {
+		for n != nil {
+			if start != nil && t.cmp(&n.key, start) < 0 {
+				n = n.right
+				continue
+			}
+			stack = append(stack, n)
+			n = n.left
+		}
+	}
+	return func(f iter.IteratorFeedback) (*rbNode[K, V], error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if !initialized {
+			pushLeft(t.root)
+			initialized = true
+		}
+		if len(stack) == 0 {
+			return nil, nil, false
+		}
+		n := stack[len(stack)-1]
+		if end != nil && t.cmp(&n.key, end) >= 0 {
+			stack = stack[:0]
+			return nil, nil, false
+		}
+		stack = stack[:len(stack)-1]
+		pushLeft(n.right)
+		return n, nil, true
+	}
+}
+
+// Removes all nodes from the tree, calling the zero function on each key and
+// value.
+func (t *rbTree[K, V, KI]) clear(zero func(k *K, v *V)) {
+	t.inOrder(func(n *rbNode[K, V]) bool {
+		zero(&n.key, &n.val)
+		return true
+	})
+	t.root = nil
+	t.size = 0
+}
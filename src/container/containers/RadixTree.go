@@ -0,0 +1,1202 @@
+package containers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/maphash"
+	stditer "iter"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// The types that can be used as the keys of a [RadixTree].
+	RadixTreeKey interface {
+		~string | ~[]byte
+	}
+
+	radixNode[V any] struct {
+		// The part of the key that is between this node and its parent.
+		label []byte
+		// Sorted by the first byte of each childs label. No two children share
+		// the same first byte.
+		children []*radixNode[V]
+		val      V
+		hasVal   bool
+	}
+
+	radixTree[V any, VI widgets.BaseInterface[V]] struct {
+		root radixNode[V]
+		size int
+	}
+
+	radixFrame[V any] struct {
+		node *radixNode[V]
+		key  []byte
+	}
+
+	// A type to represent a map that dynamically grows as key value pairs are
+	// added. The map is internally implemented with a radix tree, where keys
+	// that share a common prefix share the same path from the root of the
+	// tree. This allows for efficient longest prefix matching and for
+	// iterating over all keys that start with a given prefix. Keys are
+	// compared byte by byte and are kept in sorted order. The type constraint
+	// on the VI generic defines the logic for how value specific operations,
+	// such as equality comparisons, will be handled. Copies of a radix tree
+	// share the same underlying tree, the same as the builtin map type.
+	RadixTree[
+		K RadixTreeKey,
+		V any,
+		VI widgets.BaseInterface[V],
+	] struct {
+		tree *radixTree[V, VI]
+	}
+
+	// A synchronized version of RadixTree. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedRadixTree[
+		K RadixTreeKey,
+		V any,
+		VI widgets.BaseInterface[V],
+	] struct {
+		*sync.RWMutex
+		RadixTree[K, V, VI]
+	}
+)
+
+func (n *radixNode[V]) childIdx(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].label[0] >= b
+	})
+	return i, i < len(n.children) && n.children[i].label[0] == b
+}
+
+func (t *radixTree[V, VI]) get(key []byte) *radixNode[V] {
+	n := &t.root
+	for len(key) > 0 {
+		i, ok := n.childIdx(key[0])
+		if !ok || !bytes.HasPrefix(key, n.children[i].label) {
+			return nil
+		}
+		key = key[len(n.children[i].label):]
+		n = n.children[i]
+	}
+	if !n.hasVal {
+		return nil
+	}
+	return n
+}
+
+// Returns the node that holds the value for the supplied key, creating it and
+// splitting any existing nodes as needed. The returned node will not have a
+// value if the key was not already in the tree.
+func (t *radixTree[V, VI]) insert(key []byte) *radixNode[V] {
+	n := &t.root
+	for len(key) > 0 {
+		i, ok := n.childIdx(key[0])
+		if !ok {
+			c := &radixNode[V]{label: bytes.Clone(key)}
+			n.children = slices.Insert(n.children, i, c)
+			return c
+		}
+		c := n.children[i]
+		l := 0
+		for l < len(key) && l < len(c.label) && key[l] == c.label[l] {
+			l++
+		}
+		if l < len(c.label) {
+			mid := &radixNode[V]{
+				label:    c.label[:l:l],
+				children: []*radixNode[V]{c},
+			}
+			c.label = c.label[l:]
+			n.children[i] = mid
+			c = mid
+		}
+		key = key[l:]
+		n = c
+	}
+	return n
+}
+
+// Removes the value for the supplied key, returning false if the key was not
+// in the tree. Nodes that are left without a value are merged with their
+// child or removed so that every node without a value has at least two
+// children.
+func (t *radixTree[V, VI]) remove(key []byte) bool {
+	parents := []*radixNode[V]{}
+	idxs := []int{}
+	n := &t.root
+	for len(key) > 0 {
+		i, ok := n.childIdx(key[0])
+		if !ok || !bytes.HasPrefix(key, n.children[i].label) {
+			return false
+		}
+		parents = append(parents, n)
+		idxs = append(idxs, i)
+		key = key[len(n.children[i].label):]
+		n = n.children[i]
+	}
+	if !n.hasVal {
+		return false
+	}
+	w := widgets.Base[V, VI]{}
+	w.Zero(&n.val)
+	n.hasVal = false
+	t.size--
+	for j := len(parents) - 1; j >= 0 && j >= len(parents)-2; j-- {
+		parents[j].compact(idxs[j])
+	}
+	return true
+}
+
+func (n *radixNode[V]) compact(idx int) {
+	c := n.children[idx]
+	if c.hasVal {
+		return
+	}
+	switch len(c.children) {
+	case 0:
+		n.children = slices.Delete(n.children, idx, idx+1)
+	case 1:
+		gc := c.children[0]
+		gc.label = append(bytes.Clone(c.label), gc.label...)
+		n.children[idx] = gc
+	}
+}
+
+// Returns the node with the longest key that is a prefix of the supplied key
+// along with the length of that nodes key.
+func (t *radixTree[V, VI]) longestPrefix(key []byte) (*radixNode[V], int) {
+	var rv *radixNode[V]
+	rvLen := 0
+	if t.root.hasVal {
+		rv = &t.root
+	}
+	n := &t.root
+	consumed := 0
+	for consumed < len(key) {
+		i, ok := n.childIdx(key[consumed])
+		if !ok || !bytes.HasPrefix(key[consumed:], n.children[i].label) {
+			break
+		}
+		consumed += len(n.children[i].label)
+		n = n.children[i]
+		if n.hasVal {
+			rv = n
+			rvLen = consumed
+		}
+	}
+	return rv, rvLen
+}
+
+// Returns an iterator over all nodes with a value whose key starts with the
+// supplied prefix. The nodes are returned in sorted key order. The tree is
+// walked lazily, so stopping the iteration early will not visit the remaining
+// nodes.
+func (t *radixTree[V, VI]) nodes(prefix []byte) iter.Iter[radixFrame[V]] {
+	stack := []radixFrame[V]{}
+	started := false
+	return func(f iter.IteratorFeedback) (radixFrame[V], error, bool) {
+		if f == iter.Break {
+			return radixFrame[V]{}, nil, false
+		}
+		if !started {
+			started = true
+			if start, ok := t.prefixStart(prefix); ok {
+				stack = append(stack, start)
+			}
+		}
+		for len(stack) > 0 {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for i := len(cur.node.children) - 1; i >= 0; i-- {
+				c := cur.node.children[i]
+				key := make([]byte, 0, len(cur.key)+len(c.label))
+				key = append(append(key, cur.key...), c.label...)
+				stack = append(stack, radixFrame[V]{node: c, key: key})
+			}
+			if cur.node.hasVal {
+				return cur, nil, true
+			}
+		}
+		return radixFrame[V]{}, nil, false
+	}
+}
+
+func (t *radixTree[V, VI]) prefixStart(prefix []byte) (radixFrame[V], bool) {
+	n := &t.root
+	consumed := 0
+	for consumed < len(prefix) {
+		i, ok := n.childIdx(prefix[consumed])
+		if !ok {
+			return radixFrame[V]{}, false
+		}
+		c := n.children[i]
+		rest := prefix[consumed:]
+		if len(rest) < len(c.label) {
+			if !bytes.HasPrefix(c.label, rest) {
+				return radixFrame[V]{}, false
+			}
+		} else if !bytes.HasPrefix(rest, c.label) {
+			return radixFrame[V]{}, false
+		}
+		consumed += len(c.label)
+		n = c
+	}
+	key := make([]byte, 0, consumed)
+	key = append(key, prefix...)
+	if consumed > len(prefix) {
+		key = append(key, n.label[len(n.label)-(consumed-len(prefix)):]...)
+	}
+	return radixFrame[V]{node: n, key: key}, true
+}
+
+// Calls op on every node with a value in sorted key order, stopping early if op
+// returns false.
+func (t *radixTree[V, VI]) forEach(op func(key []byte, n *radixNode[V]) bool) {
+	var walk func(n *radixNode[V], key []byte) bool
+	walk = func(n *radixNode[V], key []byte) bool {
+		if n.hasVal && !op(key, n) {
+			return false
+		}
+		for _, c := range n.children {
+			if !walk(c, append(key[:len(key):len(key)], c.label...)) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(&t.root, []byte{})
+}
+
+func (t *radixTree[V, VI]) clear() {
+	w := widgets.Base[V, VI]{}
+	t.forEach(func(key []byte, n *radixNode[V]) bool {
+		w.Zero(&n.val)
+		return true
+	})
+	t.root = radixNode[V]{}
+	t.size = 0
+}
+
+// Creates a new, empty, radix tree.
+func NewRadixTree[
+	K RadixTreeKey,
+	V any,
+	VI widgets.BaseInterface[V],
+]() RadixTree[K, V, VI] {
+	return RadixTree[K, V, VI]{tree: &radixTree[V, VI]{}}
+}
+
+// Creates a new, empty, synced radix tree. The underlying RWMutex value will
+// be fully unlocked upon initialization.
+func NewSyncedRadixTree[
+	K RadixTreeKey,
+	V any,
+	VI widgets.BaseInterface[V],
+]() SyncedRadixTree[K, V, VI] {
+	return SyncedRadixTree[K, V, VI]{
+		RWMutex:   &sync.RWMutex{},
+		RadixTree: NewRadixTree[K, V, VI](),
+	}
+}
+
+// Creates a new radix tree and populates it with the supplied values. If
+// there are duplicated keys in the supplied slice the last key-value pair will
+// be what is in the returned radix tree.
+func RadixTreeValInit[
+	K RadixTreeKey,
+	V any,
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V]) RadixTree[K, V, VI] {
+	rv := NewRadixTree[K, V, VI]()
+	rv.Emplace(vals...)
+	return rv
+}
+
+// Creates a new synced radix tree and populates it with the supplied values.
+// If there are duplicated keys in the supplied slice the last key-value pair
+// will be what is in the returned radix tree.
+func SyncedRadixTreeValInit[
+	K RadixTreeKey,
+	V any,
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V]) SyncedRadixTree[K, V, VI] {
+	rv := NewSyncedRadixTree[K, V, VI]()
+	rv.Emplace(vals...)
+	return rv
+}
+
+// Converts the supplied radix tree to a synchronized radix tree. Beware: The
+// original non-synced radix tree will remain useable.
+func (m *RadixTree[K, V, VI]) ToSynced() SyncedRadixTree[K, V, VI] {
+	return SyncedRadixTree[K, V, VI]{
+		RWMutex:   &sync.RWMutex{},
+		RadixTree: *m,
+	}
+}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *RadixTree[K, V, VI]) Lock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *RadixTree[K, V, VI]) Unlock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *RadixTree[K, V, VI]) RLock() {}
+
+// A empty pass through function that performs no action. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *RadixTree[K, V, VI]) RUnlock() {}
+
+// The SyncedRadixTree method to override the RadixTree pass through function
+// and actually apply the mutex operation.
+func (m *SyncedRadixTree[K, V, VI]) Lock() { m.RWMutex.Lock() }
+
+// The SyncedRadixTree method to override the RadixTree pass through function
+// and actually apply the mutex operation.
+func (m *SyncedRadixTree[K, V, VI]) Unlock() { m.RWMutex.Unlock() }
+
+// The SyncedRadixTree method to override the RadixTree pass through function
+// and actually apply the mutex operation.
+func (m *SyncedRadixTree[K, V, VI]) RLock() { m.RWMutex.RLock() }
+
+// The SyncedRadixTree method to override the RadixTree pass through function
+// and actually apply the mutex operation.
+func (m *SyncedRadixTree[K, V, VI]) RUnlock() { m.RWMutex.RUnlock() }
+
+// Returns false, radix trees are not addressable.
+func (m *RadixTree[K, V, VI]) IsAddressable() bool { return false }
+
+// Returns false, a radix tree is not synced.
+func (m *RadixTree[K, V, VI]) IsSynced() bool { return false }
+
+// Returns true, a synced radix tree is synced.
+func (m *SyncedRadixTree[K, V, VI]) IsSynced() bool { return true }
+
+// Description: Returns the number of elements in the radix tree.
+//
+// Time Complexity: O(1)
+func (m *RadixTree[K, V, VI]) Length() int {
+	return m.tree.size
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (m *SyncedRadixTree[K, V, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.Length()
+}
+
+// Description: Contains will return true if the supplied value is in the
+// radix tree, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the radix tree was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *RadixTree[K, V, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedRadixTree[K, V, VI]) Contains(v V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// radix tree, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the radix tree was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *RadixTree[K, V, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.ContainsPntr] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedRadixTree[K, V, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.ContainsPntr(v)
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the radix tree.
+//
+// Time Complexity: O(k), where k is the length of the key
+func (m *RadixTree[K, V, VI]) Get(k K) (V, error) {
+	if n := m.tree.get([]byte(k)); n != nil {
+		return n.val, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the length of the key
+func (m *SyncedRadixTree[K, V, VI]) Get(k K) (V, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.Get(k)
+}
+
+// Panics, radix trees are not addressable.
+func (m *RadixTree[K, V, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("radix tree"))
+}
+
+// Description: KeyOf will return the smallest key that maps to the supplied
+// value. If the value is not found then the returned key will be a zero
+// initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the radix tree
+// was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *RadixTree[K, V, VI]) KeyOf(v V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.KeyOf] implementation method. The
+// [RadixTree.KeyOf] method is not called directly to avoid copying the val
+// variable twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedRadixTree[K, V, VI]) KeyOf(v V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: KeyOfPntr will return the smallest key that maps to the
+// supplied value. If the value is not found then the returned key will be a
+// zero initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the radix tree
+// was initialized with.
+//
+// Time Complexity: O(n) (linear search)
+func (m *RadixTree[K, V, VI]) KeyOfPntr(v *V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.KeyOfPntr] implementation method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n) (linear search)
+func (m *SyncedRadixTree[K, V, VI]) KeyOfPntr(v *V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+func (m *RadixTree[K, V, VI]) keyOfImpl(k *K, v *V) bool {
+	found := false
+	w := widgets.Base[V, VI]{}
+	m.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		if w.Eq(v, &n.val) {
+			*k = K(bytes.Clone(key))
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the radix tree. Stops setting values as soon as an error is
+// encountered.
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the length of the
+// longest key
+func (m *RadixTree[K, V, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	return m.setImpl(kvPairs)
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Set] implementaiton method. The
+// [RadixTree.Set] method is not called directly to avoid copying the vals
+// varargs twice, which could be expensive with a large types for the K or V
+// generics or a large number of values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the length of the
+// longest key
+func (m *SyncedRadixTree[K, V, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.setImpl(kvPairs)
+}
+
+func (m *RadixTree[K, V, VI]) setImpl(kvPairs []basic.Pair[K, V]) error {
+	w := widgets.Base[V, VI]{}
+	for i := 0; i < len(kvPairs); i++ {
+		n := m.tree.get([]byte(kvPairs[i].A))
+		if n == nil {
+			return getKeyError[K](&kvPairs[i].A)
+		}
+		w.Zero(&n.val)
+		n.val = kvPairs[i].B
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the radix tree if
+// they do not exist and will set they keys value if it already exists in the
+// radix tree. The values will be inserted in the order that they are given.
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the length of the
+// longest key
+func (m *RadixTree[K, V, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	w := widgets.Base[V, VI]{}
+	for i := 0; i < len(vals); i++ {
+		n := m.tree.insert([]byte(vals[i].A))
+		if n.hasVal {
+			w.Zero(&n.val)
+		} else {
+			n.hasVal = true
+			m.tree.size++
+		}
+		n.val = vals[i].B
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Emplace] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*k), where m=len(vals) and k is the length of the
+// longest key
+func (m *SyncedRadixTree[K, V, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.RadixTree.Emplace(vals...)
+}
+
+// Description: Pop will remove all occurrences of val in the radix tree. All
+// equality comparisons are performed by the generic VI widget type that the
+// radix tree was initialized with.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) Pop(v V) int {
+	return m.popImpl(&v)
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Pop] implementation method. The
+// [RadixTree.Pop] method is not called directly to avoid copying the v
+// argument twice, which could be expensive with a large type for the V
+// generic.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) Pop(v V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.popImpl(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the radix tree.
+// All equality comparisons are performed by the generic VI widget type that
+// the radix tree was initialized with.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) PopPntr(v *V) int {
+	return m.popImpl(v)
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.PopPntr] implementation method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	return m.popImpl(v)
+}
+
+func (m *RadixTree[K, V, VI]) popImpl(v *V) int {
+	keys := [][]byte{}
+	w := widgets.Base[V, VI]{}
+	m.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		if w.Eq(v, &n.val) {
+			keys = append(keys, bytes.Clone(key))
+		}
+		return true
+	})
+	for _, k := range keys {
+		m.tree.remove(k)
+	}
+	return len(keys)
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the radix tree.
+//
+// Time Complexity: O(k), where k is the length of the key
+func (m *RadixTree[K, V, VI]) Delete(k K) error {
+	if !m.tree.remove([]byte(k)) {
+		return getKeyError[K](&k)
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Delete] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(k), where k is the length of the key
+func (m *SyncedRadixTree[K, V, VI]) Delete(k K) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.RadixTree.Delete(k)
+}
+
+// Description: Clears all values from the radix tree.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) Clear() {
+	m.tree.clear()
+}
+
+// Description: Places a write lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.RadixTree.Clear()
+}
+
+// Description: Returns the key value pair with the longest key that is a
+// prefix of the supplied key. A key is considered to be a prefix of itself.
+// Returns a [containerTypes.KeyError] if no key in the radix tree is a prefix
+// of the supplied key.
+//
+// Time Complexity: O(k), where k is the length of the supplied key
+func (m *RadixTree[K, V, VI]) LongestPrefix(k K) (basic.Pair[K, V], error) {
+	key := []byte(k)
+	if n, l := m.tree.longestPrefix(key); n != nil {
+		return basic.Pair[K, V]{A: K(bytes.Clone(key[:l])), B: n.val}, nil
+	}
+	return basic.Pair[K, V]{}, getKeyError[K](&k)
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.LongestPrefix] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k), where k is the length of the supplied key
+func (m *SyncedRadixTree[K, V, VI]) LongestPrefix(k K) (basic.Pair[K, V], error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.LongestPrefix(k)
+}
+
+// Description: Returns an iterator that iterates over the keys of the radix
+// tree in sorted order.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) Keys() iter.Iter[K] {
+	return iter.Map[radixFrame[V], K](
+		m.tree.nodes(nil),
+		func(index int, val radixFrame[V]) (K, error) { return K(val.key), nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [RadixTree.Keys] method such that a read lock will be placed on the
+// underlying radix tree when the iterator is consumed. The radix tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) Keys() iter.Iter[K] {
+	return m.RadixTree.Keys().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the radix tree that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [RadixTree.Keys].
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the radix tree that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedRadixTree.Keys], so the radix tree will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the radix
+// tree. The values will be returned in the order of their keys.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) Vals() iter.Iter[V] {
+	return iter.Map[radixFrame[V], V](
+		m.tree.nodes(nil),
+		func(index int, val radixFrame[V]) (V, error) { return val.node.val, nil },
+	)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [RadixTree.Vals] method such that a read lock will be placed on the
+// underlying radix tree when the iterator is consumed. The radix tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) Vals() iter.Iter[V] {
+	return m.RadixTree.Vals().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the radix tree that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [RadixTree.Vals].
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the radix tree that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedRadixTree.Vals], so the radix tree will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the radix tree
+// that can be used in a for-range loop. The key value pairs are returned in
+// sorted order.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.Pairs())
+}
+
+// Description: Returns a sequence over the key value pairs in the radix tree
+// that can be used in a for-range loop. The radix tree will have a read lock
+// the entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.Pairs())
+}
+
+// Panics, radix trees are not addressable.
+func (m *RadixTree[K, V, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("radix tree"))
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the radix tree in sorted order.
+//
+// Time Complexity: O(n)
+func (m *RadixTree[K, V, VI]) Pairs() iter.Iter[basic.Pair[K, V]] {
+	return m.prefixPairsImpl(nil)
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [RadixTree.Pairs] method such that a read lock will be placed on the
+// underlying radix tree when the iterator is consumed. The radix tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SyncedRadixTree[K, V, VI]) Pairs() iter.Iter[basic.Pair[K, V]] {
+	return m.RadixTree.Pairs().SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the radix tree whose keys start with the supplied prefix, in sorted
+// order. The tree is walked lazily, so stopping the iteration early will not
+// visit the remaining keys.
+//
+// Time Complexity: O(k+m), where k is the length of the prefix and m is the
+// number of keys that start with the prefix
+func (m *RadixTree[K, V, VI]) PrefixPairs(prefix K) iter.Iter[basic.Pair[K, V]] {
+	return m.prefixPairsImpl([]byte(prefix))
+}
+
+// Description: Modifies the iterator chain returned by the unerlying
+// [RadixTree.PrefixPairs] method such that a read lock will be placed on the
+// underlying radix tree when the iterator is consumed. The radix tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(k+m), where k is the length of the prefix and m is the
+// number of keys that start with the prefix
+func (m *SyncedRadixTree[K, V, VI]) PrefixPairs(
+	prefix K,
+) iter.Iter[basic.Pair[K, V]] {
+	return m.RadixTree.PrefixPairs(prefix).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+func (m *RadixTree[K, V, VI]) prefixPairsImpl(
+	prefix []byte,
+) iter.Iter[basic.Pair[K, V]] {
+	// The prefix is copied so that modifying a []byte prefix after calling
+	// this function does not change the iterators results.
+	prefix = bytes.Clone(prefix)
+	return iter.Map[radixFrame[V], basic.Pair[K, V]](
+		m.tree.nodes(prefix),
+		func(index int, val radixFrame[V]) (basic.Pair[K, V], error) {
+			return basic.Pair[K, V]{A: K(val.key), B: val.node.val}, nil
+		},
+	)
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise.
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (m *RadixTree[K, V, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	if m.tree.size != other.Length() {
+		return false
+	}
+	rv := true
+	w := widgets.Base[V, VI]{}
+	m.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		otherV, err := addressableSafeGet[K, V](other, K(key))
+		rv = (err == nil && w.Eq(&n.val, otherV))
+		return rv
+	})
+	return rv
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.KeyedEq] method. Attempts to place a
+// read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on this radix tree, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.GetPntr) represents the time complexity of the GetPntr method on
+// other.
+func (m *SyncedRadixTree[K, V, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.RLock()
+	other.RLock()
+	defer m.RUnlock()
+	defer other.RUnlock()
+	return m.RadixTree.KeyedEq(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [RadixTree.KeyedEq]. Returns
+// true if l==r, false otherwise.
+func (_ *RadixTree[K, V, VI]) Eq(
+	l *RadixTree[K, V, VI],
+	r *RadixTree[K, V, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SyncedRadixTree.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *SyncedRadixTree[K, V, VI]) Eq(
+	l *SyncedRadixTree[K, V, VI],
+	r *SyncedRadixTree[K, V, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a radix tree. To do this all of the
+// individual hashes that are produced from the elements of the radix tree are
+// combined in a way that maintains identity, making it so the hash will
+// represent the same equality operation that [RadixTree.KeyedEq] and
+// [RadixTree.Eq] provide. Keys are hashed with the same seed as the
+// [widgets.BuiltinString] widget.
+func (_ *RadixTree[K, V, VI]) Hash(other *RadixTree[K, V, VI]) hash.Hash {
+	cntr := 0
+	var rv hash.Hash
+	w := widgets.Base[V, VI]{}
+	other.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		iterH := hash.Hash(maphash.Bytes(widgets.RANDOM_SEED_STRING, key)).
+			Combine(w.Hash(&n.val))
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.CombineUnordered(iterH)
+		}
+		return true
+	})
+	return rv
+}
+
+// Places a read lock on the underlying radix tree of other and then calls
+// others underlying radix trees [RadixTree.Hash] method.
+func (_ *SyncedRadixTree[K, V, VI]) Hash(
+	other *SyncedRadixTree[K, V, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.RadixTree.Hash(&other.RadixTree)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [RadixTree.Clear].
+func (_ *RadixTree[K, V, VI]) Zero(other *RadixTree[K, V, VI]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedRadixTree.Clear].
+func (_ *SyncedRadixTree[K, V, VI]) Zero(other *SyncedRadixTree[K, V, VI]) {
+	other.Clear()
+}
+
+func (m *RadixTree[K, V, VI]) toSerialized() []serializedKV[K, V] {
+	rv := []serializedKV[K, V]{}
+	if m.tree == nil {
+		return rv
+	}
+	rv = make([]serializedKV[K, V], 0, m.tree.size)
+	m.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		rv = append(rv, serializedKV[K, V]{Key: K(bytes.Clone(key)), Val: n.val})
+		return true
+	})
+	return rv
+}
+
+func (m *RadixTree[K, V, VI]) fromSerialized(kvs []serializedKV[K, V]) error {
+	if m.tree == nil {
+		m.tree = &radixTree[V, VI]{}
+	}
+	m.Clear()
+	for _, kv := range kvs {
+		m.Emplace(basic.Pair[K, V]{A: kv.Key, B: kv.Val})
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the radix tree. The radix tree is
+// encoded as a list of key value objects in sorted key order. If a key is
+// present more than once when decoding the last value is kept.
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *RadixTree[K, V, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *SyncedRadixTree[K, V, VI]) MarshalJSON() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.MarshalJSON()
+}
+
+// Description: Replaces the contents of the radix tree with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [RadixTree.MarshalJSON]. If an error is returned the radix tree is left
+// unchanged.
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *RadixTree[K, V, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying radix tree while its contents are replaced. Exhibits the same
+// behavior as [RadixTree.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *SyncedRadixTree[K, V, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.RadixTree.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the radix tree. The values
+// are laid out the same way as [RadixTree.MarshalJSON] and are encoded using
+// the [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *RadixTree[K, V, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Places a read lock on the underlying radix tree and then calls
+// the underlying radix trees [RadixTree.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *SyncedRadixTree[K, V, VI]) MarshalBinary() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.RadixTree.MarshalBinary()
+}
+
+// Description: Replaces the contents of the radix tree with the values decoded
+// from the supplied binary data, which must have been produced by
+// [RadixTree.MarshalBinary]. If an error is returned the radix tree is left
+// unchanged.
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *RadixTree[K, V, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying radix tree while its contents are replaced. Exhibits the same
+// behavior as [RadixTree.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*k), where k is the length of the longest key
+func (m *SyncedRadixTree[K, V, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.RadixTree.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m RadixTree[K, V, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("radixTree["))
+	cntr := 0
+	m.tree.forEach(func(key []byte, n *radixNode[V]) bool {
+		fmt.Fprintf(f, fmtStr, K(key), n.val)
+		cntr++
+		if cntr < m.tree.size {
+			f.Write([]byte{' '})
+		}
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *RadixTree[K, V, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func RadixTreeToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateRadixTree(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestRadixTree_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(RadixTreeToMapInterfaceFactory, t)
+}
+
+func TestRadixTree_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(RadixTreeToMapInterfaceFactory, t)
+}
@@ -0,0 +1,298 @@
+package containers
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=RadixTree -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateRadixTree
+//go:generate ../../../bin/containerInterfaceTests -type=SyncedRadixTree -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateSyncedRadixTree
+
+// The generated map tests use int keys, which a radix tree does not accept.
+// This adapter converts the int keys to their decimal string representation so
+// the generated tests can be run against a radix tree. The decimal strings
+// share prefixes (ex: "1" and "10") which exercises the splitting and merging
+// of nodes.
+type radixTreeIntKeys struct {
+	dynamicContainers.Map[string, int]
+}
+
+func generateRadixTree(capacity int) radixTreeIntKeys {
+	v := NewRadixTree[string, int, widgets.BuiltinInt]()
+	return radixTreeIntKeys{Map: &v}
+}
+
+func generateSyncedRadixTree(capacity int) radixTreeIntKeys {
+	v := NewSyncedRadixTree[string, int, widgets.BuiltinInt]()
+	return radixTreeIntKeys{Map: &v}
+}
+
+func (r *radixTreeIntKeys) toPairs(
+	kvPairs []basic.Pair[int, int],
+) []basic.Pair[string, int] {
+	rv := make([]basic.Pair[string, int], len(kvPairs))
+	for i, p := range kvPairs {
+		rv[i] = basic.Pair[string, int]{A: strconv.Itoa(p.A), B: p.B}
+	}
+	return rv
+}
+
+func (r *radixTreeIntKeys) fromKey(k string) int {
+	rv, _ := strconv.Atoi(k)
+	return rv
+}
+
+func (r *radixTreeIntKeys) Get(k int) (int, error) {
+	return r.Map.Get(strconv.Itoa(k))
+}
+
+func (r *radixTreeIntKeys) GetPntr(k int) (*int, error) {
+	return r.Map.GetPntr(strconv.Itoa(k))
+}
+
+func (r *radixTreeIntKeys) KeyOf(v int) (int, bool) {
+	k, found := r.Map.KeyOf(v)
+	return r.fromKey(k), found
+}
+
+func (r *radixTreeIntKeys) KeyOfPntr(v *int) (int, bool) {
+	k, found := r.Map.KeyOfPntr(v)
+	return r.fromKey(k), found
+}
+
+func (r *radixTreeIntKeys) Set(kvPairs ...basic.Pair[int, int]) error {
+	return r.Map.Set(r.toPairs(kvPairs)...)
+}
+
+func (r *radixTreeIntKeys) Emplace(kvPairs ...basic.Pair[int, int]) error {
+	return r.Map.Emplace(r.toPairs(kvPairs)...)
+}
+
+func (r *radixTreeIntKeys) Delete(k int) error {
+	return r.Map.Delete(strconv.Itoa(k))
+}
+
+func (r *radixTreeIntKeys) Keys() iter.Iter[int] {
+	return iter.Map[string, int](
+		r.Map.Keys(),
+		func(index int, val string) (int, error) { return r.fromKey(val), nil },
+	)
+}
+
+func (r *radixTreeIntKeys) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[int, int],
+) bool {
+	if o, ok := other.(*radixTreeIntKeys); ok {
+		return r.Map.KeyedEq(o.Map)
+	}
+	return false
+}
+
+func TestRadixTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[RadixTree[string, string, widgets.BuiltinString]]
+	v := NewRadixTree[string, string, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func radixTreeForTest() RadixTree[string, int, widgets.BuiltinInt] {
+	return RadixTreeValInit[string, int, widgets.BuiltinInt](
+		[]basic.Pair[string, int]{
+			{A: "romane", B: 0},
+			{A: "romanus", B: 1},
+			{A: "romulus", B: 2},
+			{A: "rubens", B: 3},
+			{A: "ruber", B: 4},
+			{A: "rubicon", B: 5},
+			{A: "rubicundus", B: 6},
+			{A: "rub", B: 7},
+		},
+	)
+}
+
+func TestRadixTreeKeysAreSorted(t *testing.T) {
+	r := radixTreeForTest()
+	keys, err := r.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[string](
+		[]string{
+			"romane", "romanus", "romulus", "rub",
+			"rubens", "ruber", "rubicon", "rubicundus",
+		},
+		keys, t,
+	)
+	vals, err := r.Vals().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2, 7, 3, 4, 5, 6}, vals, t)
+	test.Eq(8, r.Length(), t)
+}
+
+func TestRadixTreeEmptyKey(t *testing.T) {
+	r := NewRadixTree[string, int, widgets.BuiltinInt]()
+	_, err := r.Get("")
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.Nil(r.Emplace(basic.Pair[string, int]{A: "", B: 1}), t)
+	test.Nil(r.Emplace(basic.Pair[string, int]{A: "a", B: 2}), t)
+	v, err := r.Get("")
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+	p, err := r.LongestPrefix("b")
+	test.Nil(err, t)
+	test.Eq(basic.Pair[string, int]{A: "", B: 1}, p, t)
+	keys, err := r.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[string]([]string{"", "a"}, keys, t)
+	test.Nil(r.Delete(""), t)
+	test.Eq(1, r.Length(), t)
+	_, err = r.LongestPrefix("b")
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestRadixTreeLongestPrefix(t *testing.T) {
+	r := radixTreeForTest()
+	p, err := r.LongestPrefix("rubicons")
+	test.Nil(err, t)
+	test.Eq(basic.Pair[string, int]{A: "rubicon", B: 5}, p, t)
+	p, err = r.LongestPrefix("rubicon")
+	test.Nil(err, t)
+	test.Eq(basic.Pair[string, int]{A: "rubicon", B: 5}, p, t)
+	p, err = r.LongestPrefix("rubi")
+	test.Nil(err, t)
+	test.Eq(basic.Pair[string, int]{A: "rub", B: 7}, p, t)
+	_, err = r.LongestPrefix("ru")
+	test.ContainsError(containerTypes.KeyError, err, t)
+	_, err = r.LongestPrefix("roman")
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestRadixTreePrefixPairs(t *testing.T) {
+	r := radixTreeForTest()
+	pairs, err := r.PrefixPairs("rub").Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[string, int]](
+		[]basic.Pair[string, int]{
+			{A: "rub", B: 7}, {A: "rubens", B: 3}, {A: "ruber", B: 4},
+			{A: "rubicon", B: 5}, {A: "rubicundus", B: 6},
+		},
+		pairs, t,
+	)
+
+	// A prefix that ends in the middle of an edge
+	keys, err := iter.Map[basic.Pair[string, int], string](
+		r.PrefixPairs("rubi"),
+		func(index int, val basic.Pair[string, int]) (string, error) {
+			return val.A, nil
+		},
+	).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[string]([]string{"rubicon", "rubicundus"}, keys, t)
+
+	cnt, err := r.PrefixPairs("rom").Count()
+	test.Nil(err, t)
+	test.Eq(3, cnt, t)
+	cnt, err = r.PrefixPairs("romx").Count()
+	test.Nil(err, t)
+	test.Eq(0, cnt, t)
+	cnt, err = r.PrefixPairs("x").Count()
+	test.Nil(err, t)
+	test.Eq(0, cnt, t)
+	cnt, err = r.PrefixPairs("").Count()
+	test.Nil(err, t)
+	test.Eq(8, cnt, t)
+	cnt, err = r.PrefixPairs("rubicundusx").Count()
+	test.Nil(err, t)
+	test.Eq(0, cnt, t)
+
+	first, err := r.PrefixPairs("r").Take(1).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[string, int]](
+		[]basic.Pair[string, int]{{A: "romane", B: 0}}, first, t,
+	)
+}
+
+func TestRadixTreeDeleteMergesNodes(t *testing.T) {
+	r := radixTreeForTest()
+	test.Nil(r.Delete("rub"), t)
+	test.ContainsError(containerTypes.KeyError, r.Delete("rub"), t)
+	test.ContainsError(containerTypes.KeyError, r.Delete("ru"), t)
+	test.Nil(r.Delete("rubicon"), t)
+	test.Nil(r.Delete("rubens"), t)
+	test.Nil(r.Delete("romane"), t)
+	test.Nil(r.Delete("romulus"), t)
+	test.Eq(3, r.Length(), t)
+
+	// Every node without a value should have at least two children
+	var check func(n *radixNode[int], isRoot bool)
+	check = func(n *radixNode[int], isRoot bool) {
+		if !isRoot && !n.hasVal {
+			test.True(len(n.children) >= 2, t)
+		}
+		for _, c := range n.children {
+			test.True(len(c.label) > 0, t)
+			check(c, false)
+		}
+	}
+	check(&r.tree.root, true)
+
+	pairs, err := r.Pairs().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[string, int]](
+		[]basic.Pair[string, int]{
+			{A: "romanus", B: 1}, {A: "ruber", B: 4}, {A: "rubicundus", B: 6},
+		},
+		pairs, t,
+	)
+	test.Eq(2, r.Pop(1)+r.Pop(6), t)
+	test.Eq(1, len(r.tree.root.children), t)
+	test.Eq("ruber", string(r.tree.root.children[0].label), t)
+}
+
+func TestRadixTreeByteSliceKeys(t *testing.T) {
+	r := NewRadixTree[[]byte, int, widgets.BuiltinInt]()
+	k := []byte("abc")
+	test.Nil(r.Emplace(basic.Pair[[]byte, int]{A: k, B: 1}), t)
+	// Modifying the supplied key must not modify the tree
+	k[0] = 'x'
+	v, err := r.Get([]byte("abc"))
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+	test.Nil(r.Emplace(basic.Pair[[]byte, int]{A: []byte("ab"), B: 2}), t)
+	p, err := r.LongestPrefix([]byte("abz"))
+	test.Nil(err, t)
+	test.Eq("ab", string(p.A), t)
+	keys, err := r.Keys().Collect()
+	test.Nil(err, t)
+	test.Eq(2, len(keys), t)
+	test.Eq("ab", string(keys[0]), t)
+	test.Eq("abc", string(keys[1]), t)
+	keys[1][0] = 'x'
+	_, err = r.Get([]byte("abc"))
+	test.Nil(err, t)
+}
+
+func TestRadixTreeEq(t *testing.T) {
+	r1 := radixTreeForTest()
+	r2 := radixTreeForTest()
+	test.True(r1.Eq(&r1, &r2), t)
+	test.Eq(r1.Hash(&r1), r1.Hash(&r2), t)
+	test.Nil(r2.Set(basic.Pair[string, int]{A: "rub", B: 8}), t)
+	test.False(r1.Eq(&r1, &r2), t)
+	test.Nil(r2.Delete("rub"), t)
+	test.False(r1.Eq(&r1, &r2), t)
+	test.False(r1.Eq(&r2, &r1), t)
+}
+
+func TestRadixTreeFormat(t *testing.T) {
+	r := RadixTreeValInit[string, int, widgets.BuiltinInt](
+		[]basic.Pair[string, int]{{A: "b", B: 2}, {A: "a", B: 1}},
+	)
+	test.Eq("radixTree[a:1 b:2]", fmt.Sprintf("%v", &r), t)
+}
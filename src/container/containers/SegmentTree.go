@@ -0,0 +1,794 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+	"sync"
+
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// The interface that defines the operations a segment tree uses to reduce
+	// ranges of values and to apply updates to ranges of values.
+	// Implementations of this interface are expected to hold no state that
+	// pertains to the interface functions as the methods will not be called in
+	// any predetermined order.
+	SegmentTreeMonoid[T any] interface {
+		widgets.BaseInterface[T]
+		// Returns the identity value of Op. Combining any value with the
+		// identity value using Op must return the original value.
+		ZeroVal() T
+		// Combines l and r and places the results in res. Op must be
+		// associative but does not need to be commutative, l will always hold
+		// the reduction of the values that have lower indexes than the values
+		// reduced into r. No uniqueness guarantees are placed on res, l, and r.
+		Op(res *T, l *T, r *T)
+		// Applies update to agg, which is the reduction of n values, and places
+		// the results in agg. The result must be the same as applying update
+		// to each of the n values individually and then reducing them. n will
+		// always be > 0.
+		Apply(agg *T, update *T, n int)
+		// Combines two updates and places the results in res, such that
+		// applying res is the same as applying first and then applying second.
+		// No uniqueness guarantees are placed on res, first, and second.
+		Compose(res *T, first *T, second *T)
+	}
+
+	// A segment tree monoid that sums values and adds to values when updating
+	// ranges, with the arithmetic logic defined by the supplied arith widget.
+	SumMonoid[T any, U widgets.ArithInterface[T]] struct{}
+
+	// A type to represent a fixed length list of values that supports
+	// efficiently reducing any range of the values with an associative
+	// operation and efficiently applying updates to any range of the values.
+	// Range reductions and range updates are performed lazily and both take
+	// O(log(n)) time. The type constraints on the generics define the logic for
+	// how value specific operations, such as equality comparisons, reductions,
+	// and updates, will be handled.
+	SegmentTree[T any, U SegmentTreeMonoid[T]] struct {
+		length int
+		// The number of leaves in the tree, always a power of two.
+		numLeaves int
+		// The tree is stored 1-indexed, with the children of node i being
+		// 2*i and 2*i+1. Leaves past length hold the identity value.
+		tree []T
+		// The updates that have been applied to an internal node but not yet
+		// to its children.
+		lazy    []T
+		pending []bool
+	}
+
+	// A synchronized version of SegmentTree. All operations will be wrapped in
+	// the appropriate calls to the embedded RWMutex. A pointer to a RWMutex is
+	// embedded rather than a value to avoid copying the lock value.
+	SyncedSegmentTree[T any, U SegmentTreeMonoid[T]] struct {
+		*sync.RWMutex
+		SegmentTree[T, U]
+	}
+)
+
+// An equality function that implements the [SegmentTreeMonoid] interface.
+func (_ SumMonoid[T, U]) Eq(l *T, r *T) bool {
+	w := widgets.Arith[T, U]{}
+	return w.Eq(l, r)
+}
+
+// A hash function that implements the [SegmentTreeMonoid] interface.
+func (_ SumMonoid[T, U]) Hash(v *T) hash.Hash {
+	w := widgets.Arith[T, U]{}
+	return w.Hash(v)
+}
+
+// A zero function that implements the [SegmentTreeMonoid] interface.
+func (_ SumMonoid[T, U]) Zero(v *T) {
+	w := widgets.Arith[T, U]{}
+	w.Zero(v)
+}
+
+// Returns the zero value provided by the U widget, which is the identity value
+// of addition.
+func (_ SumMonoid[T, U]) ZeroVal() T {
+	w := widgets.Arith[T, U]{}
+	return w.ZeroVal()
+}
+
+// Adds l and r and places the results in res.
+func (_ SumMonoid[T, U]) Op(res *T, l *T, r *T) {
+	w := widgets.Arith[T, U]{}
+	w.Add(res, l, r)
+}
+
+// Adds update to each of the n values that were summed into agg. The total is
+// calculated using O(log(n)) additions rather than a multiplication so that
+// the U widget does not need to be able to represent n.
+func (_ SumMonoid[T, U]) Apply(agg *T, update *T, n int) {
+	w := widgets.Arith[T, U]{}
+	total := w.ZeroVal()
+	for double := *update; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			w.Add(&total, &total, &double)
+		}
+		if n > 1 {
+			w.Add(&double, &double, &double)
+		}
+	}
+	w.Add(agg, agg, &total)
+}
+
+// Adds first and second and places the results in res.
+func (_ SumMonoid[T, U]) Compose(res *T, first *T, second *T) {
+	w := widgets.Arith[T, U]{}
+	w.Add(res, first, second)
+}
+
+// Creates a new segment tree that holds size values, all of which are set to
+// the identity value provided by the U monoid. Size must be >= 0, an error will
+// be returned if it is not.
+func NewSegmentTree[T any, U SegmentTreeMonoid[T]](
+	size int,
+) (SegmentTree[T, U], error) {
+	if size < 0 {
+		return SegmentTree[T, U]{}, getSizeError(size)
+	}
+	return newSegmentTree[T, U](size), nil
+}
+
+// Creates a new synced segment tree that holds size values, all of which are
+// set to the identity value provided by the U monoid. Size must be >= 0, an
+// error will be returned if it is not. The underlying RWMutex value will be
+// fully unlocked upon initialization.
+func NewSyncedSegmentTree[T any, U SegmentTreeMonoid[T]](
+	size int,
+) (SyncedSegmentTree[T, U], error) {
+	rv, err := NewSegmentTree[T, U](size)
+	return SyncedSegmentTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		SegmentTree: rv,
+	}, err
+}
+
+// Creates a new segment tree that holds the supplied values. The values are
+// copied. This is faster than creating an empty segment tree and setting each
+// value individually.
+func SegmentTreeValInit[T any, U SegmentTreeMonoid[T]](
+	vals ...T,
+) SegmentTree[T, U] {
+	var m U
+	rv := newSegmentTree[T, U](len(vals))
+	copy(rv.tree[rv.numLeaves:], vals)
+	for i := rv.numLeaves - 1; i > 0; i-- {
+		m.Op(&rv.tree[i], &rv.tree[2*i], &rv.tree[2*i+1])
+	}
+	return rv
+}
+
+// Creates a new synced segment tree that holds the supplied values. The values
+// are copied. The underlying RWMutex value will be fully unlocked upon
+// initialization.
+func SyncedSegmentTreeValInit[T any, U SegmentTreeMonoid[T]](
+	vals ...T,
+) SyncedSegmentTree[T, U] {
+	return SyncedSegmentTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		SegmentTree: SegmentTreeValInit[T, U](vals...),
+	}
+}
+
+func newSegmentTree[T any, U SegmentTreeMonoid[T]](size int) SegmentTree[T, U] {
+	var m U
+	numLeaves := 1
+	for numLeaves < size {
+		numLeaves <<= 1
+	}
+	rv := SegmentTree[T, U]{
+		length:    size,
+		numLeaves: numLeaves,
+		tree:      make([]T, 2*numLeaves),
+		lazy:      make([]T, numLeaves),
+		pending:   make([]bool, numLeaves),
+	}
+	for i := 0; i < len(rv.tree); i++ {
+		rv.tree[i] = m.ZeroVal()
+	}
+	return rv
+}
+
+// Converts the supplied segment tree to a synchronized segment tree. Beware:
+// The original non-synced segment tree will remain useable.
+func (s *SegmentTree[T, U]) ToSynced() SyncedSegmentTree[T, U] {
+	return SyncedSegmentTree[T, U]{
+		RWMutex:     &sync.RWMutex{},
+		SegmentTree: *s,
+	}
+}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (s *SegmentTree[T, U]) Lock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (s *SegmentTree[T, U]) Unlock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (s *SegmentTree[T, U]) RLock() {}
+
+// A empty pass through function that performs no action. Present for
+// consistency with the other containers.
+func (s *SegmentTree[T, U]) RUnlock() {}
+
+// The SyncedSegmentTree method to override the SegmentTree pass through
+// function and actually apply the mutex operation.
+func (s *SyncedSegmentTree[T, U]) Lock() { s.RWMutex.Lock() }
+
+// The SyncedSegmentTree method to override the SegmentTree pass through
+// function and actually apply the mutex operation.
+func (s *SyncedSegmentTree[T, U]) Unlock() { s.RWMutex.Unlock() }
+
+// The SyncedSegmentTree method to override the SegmentTree pass through
+// function and actually apply the mutex operation.
+func (s *SyncedSegmentTree[T, U]) RLock() { s.RWMutex.RLock() }
+
+// The SyncedSegmentTree method to override the SegmentTree pass through
+// function and actually apply the mutex operation.
+func (s *SyncedSegmentTree[T, U]) RUnlock() { s.RWMutex.RUnlock() }
+
+// Returns false, a segment tree is not synced.
+func (s *SegmentTree[T, U]) IsSynced() bool { return false }
+
+// Returns true, a synced segment tree is synced.
+func (s *SyncedSegmentTree[T, U]) IsSynced() bool { return true }
+
+// Returns the number of values in the index range [lo,hi) that are inside the
+// segment tree.
+func (s *SegmentTree[T, U]) nodeLength(lo int, hi int) int {
+	return max(0, min(hi, s.length)-lo)
+}
+
+// Applies the supplied update to the node that covers the index range [lo,hi).
+func (s *SegmentTree[T, U]) apply(node int, lo int, hi int, update *T) {
+	var m U
+	n := s.nodeLength(lo, hi)
+	if n == 0 {
+		return
+	}
+	m.Apply(&s.tree[node], update, n)
+	if node < s.numLeaves {
+		if s.pending[node] {
+			m.Compose(&s.lazy[node], &s.lazy[node], update)
+		} else {
+			s.lazy[node] = *update
+			s.pending[node] = true
+		}
+	}
+}
+
+// Pushes the pending update of the node that covers the index range [lo,hi)
+// down to its children.
+func (s *SegmentTree[T, U]) push(node int, lo int, hi int) {
+	if !s.pending[node] {
+		return
+	}
+	var m U
+	mid := (lo + hi) / 2
+	s.apply(2*node, lo, mid, &s.lazy[node])
+	s.apply(2*node+1, mid, hi, &s.lazy[node])
+	m.Zero(&s.lazy[node])
+	s.pending[node] = false
+}
+
+func (s *SegmentTree[T, U]) checkRange(start int, end int) error {
+	if start < 0 {
+		return getIndexOutOfBoundsError(start, 0, s.length)
+	}
+	if end > s.length {
+		return getIndexOutOfBoundsError(end, 0, s.length)
+	}
+	if end < start {
+		return getStartEndIndexError(start, end)
+	}
+	return nil
+}
+
+// Description: Returns the number of values in the segment tree.
+//
+// Time Complexity: O(1)
+func (s *SegmentTree[T, U]) Length() int {
+	return s.length
+}
+
+// Description: Places a read lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Length] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(1)
+func (s *SyncedSegmentTree[T, U]) Length() int {
+	s.RLock()
+	defer s.RUnlock()
+	return s.SegmentTree.Length()
+}
+
+// Description: Returns the reduction of the values in the index range
+// [start,end), combined in index order using the Op method of the U monoid.
+// The identity value will be returned if start equals end. Returns an error if
+// the start index is < 0, the end index is > the length of the segment tree,
+// or the end index is < the start index. The segment tree is not modified.
+//
+// Time Complexity: O(log(n))
+func (s *SegmentTree[T, U]) Query(start int, end int) (T, error) {
+	var m U
+	if err := s.checkRange(start, end); err != nil {
+		var tmp T
+		return tmp, err
+	}
+	if start == end {
+		return m.ZeroVal(), nil
+	}
+	var noUpdate T
+	return s.queryImpl(1, 0, s.numLeaves, start, end, &noUpdate, false), nil
+}
+
+// Description: Places a read lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Query] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedSegmentTree[T, U]) Query(start int, end int) (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.SegmentTree.Query(start, end)
+}
+
+// Reduces the values in [start,end) that are under the node covering [lo,hi).
+// Rather than pushing pending updates down, which would require a write lock,
+// the pending updates of the ancestors of the node are carried down in update.
+// Ancestor updates are always newer than the updates of their descendants.
+func (s *SegmentTree[T, U]) queryImpl(
+	node int,
+	lo int,
+	hi int,
+	start int,
+	end int,
+	update *T,
+	hasUpdate bool,
+) T {
+	var m U
+	if start <= lo && hi <= end {
+		rv := s.tree[node]
+		if n := s.nodeLength(lo, hi); hasUpdate && n > 0 {
+			m.Apply(&rv, update, n)
+		}
+		return rv
+	}
+	childUpdate := update
+	if s.pending[node] {
+		var composed T
+		if hasUpdate {
+			m.Compose(&composed, &s.lazy[node], update)
+		} else {
+			composed = s.lazy[node]
+		}
+		childUpdate, hasUpdate = &composed, true
+	}
+	mid := (lo + hi) / 2
+	if end <= mid {
+		return s.queryImpl(2*node, lo, mid, start, end, childUpdate, hasUpdate)
+	}
+	if start >= mid {
+		return s.queryImpl(2*node+1, mid, hi, start, end, childUpdate, hasUpdate)
+	}
+	rv := s.queryImpl(2*node, lo, mid, start, end, childUpdate, hasUpdate)
+	r := s.queryImpl(2*node+1, mid, hi, start, end, childUpdate, hasUpdate)
+	m.Op(&rv, &rv, &r)
+	return rv
+}
+
+// Description: Returns the value at the supplied index. Returns an error if
+// the index is out of bounds.
+//
+// Time Complexity: O(log(n))
+func (s *SegmentTree[T, U]) Get(idx int) (T, error) {
+	if idx < 0 || idx >= s.length {
+		var tmp T
+		return tmp, getIndexOutOfBoundsError(idx, 0, s.length)
+	}
+	return s.Query(idx, idx+1)
+}
+
+// Description: Places a read lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Get] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (s *SyncedSegmentTree[T, U]) Get(idx int) (T, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.SegmentTree.Get(idx)
+}
+
+// Description: Applies the supplied update to every value in the index range
+// [start,end) using the Apply method of the U monoid. Returns an error if the
+// start index is < 0, the end index is > the length of the segment tree, or
+// the end index is < the start index.
+//
+// Time Complexity: O(log(n)) calls to the Apply and Compose methods of the U
+// monoid.
+func (s *SegmentTree[T, U]) Update(start int, end int, update T) error {
+	if err := s.checkRange(start, end); err != nil {
+		return err
+	}
+	if start < end {
+		s.updateImpl(1, 0, s.numLeaves, start, end, &update)
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Update] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n)) calls to the Apply and Compose methods of the U
+// monoid.
+func (s *SyncedSegmentTree[T, U]) Update(start int, end int, update T) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.SegmentTree.Update(start, end, update)
+}
+
+func (s *SegmentTree[T, U]) updateImpl(
+	node int,
+	lo int,
+	hi int,
+	start int,
+	end int,
+	update *T,
+) {
+	if end <= lo || hi <= start {
+		return
+	}
+	if start <= lo && hi <= end {
+		s.apply(node, lo, hi, update)
+		return
+	}
+	var m U
+	s.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	s.updateImpl(2*node, lo, mid, start, end, update)
+	s.updateImpl(2*node+1, mid, hi, start, end, update)
+	m.Op(&s.tree[node], &s.tree[2*node], &s.tree[2*node+1])
+}
+
+// Description: Sets the value at the supplied index, discarding any updates
+// that were previously applied to it. Returns an error if the index is out of
+// bounds.
+//
+// Time Complexity: O(log(n))
+func (s *SegmentTree[T, U]) Set(idx int, val T) error {
+	if idx < 0 || idx >= s.length {
+		return getIndexOutOfBoundsError(idx, 0, s.length)
+	}
+	var m U
+	node, lo, hi := 1, 0, s.numLeaves
+	for node < s.numLeaves {
+		s.push(node, lo, hi)
+		if mid := (lo + hi) / 2; idx < mid {
+			node, hi = 2*node, mid
+		} else {
+			node, lo = 2*node+1, mid
+		}
+	}
+	s.tree[node] = val
+	for node >>= 1; node > 0; node >>= 1 {
+		m.Op(&s.tree[node], &s.tree[2*node], &s.tree[2*node+1])
+	}
+	return nil
+}
+
+// Description: Places a write lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Set] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (s *SyncedSegmentTree[T, U]) Set(idx int, val T) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.SegmentTree.Set(idx, val)
+}
+
+// Description: Returns an iterator that iterates over the values in the
+// segment tree in index order.
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) Vals() iter.Iter[T] {
+	return iter.SequentialElems[T](s.length, s.Get)
+}
+
+// Description: Modifies the iterator chain returned by the underlying
+// [SegmentTree.Vals] method such that a read lock will be placed on the
+// underlying segment tree when the iterator is consumed. The segment tree will
+// have a read lock the entire time the iteration is being performed. The lock
+// will not be applied until the iterator chain starts to be consumed.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) Vals() iter.Iter[T] {
+	return s.SegmentTree.Vals().SetupTeardown(
+		func() error { s.RLock(); return nil },
+		func() error { s.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the segment tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SegmentTree.Vals].
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) ValsSeq() stditer.Seq[T] {
+	return s.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the segment tree that can
+// be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedSegmentTree.Vals], so the segment tree will have
+// a read lock the entire time the loop is running. The lock is released once
+// the loop ends, even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) ValsSeq() stditer.Seq[T] {
+	return s.Vals().Seq()
+}
+
+// Description: Sets every value in the segment tree to the identity value
+// provided by the U monoid and discards all pending updates. The length of the
+// segment tree is not changed.
+//
+// Time Complexity: O(n)
+func (s *SegmentTree[T, U]) Clear() {
+	var m U
+	for i := 0; i < len(s.tree); i++ {
+		m.Zero(&s.tree[i])
+		s.tree[i] = m.ZeroVal()
+	}
+	for i := 0; i < len(s.lazy); i++ {
+		m.Zero(&s.lazy[i])
+		s.pending[i] = false
+	}
+}
+
+// Description: Places a write lock on the underlying segment tree and then
+// calls the underlying segment trees [SegmentTree.Clear] method.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (s *SyncedSegmentTree[T, U]) Clear() {
+	s.Lock()
+	defer s.Unlock()
+	s.SegmentTree.Clear()
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Two segment trees are equal if they have the same length and the
+// values at each index are equal. Returns true if l==r, false otherwise.
+//
+// Time Complexity: O(n*log(n))
+func (_ *SegmentTree[T, U]) Eq(
+	l *SegmentTree[T, U],
+	r *SegmentTree[T, U],
+) bool {
+	if l.length != r.length {
+		return false
+	}
+	var m U
+	for i := 0; i < l.length; i++ {
+		lv, _ := l.Get(i)
+		rv, _ := r.Get(i)
+		if !m.Eq(&lv, &rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Places a read lock on l and r and then calls the underlying
+// segment trees [SegmentTree.Eq] method. Returns true if l==r, false
+// otherwise.
+func (_ *SyncedSegmentTree[T, U]) Eq(
+	l *SyncedSegmentTree[T, U],
+	r *SyncedSegmentTree[T, U],
+) bool {
+	l.RLock()
+	r.RLock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	return l.SegmentTree.Eq(&l.SegmentTree, &r.SegmentTree)
+}
+
+// A function that returns a hash of a segment tree. The hash is created from
+// the values in the segment tree in index order, making it so the hash will
+// represent the same equality operation that [SegmentTree.Eq] provides.
+func (_ *SegmentTree[T, U]) Hash(other *SegmentTree[T, U]) hash.Hash {
+	var m U
+	rv := hash.Hash(other.length)
+	for i := 0; i < other.length; i++ {
+		v, _ := other.Get(i)
+		rv = rv.Combine(m.Hash(&v))
+	}
+	return rv
+}
+
+// Places a read lock on the underlying segment tree of other and then calls
+// others underlying segment trees [SegmentTree.Hash] method.
+func (_ *SyncedSegmentTree[T, U]) Hash(
+	other *SyncedSegmentTree[T, U],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	return other.SegmentTree.Hash(&other.SegmentTree)
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SegmentTree.Clear].
+func (_ *SegmentTree[T, U]) Zero(other *SegmentTree[T, U]) {
+	other.Clear()
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SyncedSegmentTree.Clear].
+func (_ *SyncedSegmentTree[T, U]) Zero(other *SyncedSegmentTree[T, U]) {
+	other.Clear()
+}
+
+func (s *SegmentTree[T, U]) toSerialized() []T {
+	rv, _ := s.Vals().Collect()
+	if rv == nil {
+		rv = []T{}
+	}
+	return rv
+}
+
+func (s *SegmentTree[T, U]) fromSerialized(vals []T) error {
+	*s = SegmentTreeValInit[T, U](vals...)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the segment tree. The segment tree
+// is encoded as a list of its values in index order with all pending updates
+// applied, not as its internal representation.
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toSerialized())
+}
+
+// Description: Places a read lock on the underlying segment tree and then calls
+// the underlying segment trees [SegmentTree.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) MarshalJSON() ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.SegmentTree.MarshalJSON()
+}
+
+// Description: Replaces the contents of the segment tree with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [SegmentTree.MarshalJSON]. If an error is returned the segment tree is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return s.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying segment tree while its contents are replaced. Exhibits the
+// same behavior as [SegmentTree.UnmarshalJSON]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return s.SegmentTree.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the segment tree. The
+// values are laid out the same way as [SegmentTree.MarshalJSON] and are encoded
+// using the [encoding/gob] package, so the contained values must be encodable
+// by gob.
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(s.toSerialized())
+}
+
+// Description: Places a read lock on the underlying segment tree and then calls
+// the underlying segment trees [SegmentTree.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) MarshalBinary() ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.SegmentTree.MarshalBinary()
+}
+
+// Description: Replaces the contents of the segment tree with the values
+// decoded from the supplied binary data, which must have been produced by
+// [SegmentTree.MarshalBinary]. If an error is returned the segment tree is left
+// unchanged.
+//
+// Time Complexity: O(n*log(n))
+func (s *SegmentTree[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return s.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock on
+// the underlying segment tree while its contents are replaced. Exhibits the
+// same behavior as [SegmentTree.UnmarshalBinary]. The lock is not held while
+// decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (s *SyncedSegmentTree[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return s.SegmentTree.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface. The values in the segment tree are
+// printed in index order with all pending updates applied.
+func (s SegmentTree[T, U]) Format(state fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb)})
+	state.Write([]byte("segmentTree["))
+	for i := 0; i < s.length; i++ {
+		v, _ := s.Get(i)
+		fmt.Fprintf(state, fmtStr, v)
+		if i+1 < s.length {
+			state.Write([]byte{' '})
+		}
+	}
+	state.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (s *SegmentTree[T, U]) String() string {
+	return fmt.Sprintf("%v", s)
+}
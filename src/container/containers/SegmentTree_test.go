@@ -0,0 +1,305 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// Reduces with max and updates by adding to every value in a range.
+	segmentTreeMaxAddMonoid struct{ widgets.BuiltinInt }
+
+	// Reduces with string concatenation, which is not commutative, and updates
+	// by assigning to every value in a range.
+	segmentTreeConcatAssignMonoid struct{ widgets.BuiltinString }
+)
+
+const segmentTreeMaxIdentity = -1 << 62
+
+func (_ segmentTreeMaxAddMonoid) ZeroVal() int { return segmentTreeMaxIdentity }
+func (_ segmentTreeMaxAddMonoid) Op(res *int, l *int, r *int) {
+	*res = max(*l, *r)
+}
+func (_ segmentTreeMaxAddMonoid) Apply(agg *int, update *int, n int) {
+	*agg += *update
+}
+func (_ segmentTreeMaxAddMonoid) Compose(res *int, first *int, second *int) {
+	*res = *first + *second
+}
+
+func (_ segmentTreeConcatAssignMonoid) ZeroVal() string { return "" }
+func (_ segmentTreeConcatAssignMonoid) Op(res *string, l *string, r *string) {
+	*res = *l + *r
+}
+func (_ segmentTreeConcatAssignMonoid) Apply(agg *string, update *string, n int) {
+	*agg = strings.Repeat(*update, n)
+}
+func (_ segmentTreeConcatAssignMonoid) Compose(
+	res *string,
+	first *string,
+	second *string,
+) {
+	*res = *second
+}
+
+func TestSegmentTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SegmentTree[int, SumMonoid[int, widgets.BuiltinInt]]]
+	v, _ := NewSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]](5)
+	widget = &v
+	_ = widget
+}
+
+func TestSyncedSegmentTreeWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SyncedSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]]]
+	v, _ := NewSyncedSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]](5)
+	widget = &v
+	_ = widget
+}
+
+func TestSegmentTreeMonoidInterface(t *testing.T) {
+	var m SegmentTreeMonoid[int] = SumMonoid[int, widgets.BuiltinInt]{}
+	m = segmentTreeMaxAddMonoid{}
+	_ = m
+	var s SegmentTreeMonoid[string] = segmentTreeConcatAssignMonoid{}
+	_ = s
+}
+
+func TestSegmentTreeNewErrors(t *testing.T) {
+	_, err := NewSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewSyncedSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]](-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	s, err := NewSegmentTree[int, segmentTreeMaxAddMonoid](0)
+	test.Nil(err, t)
+	test.Eq(0, s.Length(), t)
+	res, err := s.Query(0, 0)
+	test.Nil(err, t)
+	test.Eq(segmentTreeMaxIdentity, res, t)
+	test.Nil(s.Update(0, 0, 1), t)
+}
+
+func TestSegmentTreeSumMonoidApply(t *testing.T) {
+	m := SumMonoid[int, widgets.BuiltinInt]{}
+	for n := 1; n < 100; n++ {
+		agg := 7
+		update := -3
+		m.Apply(&agg, &update, n)
+		test.Eq(7-3*n, agg, t)
+	}
+}
+
+func TestSegmentTreeIndexErrors(t *testing.T) {
+	s := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](1, 2, 3)
+	_, err := s.Get(3)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = s.Get(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = s.Set(3, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = s.Query(-1, 2)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = s.Query(0, 4)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = s.Query(2, 1)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	err = s.Update(-1, 2, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = s.Update(0, 4, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	err = s.Update(2, 1, 1)
+	test.ContainsError(customerr.InvalidValue, err, t)
+	vals, _ := s.Vals().Collect()
+	test.SlicesMatch[int]([]int{1, 2, 3}, vals, t)
+}
+
+func TestSegmentTreeSumRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{1, 2, 5, 64, 100} {
+		exp := make([]int, n)
+		for i := range exp {
+			exp[i] = r.Intn(100)
+		}
+		s := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](
+			append([]int{}, exp...)...,
+		)
+		for op := 0; op < 1000; op++ {
+			start := r.Intn(n + 1)
+			end := start + r.Intn(n-start+1)
+			val := r.Intn(200) - 100
+			switch r.Intn(4) {
+			case 0:
+				for i := start; i < end; i++ {
+					exp[i] += val
+				}
+				test.Nil(s.Update(start, end, val), t)
+			case 1:
+				if start < n {
+					exp[start] = val
+					test.Nil(s.Set(start, val), t)
+				}
+			case 2:
+				if start < n {
+					res, err := s.Get(start)
+					test.Nil(err, t)
+					test.Eq(exp[start], res, t)
+				}
+			case 3:
+				sum := 0
+				for i := start; i < end; i++ {
+					sum += exp[i]
+				}
+				res, err := s.Query(start, end)
+				test.Nil(err, t)
+				test.Eq(sum, res, t)
+			}
+		}
+		vals, _ := s.Vals().Collect()
+		test.SlicesMatch[int](exp, vals, t)
+	}
+}
+
+func TestSegmentTreeMaxAddRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 3, 33, 100} {
+		exp := make([]int, n)
+		s, _ := NewSegmentTree[int, segmentTreeMaxAddMonoid](n)
+		for i := 0; i < n; i++ {
+			s.Set(i, 0)
+		}
+		for op := 0; op < 1000; op++ {
+			start := r.Intn(n)
+			end := start + 1 + r.Intn(n-start)
+			if r.Intn(2) == 0 {
+				val := r.Intn(200) - 100
+				for i := start; i < end; i++ {
+					exp[i] += val
+				}
+				test.Nil(s.Update(start, end, val), t)
+			} else {
+				m := exp[start]
+				for i := start; i < end; i++ {
+					m = max(m, exp[i])
+				}
+				res, err := s.Query(start, end)
+				test.Nil(err, t)
+				test.Eq(m, res, t)
+			}
+		}
+	}
+}
+
+func TestSegmentTreeConcatAssignRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	letters := []string{"a", "b", "c", "d", "ef"}
+	for _, n := range []int{1, 6, 17, 50} {
+		exp := make([]string, n)
+		for i := range exp {
+			exp[i] = letters[r.Intn(len(letters))]
+		}
+		s := SegmentTreeValInit[string, segmentTreeConcatAssignMonoid](
+			append([]string{}, exp...)...,
+		)
+		for op := 0; op < 1000; op++ {
+			start := r.Intn(n + 1)
+			end := start + r.Intn(n-start+1)
+			switch r.Intn(3) {
+			case 0:
+				val := letters[r.Intn(len(letters))]
+				for i := start; i < end; i++ {
+					exp[i] = val
+				}
+				test.Nil(s.Update(start, end, val), t)
+			case 1:
+				if start < n {
+					val := letters[r.Intn(len(letters))]
+					exp[start] = val
+					test.Nil(s.Set(start, val), t)
+				}
+			case 2:
+				res, err := s.Query(start, end)
+				test.Nil(err, t)
+				test.Eq(strings.Join(exp[start:end], ""), res, t)
+			}
+		}
+	}
+}
+
+func TestSegmentTreeClear(t *testing.T) {
+	s := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](1, 2, 3)
+	s.Update(0, 3, 5)
+	s.Clear()
+	test.Eq(3, s.Length(), t)
+	res, _ := s.Query(0, 3)
+	test.Eq(0, res, t)
+	s.Update(1, 2, 4)
+	res, _ = s.Get(1)
+	test.Eq(4, res, t)
+}
+
+func TestSegmentTreeEqHashFormat(t *testing.T) {
+	s := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](1, 2, 3)
+	other := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](0, 1, 2)
+	test.False(s.Eq(&s, &other), t)
+	other.Update(0, 3, 1)
+	test.True(s.Eq(&s, &other), t)
+	test.Eq(s.Hash(&s), other.Hash(&other), t)
+	shorter := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](1, 2)
+	test.False(s.Eq(&s, &shorter), t)
+	test.Eq("segmentTree[1 2 3]", other.String(), t)
+	test.Eq("segmentTree[1 2 3]", fmt.Sprint(&other), t)
+	s.Zero(&s)
+	test.Eq("segmentTree[0 0 0]", s.String(), t)
+}
+
+func TestSyncedSegmentTreeConcurrent(t *testing.T) {
+	s, _ := NewSyncedSegmentTree[int, SumMonoid[int, widgets.BuiltinInt]](100)
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				s.Update(0, 100, 1)
+				s.Query(i%100, 100)
+				s.Get(i % 100)
+			}
+		}()
+	}
+	wg.Wait()
+	res, err := s.Query(0, 100)
+	test.Nil(err, t)
+	test.Eq(200000, res, t)
+}
+
+func TestSegmentTreeSerialization(t *testing.T) {
+	s := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](5, -2, 7, 0, 9)
+	s.Update(1, 4, 2)
+	data, err := json.Marshal(&s)
+	test.Nil(err, t)
+	test.Eq(`[5,0,9,2,9]`, string(data), t)
+	for _, binary := range []bool{false, true} {
+		if binary {
+			data, err = s.MarshalBinary()
+			test.Nil(err, t)
+		}
+		res := SegmentTreeValInit[int, SumMonoid[int, widgets.BuiltinInt]](1)
+		if binary {
+			test.Nil(res.UnmarshalBinary(data), t)
+		} else {
+			test.Nil(json.Unmarshal(data, &res), t)
+		}
+		test.True(s.Eq(&s, &res), t)
+		sum, _ := res.Query(1, 4)
+		test.Eq(11, sum, t)
+	}
+}
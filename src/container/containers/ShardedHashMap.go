@@ -0,0 +1,654 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a map that dynamically grows as key value pairs are
+	// added. The map is split into a fixed number of shards, each of which is
+	// a hash map with its own RWMutex. The shard a key value pair is placed in
+	// is determined by the hash of the key, so operations on keys in different
+	// shards will not contend for the same lock. This makes a sharded hash map
+	// better suited than a [SyncedHashMap] for workloads where many threads
+	// operate on the map at once. Operations that act on a single key only lock
+	// the shard that the key belongs to. Operations that act on the entire map
+	// lock all of the shards, in shard order. The type constraints on the
+	// generics define the logic for how value specific operations, such as
+	// equality comparisons, will be handled.
+	ShardedHashMap[
+		K any,
+		V any,
+		KI widgets.BaseInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		shards []SyncedHashMap[K, V, KI, VI]
+	}
+)
+
+func shardIndex(h hash.Hash, numShards int) int {
+	// The hash is mixed before selecting a shard so that widgets with poorly
+	// distributed hashes, such as sequential integers, still spread values
+	// across all of the shards.
+	return int(((uint64(h) * 0x9e3779b97f4a7c15) >> 32) % uint64(numShards))
+}
+
+// Returns an iterator that iterates over the iterators returned by shardIter,
+// one shard at a time.
+func shardedIter[T any](
+	numShards int,
+	shardIter func(i int) iter.Iter[T],
+) iter.Iter[T] {
+	i := 0
+	var cur iter.Iter[T]
+	return func(f iter.IteratorFeedback) (T, error, bool) {
+		var tmp T
+		if f == iter.Break {
+			if cur != nil {
+				return tmp, cur.Stop(), false
+			}
+			return tmp, nil, false
+		}
+		for ; i < numShards; i++ {
+			if cur == nil {
+				cur = shardIter(i)
+			}
+			v, err, cont := cur(iter.Continue)
+			if err != nil {
+				return tmp, err, false
+			} else if cont {
+				return v, nil, true
+			}
+			// The shard iterator is stopped so that it releases any resources
+			// before the next shard is iterated over.
+			if err := cur.Stop(); err != nil {
+				return tmp, err, false
+			}
+			cur = nil
+		}
+		return tmp, nil, false
+	}
+}
+
+// Creates a new sharded map initialized with enough memory to hold size
+// elements spread across numShards shards. Size must be >= 0 and numShards
+// must be > 0, an error will be returned if they are not. If size is 0 the map
+// will be initialized with 0 elements. The underlying RWMutex value of each
+// shard will be fully unlocked upon initialization.
+func NewShardedHashMap[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](size int, numShards int) (ShardedHashMap[K, V, KI, VI], error) {
+	if size < 0 {
+		return ShardedHashMap[K, V, KI, VI]{}, getSizeError(size)
+	}
+	if numShards <= 0 {
+		return ShardedHashMap[K, V, KI, VI]{}, getNumShardsError(numShards)
+	}
+	rv := ShardedHashMap[K, V, KI, VI]{
+		shards: make([]SyncedHashMap[K, V, KI, VI], numShards),
+	}
+	for i := 0; i < numShards; i++ {
+		rv.shards[i], _ = NewSyncedHashMap[K, V, KI, VI](
+			(size + numShards - 1) / numShards,
+		)
+	}
+	return rv, nil
+}
+
+// Creates a new sharded hash map with numShards shards and populates it with
+// the supplied values. If there are duplicated keys in the supplied slice the
+// last key-value pair will be what is in the returned map. NumShards must be
+// > 0, an error will be returned if it is not.
+func ShardedHashMapValInit[
+	K any,
+	V any,
+	KI widgets.BaseInterface[K],
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V], numShards int) (ShardedHashMap[K, V, KI, VI], error) {
+	rv, err := NewShardedHashMap[K, V, KI, VI](len(vals), numShards)
+	if err != nil {
+		return rv, err
+	}
+	for _, v := range vals {
+		rv.Emplace(v)
+	}
+	return rv, nil
+}
+
+func (m *ShardedHashMap[K, V, KI, VI]) shard(k *K) *SyncedHashMap[K, V, KI, VI] {
+	w := widgets.Base[K, KI]{}
+	return &m.shards[shardIndex(w.Hash(k), len(m.shards))]
+}
+
+// Places a write lock on every shard in the map, in shard order. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ShardedHashMap[K, V, KI, VI]) Lock() {
+	for i := 0; i < len(m.shards); i++ {
+		m.shards[i].Lock()
+	}
+}
+
+// Removes the write lock from every shard in the map. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ShardedHashMap[K, V, KI, VI]) Unlock() {
+	for i := len(m.shards) - 1; i >= 0; i-- {
+		m.shards[i].Unlock()
+	}
+}
+
+// Places a read lock on every shard in the map, in shard order. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ShardedHashMap[K, V, KI, VI]) RLock() {
+	for i := 0; i < len(m.shards); i++ {
+		m.shards[i].RLock()
+	}
+}
+
+// Removes the read lock from every shard in the map. Needed for the
+// [containerTypes.Comparisons] interface.
+func (m *ShardedHashMap[K, V, KI, VI]) RUnlock() {
+	for i := len(m.shards) - 1; i >= 0; i-- {
+		m.shards[i].RUnlock()
+	}
+}
+
+// Returns false, maps are not addressable.
+func (m *ShardedHashMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns true, a sharded map is synced.
+func (m *ShardedHashMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the number of shards in the map.
+//
+// Time Complexity: O(1)
+func (m *ShardedHashMap[K, V, KI, VI]) NumShards() int {
+	return len(m.shards)
+}
+
+// Description: Places a read lock on all shards and then returns the number of
+// elements in the map.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(s), where s is the number of shards
+func (m *ShardedHashMap[K, V, KI, VI]) Length() int {
+	m.RLock()
+	defer m.RUnlock()
+	return m.lengthImpl()
+}
+
+func (m *ShardedHashMap[K, V, KI, VI]) lengthImpl() int {
+	rv := 0
+	for i := 0; i < len(m.shards); i++ {
+		rv += m.shards[i].HashMap.Length()
+	}
+	return rv
+}
+
+// Description: Contains will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the map was initialized with.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n) (linear search)
+func (m *ShardedHashMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the map was initialized with.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n) (linear search)
+func (m *ShardedHashMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	m.RLock()
+	defer m.RUnlock()
+	for i := 0; i < len(m.shards); i++ {
+		if m.shards[i].HashMap.ContainsPntr(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Description: Places a read lock on the shard the key belongs to and then
+// gets the value at the specified key. Returns a [containerTypes.KeyError] if
+// the key is not found in the map.
+//
+// Lock Type: Read on one shard
+//
+// Time Complexity: O(1)
+func (m *ShardedHashMap[K, V, KI, VI]) Get(k K) (V, error) {
+	s := m.shard(&k)
+	s.RLock()
+	defer s.RUnlock()
+	if h, ok := s.getHashPosition(&k); ok {
+		return s.internalHashMapImpl[h].B, nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Panics, sharded hash maps are not addressable.
+func (m *ShardedHashMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("sharded hash map"))
+}
+
+// Description: KeyOf will return the key of the first occurrence of the
+// supplied value in the map. If the value is not found then the returned
+// key will be a zero initialized key value and the boolean flag will be set to
+// false. If the value is found then the boolean flag will be set to true. All
+// equality comparisons are performed by the generic VI widget type that the map
+// was initialized with.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n) (linear search)
+func (m *ShardedHashMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	return m.KeyOfPntr(&v)
+}
+
+// Description: KeyOfPntr will return the key of the first occurrence of the
+// supplied value in the map. If the value is not found then the returned
+// key will be a zero initialized key value and the boolean flag will be set to
+// false. If the value is found then the boolean flag will be set to true. All
+// equality comparisons are performed by the generic VI widget type that the map
+// was initialized with.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n) (linear search)
+func (m *ShardedHashMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var tmp K
+	for i := 0; i < len(m.shards); i++ {
+		if m.shards[i].HashMap.keyOfImpl(&tmp, v) {
+			return tmp, true
+		}
+	}
+	return tmp, false
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the map. Stops setting values as soon as an error is
+// encountered. Each key value pair is set while holding a write lock on the
+// shard the key belongs to, meaning that setting multiple values is not
+// atomic.
+//
+// Lock Type: Write on one shard per key value pair
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *ShardedHashMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	for i := 0; i < len(kvPairs); i++ {
+		s := m.shard(&kvPairs[i].A)
+		s.Lock()
+		err := s.HashMap.setImpl(kvPairs[i : i+1])
+		s.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the map if they do
+// not exist and will set they keys value if it already exists in the map. The
+// values will be inserted in the order that they are given. Each key value
+// pair is inserted while holding a write lock on the shard the key belongs to,
+// meaning that inserting multiple values is not atomic.
+//
+// Lock Type: Write on one shard per key value pair
+//
+// Time Complexity: O(m), where m=len(vals)
+func (m *ShardedHashMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	for i := 0; i < len(vals); i++ {
+		s := m.shard(&vals[i].A)
+		s.Lock()
+		s.HashMap.Emplace(vals[i])
+		s.Unlock()
+	}
+	return nil
+}
+
+// Description: Pop will remove all occurrences of val in the map. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) Pop(v V) int {
+	return m.PopPntr(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the map. All
+// equality comparisons are performed by the generic VI widget type that the map
+// was initialized with.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.Lock()
+	defer m.Unlock()
+	rv := 0
+	for i := 0; i < len(m.shards); i++ {
+		rv += m.shards[i].HashMap.popImpl(v)
+	}
+	return rv
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the map.
+//
+// Lock Type: Write on one shard
+//
+// Time Complexity: O(1)
+func (m *ShardedHashMap[K, V, KI, VI]) Delete(k K) error {
+	s := m.shard(&k)
+	s.Lock()
+	defer s.Unlock()
+	return s.HashMap.deleteImpl(&k)
+}
+
+// Description: Clears all values from the map.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	for i := 0; i < len(m.shards); i++ {
+		m.shards[i].HashMap.Clear()
+	}
+}
+
+// Description: Returns an iterator that iterates over the keys of the map. A
+// read lock will be placed on all shards when the iterator is consumed. The
+// map will have a read lock the entire time the iteration is being performed.
+// The lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return shardedIter[K](
+		len(m.shards),
+		func(i int) iter.Iter[K] { return m.shards[i].HashMap.Keys() },
+	).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the keys in the sharded hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [ShardedHashMap.Keys].
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the map. A
+// read lock will be placed on all shards when the iterator is consumed. The
+// map will have a read lock the entire time the iteration is being performed.
+// The lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return shardedIter[V](
+		len(m.shards),
+		func(i int) iter.Iter[V] { return m.shards[i].HashMap.Vals() },
+	).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the sharded hash map that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [ShardedHashMap.Vals].
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the sharded hash
+// map that can be used in a for-range loop. The sharded hash map will have a
+// read lock the entire time the loop is running. The lock is released once the
+// loop ends, even if the loop is exited early.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(shardedIter[basic.Pair[K, V]](
+		len(m.shards),
+		func(i int) iter.Iter[basic.Pair[K, V]] {
+			return m.shards[i].HashMap.pairs()
+		},
+	).SetupTeardown(
+		func() error { m.RLock(); return nil },
+		func() error { m.RUnlock(); return nil },
+	))
+}
+
+// Panics, a sharded hash map is not addressable.
+func (m *ShardedHashMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("sharded hash map"))
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise. Attempts to place a read lock on other but whether or not
+// that happens is implementation dependent.
+//
+// Lock Type: Read on all shards, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *ShardedHashMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.RLock()
+	other.RLock()
+	defer m.RUnlock()
+	defer other.RUnlock()
+	vw := widgets.Base[V, VI]{}
+	if m.lengthImpl() != other.Length() {
+		return false
+	}
+	for i := 0; i < len(m.shards); i++ {
+		for _, v := range m.shards[i].internalHashMapImpl {
+			otherV, err := addressableSafeGet[K, V](other, v.A)
+			if err != nil || !vw.Eq(&v.B, otherV) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [ShardedHashMap.KeyedEq].
+// Returns true if l==r, false otherwise.
+func (_ *ShardedHashMap[K, V, KI, VI]) Eq(
+	l *ShardedHashMap[K, V, KI, VI],
+	r *ShardedHashMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a sharded hash map. To do this all of the
+// individual hashes that are produced from the elements of the map are
+// combined in a way that maintains identity, making it so the hash will
+// represent the same equality operation that [ShardedHashMap.KeyedEq] and
+// [ShardedHashMap.Eq] provide. The hash does not depend on the number of
+// shards.
+func (_ *ShardedHashMap[K, V, KI, VI]) Hash(
+	other *ShardedHashMap[K, V, KI, VI],
+) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	var rv hash.Hash
+	for i := 0; i < len(other.shards); i++ {
+		s := &other.shards[i].HashMap
+		rv = rv.CombineUnordered(s.Hash(s))
+	}
+	return rv
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [ShardedHashMap.Clear].
+func (_ *ShardedHashMap[K, V, KI, VI]) Zero(other *ShardedHashMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+type serializedShardedHashMap[K any, V any] struct {
+	NumShards int                  `json:"numShards"`
+	Vals      []serializedKV[K, V] `json:"vals"`
+}
+
+func (m *ShardedHashMap[K, V, KI, VI]) toSerialized() serializedShardedHashMap[
+	K, V,
+] {
+	m.RLock()
+	defer m.RUnlock()
+	rv := serializedShardedHashMap[K, V]{
+		NumShards: len(m.shards),
+		Vals:      make([]serializedKV[K, V], 0, m.lengthImpl()),
+	}
+	for i := 0; i < len(m.shards); i++ {
+		rv.Vals = append(rv.Vals, m.shards[i].HashMap.toSerialized()...)
+	}
+	return rv
+}
+
+func (m *ShardedHashMap[K, V, KI, VI]) fromSerialized(
+	s serializedShardedHashMap[K, V],
+) error {
+	if s.NumShards <= 0 {
+		return getNumShardsError(s.NumShards)
+	}
+	if s.NumShards != len(m.shards) {
+		newMap, _ := NewShardedHashMap[K, V, KI, VI](len(s.Vals), s.NumShards)
+		m.shards = newMap.shards
+	}
+	m.Lock()
+	defer m.Unlock()
+	for i := 0; i < len(m.shards); i++ {
+		m.shards[i].HashMap.Clear()
+	}
+	for _, kv := range s.Vals {
+		m.shard(&kv.Key).HashMap.Emplace(basic.Pair[K, V]{A: kv.Key, B: kv.Val})
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the sharded hash map. The number of
+// shards is encoded along with the key value pairs so that decoding restores
+// the same sharding.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Replaces the contents of the sharded hash map with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [ShardedHashMap.MarshalJSON]. If the number of shards in the data differs
+// from the number of shards in the sharded hash map the shards are rebuilt,
+// which is not safe to do while other threads are using the sharded hash map.
+// If an error is returned the sharded hash map is left unchanged.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedShardedHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the sharded hash map. The
+// values are laid out the same way as [ShardedHashMap.MarshalJSON] and are
+// encoded using the [encoding/gob] package.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Replaces the contents of the sharded hash map with the values
+// decoded from the supplied binary data, which must have been produced by
+// [ShardedHashMap.MarshalBinary]. Exhibits the same behavior as
+// [ShardedHashMap.UnmarshalJSON] with regards to the number of shards. If an
+// error is returned the sharded hash map is left unchanged.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (m *ShardedHashMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedShardedHashMap[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m ShardedHashMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	m.RLock()
+	defer m.RUnlock()
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("shardedHashMap["))
+	cntr := 0
+	for i := 0; i < len(m.shards); i++ {
+		for _, v := range m.shards[i].internalHashMapImpl {
+			if cntr > 0 {
+				f.Write([]byte{' '})
+			}
+			fmt.Fprintf(f, fmtStr, v.A, v.B)
+			cntr++
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *ShardedHashMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
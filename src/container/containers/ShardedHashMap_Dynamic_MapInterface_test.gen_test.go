@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func ShardedHashMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateShardedHashMap(capacity)
+	var rv dynamicContainers.Map[int, int] = v
+	return rv
+}
+
+func TestShardedHashMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(ShardedHashMapToMapInterfaceFactory, t)
+}
+
+func TestShardedHashMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(ShardedHashMapToMapInterfaceFactory, t)
+}
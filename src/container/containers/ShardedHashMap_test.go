@@ -0,0 +1,153 @@
+package containers
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=ShardedHashMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateShardedHashMap -pntrFactory
+
+func generateShardedHashMap(capacity int) *ShardedHashMap[
+	int,
+	int,
+	badBuiltinInt,
+	widgets.BuiltinInt,
+] {
+	m, _ := NewShardedHashMap[int, int, badBuiltinInt, widgets.BuiltinInt](
+		capacity, 4,
+	)
+	return &m
+}
+
+func TestShardedHashMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[ShardedHashMap[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v, _ := NewShardedHashMap[string, string, widgets.BuiltinString, widgets.BuiltinString](0, 1)
+	widget = &v
+	_ = widget
+}
+
+func TestShardedHashMapNewErrors(t *testing.T) {
+	_, err := NewShardedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](-1, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewShardedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0, 0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = ShardedHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		[]basic.Pair[int, int]{}, -1,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestShardedHashMapDistributesKeys(t *testing.T) {
+	m, err := NewShardedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0, 8)
+	test.Nil(err, t)
+	test.Eq(8, m.NumShards(), t)
+	for i := 0; i < 800; i++ {
+		test.Nil(m.Emplace(basic.Pair[int, int]{A: i, B: i}), t)
+	}
+	test.Eq(800, m.Length(), t)
+	for i := 0; i < m.NumShards(); i++ {
+		test.True(m.shards[i].HashMap.Length() > 0, t)
+	}
+	for i := 0; i < 800; i++ {
+		v, err := m.Get(i)
+		test.Nil(err, t)
+		test.Eq(i, v, t)
+	}
+	_, err = m.Get(800)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestShardedHashMapEq(t *testing.T) {
+	vals := []basic.Pair[int, string]{{A: 1, B: "one"}, {A: 2, B: "two"}}
+	m1, _ := ShardedHashMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](vals, 1)
+	m2, _ := ShardedHashMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](vals, 7)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.True(m1.Eq(&m2, &m1), t)
+	test.Eq(m1.Hash(&m1), m1.Hash(&m2), t)
+	h := HashMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](vals)
+	test.Eq(h.Hash(&h), m1.Hash(&m2), t)
+	m2.Set(basic.Pair[int, string]{A: 2, B: "three"})
+	test.False(m1.Eq(&m1, &m2), t)
+	m1.Zero(&m1)
+	test.Eq(0, m1.Length(), t)
+}
+
+func TestShardedHashMapFormat(t *testing.T) {
+	m, _ := ShardedHashMapValInit[int, int, widgets.BuiltinInt, widgets.BuiltinInt](
+		[]basic.Pair[int, int]{{A: 1, B: 2}}, 4,
+	)
+	test.Eq("shardedHashMap[1:2]", fmt.Sprintf("%v", &m), t)
+	test.Eq("shardedHashMap[1:2]", m.String(), t)
+}
+
+func TestShardedHashMapConcurrentOps(t *testing.T) {
+	m, _ := NewShardedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](0, 8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for j := start; j < 1000; j += 8 {
+				m.Emplace(basic.Pair[int, int]{A: j, B: j})
+				m.Get(j)
+				m.Set(basic.Pair[int, int]{A: j, B: j + 1})
+				if j%3 == 0 {
+					m.Delete(j)
+				}
+				if j%100 == 0 {
+					m.Length()
+					m.Keys().Count()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.Eq(1000-334, m.Length(), t)
+	for i := 0; i < 1000; i++ {
+		v, err := m.Get(i)
+		if i%3 == 0 {
+			test.ContainsError(containerTypes.KeyError, err, t)
+		} else {
+			test.Nil(err, t)
+			test.Eq(i+1, v, t)
+		}
+	}
+}
+
+func benchmarkMapParallelHelper(b *testing.B, m interface {
+	Get(k int) (int, error)
+	Emplace(vals ...basic.Pair[int, int]) error
+}) {
+	for i := 0; i < 1024; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i, B: i})
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%10 == 0 {
+				m.Emplace(basic.Pair[int, int]{A: i % 1024, B: i})
+			} else {
+				m.Get(i % 1024)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncedHashMapParallel(b *testing.B) {
+	m, _ := NewSyncedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](1024)
+	benchmarkMapParallelHelper(b, &m)
+}
+
+func BenchmarkShardedHashMapParallel(b *testing.B) {
+	m, _ := NewShardedHashMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt](1024, 32)
+	benchmarkMapParallelHelper(b, &m)
+}
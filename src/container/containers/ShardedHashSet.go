@@ -0,0 +1,649 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a set that dynamically grows as elements are added.
+	// The set is split into a fixed number of shards, each of which is a hash
+	// set with its own RWMutex. The shard a value is placed in is determined by
+	// the hash of the value, so operations on values in different shards will
+	// not contend for the same lock. This makes a sharded hash set better
+	// suited than a [SyncedHashSet] for workloads where many threads operate on
+	// the set at once. Operations that act on a single value only lock the
+	// shard that the value belongs to. Operations that act on the entire set
+	// lock all of the shards, in shard order. The type constraints on the
+	// generics define the logic for how value specific operations, such as
+	// equality comparisons, will be handled.
+	ShardedHashSet[T any, U widgets.BaseInterface[T]] struct {
+		shards []SyncedHashSet[T, U]
+	}
+)
+
+// Creates a new sharded hash set initialized with enough memory to hold size
+// elements spread across numShards shards. Size must be >= 0 and numShards
+// must be > 0, an error will be returned if they are not. If size is 0 the
+// hash set will be initialized with 0 elements. The underlying RWMutex value of
+// each shard will be fully unlocked upon initialization.
+func NewShardedHashSet[
+	T any,
+	U widgets.BaseInterface[T],
+](size int, numShards int) (ShardedHashSet[T, U], error) {
+	if size < 0 {
+		return ShardedHashSet[T, U]{}, getSizeError(size)
+	}
+	if numShards <= 0 {
+		return ShardedHashSet[T, U]{}, getNumShardsError(numShards)
+	}
+	rv := ShardedHashSet[T, U]{shards: make([]SyncedHashSet[T, U], numShards)}
+	for i := 0; i < numShards; i++ {
+		rv.shards[i], _ = NewSyncedHashSet[T, U](
+			(size + numShards - 1) / numShards,
+		)
+	}
+	return rv, nil
+}
+
+// Creates a new sharded hash set with numShards shards and populates it with
+// the supplied values. NumShards must be > 0, an error will be returned if it
+// is not.
+func ShardedHashSetValInit[T any, U widgets.BaseInterface[T]](
+	vals []T,
+	numShards int,
+) (ShardedHashSet[T, U], error) {
+	rv, err := NewShardedHashSet[T, U](len(vals), numShards)
+	if err != nil {
+		return rv, err
+	}
+	rv.AppendUnique(vals...)
+	return rv, nil
+}
+
+func (h *ShardedHashSet[T, U]) shardIdx(v *T) int {
+	w := widgets.Base[T, U]{}
+	return shardIndex(w.Hash(v), len(h.shards))
+}
+
+func (h *ShardedHashSet[T, U]) shard(v *T) *SyncedHashSet[T, U] {
+	return &h.shards[h.shardIdx(v)]
+}
+
+// Places a write lock on every shard in the set, in shard order. Needed for the
+// [containerTypes.Comparisons] interface.
+func (h *ShardedHashSet[T, U]) Lock() {
+	for i := 0; i < len(h.shards); i++ {
+		h.shards[i].Lock()
+	}
+}
+
+// Removes the write lock from every shard in the set. Needed for the
+// [containerTypes.Comparisons] interface.
+func (h *ShardedHashSet[T, U]) Unlock() {
+	for i := len(h.shards) - 1; i >= 0; i-- {
+		h.shards[i].Unlock()
+	}
+}
+
+// Places a read lock on every shard in the set, in shard order. Needed for the
+// [containerTypes.Comparisons] interface.
+func (h *ShardedHashSet[T, U]) RLock() {
+	for i := 0; i < len(h.shards); i++ {
+		h.shards[i].RLock()
+	}
+}
+
+// Removes the read lock from every shard in the set. Needed for the
+// [containerTypes.Comparisons] interface.
+func (h *ShardedHashSet[T, U]) RUnlock() {
+	for i := len(h.shards) - 1; i >= 0; i-- {
+		h.shards[i].RUnlock()
+	}
+}
+
+// Returns false, sharded hash sets are not addressable.
+func (h *ShardedHashSet[T, U]) IsAddressable() bool { return false }
+
+// Returns true, a sharded hash set is synced.
+func (h *ShardedHashSet[T, U]) IsSynced() bool { return true }
+
+// Description: Returns the number of shards in the set.
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) NumShards() int {
+	return len(h.shards)
+}
+
+// Description: Places a read lock on all shards and then returns the number of
+// values in the set.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(s), where s is the number of shards
+func (h *ShardedHashSet[T, U]) Length() int {
+	h.RLock()
+	defer h.RUnlock()
+	return h.lengthImpl()
+}
+
+func (h *ShardedHashSet[T, U]) lengthImpl() int {
+	rv := 0
+	for i := 0; i < len(h.shards); i++ {
+		rv += h.shards[i].HashSet.Length()
+	}
+	return rv
+}
+
+// Description: Returns an iterator that iterates over the values in the set. A
+// read lock will be placed on all shards when the iterator is consumed. The
+// set will have a read lock the entire time the iteration is being performed.
+// The lock will not be applied until the iterator starts to be consumed.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) Vals() iter.Iter[T] {
+	return shardedIter[T](
+		len(h.shards),
+		func(i int) iter.Iter[T] { return h.shards[i].HashSet.Vals() },
+	).SetupTeardown(
+		func() error { h.RLock(); return nil },
+		func() error { h.RUnlock(); return nil },
+	)
+}
+
+// Description: Returns a sequence over the values in the sharded hash set that
+// can be used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [ShardedHashSet.Vals].
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) ValsSeq() stditer.Seq[T] {
+	return h.Vals().Seq()
+}
+
+// Panics, sharded hash sets are not addressable.
+func (h *ShardedHashSet[T, U]) ValPntrs() iter.Iter[*T] {
+	panic(getNonAddressablePanicText("sharded hash set"))
+}
+
+// Description: Places a read lock on the shard the value belongs to and then
+// populates the supplied value with the value that is in the set. Exhibits the
+// same behavior as the [HashSet.GetUnique] method.
+//
+// Lock Type: Read on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) GetUnique(v *T) error {
+	s := h.shard(v)
+	s.RLock()
+	defer s.RUnlock()
+	return s.HashSet.GetUnique(v)
+}
+
+// Description: Contains will return true if the supplied value is in the set,
+// false otherwise. All equality comparisons are performed by the generic U
+// widget type that the set was initialized with.
+//
+// Lock Type: Read on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) Contains(v T) bool {
+	return h.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// set, false otherwise. All equality comparisons are performed by the generic
+// U widget type that the set was initialized with.
+//
+// Lock Type: Read on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) ContainsPntr(v *T) bool {
+	s := h.shard(v)
+	s.RLock()
+	defer s.RUnlock()
+	return s.HashSet.ContainsPntr(v)
+}
+
+// Description: AppendUnique will append the supplied values to the set if they
+// are not already present in the set (unique). Non-unique values will not be
+// appended. Each value is appended while holding a write lock on the shard the
+// value belongs to, meaning that appending multiple values is not atomic. This
+// function will never return an error.
+//
+// Lock Type: Write on one shard per value
+//
+// Time Complexity: O(m), where m=len(vals)
+func (h *ShardedHashSet[T, U]) AppendUnique(vals ...T) error {
+	for i := 0; i < len(vals); i++ {
+		s := h.shard(&vals[i])
+		s.Lock()
+		s.HashSet.appendOp(&vals[i])
+		s.Unlock()
+	}
+	return nil
+}
+
+// Description: Places a write lock on the shard the value belongs to and then
+// updates the supplied value. Exhibits the same behavior as the
+// [HashSet.UpdateUnique] method. The hash of a value cannot change during an
+// update, so the updated value will always remain in the same shard.
+//
+// Lock Type: Write on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) UpdateUnique(
+	orig T,
+	updateOp func(orig *T),
+) error {
+	s := h.shard(&orig)
+	s.Lock()
+	defer s.Unlock()
+	return s.HashSet.updateOp(&orig, updateOp)
+}
+
+// Description: Pop will remove all occurrences of val in the set. All equality
+// comparisons are performed by the generic U widget type that the set was
+// initialized with.
+//
+// Lock Type: Write on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) Pop(v T) int {
+	return h.PopPntr(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the set. All
+// equality comparisons are performed by the generic U widget type that the set
+// was initialized with.
+//
+// Lock Type: Write on one shard
+//
+// Time Complexity: O(1)
+func (h *ShardedHashSet[T, U]) PopPntr(v *T) int {
+	s := h.shard(v)
+	s.Lock()
+	defer s.Unlock()
+	return s.HashSet.PopPntr(v)
+}
+
+// Description: Clears all values from the set.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) Clear() {
+	h.Lock()
+	defer h.Unlock()
+	for i := 0; i < len(h.shards); i++ {
+		h.shards[i].HashSet.Clear()
+	}
+}
+
+// Description: Returns true if the elements in h are all contained in other and
+// the elements of other are all contained in h, regardless of position. Returns
+// false otherwise. Attempts to place a read lock on other but whether or not
+// that happens is implementation dependent.
+//
+// Lock Type: Read on all shards, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on other. In big-O it might look something like this,
+// O(n*O(other.ContainsPntr))), where O(other.ContainsPntr) represents the time
+// complexity of the ContainsPntr method on other with m values.
+func (h *ShardedHashSet[T, U]) UnorderedEq(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	h.RLock()
+	other.RLock()
+	defer h.RUnlock()
+	defer other.RUnlock()
+	if h.lengthImpl() != other.Length() {
+		return false
+	}
+	return h.allInImpl(other)
+}
+
+func (h *ShardedHashSet[T, U]) allInImpl(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	for i := 0; i < len(h.shards); i++ {
+		for _, iterV := range h.shards[i].internalHashSetImpl {
+			if !other.ContainsPntr(&iterV) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (h *ShardedHashSet[T, U]) containsImpl(v *T) bool {
+	return h.shards[h.shardIdx(v)].HashSet.ContainsPntr(v)
+}
+
+func (h *ShardedHashSet[T, U]) replaceShards(
+	op func(newShards []HashSet[T, U]),
+) {
+	newShards := make([]HashSet[T, U], len(h.shards))
+	for i := 0; i < len(newShards); i++ {
+		newShards[i], _ = NewHashSet[T, U](0)
+	}
+	op(newShards)
+	for i := 0; i < len(h.shards); i++ {
+		h.shards[i].HashSet.Clear()
+		h.shards[i].HashSet = newShards[i]
+	}
+}
+
+// Description: Populates the set with the intersection of values from the l
+// and r containers. This set will be cleared before storing the result.
+// Attempts to place a read lock on l and r but whether or not that happens is
+// implementation dependent.
+//
+// Lock Type: Write on all shards, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on l and r. In big-O it might look something like
+// this, O(O(r.ContainsPntr)*O(l.ContainsPntr)), where O(r.ContainsPntr)
+// represents the time complexity of the containsPntr method on r and
+// O(l.ContainsPntr) represents the time complexity of the containsPntr method
+// on l.
+func (h *ShardedHashSet[T, U]) Intersection(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	h.Lock()
+	l.RLock()
+	r.RLock()
+	defer h.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	h.replaceShards(func(newShards []HashSet[T, U]) {
+		addressableSafeValIter[T](r).ForEach(
+			func(index int, val *T) (iter.IteratorFeedback, error) {
+				if l.ContainsPntr(val) {
+					newShards[h.shardIdx(val)].appendOp(val)
+				}
+				return iter.Continue, nil
+			},
+		)
+	})
+}
+
+// Description: Populates the set with the union of values from the l and r
+// containers. This set will be cleared before storing the result. Attempts to
+// place a read lock on l and r but whether or not that happens is
+// implementation dependent.
+//
+// Lock Type: Write on all shards, read on l and r
+//
+// Time Complexity: O(n+m), where n is the number of values in l and m is the
+// number of values in r.
+func (h *ShardedHashSet[T, U]) Union(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	h.Lock()
+	l.RLock()
+	r.RLock()
+	defer h.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	h.replaceShards(func(newShards []HashSet[T, U]) {
+		op := func(index int, val *T) (iter.IteratorFeedback, error) {
+			newShards[h.shardIdx(val)].appendOp(val)
+			return iter.Continue, nil
+		}
+		addressableSafeValIter[T](l).ForEach(op)
+		addressableSafeValIter[T](r).ForEach(op)
+	})
+}
+
+// Description: Populates the set with the result of taking the difference of r
+// from l. This set will be cleared before storing the result. Attempts to place
+// a read lock on l and r but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Write on all shards, read on l and r
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the ContainsPntr method on l and r. In big-O it might look something like
+// this, O(O(r.ContainsPntr)*O(l.ContainsPntr)), where O(r.ContainsPntr)
+// represents the time complexity of the containsPntr method on r and
+// O(l.ContainsPntr) represents the time complexity of the containsPntr method
+// on l.
+func (h *ShardedHashSet[T, U]) Difference(
+	l containerTypes.ComparisonsOtherConstraint[T],
+	r containerTypes.ComparisonsOtherConstraint[T],
+) {
+	h.Lock()
+	l.RLock()
+	r.RLock()
+	defer h.Unlock()
+	defer l.RUnlock()
+	defer r.RUnlock()
+	h.replaceShards(func(newShards []HashSet[T, U]) {
+		addressableSafeValIter[T](l).ForEach(
+			func(index int, val *T) (iter.IteratorFeedback, error) {
+				if !r.ContainsPntr(val) {
+					newShards[h.shardIdx(val)].appendOp(val)
+				}
+				return iter.Continue, nil
+			},
+		)
+	})
+}
+
+// Description: Returns true if this set is a superset to other. Attempts to
+// place a read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on all shards, read on other
+//
+// Time Complexity: O(m), where m is the number of values in other.
+func (h *ShardedHashSet[T, U]) IsSuperset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	h.RLock()
+	other.RLock()
+	defer h.RUnlock()
+	defer other.RUnlock()
+	rv := (h.lengthImpl() >= other.Length())
+	if !rv {
+		return false
+	}
+	addressableSafeValIter[T](other).ForEach(
+		func(index int, val *T) (iter.IteratorFeedback, error) {
+			if rv = h.containsImpl(val); !rv {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return rv
+}
+
+// Description: Returns true if this set is a subset to other. Attempts to
+// place a read lock on other but whether or not that happens is implementation
+// dependent.
+//
+// Lock Type: Read on all shards, read on other
+//
+// Time Complexity: Dependent on the ContainsPntr method of other. In big-O
+// terms it may look somwthing like this: O(n*O(other.ContainsPntr)), where n is
+// the number of elements in the current set and other.ContainsPntr represents
+// the time complexity of the containsPntr method on other.
+func (h *ShardedHashSet[T, U]) IsSubset(
+	other containerTypes.ComparisonsOtherConstraint[T],
+) bool {
+	h.RLock()
+	other.RLock()
+	defer h.RUnlock()
+	defer other.RUnlock()
+	if h.lengthImpl() > other.Length() {
+		return false
+	}
+	return h.allInImpl(other)
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [ShardedHashSet.UnorderedEq].
+// Returns true if l==r, false otherwise.
+func (_ *ShardedHashSet[T, U]) Eq(
+	l *ShardedHashSet[T, U],
+	r *ShardedHashSet[T, U],
+) bool {
+	return l.UnorderedEq(r)
+}
+
+// A function that returns a hash of a sharded hash set. To do this all of the
+// individual hashes that are produced from the elements of the set are
+// combined in a way that maintains identity, making it so the hash will
+// represent the same equality operation that [ShardedHashSet.UnorderedEq] and
+// [ShardedHashSet.Eq] provide. The hash does not depend on the number of
+// shards.
+func (_ *ShardedHashSet[T, U]) Hash(other *ShardedHashSet[T, U]) hash.Hash {
+	other.RLock()
+	defer other.RUnlock()
+	var rv hash.Hash
+	for i := 0; i < len(other.shards); i++ {
+		s := &other.shards[i].HashSet
+		rv = rv.CombineUnordered(s.Hash(s))
+	}
+	return rv
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [ShardedHashSet.Clear].
+func (_ *ShardedHashSet[T, U]) Zero(other *ShardedHashSet[T, U]) {
+	other.Clear()
+}
+
+type serializedShardedHashSet[T any] struct {
+	NumShards int `json:"numShards"`
+	Vals      []T `json:"vals"`
+}
+
+func (h *ShardedHashSet[T, U]) toSerialized() serializedShardedHashSet[T] {
+	h.RLock()
+	defer h.RUnlock()
+	rv := serializedShardedHashSet[T]{
+		NumShards: len(h.shards),
+		Vals:      make([]T, 0, h.lengthImpl()),
+	}
+	for i := 0; i < len(h.shards); i++ {
+		rv.Vals = append(rv.Vals, h.shards[i].HashSet.toSerialized()...)
+	}
+	return rv
+}
+
+func (h *ShardedHashSet[T, U]) fromSerialized(
+	s serializedShardedHashSet[T],
+) error {
+	if s.NumShards <= 0 {
+		return getNumShardsError(s.NumShards)
+	}
+	if s.NumShards != len(h.shards) {
+		newSet, _ := NewShardedHashSet[T, U](len(s.Vals), s.NumShards)
+		h.shards = newSet.shards
+	}
+	h.Lock()
+	defer h.Unlock()
+	for i := 0; i < len(h.shards); i++ {
+		h.shards[i].HashSet.Clear()
+	}
+	for i := range s.Vals {
+		h.shard(&s.Vals[i]).HashSet.AppendUnique(s.Vals[i])
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the sharded hash set. The number of
+// shards is encoded along with the values so that decoding restores the same
+// sharding.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.toSerialized())
+}
+
+// Description: Replaces the contents of the sharded hash set with the values
+// decoded from the supplied JSON data, which must be in the format produced by
+// [ShardedHashSet.MarshalJSON]. If the number of shards in the data differs
+// from the number of shards in the sharded hash set the shards are rebuilt,
+// which is not safe to do while other threads are using the sharded hash set.
+// If an error is returned the sharded hash set is left unchanged.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[serializedShardedHashSet[T]](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the sharded hash set. The
+// values are laid out the same way as [ShardedHashSet.MarshalJSON] and are
+// encoded using the [encoding/gob] package.
+//
+// Lock Type: Read on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(h.toSerialized())
+}
+
+// Description: Replaces the contents of the sharded hash set with the values
+// decoded from the supplied binary data, which must have been produced by
+// [ShardedHashSet.MarshalBinary]. Exhibits the same behavior as
+// [ShardedHashSet.UnmarshalJSON] with regards to the number of shards. If an
+// error is returned the sharded hash set is left unchanged.
+//
+// Lock Type: Write on all shards
+//
+// Time Complexity: O(n)
+func (h *ShardedHashSet[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[serializedShardedHashSet[T]](data)
+	if err != nil {
+		return err
+	}
+	return h.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (h ShardedHashSet[T, U]) Format(f fmt.State, verb rune) {
+	h.RLock()
+	defer h.RUnlock()
+	fmtStr := string([]byte{'%', byte(verb)})
+	f.Write([]byte("shardedHashSet["))
+	cntr := 0
+	for i := 0; i < len(h.shards); i++ {
+		for _, iterV := range h.shards[i].internalHashSetImpl {
+			if cntr > 0 {
+				f.Write([]byte{' '})
+			}
+			fmt.Fprintf(f, fmtStr, iterV)
+			cntr++
+		}
+	}
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (h *ShardedHashSet[T, U]) String() string {
+	return fmt.Sprintf("%v", h)
+}
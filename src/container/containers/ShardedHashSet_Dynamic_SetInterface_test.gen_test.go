@@ -0,0 +1,134 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func ShardedHashSetToSetInterfaceFactory(capacity int) dynamicContainers.Set[int] {
+	v := generateShardedHashSet(capacity)
+	var rv dynamicContainers.Set[int] = v
+	return rv
+}
+
+func TestShardedHashSet_DynSetInterfaceSyncableInterface(t *testing.T) {
+	tests.DynSetInterfaceSyncableInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceAddressableInterface(t *testing.T) {
+	tests.DynSetInterfaceAddressableInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceLengthInterface(t *testing.T) {
+	tests.DynSetInterfaceLengthInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceClearInterface(t *testing.T) {
+	tests.DynSetInterfaceClearInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceWriteUniqueOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceWriteUniqueOpsInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceReadOpsInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceDeleteOpsInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_ReadDynSetInterface(t *testing.T) {
+	tests.ReadDynSetInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_WriteDynSetInterface(t *testing.T) {
+	tests.WriteDynSetInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceInterface(t *testing.T) {
+	tests.DynSetInterfaceInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynSetInterfaceStaticCapacityInterface(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceVals(t *testing.T) {
+	tests.DynSetInterfaceVals(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceValPntrs(t *testing.T) {
+	tests.DynSetInterfaceValPntrs(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceContainsPntr(t *testing.T) {
+	tests.DynSetInterfaceContainsPntr(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceGetUnique(t *testing.T) {
+	tests.DynSetInterfaceGetUnique(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceContains(t *testing.T) {
+	tests.DynSetInterfaceContains(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceClear(t *testing.T) {
+	tests.DynSetInterfaceClear(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceAppendUnique(t *testing.T) {
+	tests.DynSetInterfaceAppendUnique(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceUpdateUnique(t *testing.T) {
+	tests.DynSetInterfaceUpdateUnique(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfacePop(t *testing.T) {
+	tests.DynSetInterfacePop(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfacePopPntr(t *testing.T) {
+	tests.DynSetInterfacePopPntr(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceUnorderedEq(t *testing.T) {
+	tests.DynSetInterfaceUnorderedEq(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceIntersection(t *testing.T) {
+	tests.DynSetInterfaceIntersection(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceUnion(t *testing.T) {
+	tests.DynSetInterfaceUnion(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceDifference(t *testing.T) {
+	tests.DynSetInterfaceDifference(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceIsSuperset(t *testing.T) {
+	tests.DynSetInterfaceIsSuperset(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceIsSubset(t *testing.T) {
+	tests.DynSetInterfaceIsSubset(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(ShardedHashSetToSetInterfaceFactory, t)
+}
+
+func TestShardedHashSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(ShardedHashSetToSetInterfaceFactory, t)
+}
@@ -0,0 +1,138 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=ShardedHashSet -category=dynamic -interface=Set -genericDecl=[int] -factory=generateShardedHashSet -pntrFactory
+
+func generateShardedHashSet(capacity int) *ShardedHashSet[int, badBuiltinInt] {
+	v, _ := NewShardedHashSet[int, badBuiltinInt](capacity, 4)
+	return &v
+}
+
+func TestShardedHashSetWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[ShardedHashSet[string, widgets.BuiltinString]]
+	v, _ := NewShardedHashSet[string, widgets.BuiltinString](0, 1)
+	widget = &v
+	_ = widget
+}
+
+func TestShardedHashSetNewErrors(t *testing.T) {
+	_, err := NewShardedHashSet[int, widgets.BuiltinInt](-1, 1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = NewShardedHashSet[int, widgets.BuiltinInt](0, 0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = ShardedHashSetValInit[int, widgets.BuiltinInt]([]int{}, 0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestShardedHashSetEquality(t *testing.T) {
+	s1, _ := ShardedHashSetValInit[int, widgets.BuiltinInt]([]int{0, 1, 2, 3, 4}, 3)
+	s2, _ := ShardedHashSetValInit[int, widgets.BuiltinInt]([]int{4, 3, 2, 1, 0}, 5)
+	test.True(s1.Eq(&s1, &s2), t)
+	test.Eq(s1.Hash(&s1), s1.Hash(&s2), t)
+	h := HashSetValInit[int, widgets.BuiltinInt](0, 1, 2, 3, 4)
+	test.Eq(h.Hash(&h), s1.Hash(&s1), t)
+	test.True(s1.UnorderedEq(&h), t)
+	s2.AppendUnique(5)
+	test.False(s1.Eq(&s1, &s2), t)
+	test.True(s1.IsSubset(&s2), t)
+	test.True(s2.IsSuperset(&s1), t)
+	s1.Zero(&s1)
+	test.Eq(0, s1.Length(), t)
+}
+
+func TestShardedHashSetFormat(t *testing.T) {
+	s, _ := ShardedHashSetValInit[int, widgets.BuiltinInt]([]int{1}, 2)
+	test.Eq("shardedHashSet[1]", fmt.Sprintf("%v", &s), t)
+	test.Eq("shardedHashSet[1]", s.String(), t)
+}
+
+func TestShardedHashSetConcurrentOps(t *testing.T) {
+	s, _ := NewShardedHashSet[int, widgets.BuiltinInt](0, 8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for j := start; j < 1000; j += 8 {
+				s.AppendUnique(j)
+				s.Contains(j)
+				if j%2 == 0 {
+					s.Pop(j)
+				}
+				if j%100 == 0 {
+					s.Vals().Count()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.Eq(500, s.Length(), t)
+	for i := 0; i < 1000; i++ {
+		test.Eq(i%2 == 1, s.Contains(i), t)
+	}
+}
+
+func benchmarkSetParallelHelper(b *testing.B, s interface {
+	Contains(v int) bool
+	AppendUnique(vals ...int) error
+}) {
+	for i := 0; i < 1024; i++ {
+		s.AppendUnique(i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%10 == 0 {
+				s.AppendUnique(i % 2048)
+			} else {
+				s.Contains(i % 2048)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncedHashSetParallel(b *testing.B) {
+	s, _ := NewSyncedHashSet[int, widgets.BuiltinInt](1024)
+	benchmarkSetParallelHelper(b, &s)
+}
+
+func BenchmarkShardedHashSetParallel(b *testing.B) {
+	s, _ := NewShardedHashSet[int, widgets.BuiltinInt](1024, 32)
+	benchmarkSetParallelHelper(b, &s)
+}
+
+func TestShardedHashSetSerializationNumShards(t *testing.T) {
+	h, _ := ShardedHashSetValInit[int, widgets.BuiltinInt](
+		[]int{1, 2, 3, 4, 5, 6, 7, 8}, 3,
+	)
+	data, err := json.Marshal(&h)
+	test.Nil(err, t)
+
+	var res ShardedHashSet[int, widgets.BuiltinInt]
+	test.Nil(json.Unmarshal(data, &res), t)
+	test.Eq(3, res.NumShards(), t)
+	test.True(h.UnorderedEq(&res), t)
+
+	res, _ = NewShardedHashSet[int, widgets.BuiltinInt](0, 5)
+	test.Nil(res.AppendUnique(100), t)
+	test.Nil(json.Unmarshal(data, &res), t)
+	test.Eq(3, res.NumShards(), t)
+	test.True(h.UnorderedEq(&res), t)
+
+	err = json.Unmarshal([]byte(`{"numShards":0,"vals":[1]}`), &res)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.Eq(3, res.NumShards(), t)
+	test.True(h.UnorderedEq(&res), t)
+}
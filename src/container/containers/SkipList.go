@@ -0,0 +1,317 @@
+package containers
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+const skipListMaxLevel = 32
+
+type (
+	skipListNode[K any, V any] struct {
+		key K
+		// Values are stored behind an atomic pointer so that they can be
+		// replaced while readers are traversing the list.
+		val  atomic.Pointer[V]
+		next []atomic.Pointer[skipListNode[K, V]]
+		// The number of level 0 links between this node and the node pointed
+		// to by next at the same level. Used to answer rank queries. Only
+		// accessed while holding the skip lists lock.
+		span []int
+	}
+
+	// A skip list that allows readers to traverse the list without acquiring
+	// any locks. Writers are serialized by the embedded RWMutex. Because the
+	// next pointers of a removed node are left untouched, a reader that is on
+	// a node while it is removed can still continue its traversal. The span
+	// values are only consistent while the RWMutex is held, so rank queries
+	// must acquire a read lock.
+	skipList[K any, V any, KI widgets.PartialOrderInterface[K]] struct {
+		sync.RWMutex
+		head   *skipListNode[K, V]
+		level  atomic.Int32
+		length atomic.Int64
+	}
+)
+
+func newSkipList[K any, V any, KI widgets.PartialOrderInterface[K]]() *skipList[
+	K, V, KI,
+] {
+	rv := &skipList[K, V, KI]{head: newSkipListNode[K, V](skipListMaxLevel)}
+	rv.level.Store(1)
+	return rv
+}
+
+func newSkipListNode[K any, V any](level int) *skipListNode[K, V] {
+	return &skipListNode[K, V]{
+		next: make([]atomic.Pointer[skipListNode[K, V]], level),
+		span: make([]int, level),
+	}
+}
+
+// Returns a random level in the range [1, skipListMaxLevel] where each level
+// is a quarter as likely as the level below it.
+func skipListRandomLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64())/2+1, skipListMaxLevel)
+}
+
+func (l *skipList[K, V, KI]) lt(a *K, b *K) bool {
+	w := widgets.PartialOrder[K, KI]{}
+	return w.Lt(a, b)
+}
+
+func (l *skipList[K, V, KI]) eq(a *K, b *K) bool {
+	w := widgets.PartialOrder[K, KI]{}
+	return w.Eq(a, b)
+}
+
+// Returns the last node with a key that is less than k, or the head node if
+// there is no such node. Safe to call without holding the lock.
+func (l *skipList[K, V, KI]) lastLt(k *K) *skipListNode[K, V] {
+	x := l.head
+	for i := int(l.level.Load()) - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil && l.lt(&nx.key, k); {
+			x = nx
+			nx = x.next[i].Load()
+		}
+	}
+	return x
+}
+
+// Returns the last node with a key that is less than or equal to k, or the
+// head node if there is no such node. Safe to call without holding the lock.
+func (l *skipList[K, V, KI]) lastLe(k *K) *skipListNode[K, V] {
+	x := l.head
+	for i := int(l.level.Load()) - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil && !l.lt(k, &nx.key); {
+			x = nx
+			nx = x.next[i].Load()
+		}
+	}
+	return x
+}
+
+// Returns the node with the supplied key, or nil if there is no such node.
+// Safe to call without holding the lock.
+func (l *skipList[K, V, KI]) find(k *K) *skipListNode[K, V] {
+	if n := l.lastLt(k).next[0].Load(); n != nil && l.eq(&n.key, k) {
+		return n
+	}
+	return nil
+}
+
+// Returns the node with the smallest key that is >= k, or nil if there is no
+// such node. Safe to call without holding the lock.
+func (l *skipList[K, V, KI]) ceiling(k *K) *skipListNode[K, V] {
+	return l.lastLt(k).next[0].Load()
+}
+
+// Returns the node with the largest key that is <= k, or nil if there is no
+// such node. Safe to call without holding the lock.
+func (l *skipList[K, V, KI]) floor(k *K) *skipListNode[K, V] {
+	if n := l.lastLe(k); n != l.head {
+		return n
+	}
+	return nil
+}
+
+// Returns the node with the smallest key, or nil if the list is empty. Safe
+// to call without holding the lock.
+func (l *skipList[K, V, KI]) first() *skipListNode[K, V] {
+	return l.head.next[0].Load()
+}
+
+// Returns the node with the largest key, or nil if the list is empty. Safe to
+// call without holding the lock.
+func (l *skipList[K, V, KI]) last() *skipListNode[K, V] {
+	x := l.head
+	for i := int(l.level.Load()) - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil; nx = x.next[i].Load() {
+			x = nx
+		}
+	}
+	if x == l.head {
+		return nil
+	}
+	return x
+}
+
+// Inserts the supplied key value pair into the list. If the key is already
+// present then the value will only be replaced if overwrite is true. Returns
+// true if a new node was added. The write lock must be held.
+func (l *skipList[K, V, KI]) insert(k *K, v *V, overwrite bool) bool {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	var rank [skipListMaxLevel]int
+	level := int(l.level.Load())
+	x := l.head
+	for i := level - 1; i >= 0; i-- {
+		if i < level-1 {
+			rank[i] = rank[i+1]
+		}
+		for nx := x.next[i].Load(); nx != nil && l.lt(&nx.key, k); {
+			rank[i] += x.span[i]
+			x = nx
+			nx = x.next[i].Load()
+		}
+		update[i] = x
+	}
+	if n := x.next[0].Load(); n != nil && l.eq(&n.key, k) {
+		if overwrite {
+			tmp := *v
+			n.val.Store(&tmp)
+		}
+		return false
+	}
+
+	newLevel := skipListRandomLevel()
+	length := int(l.length.Load())
+	for i := level; i < newLevel; i++ {
+		rank[i] = 0
+		update[i] = l.head
+		l.head.span[i] = length
+	}
+	n := newSkipListNode[K, V](newLevel)
+	n.key = *k
+	tmp := *v
+	n.val.Store(&tmp)
+	for i := 0; i < newLevel; i++ {
+		// The new node is fully linked at each level before it is published
+		// so readers never see a partially initialized node.
+		n.next[i].Store(update[i].next[i].Load())
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+		update[i].next[i].Store(n)
+	}
+	for i := newLevel; i < level; i++ {
+		update[i].span[i]++
+	}
+	if newLevel > level {
+		l.level.Store(int32(newLevel))
+	}
+	l.length.Add(1)
+	return true
+}
+
+// Removes the node with the supplied key from the list. Returns true if a
+// node was removed. The write lock must be held.
+func (l *skipList[K, V, KI]) remove(k *K) bool {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	level := int(l.level.Load())
+	x := l.head
+	for i := level - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil && l.lt(&nx.key, k); {
+			x = nx
+			nx = x.next[i].Load()
+		}
+		update[i] = x
+	}
+	n := x.next[0].Load()
+	if n == nil || !l.eq(&n.key, k) {
+		return false
+	}
+	for i := 0; i < level; i++ {
+		// The next pointers of the removed node are left as is so that any
+		// readers currently on the node can continue their traversal.
+		if update[i].next[i].Load() == n {
+			update[i].span[i] += n.span[i] - 1
+			update[i].next[i].Store(n.next[i].Load())
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for level > 1 && l.head.next[level-1].Load() == nil {
+		level--
+	}
+	l.level.Store(int32(level))
+	l.length.Add(-1)
+	return true
+}
+
+// Removes all nodes from the list. The write lock must be held.
+func (l *skipList[K, V, KI]) clear() {
+	for i := 0; i < skipListMaxLevel; i++ {
+		l.head.next[i].Store(nil)
+		l.head.span[i] = 0
+	}
+	l.level.Store(1)
+	l.length.Store(0)
+}
+
+// Returns the number of nodes with a key that is less than k. The read lock
+// must be held.
+func (l *skipList[K, V, KI]) rank(k *K) int {
+	rv := 0
+	x := l.head
+	for i := int(l.level.Load()) - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil && l.lt(&nx.key, k); {
+			rv += x.span[i]
+			x = nx
+			nx = x.next[i].Load()
+		}
+	}
+	return rv
+}
+
+// Returns the node at the supplied zero based index in key order, or nil if
+// idx is out of bounds. The read lock must be held.
+func (l *skipList[K, V, KI]) selectNode(idx int) *skipListNode[K, V] {
+	if idx < 0 || idx >= int(l.length.Load()) {
+		return nil
+	}
+	traversed := 0
+	x := l.head
+	for i := int(l.level.Load()) - 1; i >= 0; i-- {
+		for nx := x.next[i].Load(); nx != nil && traversed+x.span[i] <= idx+1; {
+			traversed += x.span[i]
+			x = nx
+			nx = x.next[i].Load()
+		}
+		if traversed == idx+1 {
+			return x
+		}
+	}
+	return nil
+}
+
+// Calls op on every node in key order until op returns false. Safe to call
+// without holding the lock.
+func (l *skipList[K, V, KI]) inOrder(op func(n *skipListNode[K, V]) bool) {
+	for n := l.first(); n != nil && op(n); n = n.next[0].Load() {
+	}
+}
+
+// Returns an iterator over the nodes with keys in the range [start, end). A
+// nil start or end leaves that side of the range unbounded. The list is
+// walked lazily and without acquiring any locks.
+func (l *skipList[K, V, KI]) nodes(
+	start *K,
+	end *K,
+) iter.Iter[*skipListNode[K, V]] {
+	initialized := false
+	var cur *skipListNode[K, V]
+	return func(f iter.IteratorFeedback) (*skipListNode[K, V], error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if !initialized {
+			if start != nil {
+				cur = l.ceiling(start)
+			} else {
+				cur = l.first()
+			}
+			initialized = true
+		} else if cur != nil {
+			cur = cur.next[0].Load()
+		}
+		if cur == nil || (end != nil && !l.lt(&cur.key, end)) {
+			cur = nil
+			return nil, nil, false
+		}
+		return cur, nil, true
+	}
+}
@@ -0,0 +1,634 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	stditer "iter"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A type to represent a map that dynamically grows as key value pairs are
+	// added. The map keeps its keys in sorted order and is internally
+	// implemented with a skip list. Unlike a [SyncedOrderedMap] the skip map
+	// does not need a lock to be read: lookups, floor/ceiling queries, and
+	// range scans traverse the skip list without acquiring any locks, so they
+	// never block and are never blocked by writers. Writes are serialized by
+	// an internal RWMutex, and rank queries place a read lock on it. The type
+	// constraints on the generics define the logic for how value specific
+	// operations, such as ordering and equality comparisons, will be handled.
+	// Copies of a skip map share the same underlying skip list, the same as
+	// the builtin map type.
+	//
+	// Because readers may still be looking at a key value pair after it is
+	// overwritten or removed, the skip map never zeros the keys and values
+	// that it removes.
+	SkipMap[
+		K any,
+		V any,
+		KI widgets.PartialOrderInterface[K],
+		VI widgets.BaseInterface[V],
+	] struct {
+		list *skipList[K, V, KI]
+	}
+)
+
+// Creates a new, empty, skip map. The underlying RWMutex value will be fully
+// unlocked upon initialization.
+func NewSkipMap[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+]() SkipMap[K, V, KI, VI] {
+	return SkipMap[K, V, KI, VI]{list: newSkipList[K, V, KI]()}
+}
+
+// Creates a new skip map and populates it with the supplied values. If there
+// are duplicated keys in the supplied slice the last key-value pair will be
+// what is in the returned map.
+func SkipMapValInit[
+	K any,
+	V any,
+	KI widgets.PartialOrderInterface[K],
+	VI widgets.BaseInterface[V],
+](vals []basic.Pair[K, V]) SkipMap[K, V, KI, VI] {
+	rv := NewSkipMap[K, V, KI, VI]()
+	rv.Emplace(vals...)
+	return rv
+}
+
+// Places a write lock on the skip map. While the lock is held no other
+// goroutine can modify the map, but lock free reads can still be performed.
+// Needed for the [containerTypes.RWSyncable] interface.
+func (m *SkipMap[K, V, KI, VI]) Lock() { m.list.Lock() }
+
+// Removes the write lock from the skip map. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *SkipMap[K, V, KI, VI]) Unlock() { m.list.Unlock() }
+
+// Places a read lock on the skip map. While the lock is held the map cannot be
+// modified. Needed for the [containerTypes.RWSyncable] interface.
+func (m *SkipMap[K, V, KI, VI]) RLock() { m.list.RLock() }
+
+// Removes the read lock from the skip map. Needed for the
+// [containerTypes.RWSyncable] interface.
+func (m *SkipMap[K, V, KI, VI]) RUnlock() { m.list.RUnlock() }
+
+// Returns false, skip maps are not addressable.
+func (m *SkipMap[K, V, KI, VI]) IsAddressable() bool { return false }
+
+// Returns true, a skip map is synced.
+func (m *SkipMap[K, V, KI, VI]) IsSynced() bool { return true }
+
+// Description: Returns the number of elements in the skip map. Does not
+// acquire a lock.
+//
+// Time Complexity: O(1)
+func (m *SkipMap[K, V, KI, VI]) Length() int {
+	return int(m.list.length.Load())
+}
+
+// Description: Contains will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the skip map was initialized with. Does not
+// acquire a lock.
+//
+// Time Complexity: O(n) (linear search)
+func (m *SkipMap[K, V, KI, VI]) Contains(v V) bool {
+	return m.ContainsPntr(&v)
+}
+
+// Description: ContainsPntr will return true if the supplied value is in the
+// map, false otherwise. All equality comparisons are performed by the
+// generic VI widget type that the skip map was initialized with. Does not
+// acquire a lock.
+//
+// Time Complexity: O(n) (linear search)
+func (m *SkipMap[K, V, KI, VI]) ContainsPntr(v *V) bool {
+	var tmp K
+	return m.keyOfImpl(&tmp, v)
+}
+
+// Description: Gets the value at the specified key. Returns a
+// [containerTypes.KeyError] if the key is not found in the skip map. Does not
+// acquire a lock.
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Get(k K) (V, error) {
+	if n := m.list.find(&k); n != nil {
+		return *n.val.Load(), nil
+	}
+	var tmp V
+	return tmp, getKeyError[K](&k)
+}
+
+// Panics, skip maps are not addressable.
+func (m *SkipMap[K, V, KI, VI]) GetPntr(k K) (*V, error) {
+	panic(getNonAddressablePanicText("skip map"))
+}
+
+// Description: KeyOf will return the smallest key that maps to the supplied
+// value. If the value is not found then the returned key will be a zero
+// initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with. Does not acquire a lock.
+//
+// Time Complexity: O(n) (linear search)
+func (m *SkipMap[K, V, KI, VI]) KeyOf(v V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, &v)
+}
+
+// Description: KeyOfPntr will return the smallest key that maps to the
+// supplied value. If the value is not found then the returned key will be a
+// zero initialized key value and the boolean flag will be set to false. If the
+// value is found then the boolean flag will be set to true. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with. Does not acquire a lock.
+//
+// Time Complexity: O(n) (linear search)
+func (m *SkipMap[K, V, KI, VI]) KeyOfPntr(v *V) (K, bool) {
+	var tmp K
+	return tmp, m.keyOfImpl(&tmp, v)
+}
+
+func (m *SkipMap[K, V, KI, VI]) keyOfImpl(k *K, v *V) bool {
+	found := false
+	w := widgets.Base[V, VI]{}
+	m.list.inOrder(func(n *skipListNode[K, V]) bool {
+		if w.Eq(v, n.val.Load()) {
+			*k = n.key
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Description: Sets the values at the specified keys. Returns an error if the
+// key is not in the map. Stops setting values as soon as an error is
+// encountered. Unlike an [OrderedMap] the keys themselves are not updated.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SkipMap[K, V, KI, VI]) Set(kvPairs ...basic.Pair[K, V]) error {
+	m.list.Lock()
+	defer m.list.Unlock()
+	for i := 0; i < len(kvPairs); i++ {
+		n := m.list.find(&kvPairs[i].A)
+		if n == nil {
+			return getKeyError[K](&kvPairs[i].A)
+		}
+		tmp := kvPairs[i].B
+		n.val.Store(&tmp)
+	}
+	return nil
+}
+
+// Description: Emplace will insert the supplied values into the skip map if
+// they do not exist and will set they keys value if it already exists in the
+// skip map. The values will be inserted in the order that they are given.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(m*log(n)), where m=len(vals)
+func (m *SkipMap[K, V, KI, VI]) Emplace(vals ...basic.Pair[K, V]) error {
+	m.list.Lock()
+	defer m.list.Unlock()
+	for i := 0; i < len(vals); i++ {
+		m.list.insert(&vals[i].A, &vals[i].B, true)
+	}
+	return nil
+}
+
+// Description: Pop will remove all occurrences of val in the map. All equality
+// comparisons are performed by the generic VI widget type that the map was
+// initialized with.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *SkipMap[K, V, KI, VI]) Pop(v V) int {
+	return m.PopPntr(&v)
+}
+
+// Description: PopPntr will remove all occurrences of val in the map. All
+// equality comparisons are performed by the generic VI widget type that the
+// map was initialized with.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n+m*log(n)), where m is the number of removed values
+func (m *SkipMap[K, V, KI, VI]) PopPntr(v *V) int {
+	m.list.Lock()
+	defer m.list.Unlock()
+	vw := widgets.Base[V, VI]{}
+	keys := []K{}
+	m.list.inOrder(func(n *skipListNode[K, V]) bool {
+		if vw.Eq(v, n.val.Load()) {
+			keys = append(keys, n.key)
+		}
+		return true
+	})
+	for i := 0; i < len(keys); i++ {
+		m.list.remove(&keys[i])
+	}
+	return len(keys)
+}
+
+// Description: Deletes the key value pair that has the specified key. Returns
+// an error if the key is not found in the skip map.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Delete(k K) error {
+	m.list.Lock()
+	defer m.list.Unlock()
+	if !m.list.remove(&k) {
+		return getKeyError[K](&k)
+	}
+	return nil
+}
+
+// Description: Clears all values from the skip map. Readers that are
+// traversing the map while it is cleared may still see the old values.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(1)
+func (m *SkipMap[K, V, KI, VI]) Clear() {
+	if m.list == nil {
+		m.list = newSkipList[K, V, KI]()
+		return
+	}
+	m.list.Lock()
+	defer m.list.Unlock()
+	m.list.clear()
+}
+
+func skipListNodeToPair[K any, V any](n *skipListNode[K, V]) basic.Pair[K, V] {
+	return basic.Pair[K, V]{A: n.key, B: *n.val.Load()}
+}
+
+// Description: Returns the key value pair with the smallest key in the skip
+// map. Returns a [containerTypes.Empty] error if the map is empty. Does not
+// acquire a lock.
+//
+// Time Complexity: O(1)
+func (m *SkipMap[K, V, KI, VI]) Min() (basic.Pair[K, V], error) {
+	if n := m.list.first(); n != nil {
+		return skipListNodeToPair(n), nil
+	}
+	return basic.Pair[K, V]{}, getEmptyError()
+}
+
+// Description: Returns the key value pair with the largest key in the skip
+// map. Returns a [containerTypes.Empty] error if the map is empty. Does not
+// acquire a lock.
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Max() (basic.Pair[K, V], error) {
+	if n := m.list.last(); n != nil {
+		return skipListNodeToPair(n), nil
+	}
+	return basic.Pair[K, V]{}, getEmptyError()
+}
+
+// Description: Returns the key value pair with the largest key that is less
+// than or equal to the supplied key. Returns a [containerTypes.KeyError] if no
+// such key exists. Does not acquire a lock.
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Floor(k K) (basic.Pair[K, V], error) {
+	if n := m.list.floor(&k); n != nil {
+		return skipListNodeToPair(n), nil
+	}
+	return basic.Pair[K, V]{}, getKeyError[K](&k)
+}
+
+// Description: Returns the key value pair with the smallest key that is
+// greater than or equal to the supplied key. Returns a
+// [containerTypes.KeyError] if no such key exists. Does not acquire a lock.
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Ceiling(k K) (basic.Pair[K, V], error) {
+	if n := m.list.ceiling(&k); n != nil {
+		return skipListNodeToPair(n), nil
+	}
+	return basic.Pair[K, V]{}, getKeyError[K](&k)
+}
+
+// Description: Returns the number of keys in the skip map that are less than
+// the supplied key. The supplied key does not need to be in the map.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Rank(k K) int {
+	m.list.RLock()
+	defer m.list.RUnlock()
+	return m.list.rank(&k)
+}
+
+// Description: Returns the key value pair with the k-th smallest key in the
+// skip map, starting from 0. This is the inverse of [SkipMap.Rank], meaning
+// Rank(Select(k).A)==k. K must be in the range [0, Length()), an error will be
+// returned if it is not.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(log(n))
+func (m *SkipMap[K, V, KI, VI]) Select(k int) (basic.Pair[K, V], error) {
+	m.list.RLock()
+	defer m.list.RUnlock()
+	if n := m.list.selectNode(k); n != nil {
+		return skipListNodeToPair(n), nil
+	}
+	return basic.Pair[K, V]{}, getIndexOutOfBoundsError(
+		k, int(m.list.length.Load()), 0,
+	)
+}
+
+// Description: Returns an iterator that iterates over the keys of the skip map
+// in sorted order. The iterator does not acquire a lock, so it will reflect
+// any modifications that are made to the map while it is being consumed.
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) Keys() iter.Iter[K] {
+	return iter.Map[*skipListNode[K, V], K](
+		m.list.nodes(nil, nil),
+		func(index int, val *skipListNode[K, V]) (K, error) {
+			return val.key, nil
+		},
+	)
+}
+
+// Description: Returns a sequence over the keys in the skip map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SkipMap.Keys].
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) KeysSeq() stditer.Seq[K] {
+	return m.Keys().Seq()
+}
+
+// Description: Returns an iterator that iterates over the values of the skip
+// map. The values will be returned in the order of their keys. The iterator
+// does not acquire a lock, so it will reflect any modifications that are made
+// to the map while it is being consumed.
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) Vals() iter.Iter[V] {
+	return iter.Map[*skipListNode[K, V], V](
+		m.list.nodes(nil, nil),
+		func(index int, val *skipListNode[K, V]) (V, error) {
+			return *val.val.Load(), nil
+		},
+	)
+}
+
+// Description: Returns a sequence over the values in the skip map that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SkipMap.Vals].
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) ValsSeq() stditer.Seq[V] {
+	return m.Vals().Seq()
+}
+
+// Description: Returns a sequence over the key value pairs in the skip map that
+// can be used in a for-range loop. The key value pairs are returned in sorted
+// order. The sequence does not acquire a lock, so it will reflect any
+// modifications that are made to the map while the loop is running.
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) All() stditer.Seq2[K, V] {
+	return iter.PairSeq(m.Pairs())
+}
+
+// Panics, skip maps are not addressable.
+func (m *SkipMap[K, V, KI, VI]) ValPntrs() iter.Iter[*V] {
+	panic(getNonAddressablePanicText("skip map"))
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the skip map in sorted order. The iterator does not acquire a lock,
+// so it will reflect any modifications that are made to the map while it is
+// being consumed.
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) Pairs() iter.Iter[basic.Pair[K, V]] {
+	return m.rangeImpl(nil, nil)
+}
+
+// Description: Returns an iterator that iterates over all of the key value
+// pairs in the skip map with keys in the range [start, end) in sorted order.
+// The skip list is walked lazily, so stopping the iteration early will not
+// visit the remaining keys. The iterator does not acquire a lock, so it will
+// reflect any modifications that are made to the map while it is being
+// consumed.
+//
+// Time Complexity: O(log(n)+m), where m is the number of keys in the range
+func (m *SkipMap[K, V, KI, VI]) Range(
+	start K,
+	end K,
+) iter.Iter[basic.Pair[K, V]] {
+	return m.rangeImpl(&start, &end)
+}
+
+func (m *SkipMap[K, V, KI, VI]) rangeImpl(
+	start *K,
+	end *K,
+) iter.Iter[basic.Pair[K, V]] {
+	return iter.Map[*skipListNode[K, V], basic.Pair[K, V]](
+		m.list.nodes(start, end),
+		func(index int, val *skipListNode[K, V]) (basic.Pair[K, V], error) {
+			return skipListNodeToPair(val), nil
+		},
+	)
+}
+
+// Description: Returns true if all the key value pairs in v are all contained
+// in other and the key value pairs in other are all contained in v. Returns
+// false otherwise. Attempts to place a read lock on other but whether or not
+// that happens is implementation dependent.
+//
+// Lock Type: Read on this skip map, read on other
+//
+// Time Complexity: Dependent on the time complexity of the implementation of
+// the Get/GetPntr method on other. In big-O it might look something like this,
+// O(n*O(other.GetPntr))), where n is the number of elements in v and
+// O(other.ContainsPntr) represents the time complexity of the containsPntr
+// method on other.
+func (m *SkipMap[K, V, KI, VI]) KeyedEq(
+	other containerTypes.KeyedComparisonsOtherConstraint[K, V],
+) bool {
+	m.list.RLock()
+	other.RLock()
+	defer m.list.RUnlock()
+	defer other.RUnlock()
+	if m.Length() != other.Length() {
+		return false
+	}
+	rv := true
+	vw := widgets.Base[V, VI]{}
+	m.list.inOrder(func(n *skipListNode[K, V]) bool {
+		otherV, err := addressableSafeGet[K, V](other, n.key)
+		rv = (err == nil && vw.Eq(n.val.Load(), otherV))
+		return rv
+	})
+	return rv
+}
+
+// An equality function that implements the [algo.widget.WidgetInterface]
+// interface. Internally this is equivalent to [SkipMap.KeyedEq]. Returns true
+// if l==r, false otherwise.
+func (_ *SkipMap[K, V, KI, VI]) Eq(
+	l *SkipMap[K, V, KI, VI],
+	r *SkipMap[K, V, KI, VI],
+) bool {
+	return l.KeyedEq(r)
+}
+
+// A function that returns a hash of a skip map. To do this all of the
+// individual hashes that are produced from the key value pairs of the skip map
+// are combined in key order, making it so the hash will represent the same
+// equality operation that [SkipMap.KeyedEq] and [SkipMap.Eq] provide. Places
+// a read lock on other while the hash is computed.
+func (_ *SkipMap[K, V, KI, VI]) Hash(other *SkipMap[K, V, KI, VI]) hash.Hash {
+	other.list.RLock()
+	defer other.list.RUnlock()
+	cntr := 0
+	var rv hash.Hash
+	kw := widgets.PartialOrder[K, KI]{}
+	vw := widgets.Base[V, VI]{}
+	other.list.inOrder(func(n *skipListNode[K, V]) bool {
+		iterH := kw.Hash(&n.key).Combine(vw.Hash(n.val.Load()))
+		if cntr == 0 {
+			rv = iterH
+			cntr++
+		} else {
+			rv = rv.Combine(iterH)
+		}
+		return true
+	})
+	return rv
+}
+
+// An zero function that implements the [algo.widget.WidgetInterface] interface.
+// Internally this is equivalent to [SkipMap.Clear].
+func (_ *SkipMap[K, V, KI, VI]) Zero(other *SkipMap[K, V, KI, VI]) {
+	other.Clear()
+}
+
+func (m *SkipMap[K, V, KI, VI]) toSerialized() []serializedKV[K, V] {
+	rv := []serializedKV[K, V]{}
+	if m.list == nil {
+		return rv
+	}
+	m.list.RLock()
+	defer m.list.RUnlock()
+	rv = make([]serializedKV[K, V], 0, m.Length())
+	m.list.inOrder(func(n *skipListNode[K, V]) bool {
+		rv = append(rv, serializedKV[K, V]{Key: n.key, Val: *n.val.Load()})
+		return true
+	})
+	return rv
+}
+
+func (m *SkipMap[K, V, KI, VI]) fromSerialized(kvs []serializedKV[K, V]) error {
+	if m.list == nil {
+		m.list = newSkipList[K, V, KI]()
+	}
+	m.list.Lock()
+	defer m.list.Unlock()
+	m.list.clear()
+	for i := 0; i < len(kvs); i++ {
+		m.list.insert(&kvs[i].Key, &kvs[i].Val, true)
+	}
+	return nil
+}
+
+// Description: Returns the JSON encoding of the skip map. The skip map is
+// encoded as a list of key value objects in sorted key order. If a key is
+// present more than once when decoding the last value is kept.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toSerialized())
+}
+
+// Description: Replaces the contents of the skip map with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [SkipMap.MarshalJSON]. If an error is returned the skip map is left
+// unchanged. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SkipMap[K, V, KI, VI]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the skip map. The values
+// are laid out the same way as [SkipMap.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (m *SkipMap[K, V, KI, VI]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.toSerialized())
+}
+
+// Description: Replaces the contents of the skip map with the values decoded
+// from the supplied binary data, which must have been produced by
+// [SkipMap.MarshalBinary]. If an error is returned the skip map is left
+// unchanged. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n*log(n))
+func (m *SkipMap[K, V, KI, VI]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]serializedKV[K, V]](data)
+	if err != nil {
+		return err
+	}
+	return m.fromSerialized(decoded)
+}
+
+// Implements the [fmt.Formatter] interface.
+func (m SkipMap[K, V, KI, VI]) Format(f fmt.State, verb rune) {
+	fmtStr := string([]byte{'%', byte(verb), ':', '%', byte(verb)})
+	f.Write([]byte("skipMap["))
+	cntr := 0
+	m.list.inOrder(func(n *skipListNode[K, V]) bool {
+		if cntr > 0 {
+			f.Write([]byte{' '})
+		}
+		fmt.Fprintf(f, fmtStr, n.key, *n.val.Load())
+		cntr++
+		return true
+	})
+	f.Write([]byte{']'})
+}
+
+// Implements the [fmt.Stringer] interface.
+func (m *SkipMap[K, V, KI, VI]) String() string {
+	return fmt.Sprintf("%v", m)
+}
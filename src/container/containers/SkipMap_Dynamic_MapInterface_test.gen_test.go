@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SkipMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateSkipMap(capacity)
+	var rv dynamicContainers.Map[int, int] = v
+	return rv
+}
+
+func TestSkipMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SkipMapToMapInterfaceFactory, t)
+}
+
+func TestSkipMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SkipMapToMapInterfaceFactory, t)
+}
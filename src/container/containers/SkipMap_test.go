@@ -0,0 +1,221 @@
+package containers
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+//go:generate ../../../bin/containerInterfaceTests -type=SkipMap -category=dynamic -interface=Map -genericDecl=[int,int] -factory=generateSkipMap -pntrFactory
+
+func generateSkipMap(capacity int) *SkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt] {
+	m := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	return &m
+}
+
+func TestSkipMapWidgetInterface(t *testing.T) {
+	var widget widgets.BaseInterface[SkipMap[string, string, widgets.BuiltinString, widgets.BuiltinString]]
+	v := NewSkipMap[string, string, widgets.BuiltinString, widgets.BuiltinString]()
+	widget = &v
+	_ = widget
+}
+
+func TestSkipMapEqHashZero(t *testing.T) {
+	m1 := SkipMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	m2 := SkipMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.True(m1.Eq(&m1, &m2), t)
+	test.Eq(m1.Hash(&m1), m2.Hash(&m2), t)
+	o := OrderedMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.Eq(o.Hash(&o), m1.Hash(&m1), t)
+	m2.Set(basic.Pair[int, string]{A: 0, B: "nil"})
+	test.False(m1.Eq(&m1, &m2), t)
+	test.Neq(m1.Hash(&m1), m2.Hash(&m2), t)
+	m1.Zero(&m1)
+	test.Eq(0, m1.Length(), t)
+	_, err := m1.Min()
+	test.ContainsError(containerTypes.Empty, err, t)
+}
+
+func TestSkipMapFormat(t *testing.T) {
+	m := SkipMapValInit[int, string, widgets.BuiltinInt, widgets.BuiltinString](
+		orderedMapTestVals(),
+	)
+	test.Eq("skipMap[0:zero 1:one 2:two 3:three]", fmt.Sprintf("%v", &m), t)
+	test.Eq("skipMap[0:zero 1:one 2:two 3:three]", m.String(), t)
+}
+
+func TestSkipMapCopiesShareState(t *testing.T) {
+	m1 := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	m2 := SkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]{list: m1.list}
+	m1.Emplace(basic.Pair[int, int]{A: 1, B: 1})
+	test.Eq(1, m2.Length(), t)
+	v, err := m2.Get(1)
+	test.Nil(err, t)
+	test.Eq(1, v, t)
+}
+
+func TestSkipMapMinMaxFloorCeiling(t *testing.T) {
+	m := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	_, err := m.Max()
+	test.ContainsError(containerTypes.Empty, err, t)
+	for i := 9; i >= 0; i-- {
+		m.Emplace(basic.Pair[int, int]{A: i * 10, B: i})
+	}
+	p, err := m.Min()
+	test.Nil(err, t)
+	test.Eq(basic.Pair[int, int]{A: 0, B: 0}, p, t)
+	p, err = m.Max()
+	test.Nil(err, t)
+	test.Eq(basic.Pair[int, int]{A: 90, B: 9}, p, t)
+	p, err = m.Floor(25)
+	test.Nil(err, t)
+	test.Eq(20, p.A, t)
+	p, err = m.Floor(30)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	_, err = m.Floor(-1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	p, err = m.Ceiling(25)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	p, err = m.Ceiling(30)
+	test.Nil(err, t)
+	test.Eq(30, p.A, t)
+	_, err = m.Ceiling(91)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+func TestSkipMapRange(t *testing.T) {
+	m := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	for i := 0; i < 10; i++ {
+		m.Emplace(basic.Pair[int, int]{A: i * 10, B: i})
+	}
+	res, err := m.Range(15, 55).Collect()
+	test.Nil(err, t)
+	test.Eq(4, len(res), t)
+	for i := 0; i < len(res); i++ {
+		test.Eq(basic.Pair[int, int]{A: (i + 2) * 10, B: i + 2}, res[i], t)
+	}
+	res, err = m.Range(50, 20).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = m.Range(-10, 1000).Collect()
+	test.Nil(err, t)
+	test.Eq(10, len(res), t)
+	cntr := 0
+	err = m.Range(0, 100).ForEach(
+		func(index int, val basic.Pair[int, int]) (iter.IteratorFeedback, error) {
+			cntr++
+			if cntr == 3 {
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	test.Nil(err, t)
+	test.Eq(3, cntr, t)
+}
+
+func TestSkipMapRankSelect(t *testing.T) {
+	m := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	_, err := m.Select(0)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	keys := []int{}
+	for i := 0; i < 2000; i++ {
+		k := rand.Intn(1000)
+		if rand.Intn(3) == 0 {
+			m.Delete(k)
+			if idx, ok := slices.BinarySearch(keys, k); ok {
+				keys = slices.Delete(keys, idx, idx+1)
+			}
+		} else {
+			m.Emplace(basic.Pair[int, int]{A: k, B: -k})
+			if idx, ok := slices.BinarySearch(keys, k); !ok {
+				keys = slices.Insert(keys, idx, k)
+			}
+		}
+	}
+	test.Eq(len(keys), m.Length(), t)
+	for i, k := range keys {
+		p, err := m.Select(i)
+		test.Nil(err, t)
+		test.Eq(basic.Pair[int, int]{A: k, B: -k}, p, t)
+		test.Eq(i, m.Rank(k), t)
+		test.Eq(i+1, m.Rank(k+1), t)
+	}
+	_, err = m.Select(-1)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = m.Select(len(keys))
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.Eq(0, m.Rank(-1), t)
+	test.Eq(len(keys), m.Rank(1000), t)
+	res, err := m.Keys().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](keys, res, t)
+}
+
+// Meant to be run with the race detector enabled. Readers never acquire a
+// lock while the writers modify the map.
+func TestSkipMapConcurrentReadersAndWriters(t *testing.T) {
+	m := NewSkipMap[int, int, widgets.BuiltinInt, widgets.BuiltinInt]()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for j := start; j < 1000; j += 4 {
+				m.Emplace(basic.Pair[int, int]{A: j, B: j})
+				if j%3 == 0 {
+					m.Delete(j)
+				}
+			}
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if v, err := m.Get(j); err == nil {
+					test.Eq(j, v, t)
+				}
+				prev := -1
+				m.Range(j, j+50).ForEach(
+					func(index int, val basic.Pair[int, int]) (iter.IteratorFeedback, error) {
+						test.True(val.A > prev, t)
+						prev = val.A
+						return iter.Continue, nil
+					},
+				)
+				m.Floor(j)
+				m.Select(j)
+				m.Rank(j)
+			}
+		}()
+	}
+	wg.Wait()
+	test.Eq(1000-334, m.Length(), t)
+	for i := 0; i < 1000; i++ {
+		_, err := m.Get(i)
+		if i%3 == 0 {
+			test.ContainsError(containerTypes.KeyError, err, t)
+		} else {
+			test.Nil(err, t)
+		}
+	}
+}
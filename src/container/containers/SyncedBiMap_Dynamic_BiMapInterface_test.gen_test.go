@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedBiMapToBiMapInterfaceFactory(capacity int) dynamicContainers.BiMap[int, int] {
+	v := generateSyncedBiMap(capacity)
+	var rv dynamicContainers.BiMap[int, int] = &v
+	return rv
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceSyncableInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceAddressableInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynBiMapInterfaceLengthInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceClearInterface(t *testing.T) {
+	tests.DynBiMapInterfaceClearInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceWriteKeyedOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadKeyedOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceReadBiKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceReadBiKeyedOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceDeleteOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynBiMapInterfaceDeleteKeyedOpsInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_ReadDynBiMapInterface(t *testing.T) {
+	tests.ReadDynBiMapInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_WriteDynBiMapInterface(t *testing.T) {
+	tests.WriteDynBiMapInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceInterface(t *testing.T) {
+	tests.DynBiMapInterfaceInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynBiMapInterfaceSerializableInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynBiMapInterfaceStaticCapacityInterface(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceGet(t *testing.T) {
+	tests.DynBiMapInterfaceGet(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceGetPntr(t *testing.T) {
+	tests.DynBiMapInterfaceGetPntr(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceGetKey(t *testing.T) {
+	tests.DynBiMapInterfaceGetKey(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceContainsKey(t *testing.T) {
+	tests.DynBiMapInterfaceContainsKey(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceSet(t *testing.T) {
+	tests.DynBiMapInterfaceSet(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceEmplace(t *testing.T) {
+	tests.DynBiMapInterfaceEmplace(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceContains(t *testing.T) {
+	tests.DynBiMapInterfaceContains(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceKeyOf(t *testing.T) {
+	tests.DynBiMapInterfaceKeyOf(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfacePop(t *testing.T) {
+	tests.DynBiMapInterfacePop(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceDelete(t *testing.T) {
+	tests.DynBiMapInterfaceDelete(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceClear(t *testing.T) {
+	tests.DynBiMapInterfaceClear(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceKeysVals(t *testing.T) {
+	tests.DynBiMapInterfaceKeysVals(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceValPntrs(t *testing.T) {
+	tests.DynBiMapInterfaceValPntrs(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceKeyedEq(t *testing.T) {
+	tests.DynBiMapInterfaceKeyedEq(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceJSON(t *testing.T) {
+	tests.DynBiMapInterfaceJSON(SyncedBiMapToBiMapInterfaceFactory, t)
+}
+
+func TestSyncedBiMap_DynBiMapInterfaceBinary(t *testing.T) {
+	tests.DynBiMapInterfaceBinary(SyncedBiMapToBiMapInterfaceFactory, t)
+}
@@ -0,0 +1,134 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedBitSetToSetInterfaceFactory(capacity int) dynamicContainers.Set[int] {
+	v := generateSyncedBitSet(capacity)
+	var rv dynamicContainers.Set[int] = v
+	return rv
+}
+
+func TestSyncedBitSet_DynSetInterfaceSyncableInterface(t *testing.T) {
+	tests.DynSetInterfaceSyncableInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceAddressableInterface(t *testing.T) {
+	tests.DynSetInterfaceAddressableInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceLengthInterface(t *testing.T) {
+	tests.DynSetInterfaceLengthInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceClearInterface(t *testing.T) {
+	tests.DynSetInterfaceClearInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceWriteUniqueOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceWriteUniqueOpsInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceReadOpsInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceDeleteOpsInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_ReadDynSetInterface(t *testing.T) {
+	tests.ReadDynSetInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_WriteDynSetInterface(t *testing.T) {
+	tests.WriteDynSetInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceInterface(t *testing.T) {
+	tests.DynSetInterfaceInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynSetInterfaceStaticCapacityInterface(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceVals(t *testing.T) {
+	tests.DynSetInterfaceVals(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceValPntrs(t *testing.T) {
+	tests.DynSetInterfaceValPntrs(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceContainsPntr(t *testing.T) {
+	tests.DynSetInterfaceContainsPntr(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceGetUnique(t *testing.T) {
+	tests.DynSetInterfaceGetUnique(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceContains(t *testing.T) {
+	tests.DynSetInterfaceContains(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceClear(t *testing.T) {
+	tests.DynSetInterfaceClear(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceAppendUnique(t *testing.T) {
+	tests.DynSetInterfaceAppendUnique(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceUpdateUnique(t *testing.T) {
+	tests.DynSetInterfaceUpdateUnique(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfacePop(t *testing.T) {
+	tests.DynSetInterfacePop(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfacePopPntr(t *testing.T) {
+	tests.DynSetInterfacePopPntr(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceUnorderedEq(t *testing.T) {
+	tests.DynSetInterfaceUnorderedEq(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceIntersection(t *testing.T) {
+	tests.DynSetInterfaceIntersection(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceUnion(t *testing.T) {
+	tests.DynSetInterfaceUnion(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceDifference(t *testing.T) {
+	tests.DynSetInterfaceDifference(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceIsSuperset(t *testing.T) {
+	tests.DynSetInterfaceIsSuperset(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceIsSubset(t *testing.T) {
+	tests.DynSetInterfaceIsSubset(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(SyncedBitSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedBitSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(SyncedBitSetToSetInterfaceFactory, t)
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedCacheToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateSyncedCache(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestSyncedCache_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SyncedCacheToMapInterfaceFactory, t)
+}
+
+func TestSyncedCache_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SyncedCacheToMapInterfaceFactory, t)
+}
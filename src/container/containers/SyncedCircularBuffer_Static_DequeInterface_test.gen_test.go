@@ -65,6 +65,10 @@ func TestSyncedCircularBuffer_StaticDequeInterfaceInterface(t *testing.T) {
 	tests.StaticDequeInterfaceInterface(SyncedCircularBufferToDequeInterfaceFactory, t)
 }
 
+func TestSyncedCircularBuffer_StaticDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticDequeInterfaceSerializableInterface(SyncedCircularBufferToDequeInterfaceFactory, t)
+}
+
 func TestSyncedCircularBuffer_StaticDequeInterfaceClear(t *testing.T) {
 	tests.StaticDequeInterfaceClear(SyncedCircularBufferToDequeInterfaceFactory, t)
 }
@@ -108,3 +112,11 @@ func TestSyncedCircularBuffer_StaticDequeInterfacePushBack(t *testing.T) {
 func TestSyncedCircularBuffer_StaticDequeInterfaceForcePushBack(t *testing.T) {
 	tests.StaticDequeInterfaceForcePushBack(SyncedCircularBufferToDequeInterfaceFactory, t)
 }
+
+func TestSyncedCircularBuffer_StaticDequeInterfaceJSON(t *testing.T) {
+	tests.StaticDequeInterfaceJSON(SyncedCircularBufferToDequeInterfaceFactory, t)
+}
+
+func TestSyncedCircularBuffer_StaticDequeInterfaceBinary(t *testing.T) {
+	tests.StaticDequeInterfaceBinary(SyncedCircularBufferToDequeInterfaceFactory, t)
+}
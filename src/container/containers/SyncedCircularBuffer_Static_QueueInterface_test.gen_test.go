@@ -53,6 +53,10 @@ func TestSyncedCircularBuffer_StaticQueueInterfaceInterface(t *testing.T) {
 	tests.StaticQueueInterfaceInterface(SyncedCircularBufferToQueueInterfaceFactory, t)
 }
 
+func TestSyncedCircularBuffer_StaticQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticQueueInterfaceSerializableInterface(SyncedCircularBufferToQueueInterfaceFactory, t)
+}
+
 func TestSyncedCircularBuffer_StaticQueueInterfaceClear(t *testing.T) {
 	tests.StaticQueueInterfaceClear(SyncedCircularBufferToQueueInterfaceFactory, t)
 }
@@ -76,3 +80,11 @@ func TestSyncedCircularBuffer_StaticQueueInterfacePushBack(t *testing.T) {
 func TestSyncedCircularBuffer_StaticQueueInterfaceForcePushBack(t *testing.T) {
 	tests.StaticQueueInterfaceForcePushBack(SyncedCircularBufferToQueueInterfaceFactory, t)
 }
+
+func TestSyncedCircularBuffer_StaticQueueInterfaceJSON(t *testing.T) {
+	tests.StaticQueueInterfaceJSON(SyncedCircularBufferToQueueInterfaceFactory, t)
+}
+
+func TestSyncedCircularBuffer_StaticQueueInterfaceBinary(t *testing.T) {
+	tests.StaticQueueInterfaceBinary(SyncedCircularBufferToQueueInterfaceFactory, t)
+}
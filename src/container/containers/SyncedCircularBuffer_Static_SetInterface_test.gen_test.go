@@ -49,6 +49,10 @@ func TestSyncedCircularBuffer_StaticSetInterfaceInterface(t *testing.T) {
 	tests.StaticSetInterfaceInterface(SyncedCircularBufferToSetInterfaceFactory, t)
 }
 
+func TestSyncedCircularBuffer_StaticSetInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticSetInterfaceSerializableInterface(SyncedCircularBufferToSetInterfaceFactory, t)
+}
+
 func TestSyncedCircularBuffer_StaticSetInterfaceVals(t *testing.T) {
 	tests.StaticSetInterfaceVals(SyncedCircularBufferToSetInterfaceFactory, t)
 }
@@ -108,3 +112,11 @@ func TestSyncedCircularBuffer_StaticSetInterfaceIsSuperset(t *testing.T) {
 func TestSyncedCircularBuffer_StaticSetInterfaceIsSubset(t *testing.T) {
 	tests.StaticSetInterfaceIsSubset(SyncedCircularBufferToSetInterfaceFactory, t)
 }
+
+func TestSyncedCircularBuffer_StaticSetInterfaceJSON(t *testing.T) {
+	tests.StaticSetInterfaceJSON(SyncedCircularBufferToSetInterfaceFactory, t)
+}
+
+func TestSyncedCircularBuffer_StaticSetInterfaceBinary(t *testing.T) {
+	tests.StaticSetInterfaceBinary(SyncedCircularBufferToSetInterfaceFactory, t)
+}
@@ -53,6 +53,10 @@ func TestSyncedCircularBuffer_StaticStackInterfaceInterface(t *testing.T) {
 	tests.StaticStackInterfaceInterface(SyncedCircularBufferToStackInterfaceFactory, t)
 }
 
+func TestSyncedCircularBuffer_StaticStackInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticStackInterfaceSerializableInterface(SyncedCircularBufferToStackInterfaceFactory, t)
+}
+
 func TestSyncedCircularBuffer_StaticStackInterfaceClear(t *testing.T) {
 	tests.StaticStackInterfaceClear(SyncedCircularBufferToStackInterfaceFactory, t)
 }
@@ -76,3 +80,11 @@ func TestSyncedCircularBuffer_StaticStackInterfacePushBack(t *testing.T) {
 func TestSyncedCircularBuffer_StaticStackInterfaceForcePushBack(t *testing.T) {
 	tests.StaticStackInterfaceForcePushBack(SyncedCircularBufferToStackInterfaceFactory, t)
 }
+
+func TestSyncedCircularBuffer_StaticStackInterfaceJSON(t *testing.T) {
+	tests.StaticStackInterfaceJSON(SyncedCircularBufferToStackInterfaceFactory, t)
+}
+
+func TestSyncedCircularBuffer_StaticStackInterfaceBinary(t *testing.T) {
+	tests.StaticStackInterfaceBinary(SyncedCircularBufferToStackInterfaceFactory, t)
+}
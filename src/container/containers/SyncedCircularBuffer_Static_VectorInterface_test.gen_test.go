@@ -85,6 +85,10 @@ func TestSyncedCircularBuffer_StaticVectorInterfaceInterface(t *testing.T) {
 	tests.StaticVectorInterfaceInterface(SyncedCircularBufferToVectorInterfaceFactory, t)
 }
 
+func TestSyncedCircularBuffer_StaticVectorInterfaceSerializableInterface(t *testing.T) {
+	tests.StaticVectorInterfaceSerializableInterface(SyncedCircularBufferToVectorInterfaceFactory, t)
+}
+
 func TestSyncedCircularBuffer_StaticVectorInterfaceGet(t *testing.T) {
 	tests.StaticVectorInterfaceGet(SyncedCircularBufferToVectorInterfaceFactory, t)
 }
@@ -168,3 +172,11 @@ func TestSyncedCircularBuffer_StaticVectorInterfaceKeys(t *testing.T) {
 func TestSyncedCircularBuffer_StaticVectorInterfaceKeyedEq(t *testing.T) {
 	tests.StaticVectorInterfaceKeyedEq(SyncedCircularBufferToVectorInterfaceFactory, t)
 }
+
+func TestSyncedCircularBuffer_StaticVectorInterfaceJSON(t *testing.T) {
+	tests.StaticVectorInterfaceJSON(SyncedCircularBufferToVectorInterfaceFactory, t)
+}
+
+func TestSyncedCircularBuffer_StaticVectorInterfaceBinary(t *testing.T) {
+	tests.StaticVectorInterfaceBinary(SyncedCircularBufferToVectorInterfaceFactory, t)
+}
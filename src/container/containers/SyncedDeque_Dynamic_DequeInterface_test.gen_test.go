@@ -0,0 +1,130 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedDequeToDequeInterfaceFactory(capacity int) dynamicContainers.Deque[int] {
+	v := generateSyncedDeque(capacity)
+	var rv dynamicContainers.Deque[int] = &v
+	return rv
+}
+
+func TestSyncedDeque_DynDequeInterfaceSyncableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSyncableInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceAddressableInterface(t *testing.T) {
+	tests.DynDequeInterfaceAddressableInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceLengthInterface(t *testing.T) {
+	tests.DynDequeInterfaceLengthInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceCapacityInterface(t *testing.T) {
+	tests.DynDequeInterfaceCapacityInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceClearInterface(t *testing.T) {
+	tests.DynDequeInterfaceClearInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceFirstElemReadInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemReadInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceFirstElemWriteInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemWriteInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceFirstElemDeleteInterface(t *testing.T) {
+	tests.DynDequeInterfaceFirstElemDeleteInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceLastElemReadInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemReadInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceLastElemWriteInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemWriteInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceLastElemDeleteInterface(t *testing.T) {
+	tests.DynDequeInterfaceLastElemDeleteInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_ReadDynDequeInterface(t *testing.T) {
+	tests.ReadDynDequeInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_WriteDynDequeInterface(t *testing.T) {
+	tests.WriteDynDequeInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceInterface(t *testing.T) {
+	tests.DynDequeInterfaceInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSerializableInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynDequeInterfaceStaticCapacityInterface(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceClear(t *testing.T) {
+	tests.DynDequeInterfaceClear(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePeekPntrFront(t *testing.T) {
+	tests.DynDequeInterfacePeekPntrFront(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePeekFront(t *testing.T) {
+	tests.DynDequeInterfacePeekFront(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePeekPntrBack(t *testing.T) {
+	tests.DynDequeInterfacePeekPntrBack(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePeekBack(t *testing.T) {
+	tests.DynDequeInterfacePeekBack(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePopFront(t *testing.T) {
+	tests.DynDequeInterfacePopFront(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePopBack(t *testing.T) {
+	tests.DynDequeInterfacePopBack(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePushFront(t *testing.T) {
+	tests.DynDequeInterfacePushFront(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceForcePushFront(t *testing.T) {
+	tests.DynDequeInterfaceForcePushFront(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfacePushBack(t *testing.T) {
+	tests.DynDequeInterfacePushBack(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceForcePushBack(t *testing.T) {
+	tests.DynDequeInterfaceForcePushBack(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceJSON(t *testing.T) {
+	tests.DynDequeInterfaceJSON(SyncedDequeToDequeInterfaceFactory, t)
+}
+
+func TestSyncedDeque_DynDequeInterfaceBinary(t *testing.T) {
+	tests.DynDequeInterfaceBinary(SyncedDequeToDequeInterfaceFactory, t)
+}
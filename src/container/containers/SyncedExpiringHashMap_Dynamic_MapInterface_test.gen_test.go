@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedExpiringHashMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateSyncedExpiringHashMap(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedExpiringHashMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SyncedExpiringHashMapToMapInterfaceFactory, t)
+}
@@ -37,6 +37,10 @@ func TestSyncedHashGraph_DynDirectedGraphInterfaceInterface(t *testing.T) {
 	tests.DynDirectedGraphInterfaceInterface(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
 }
 
+func TestSyncedHashGraph_DynDirectedGraphInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDirectedGraphInterfaceSerializableInterface(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
+}
+
 func TestSyncedHashGraph_DynDirectedGraphInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynDirectedGraphInterfaceStaticCapacityInterface(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
 }
@@ -180,3 +184,11 @@ func TestSyncedHashGraph_DynDirectedGraphIsSuperset(t *testing.T) {
 func TestSyncedHashGraph_DynDirectedGraphIsSubset(t *testing.T) {
 	tests.DynDirectedGraphIsSubset(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
 }
+
+func TestSyncedHashGraph_DynDirectedGraphInterfaceJSON(t *testing.T) {
+	tests.DynDirectedGraphInterfaceJSON(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashGraph_DynDirectedGraphInterfaceBinary(t *testing.T) {
+	tests.DynDirectedGraphInterfaceBinary(SyncedHashGraphToDirectedGraphInterfaceFactory, t)
+}
@@ -61,6 +61,10 @@ func TestSyncedHashMap_DynMapInterfaceInterface(t *testing.T) {
 	tests.DynMapInterfaceInterface(SyncedHashMapToMapInterfaceFactory, t)
 }
 
+func TestSyncedHashMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SyncedHashMapToMapInterfaceFactory, t)
+}
+
 func TestSyncedHashMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynMapInterfaceStaticCapacityInterface(SyncedHashMapToMapInterfaceFactory, t)
 }
@@ -124,3 +128,11 @@ func TestSyncedHashMap_DynMapInterfaceValPntrs(t *testing.T) {
 func TestSyncedHashMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
 	tests.HashDynMapInterfaceKeyedEq(SyncedHashMapToMapInterfaceFactory, t)
 }
+
+func TestSyncedHashMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SyncedHashMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedHashMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SyncedHashMapToMapInterfaceFactory, t)
+}
@@ -53,6 +53,10 @@ func TestSyncedHashSet_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(SyncedHashSetToSetInterfaceFactory, t)
 }
 
+func TestSyncedHashSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(SyncedHashSetToSetInterfaceFactory, t)
+}
+
 func TestSyncedHashSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(SyncedHashSetToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestSyncedHashSet_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestSyncedHashSet_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(SyncedHashSetToSetInterfaceFactory, t)
 }
+
+func TestSyncedHashSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(SyncedHashSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedHashSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(SyncedHashSetToSetInterfaceFactory, t)
+}
@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory(capacity int) dynamicContainers.UndirectedGraph[int, int] {
+	v := generateSyncedHashUndirectedGraph(capacity)
+	var rv dynamicContainers.UndirectedGraph[int, int] = &v
+	return rv
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceSyncableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceSyncableInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceAddressableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceAddressableInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceClearInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceClearInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_ReadDynUndirectedGraphInterface(t *testing.T) {
+	tests.ReadDynUndirectedGraphInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_WriteDynUndirectedGraphInterface(t *testing.T) {
+	tests.WriteDynUndirectedGraphInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceSerializableInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceSerializableInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceStaticCapacityInterface(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceContains(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceContains(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceEdgesAndVertices(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceEdgesAndVertices(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphLink(t *testing.T) {
+	tests.DynUndirectedGraphLink(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDegree(t *testing.T) {
+	tests.DynUndirectedGraphDegree(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphNeighbors(t *testing.T) {
+	tests.DynUndirectedGraphNeighbors(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphIncidentEdges(t *testing.T) {
+	tests.DynUndirectedGraphIncidentEdges(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphIncidentEdgesAndNeighbors(t *testing.T) {
+	tests.DynUndirectedGraphIncidentEdgesAndNeighbors(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphEdgesBetween(t *testing.T) {
+	tests.DynUndirectedGraphEdgesBetween(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDeleteLink(t *testing.T) {
+	tests.DynUndirectedGraphDeleteLink(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDeleteLinks(t *testing.T) {
+	tests.DynUndirectedGraphDeleteLinks(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDeleteVertex(t *testing.T) {
+	tests.DynUndirectedGraphDeleteVertex(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDeleteEdge(t *testing.T) {
+	tests.DynUndirectedGraphDeleteEdge(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphClear(t *testing.T) {
+	tests.DynUndirectedGraphClear(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphConnectedComponents(t *testing.T) {
+	tests.DynUndirectedGraphConnectedComponents(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphNumConnectedComponents(t *testing.T) {
+	tests.DynUndirectedGraphNumConnectedComponents(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphConnected(t *testing.T) {
+	tests.DynUndirectedGraphConnected(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphKeyedEq(t *testing.T) {
+	tests.DynUndirectedGraphKeyedEq(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphIntersection(t *testing.T) {
+	tests.DynUndirectedGraphIntersection(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphUnion(t *testing.T) {
+	tests.DynUndirectedGraphUnion(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphDifference(t *testing.T) {
+	tests.DynUndirectedGraphDifference(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphIsSubsetIsSuperset(t *testing.T) {
+	tests.DynUndirectedGraphIsSubsetIsSuperset(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceJSON(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceJSON(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
+
+func TestSyncedHashUndirectedGraph_DynUndirectedGraphInterfaceBinary(t *testing.T) {
+	tests.DynUndirectedGraphInterfaceBinary(SyncedHashUndirectedGraphToUndirectedGraphInterfaceFactory, t)
+}
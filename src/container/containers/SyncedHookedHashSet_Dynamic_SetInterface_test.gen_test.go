@@ -53,6 +53,10 @@ func TestSyncedHookedHashSet_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(SyncedHookedHashSetToSetInterfaceFactory, t)
 }
 
+func TestSyncedHookedHashSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(SyncedHookedHashSetToSetInterfaceFactory, t)
+}
+
 func TestSyncedHookedHashSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(SyncedHookedHashSetToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestSyncedHookedHashSet_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestSyncedHookedHashSet_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(SyncedHookedHashSetToSetInterfaceFactory, t)
 }
+
+func TestSyncedHookedHashSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(SyncedHookedHashSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedHookedHashSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(SyncedHookedHashSetToSetInterfaceFactory, t)
+}
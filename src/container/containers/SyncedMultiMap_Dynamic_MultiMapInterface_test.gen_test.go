@@ -0,0 +1,126 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedMultiMapToMultiMapInterfaceFactory(capacity int) dynamicContainers.MultiMap[int, int] {
+	v := generateSyncedMultiMap(capacity)
+	var rv dynamicContainers.MultiMap[int, int] = &v
+	return rv
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceSyncableInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceAddressableInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceLengthInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceClearInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceReadOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceReadMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceReadMultiKeyedOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceWriteMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceWriteMultiKeyedOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteKeyedOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceDeleteMultiKeyedOpsInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceDeleteMultiKeyedOpsInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_ReadDynMultiMapInterface(t *testing.T) {
+	tests.ReadDynMultiMapInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_WriteDynMultiMapInterface(t *testing.T) {
+	tests.WriteDynMultiMapInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceSerializableInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMultiMapInterfaceStaticCapacityInterface(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceAdd(t *testing.T) {
+	tests.DynMultiMapInterfaceAdd(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceGet(t *testing.T) {
+	tests.DynMultiMapInterfaceGet(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceContainsKey(t *testing.T) {
+	tests.DynMultiMapInterfaceContainsKey(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceContains(t *testing.T) {
+	tests.DynMultiMapInterfaceContains(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfacePop(t *testing.T) {
+	tests.DynMultiMapInterfacePop(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceDelete(t *testing.T) {
+	tests.DynMultiMapInterfaceDelete(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceDeletePair(t *testing.T) {
+	tests.DynMultiMapInterfaceDeletePair(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceClear(t *testing.T) {
+	tests.DynMultiMapInterfaceClear(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceKeys(t *testing.T) {
+	tests.DynMultiMapInterfaceKeys(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceVals(t *testing.T) {
+	tests.DynMultiMapInterfaceVals(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMultiMapInterfaceValPntrs(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceJSON(t *testing.T) {
+	tests.DynMultiMapInterfaceJSON(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
+
+func TestSyncedMultiMap_DynMultiMapInterfaceBinary(t *testing.T) {
+	tests.DynMultiMapInterfaceBinary(SyncedMultiMapToMultiMapInterfaceFactory, t)
+}
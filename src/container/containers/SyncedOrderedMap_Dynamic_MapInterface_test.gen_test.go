@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedOrderedMapToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateSyncedOrderedMap(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SyncedOrderedMapToMapInterfaceFactory, t)
+}
+
+func TestSyncedOrderedMap_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SyncedOrderedMapToMapInterfaceFactory, t)
+}
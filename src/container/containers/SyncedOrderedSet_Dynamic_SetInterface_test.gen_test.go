@@ -0,0 +1,134 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedOrderedSetToSetInterfaceFactory(capacity int) dynamicContainers.Set[int] {
+	v := generateSyncedOrderedSet(capacity)
+	var rv dynamicContainers.Set[int] = &v
+	return rv
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceSyncableInterface(t *testing.T) {
+	tests.DynSetInterfaceSyncableInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceAddressableInterface(t *testing.T) {
+	tests.DynSetInterfaceAddressableInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceLengthInterface(t *testing.T) {
+	tests.DynSetInterfaceLengthInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceClearInterface(t *testing.T) {
+	tests.DynSetInterfaceClearInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceWriteUniqueOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceWriteUniqueOpsInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceReadOpsInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynSetInterfaceDeleteOpsInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_ReadDynSetInterface(t *testing.T) {
+	tests.ReadDynSetInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_WriteDynSetInterface(t *testing.T) {
+	tests.WriteDynSetInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceInterface(t *testing.T) {
+	tests.DynSetInterfaceInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynSetInterfaceStaticCapacityInterface(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceVals(t *testing.T) {
+	tests.DynSetInterfaceVals(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceValPntrs(t *testing.T) {
+	tests.DynSetInterfaceValPntrs(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceContainsPntr(t *testing.T) {
+	tests.DynSetInterfaceContainsPntr(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceGetUnique(t *testing.T) {
+	tests.DynSetInterfaceGetUnique(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceContains(t *testing.T) {
+	tests.DynSetInterfaceContains(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceClear(t *testing.T) {
+	tests.DynSetInterfaceClear(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceAppendUnique(t *testing.T) {
+	tests.DynSetInterfaceAppendUnique(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceUpdateUnique(t *testing.T) {
+	tests.DynSetInterfaceUpdateUnique(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfacePop(t *testing.T) {
+	tests.DynSetInterfacePop(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfacePopPntr(t *testing.T) {
+	tests.DynSetInterfacePopPntr(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceUnorderedEq(t *testing.T) {
+	tests.DynSetInterfaceUnorderedEq(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceIntersection(t *testing.T) {
+	tests.DynSetInterfaceIntersection(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceUnion(t *testing.T) {
+	tests.DynSetInterfaceUnion(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceDifference(t *testing.T) {
+	tests.DynSetInterfaceDifference(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceIsSuperset(t *testing.T) {
+	tests.DynSetInterfaceIsSuperset(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceIsSubset(t *testing.T) {
+	tests.DynSetInterfaceIsSubset(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(SyncedOrderedSetToSetInterfaceFactory, t)
+}
+
+func TestSyncedOrderedSet_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(SyncedOrderedSetToSetInterfaceFactory, t)
+}
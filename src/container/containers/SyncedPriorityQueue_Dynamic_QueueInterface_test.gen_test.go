@@ -0,0 +1,98 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedPriorityQueueToQueueInterfaceFactory(capacity int) dynamicContainers.Queue[int] {
+	v := generateSyncedPriorityQueue(capacity)
+	var rv dynamicContainers.Queue[int] = &v
+	return rv
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceSyncableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSyncableInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceAddressableInterface(t *testing.T) {
+	tests.DynQueueInterfaceAddressableInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceLengthInterface(t *testing.T) {
+	tests.DynQueueInterfaceLengthInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceCapacityInterface(t *testing.T) {
+	tests.DynQueueInterfaceCapacityInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceClearInterface(t *testing.T) {
+	tests.DynQueueInterfaceClearInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceFirstElemReadInterface(t *testing.T) {
+	tests.DynQueueInterfaceFirstElemReadInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceFirstElemDeleteInterface(t *testing.T) {
+	tests.DynQueueInterfaceFirstElemDeleteInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceLastElemWriteInterface(t *testing.T) {
+	tests.DynQueueInterfaceLastElemWriteInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_ReadDynQueueInterface(t *testing.T) {
+	tests.ReadDynQueueInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_WriteDynQueueInterface(t *testing.T) {
+	tests.WriteDynQueueInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceInterface(t *testing.T) {
+	tests.DynQueueInterfaceInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSerializableInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynQueueInterfaceStaticCapacityInterface(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceClear(t *testing.T) {
+	tests.DynQueueInterfaceClear(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfacePeekPntrFront(t *testing.T) {
+	tests.DynQueueInterfacePeekPntrFront(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfacePeekFront(t *testing.T) {
+	tests.DynQueueInterfacePeekFront(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfacePopFront(t *testing.T) {
+	tests.DynQueueInterfacePopFront(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfacePushBack(t *testing.T) {
+	tests.DynQueueInterfacePushBack(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceForcePushBack(t *testing.T) {
+	tests.DynQueueInterfaceForcePushBack(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceJSON(t *testing.T) {
+	tests.DynQueueInterfaceJSON(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
+
+func TestSyncedPriorityQueue_DynQueueInterfaceBinary(t *testing.T) {
+	tests.DynQueueInterfaceBinary(SyncedPriorityQueueToQueueInterfaceFactory, t)
+}
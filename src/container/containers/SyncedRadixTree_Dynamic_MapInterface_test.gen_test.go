@@ -0,0 +1,138 @@
+package containers
+
+// Code generated by ../../../bin/containerInterfaceTests - DO NOT EDIT.
+import (
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/container/tests"
+	"testing"
+)
+
+func SyncedRadixTreeToMapInterfaceFactory(capacity int) dynamicContainers.Map[int, int] {
+	v := generateSyncedRadixTree(capacity)
+	var rv dynamicContainers.Map[int, int] = &v
+	return rv
+}
+
+func TestSyncedRadixTree_DynMapInterfaceSyncableInterface(t *testing.T) {
+	tests.DynMapInterfaceSyncableInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceAddressableInterface(t *testing.T) {
+	tests.DynMapInterfaceAddressableInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceLengthInterface(t *testing.T) {
+	tests.DynMapInterfaceLengthInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceClearInterface(t *testing.T) {
+	tests.DynMapInterfaceClearInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceWriteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceWriteKeyedOpsInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceReadOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadOpsInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceReadKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceReadKeyedOpsInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceDeleteOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteOpsInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceDeleteKeyedOpsInterface(t *testing.T) {
+	tests.DynMapInterfaceDeleteKeyedOpsInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_ReadDynMapInterface(t *testing.T) {
+	tests.ReadDynMapInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_WriteDynMapInterface(t *testing.T) {
+	tests.WriteDynMapInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceInterface(t *testing.T) {
+	tests.DynMapInterfaceInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceSerializableInterface(t *testing.T) {
+	tests.DynMapInterfaceSerializableInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceStaticCapacityInterface(t *testing.T) {
+	tests.DynMapInterfaceStaticCapacityInterface(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceGet(t *testing.T) {
+	tests.DynMapInterfaceGet(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceGetPntr(t *testing.T) {
+	tests.DynMapInterfaceGetPntr(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceSet(t *testing.T) {
+	tests.DynMapInterfaceSet(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceContains(t *testing.T) {
+	tests.DynMapInterfaceContains(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceContainsPntr(t *testing.T) {
+	tests.DynMapInterfaceContainsPntr(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceKeyOf(t *testing.T) {
+	tests.DynMapInterfaceKeyOf(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceKeyOfPntr(t *testing.T) {
+	tests.DynMapInterfaceKeyOfPntr(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfacePop(t *testing.T) {
+	tests.DynMapInterfacePop(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfacePopPntr(t *testing.T) {
+	tests.DynMapInterfacePopPntr(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceDelete(t *testing.T) {
+	tests.DynMapInterfaceDelete(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceClear(t *testing.T) {
+	tests.DynMapInterfaceClear(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceKeys(t *testing.T) {
+	tests.DynMapInterfaceKeys(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceVals(t *testing.T) {
+	tests.DynMapInterfaceVals(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceValPntrs(t *testing.T) {
+	tests.DynMapInterfaceValPntrs(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_HashDynMapInterfaceKeyedEq(t *testing.T) {
+	tests.HashDynMapInterfaceKeyedEq(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceJSON(t *testing.T) {
+	tests.DynMapInterfaceJSON(SyncedRadixTreeToMapInterfaceFactory, t)
+}
+
+func TestSyncedRadixTree_DynMapInterfaceBinary(t *testing.T) {
+	tests.DynMapInterfaceBinary(SyncedRadixTreeToMapInterfaceFactory, t)
+}
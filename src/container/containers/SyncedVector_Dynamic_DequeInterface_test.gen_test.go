@@ -69,6 +69,10 @@ func TestSyncedVector_DynDequeInterfaceInterface(t *testing.T) {
 	tests.DynDequeInterfaceInterface(SyncedVectorToDequeInterfaceFactory, t)
 }
 
+func TestSyncedVector_DynDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSerializableInterface(SyncedVectorToDequeInterfaceFactory, t)
+}
+
 func TestSyncedVector_DynDequeInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynDequeInterfaceStaticCapacityInterface(SyncedVectorToDequeInterfaceFactory, t)
 }
@@ -116,3 +120,11 @@ func TestSyncedVector_DynDequeInterfacePushBack(t *testing.T) {
 func TestSyncedVector_DynDequeInterfaceForcePushBack(t *testing.T) {
 	tests.DynDequeInterfaceForcePushBack(SyncedVectorToDequeInterfaceFactory, t)
 }
+
+func TestSyncedVector_DynDequeInterfaceJSON(t *testing.T) {
+	tests.DynDequeInterfaceJSON(SyncedVectorToDequeInterfaceFactory, t)
+}
+
+func TestSyncedVector_DynDequeInterfaceBinary(t *testing.T) {
+	tests.DynDequeInterfaceBinary(SyncedVectorToDequeInterfaceFactory, t)
+}
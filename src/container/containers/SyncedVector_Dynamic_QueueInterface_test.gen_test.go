@@ -57,6 +57,10 @@ func TestSyncedVector_DynQueueInterfaceInterface(t *testing.T) {
 	tests.DynQueueInterfaceInterface(SyncedVectorToQueueInterfaceFactory, t)
 }
 
+func TestSyncedVector_DynQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSerializableInterface(SyncedVectorToQueueInterfaceFactory, t)
+}
+
 func TestSyncedVector_DynQueueInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynQueueInterfaceStaticCapacityInterface(SyncedVectorToQueueInterfaceFactory, t)
 }
@@ -84,3 +88,11 @@ func TestSyncedVector_DynQueueInterfacePushBack(t *testing.T) {
 func TestSyncedVector_DynQueueInterfaceForcePushBack(t *testing.T) {
 	tests.DynQueueInterfaceForcePushBack(SyncedVectorToQueueInterfaceFactory, t)
 }
+
+func TestSyncedVector_DynQueueInterfaceJSON(t *testing.T) {
+	tests.DynQueueInterfaceJSON(SyncedVectorToQueueInterfaceFactory, t)
+}
+
+func TestSyncedVector_DynQueueInterfaceBinary(t *testing.T) {
+	tests.DynQueueInterfaceBinary(SyncedVectorToQueueInterfaceFactory, t)
+}
@@ -53,6 +53,10 @@ func TestSyncedVector_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(SyncedVectorToSetInterfaceFactory, t)
 }
 
+func TestSyncedVector_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(SyncedVectorToSetInterfaceFactory, t)
+}
+
 func TestSyncedVector_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(SyncedVectorToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestSyncedVector_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestSyncedVector_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(SyncedVectorToSetInterfaceFactory, t)
 }
+
+func TestSyncedVector_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(SyncedVectorToSetInterfaceFactory, t)
+}
+
+func TestSyncedVector_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(SyncedVectorToSetInterfaceFactory, t)
+}
@@ -57,6 +57,10 @@ func TestSyncedVector_DynStackInterfaceInterface(t *testing.T) {
 	tests.DynStackInterfaceInterface(SyncedVectorToStackInterfaceFactory, t)
 }
 
+func TestSyncedVector_DynStackInterfaceSerializableInterface(t *testing.T) {
+	tests.DynStackInterfaceSerializableInterface(SyncedVectorToStackInterfaceFactory, t)
+}
+
 func TestSyncedVector_DynStackInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynStackInterfaceStaticCapacityInterface(SyncedVectorToStackInterfaceFactory, t)
 }
@@ -84,3 +88,11 @@ func TestSyncedVector_DynStackInterfacePushBack(t *testing.T) {
 func TestSyncedVector_DynStackInterfaceForcePushBack(t *testing.T) {
 	tests.DynStackInterfaceForcePushBack(SyncedVectorToStackInterfaceFactory, t)
 }
+
+func TestSyncedVector_DynStackInterfaceJSON(t *testing.T) {
+	tests.DynStackInterfaceJSON(SyncedVectorToStackInterfaceFactory, t)
+}
+
+func TestSyncedVector_DynStackInterfaceBinary(t *testing.T) {
+	tests.DynStackInterfaceBinary(SyncedVectorToStackInterfaceFactory, t)
+}
@@ -85,6 +85,10 @@ func TestSyncedVector_DynVectorInterfaceInterface(t *testing.T) {
 	tests.DynVectorInterfaceInterface(SyncedVectorToVectorInterfaceFactory, t)
 }
 
+func TestSyncedVector_DynVectorInterfaceSerializableInterface(t *testing.T) {
+	tests.DynVectorInterfaceSerializableInterface(SyncedVectorToVectorInterfaceFactory, t)
+}
+
 func TestSyncedVector_DynVectorInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynVectorInterfaceStaticCapacityInterface(SyncedVectorToVectorInterfaceFactory, t)
 }
@@ -196,3 +200,11 @@ func TestSyncedVector_DynVectorInterfaceIsSuperset(t *testing.T) {
 func TestSyncedVector_DynVectorInterfaceIsSubset(t *testing.T) {
 	tests.DynVectorInterfaceIsSubset(SyncedVectorToVectorInterfaceFactory, t)
 }
+
+func TestSyncedVector_DynVectorInterfaceJSON(t *testing.T) {
+	tests.DynVectorInterfaceJSON(SyncedVectorToVectorInterfaceFactory, t)
+}
+
+func TestSyncedVector_DynVectorInterfaceBinary(t *testing.T) {
+	tests.DynVectorInterfaceBinary(SyncedVectorToVectorInterfaceFactory, t)
+}
@@ -1,11 +1,14 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
+	stditer "iter"
 	"sync"
 
 	"github.com/barbell-math/util/src/container/basic"
 	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
 	"github.com/barbell-math/util/src/customerr"
 	"github.com/barbell-math/util/src/hash"
 	"github.com/barbell-math/util/src/iter"
@@ -122,6 +125,13 @@ func (v *SyncedVector[T, U]) RLock() { v.RWMutex.RLock() }
 // actually apply the mutex operation.
 func (v *SyncedVector[T, U]) RUnlock() { v.RWMutex.RUnlock() }
 
+// Returns the underlying vector without acquiring any locks. The caller must
+// hold the appropriate lock for as long as the returned vector is used. This
+// allows the vector algorithms to hold the lock for an entire operation.
+func (v *SyncedVector[T, U]) unsynced() dynamicContainers.Vector[T] {
+	return &v.Vector
+}
+
 // Returns true, vectors are addressable.
 func (v *Vector[T, U]) IsAddressable() bool { return true }
 
@@ -1100,6 +1110,28 @@ func (v *SyncedVector[T, U]) Vals() iter.Iter[T] {
 	)
 }
 
+// Description: Returns a sequence over the values in the vector that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [Vector.Vals].
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) ValsSeq() stditer.Seq[T] {
+	return v.Vals().Seq()
+}
+
+// Description: Returns a sequence over the values in the vector that can be
+// used in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the
+// iterator returned by [SyncedVector.Vals], so the vector will have a read lock
+// the entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) ValsSeq() stditer.Seq[T] {
+	return v.Vals().Seq()
+}
+
 // Description: Returns an iterator that iterates over the pointers to the
 // values in the vector. The vector will have a read lock the entire time the
 // iteration is being performed. The lock will not be applied until the iterator
@@ -1155,6 +1187,28 @@ func (v *SyncedVector[T, U]) Keys() iter.Iter[int] {
 	)
 }
 
+// Description: Returns a sequence over the keys in the vector that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [Vector.Keys].
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) KeysSeq() stditer.Seq[int] {
+	return v.Keys().Seq()
+}
+
+// Description: Returns a sequence over the keys in the vector that can be used
+// in a for-range loop. Equivalent to calling [iter.Iter.Seq] on the iterator
+// returned by [SyncedVector.Keys], so the vector will have a read lock the
+// entire time the loop is running. The lock is released once the loop ends,
+// even if the loop is exited early.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) KeysSeq() stditer.Seq[int] {
+	return v.Keys().Seq()
+}
+
 // Description: Returns true if the elements in v are all contained in other and
 // the elements of other are all contained in v, regardless of position. Returns
 // false otherwise.
@@ -1534,6 +1588,121 @@ func (_ *SyncedVector[T, U]) Zero(other *SyncedVector[T, U]) {
 	other.Clear()
 }
 
+func (v *Vector[T, U]) toSerialized() []T {
+	if *v == nil {
+		return []T{}
+	}
+	return []T(*v)
+}
+
+func (v *Vector[T, U]) fromSerialized(vals []T) error {
+	v.Clear()
+	*v = append(*v, vals...)
+	return nil
+}
+
+// Description: Returns the JSON encoding of the vector. The vector is encoded
+// as a list of its values.
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.toSerialized())
+}
+
+// Description: Places a read lock on the underlying vector and then calls the
+// underlying vectors [Vector.MarshalJSON] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) MarshalJSON() ([]byte, error) {
+	v.RLock()
+	defer v.RUnlock()
+	return v.Vector.MarshalJSON()
+}
+
+// Description: Replaces the contents of the vector with the values decoded
+// from the supplied JSON data, which must be in the format produced by
+// [Vector.MarshalJSON]. If an error is returned the vector is left unchanged.
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	return v.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied JSON data and then places a write lock on
+// the underlying vector while its contents are replaced. Exhibits the same
+// behavior as [Vector.UnmarshalJSON]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[[]T](data)
+	if err != nil {
+		return err
+	}
+	v.Lock()
+	defer v.Unlock()
+	return v.Vector.fromSerialized(decoded)
+}
+
+// Description: Returns a compact binary encoding of the vector. The values are
+// laid out the same way as [Vector.MarshalJSON] and are encoded using the
+// [encoding/gob] package, so the contained values must be encodable by gob.
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(v.toSerialized())
+}
+
+// Description: Places a read lock on the underlying vector and then calls the
+// underlying vectors [Vector.MarshalBinary] method.
+//
+// Lock Type: Read
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) MarshalBinary() ([]byte, error) {
+	v.RLock()
+	defer v.RUnlock()
+	return v.Vector.MarshalBinary()
+}
+
+// Description: Replaces the contents of the vector with the values decoded
+// from the supplied binary data, which must have been produced by
+// [Vector.MarshalBinary]. If an error is returned the vector is left
+// unchanged.
+//
+// Time Complexity: O(n)
+func (v *Vector[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	return v.fromSerialized(decoded)
+}
+
+// Description: Decodes the supplied binary data and then places a write lock
+// on the underlying vector while its contents are replaced. Exhibits the same
+// behavior as [Vector.UnmarshalBinary]. The lock is not held while decoding.
+//
+// Lock Type: Write
+//
+// Time Complexity: O(n)
+func (v *SyncedVector[T, U]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalBinary[[]T](data)
+	if err != nil {
+		return err
+	}
+	v.Lock()
+	defer v.Unlock()
+	return v.Vector.fromSerialized(decoded)
+}
+
 // Implements the [fmt.Formatter] interface.
 func (v Vector[T, U]) Format(f fmt.State, verb rune) {
 	fmtStr := string([]byte{'%', byte(verb)})
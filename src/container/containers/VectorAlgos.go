@@ -0,0 +1,473 @@
+package containers
+
+import (
+	"math/bits"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// Implemented by synced vectors that can expose the vector they wrap so
+	// that an algorithm can place a single lock on the vector and then
+	// operate on it without every individual operation acquiring the lock.
+	unsyncedVector[T any] interface {
+		unsynced() dynamicContainers.Vector[T]
+	}
+
+	// Provides index based access to the values in a vector. Addressable
+	// vectors are accessed through pointers to avoid copying values, all other
+	// vectors fall back to the Get and Set methods.
+	vectorView[T any] struct {
+		vec         dynamicContainers.Vector[T]
+		addressable bool
+		length      int
+	}
+
+	// A vector view that can compare the values in the vector using the
+	// supplied partial order widget.
+	orderedVectorView[T any, U widgets.PartialOrderInterface[T]] struct {
+		vectorView[T]
+		w widgets.PartialOrder[T, U]
+	}
+)
+
+const (
+	// Ranges that are smaller than this are sorted with insertion sort.
+	vectorInsertionSortSize = 12
+	// The size of the blocks that are insertion sorted before being merged
+	// when performing a stable sort.
+	vectorStableBlockSize = 20
+)
+
+// Places either a read or write lock on v and returns the vector that should
+// be operated on along with the function that releases the lock. If v is a
+// synced vector that cannot expose its underlying vector no lock is placed and
+// each operation on v will acquire the lock individually.
+func lockVector[T any](
+	v dynamicContainers.Vector[T],
+	write bool,
+) (vectorView[T], func()) {
+	unlock := func() {}
+	if u, ok := v.(unsyncedVector[T]); ok {
+		if write {
+			v.Lock()
+			unlock = v.Unlock
+		} else {
+			v.RLock()
+			unlock = v.RUnlock
+		}
+		v = u.unsynced()
+	}
+	return vectorView[T]{
+		vec:         v,
+		addressable: v.IsAddressable(),
+		length:      v.Length(),
+	}, unlock
+}
+
+func lockOrderedVector[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+	write bool,
+) (orderedVectorView[T, U], func()) {
+	view, unlock := lockVector[T](v, write)
+	return orderedVectorView[T, U]{vectorView: view}, unlock
+}
+
+func (v *vectorView[T]) get(i int) *T {
+	if v.addressable {
+		rv, _ := v.vec.GetPntr(i)
+		return rv
+	}
+	rv, _ := v.vec.Get(i)
+	return &rv
+}
+
+func (v *vectorView[T]) swap(i int, j int) {
+	if v.addressable {
+		l, _ := v.vec.GetPntr(i)
+		r, _ := v.vec.GetPntr(j)
+		*l, *r = *r, *l
+		return
+	}
+	l, _ := v.vec.Get(i)
+	r, _ := v.vec.Get(j)
+	v.vec.Set(basic.Pair[int, T]{A: i, B: r}, basic.Pair[int, T]{A: j, B: l})
+}
+
+func (v *vectorView[T]) reverse(lo int, hi int) {
+	for i, j := lo, hi-1; i < j; i, j = i+1, j-1 {
+		v.swap(i, j)
+	}
+}
+
+func (v *orderedVectorView[T, U]) less(i int, j int) bool {
+	return v.w.Lt(v.get(i), v.get(j))
+}
+
+func (v *orderedVectorView[T, U]) insertionSort(lo int, hi int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && v.less(j, j-1); j-- {
+			v.swap(j, j-1)
+		}
+	}
+}
+
+func (v *orderedVectorView[T, U]) siftDown(lo int, root int, hi int) {
+	for {
+		child := 2*root + 1
+		if child >= hi {
+			return
+		}
+		if child+1 < hi && v.less(lo+child, lo+child+1) {
+			child++
+		}
+		if !v.less(lo+root, lo+child) {
+			return
+		}
+		v.swap(lo+root, lo+child)
+		root = child
+	}
+}
+
+func (v *orderedVectorView[T, U]) heapSort(lo int, hi int) {
+	n := hi - lo
+	for i := (n - 1) / 2; i >= 0; i-- {
+		v.siftDown(lo, i, n)
+	}
+	for i := n - 1; i >= 0; i-- {
+		v.swap(lo, lo+i)
+		v.siftDown(lo, 0, i)
+	}
+}
+
+// Partitions the range [lo,hi) around a pivot that is chosen using the median
+// of three and returns the final index of the pivot. All values before the
+// returned index are not greater than the pivot and all values after it are
+// not less than the pivot. Values that are equal to the pivot are spread
+// across both sides so that ranges with many duplicates stay balanced.
+func (v *orderedVectorView[T, U]) partition(lo int, hi int) int {
+	m := int(uint(lo+hi) >> 1)
+	if v.less(m, lo) {
+		v.swap(m, lo)
+	}
+	if v.less(hi-1, lo) {
+		v.swap(hi-1, lo)
+	}
+	if v.less(hi-1, m) {
+		v.swap(hi-1, m)
+	}
+	v.swap(lo, m)
+	i, j := lo+1, hi-1
+	for {
+		for i <= j && v.less(i, lo) {
+			i++
+		}
+		for i <= j && v.less(lo, j) {
+			j--
+		}
+		if i >= j {
+			break
+		}
+		v.swap(i, j)
+		i++
+		j--
+	}
+	v.swap(lo, j)
+	return j
+}
+
+// Sorts the range [lo,hi) using quick sort, falling back to heap sort once
+// depth partitions have been made to guarantee O(n*log(n)) time.
+func (v *orderedVectorView[T, U]) introSort(lo int, hi int, depth int) {
+	for hi-lo > vectorInsertionSortSize {
+		if depth == 0 {
+			v.heapSort(lo, hi)
+			return
+		}
+		depth--
+		p := v.partition(lo, hi)
+		// Recurse on the smaller side to bound the stack depth
+		if p-lo < hi-p {
+			v.introSort(lo, p, depth)
+			lo = p + 1
+		} else {
+			v.introSort(p+1, hi, depth)
+			hi = p
+		}
+	}
+	v.insertionSort(lo, hi)
+}
+
+// Merges the sorted ranges [a,m) and [m,b) in place without allocating. This
+// is the SymMerge algorithm described by Pok-Son Kim and Arne Kutzner in
+// "Stable Minimum Storage Merging by Symmetric Comparisons".
+func (v *orderedVectorView[T, U]) symMerge(a int, m int, b int) {
+	if m-a == 1 {
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if v.less(h, a) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			v.swap(k, k+1)
+		}
+		return
+	}
+	if b-m == 1 {
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if !v.less(m, h) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			v.swap(k, k-1)
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start, r = n-b, mid
+	} else {
+		start, r = a, m
+	}
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if !v.less(p-c, c) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+	end := n - start
+	if start < m && m < end {
+		v.reverse(start, m)
+		v.reverse(m, end)
+		v.reverse(start, end)
+	}
+	if a < start && start < mid {
+		v.symMerge(a, start, mid)
+	}
+	if mid < end && end < b {
+		v.symMerge(mid, end, b)
+	}
+}
+
+// Returns the index of the first value in the vector that does not satisfy
+// op, assuming that all values that satisfy op come first.
+func (v *orderedVectorView[T, U]) search(op func(val *T) bool) int {
+	i, j := 0, v.length
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if op(v.get(h)) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i
+}
+
+// Description: Sorts the supplied vector in ascending order as defined by the
+// U widget. The sort is not stable, see [VectorStableSort] for a stable sort.
+// If v is a [SyncedVector] a write lock is held for the entire sort.
+//
+// Time Complexity: O(n*log(n))
+func VectorSort[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+) {
+	view, unlock := lockOrderedVector[T, U](v, true)
+	defer unlock()
+	view.introSort(0, view.length, 2*bits.Len(uint(view.length)))
+}
+
+// Description: Sorts the supplied vector in ascending order as defined by the
+// U widget while keeping equal values in their original order. No additional
+// memory is allocated. If v is a [SyncedVector] a write lock is held for the
+// entire sort.
+//
+// Time Complexity: O(n*log(n)*log(n))
+func VectorStableSort[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+) {
+	view, unlock := lockOrderedVector[T, U](v, true)
+	defer unlock()
+	n := view.length
+	blockSize := vectorStableBlockSize
+	a, b := 0, blockSize
+	for b <= n {
+		view.insertionSort(a, b)
+		a, b = b, b+blockSize
+	}
+	view.insertionSort(a, n)
+	for blockSize < n {
+		a, b = 0, 2*blockSize
+		for b <= n {
+			view.symMerge(a, a+blockSize, b)
+			a, b = b, b+2*blockSize
+		}
+		if m := a + blockSize; m < n {
+			view.symMerge(a, m, n)
+		}
+		blockSize *= 2
+	}
+}
+
+// Description: Returns true if the supplied vector is sorted in ascending
+// order as defined by the U widget, false otherwise. If v is a [SyncedVector]
+// a read lock is held for the entire operation.
+//
+// Time Complexity: O(n)
+func VectorIsSorted[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+) bool {
+	view, unlock := lockOrderedVector[T, U](v, false)
+	defer unlock()
+	for i := view.length - 1; i > 0; i-- {
+		if view.less(i, i-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// Description: Returns the index of the first value in the supplied vector
+// that is not less than val. If all values are less than val the length of
+// the vector is returned. The vector must be sorted in ascending order as
+// defined by the U widget. If v is a [SyncedVector] a read lock is held for
+// the entire operation.
+//
+// Time Complexity: O(log(n))
+func VectorLowerBound[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+	val T,
+) int {
+	view, unlock := lockOrderedVector[T, U](v, false)
+	defer unlock()
+	return view.search(func(other *T) bool { return view.w.Lt(other, &val) })
+}
+
+// Description: Returns the index of the first value in the supplied vector
+// that is greater than val. If no values are greater than val the length of
+// the vector is returned. The vector must be sorted in ascending order as
+// defined by the U widget. If v is a [SyncedVector] a read lock is held for
+// the entire operation.
+//
+// Time Complexity: O(log(n))
+func VectorUpperBound[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+	val T,
+) int {
+	view, unlock := lockOrderedVector[T, U](v, false)
+	defer unlock()
+	return view.search(func(other *T) bool { return !view.w.Lt(&val, other) })
+}
+
+// Description: Searches the supplied vector for val. If val is found the index
+// of the first value that is equal to val is returned along with true.
+// Otherwise the index that val would need to be inserted at to keep the vector
+// sorted is returned along with false. The vector must be sorted in ascending
+// order as defined by the U widget. If v is a [SyncedVector] a read lock is
+// held for the entire operation.
+//
+// Time Complexity: O(log(n))
+func VectorBinarySearch[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+	val T,
+) (int, bool) {
+	view, unlock := lockOrderedVector[T, U](v, false)
+	defer unlock()
+	i := view.search(func(other *T) bool { return view.w.Lt(other, &val) })
+	return i, i < view.length && view.w.Eq(view.get(i), &val)
+}
+
+// Description: Rearranges the supplied vector so that the value at index n is
+// the value that would be there if the vector was sorted. All values before
+// index n will not be greater than it and all values after index n will not
+// be less than it. No other ordering guarantees are made. Returns an error if
+// n is not a valid index. If v is a [SyncedVector] a write lock is held for
+// the entire operation.
+//
+// Time Complexity: O(n) on average, O(n*log(n)) worst case
+func VectorNthElement[T any, U widgets.PartialOrderInterface[T]](
+	v dynamicContainers.Vector[T],
+	n int,
+) error {
+	view, unlock := lockOrderedVector[T, U](v, true)
+	defer unlock()
+	if n < 0 || n >= view.length {
+		return getIndexOutOfBoundsError(n, 0, view.length)
+	}
+	lo, hi := 0, view.length
+	depth := 2 * bits.Len(uint(view.length))
+	for hi-lo > vectorInsertionSortSize {
+		if depth == 0 {
+			view.heapSort(lo, hi)
+			return nil
+		}
+		depth--
+		p := view.partition(lo, hi)
+		if p == n {
+			return nil
+		} else if n < p {
+			hi = p
+		} else {
+			lo = p + 1
+		}
+	}
+	view.insertionSort(lo, hi)
+	return nil
+}
+
+// Description: Reverses the order of the values in the supplied vector. If v
+// is a [SyncedVector] a write lock is held for the entire operation.
+//
+// Time Complexity: O(n)
+func VectorReverse[T any](v dynamicContainers.Vector[T]) {
+	view, unlock := lockVector[T](v, true)
+	defer unlock()
+	view.reverse(0, view.length)
+}
+
+// Description: Rearranges the supplied vector so that all values that satisfy
+// op come before all values that do not. Returns the number of values that
+// satisfied op, which is also the index of the first value that did not. The
+// relative order of the values is not preserved. If v is a [SyncedVector] a
+// write lock is held for the entire operation.
+//
+// Time Complexity: O(n)
+func VectorPartition[T any](
+	v dynamicContainers.Vector[T],
+	op func(val *T) bool,
+) int {
+	view, unlock := lockVector[T](v, true)
+	defer unlock()
+	i, j := 0, view.length-1
+	for {
+		for i <= j && op(view.get(i)) {
+			i++
+		}
+		for i <= j && !op(view.get(j)) {
+			j--
+		}
+		if i >= j {
+			return i
+		}
+		view.swap(i, j)
+		i++
+		j--
+	}
+}
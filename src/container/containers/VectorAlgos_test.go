@@ -0,0 +1,274 @@
+package containers
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A vector that hides its addressability so that the vector algorithms
+	// have to fall back to the Get and Set methods.
+	nonAddressableVector struct {
+		*Vector[int, widgets.BuiltinInt]
+	}
+
+	// A value that is ordered only by its key, used to check stability.
+	vectorAlgosStableVal struct {
+		key   int
+		order int
+	}
+)
+
+func (_ nonAddressableVector) IsAddressable() bool { return false }
+
+func (_ nonAddressableVector) GetPntr(idx int) (*int, error) {
+	panic("GetPntr should not be called on a non-addressable vector")
+}
+
+func (_ vectorAlgosStableVal) Eq(l *vectorAlgosStableVal, r *vectorAlgosStableVal) bool {
+	return l.key == r.key
+}
+func (_ vectorAlgosStableVal) Lt(l *vectorAlgosStableVal, r *vectorAlgosStableVal) bool {
+	return l.key < r.key
+}
+func (_ vectorAlgosStableVal) Hash(other *vectorAlgosStableVal) hash.Hash {
+	return hash.Hash(other.key)
+}
+func (_ vectorAlgosStableVal) Zero(other *vectorAlgosStableVal) {
+	*other = vectorAlgosStableVal{}
+}
+
+// Returns the supplied values in every kind of vector that the vector
+// algorithms should be tested with.
+func vectorAlgosTestVectors(vals []int) []dynamicContainers.Vector[int] {
+	v := Vector[int, widgets.BuiltinInt](append([]int{}, vals...))
+	sv := SyncedVectorValInit[int, widgets.BuiltinInt](
+		append([]int{}, vals...)...,
+	)
+	nv := Vector[int, widgets.BuiltinInt](append([]int{}, vals...))
+	return []dynamicContainers.Vector[int]{
+		&v, &sv, nonAddressableVector{Vector: &nv},
+	}
+}
+
+func vectorAlgosRandVals(n int, maxVal int, r *rand.Rand) []int {
+	rv := make([]int, n)
+	for i := range rv {
+		rv[i] = r.Intn(maxVal)
+	}
+	return rv
+}
+
+func checkVectorVals(exp []int, v dynamicContainers.Vector[int], t *testing.T) {
+	vals, err := v.Vals().Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](exp, vals, t)
+}
+
+func TestVectorSort(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{0, 1, 2, 3, 12, 13, 50, 1000} {
+		for _, maxVal := range []int{2, 10, 1 << 30} {
+			vals := vectorAlgosRandVals(n, maxVal, r)
+			exp := append([]int{}, vals...)
+			sort.Ints(exp)
+			for _, v := range vectorAlgosTestVectors(vals) {
+				test.Eq(
+					sort.IntsAreSorted(vals),
+					VectorIsSorted[int, widgets.BuiltinInt](v),
+					t,
+				)
+				VectorSort[int, widgets.BuiltinInt](v)
+				checkVectorVals(exp, v, t)
+				test.True(VectorIsSorted[int, widgets.BuiltinInt](v), t)
+			}
+		}
+	}
+}
+
+func TestVectorSortAdversarialInputs(t *testing.T) {
+	n := 2000
+	inputs := [][]int{make([]int, n), make([]int, n), make([]int, n)}
+	for i := 0; i < n; i++ {
+		inputs[0][i] = i
+		inputs[1][i] = n - i
+		inputs[2][i] = i % 2
+	}
+	for _, vals := range inputs {
+		exp := append([]int{}, vals...)
+		sort.Ints(exp)
+		v := Vector[int, widgets.BuiltinInt](vals)
+		VectorSort[int, widgets.BuiltinInt](&v)
+		checkVectorVals(exp, &v, t)
+	}
+}
+
+func TestVectorStableSort(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 19, 20, 21, 100, 1000} {
+		v := make(Vector[vectorAlgosStableVal, vectorAlgosStableVal], n)
+		for i := 0; i < n; i++ {
+			v[i] = vectorAlgosStableVal{key: r.Intn(10), order: i}
+		}
+		exp := append([]vectorAlgosStableVal{}, v...)
+		sort.SliceStable(exp, func(i, j int) bool { return exp[i].key < exp[j].key })
+		VectorStableSort[vectorAlgosStableVal, vectorAlgosStableVal](&v)
+		test.SlicesMatch[vectorAlgosStableVal](exp, v, t)
+	}
+
+	r = rand.New(rand.NewSource(2))
+	for _, n := range []int{0, 1, 50, 1000} {
+		vals := vectorAlgosRandVals(n, 100, r)
+		exp := append([]int{}, vals...)
+		sort.Ints(exp)
+		for _, v := range vectorAlgosTestVectors(vals) {
+			VectorStableSort[int, widgets.BuiltinInt](v)
+			checkVectorVals(exp, v, t)
+		}
+	}
+}
+
+func TestVectorBounds(t *testing.T) {
+	for _, v := range vectorAlgosTestVectors([]int{1, 3, 3, 3, 5, 7}) {
+		test.Eq(0, VectorLowerBound[int, widgets.BuiltinInt](v, 0), t)
+		test.Eq(0, VectorLowerBound[int, widgets.BuiltinInt](v, 1), t)
+		test.Eq(1, VectorLowerBound[int, widgets.BuiltinInt](v, 2), t)
+		test.Eq(1, VectorLowerBound[int, widgets.BuiltinInt](v, 3), t)
+		test.Eq(6, VectorLowerBound[int, widgets.BuiltinInt](v, 8), t)
+		test.Eq(0, VectorUpperBound[int, widgets.BuiltinInt](v, 0), t)
+		test.Eq(1, VectorUpperBound[int, widgets.BuiltinInt](v, 1), t)
+		test.Eq(4, VectorUpperBound[int, widgets.BuiltinInt](v, 3), t)
+		test.Eq(6, VectorUpperBound[int, widgets.BuiltinInt](v, 7), t)
+
+		idx, found := VectorBinarySearch[int, widgets.BuiltinInt](v, 3)
+		test.Eq(1, idx, t)
+		test.True(found, t)
+		idx, found = VectorBinarySearch[int, widgets.BuiltinInt](v, 7)
+		test.Eq(5, idx, t)
+		test.True(found, t)
+		idx, found = VectorBinarySearch[int, widgets.BuiltinInt](v, 4)
+		test.Eq(4, idx, t)
+		test.False(found, t)
+		idx, found = VectorBinarySearch[int, widgets.BuiltinInt](v, 8)
+		test.Eq(6, idx, t)
+		test.False(found, t)
+	}
+	for _, v := range vectorAlgosTestVectors([]int{}) {
+		test.Eq(0, VectorLowerBound[int, widgets.BuiltinInt](v, 1), t)
+		test.Eq(0, VectorUpperBound[int, widgets.BuiltinInt](v, 1), t)
+		idx, found := VectorBinarySearch[int, widgets.BuiltinInt](v, 1)
+		test.Eq(0, idx, t)
+		test.False(found, t)
+	}
+}
+
+func TestVectorNthElement(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, n := range []int{1, 5, 13, 100, 1000} {
+		for _, maxVal := range []int{3, 1 << 30} {
+			vals := vectorAlgosRandVals(n, maxVal, r)
+			exp := append([]int{}, vals...)
+			sort.Ints(exp)
+			for _, nth := range []int{0, n / 3, n / 2, n - 1} {
+				for _, v := range vectorAlgosTestVectors(vals) {
+					test.Nil(VectorNthElement[int, widgets.BuiltinInt](v, nth), t)
+					res, _ := v.Vals().Collect()
+					test.Eq(exp[nth], res[nth], t)
+					for i := 0; i < nth; i++ {
+						test.True(res[i] <= res[nth], t)
+					}
+					for i := nth + 1; i < n; i++ {
+						test.True(res[i] >= res[nth], t)
+					}
+				}
+			}
+		}
+	}
+	for _, v := range vectorAlgosTestVectors([]int{1, 2}) {
+		err := VectorNthElement[int, widgets.BuiltinInt](v, 2)
+		test.ContainsError(containerTypes.KeyError, err, t)
+		test.ContainsError(customerr.ValOutsideRange, err, t)
+		err = VectorNthElement[int, widgets.BuiltinInt](v, -1)
+		test.ContainsError(containerTypes.KeyError, err, t)
+	}
+}
+
+func TestVectorReverse(t *testing.T) {
+	for _, v := range vectorAlgosTestVectors([]int{}) {
+		VectorReverse[int](v)
+		checkVectorVals([]int{}, v, t)
+	}
+	for _, v := range vectorAlgosTestVectors([]int{1, 2, 3, 4, 5}) {
+		VectorReverse[int](v)
+		checkVectorVals([]int{5, 4, 3, 2, 1}, v, t)
+	}
+	for _, v := range vectorAlgosTestVectors([]int{1, 2, 3, 4}) {
+		VectorReverse[int](v)
+		checkVectorVals([]int{4, 3, 2, 1}, v, t)
+	}
+}
+
+func TestVectorPartition(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	even := func(val *int) bool { return *val%2 == 0 }
+	for _, n := range []int{0, 1, 2, 10, 101} {
+		vals := vectorAlgosRandVals(n, 100, r)
+		numEven := 0
+		for _, iterV := range vals {
+			if iterV%2 == 0 {
+				numEven++
+			}
+		}
+		for _, v := range vectorAlgosTestVectors(vals) {
+			test.Eq(numEven, VectorPartition[int](v, even), t)
+			res, _ := v.Vals().Collect()
+			for i := 0; i < n; i++ {
+				test.Eq(i < numEven, even(&res[i]), t)
+			}
+			test.SlicesMatchUnordered[int](vals, res, t)
+		}
+	}
+	for _, v := range vectorAlgosTestVectors([]int{2, 4, 6}) {
+		test.Eq(3, VectorPartition[int](v, even), t)
+		checkVectorVals([]int{2, 4, 6}, v, t)
+		test.Eq(0, VectorPartition[int](v, func(val *int) bool { return false }), t)
+	}
+}
+
+func TestVectorAlgosSyncedVectorHoldsLock(t *testing.T) {
+	v, _ := NewSyncedVector[int, widgets.BuiltinInt](0)
+	r := rand.New(rand.NewSource(5))
+	v.Append(vectorAlgosRandVals(1000, 1000, r)...)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				v.Append(i*1000 + j)
+				if j%2 == 0 {
+					VectorSort[int, widgets.BuiltinInt](&v)
+				} else {
+					VectorStableSort[int, widgets.BuiltinInt](&v)
+				}
+				// Other threads may have appended since the sort, so only the
+				// read locks are being exercised here.
+				VectorIsSorted[int, widgets.BuiltinInt](&v)
+				VectorLowerBound[int, widgets.BuiltinInt](&v, 500)
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.Eq(1080, v.Length(), t)
+	VectorSort[int, widgets.BuiltinInt](&v)
+	test.True(VectorIsSorted[int, widgets.BuiltinInt](&v), t)
+}
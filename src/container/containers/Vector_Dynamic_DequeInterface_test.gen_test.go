@@ -69,6 +69,10 @@ func TestVector_DynDequeInterfaceInterface(t *testing.T) {
 	tests.DynDequeInterfaceInterface(VectorToDequeInterfaceFactory, t)
 }
 
+func TestVector_DynDequeInterfaceSerializableInterface(t *testing.T) {
+	tests.DynDequeInterfaceSerializableInterface(VectorToDequeInterfaceFactory, t)
+}
+
 func TestVector_DynDequeInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynDequeInterfaceStaticCapacityInterface(VectorToDequeInterfaceFactory, t)
 }
@@ -116,3 +120,11 @@ func TestVector_DynDequeInterfacePushBack(t *testing.T) {
 func TestVector_DynDequeInterfaceForcePushBack(t *testing.T) {
 	tests.DynDequeInterfaceForcePushBack(VectorToDequeInterfaceFactory, t)
 }
+
+func TestVector_DynDequeInterfaceJSON(t *testing.T) {
+	tests.DynDequeInterfaceJSON(VectorToDequeInterfaceFactory, t)
+}
+
+func TestVector_DynDequeInterfaceBinary(t *testing.T) {
+	tests.DynDequeInterfaceBinary(VectorToDequeInterfaceFactory, t)
+}
@@ -57,6 +57,10 @@ func TestVector_DynQueueInterfaceInterface(t *testing.T) {
 	tests.DynQueueInterfaceInterface(VectorToQueueInterfaceFactory, t)
 }
 
+func TestVector_DynQueueInterfaceSerializableInterface(t *testing.T) {
+	tests.DynQueueInterfaceSerializableInterface(VectorToQueueInterfaceFactory, t)
+}
+
 func TestVector_DynQueueInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynQueueInterfaceStaticCapacityInterface(VectorToQueueInterfaceFactory, t)
 }
@@ -84,3 +88,11 @@ func TestVector_DynQueueInterfacePushBack(t *testing.T) {
 func TestVector_DynQueueInterfaceForcePushBack(t *testing.T) {
 	tests.DynQueueInterfaceForcePushBack(VectorToQueueInterfaceFactory, t)
 }
+
+func TestVector_DynQueueInterfaceJSON(t *testing.T) {
+	tests.DynQueueInterfaceJSON(VectorToQueueInterfaceFactory, t)
+}
+
+func TestVector_DynQueueInterfaceBinary(t *testing.T) {
+	tests.DynQueueInterfaceBinary(VectorToQueueInterfaceFactory, t)
+}
@@ -53,6 +53,10 @@ func TestVector_DynSetInterfaceInterface(t *testing.T) {
 	tests.DynSetInterfaceInterface(VectorToSetInterfaceFactory, t)
 }
 
+func TestVector_DynSetInterfaceSerializableInterface(t *testing.T) {
+	tests.DynSetInterfaceSerializableInterface(VectorToSetInterfaceFactory, t)
+}
+
 func TestVector_DynSetInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynSetInterfaceStaticCapacityInterface(VectorToSetInterfaceFactory, t)
 }
@@ -120,3 +124,11 @@ func TestVector_DynSetInterfaceIsSuperset(t *testing.T) {
 func TestVector_DynSetInterfaceIsSubset(t *testing.T) {
 	tests.DynSetInterfaceIsSubset(VectorToSetInterfaceFactory, t)
 }
+
+func TestVector_DynSetInterfaceJSON(t *testing.T) {
+	tests.DynSetInterfaceJSON(VectorToSetInterfaceFactory, t)
+}
+
+func TestVector_DynSetInterfaceBinary(t *testing.T) {
+	tests.DynSetInterfaceBinary(VectorToSetInterfaceFactory, t)
+}
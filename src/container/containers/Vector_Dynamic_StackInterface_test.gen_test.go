@@ -57,6 +57,10 @@ func TestVector_DynStackInterfaceInterface(t *testing.T) {
 	tests.DynStackInterfaceInterface(VectorToStackInterfaceFactory, t)
 }
 
+func TestVector_DynStackInterfaceSerializableInterface(t *testing.T) {
+	tests.DynStackInterfaceSerializableInterface(VectorToStackInterfaceFactory, t)
+}
+
 func TestVector_DynStackInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynStackInterfaceStaticCapacityInterface(VectorToStackInterfaceFactory, t)
 }
@@ -84,3 +88,11 @@ func TestVector_DynStackInterfacePushBack(t *testing.T) {
 func TestVector_DynStackInterfaceForcePushBack(t *testing.T) {
 	tests.DynStackInterfaceForcePushBack(VectorToStackInterfaceFactory, t)
 }
+
+func TestVector_DynStackInterfaceJSON(t *testing.T) {
+	tests.DynStackInterfaceJSON(VectorToStackInterfaceFactory, t)
+}
+
+func TestVector_DynStackInterfaceBinary(t *testing.T) {
+	tests.DynStackInterfaceBinary(VectorToStackInterfaceFactory, t)
+}
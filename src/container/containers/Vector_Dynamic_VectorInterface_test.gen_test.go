@@ -85,6 +85,10 @@ func TestVector_DynVectorInterfaceInterface(t *testing.T) {
 	tests.DynVectorInterfaceInterface(VectorToVectorInterfaceFactory, t)
 }
 
+func TestVector_DynVectorInterfaceSerializableInterface(t *testing.T) {
+	tests.DynVectorInterfaceSerializableInterface(VectorToVectorInterfaceFactory, t)
+}
+
 func TestVector_DynVectorInterfaceStaticCapacityInterface(t *testing.T) {
 	tests.DynVectorInterfaceStaticCapacityInterface(VectorToVectorInterfaceFactory, t)
 }
@@ -196,3 +200,11 @@ func TestVector_DynVectorInterfaceIsSuperset(t *testing.T) {
 func TestVector_DynVectorInterfaceIsSubset(t *testing.T) {
 	tests.DynVectorInterfaceIsSubset(VectorToVectorInterfaceFactory, t)
 }
+
+func TestVector_DynVectorInterfaceJSON(t *testing.T) {
+	tests.DynVectorInterfaceJSON(VectorToVectorInterfaceFactory, t)
+}
+
+func TestVector_DynVectorInterfaceBinary(t *testing.T) {
+	tests.DynVectorInterfaceBinary(VectorToVectorInterfaceFactory, t)
+}
@@ -2,6 +2,7 @@ package containers
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/barbell-math/util/src/test"
@@ -125,3 +126,29 @@ func TestVectorString(t *testing.T) {
 	v := Vector[int, widgets.BuiltinInt]{1, 2, 3}
 	test.Eq("vec[1 2 3]", v.String(), t)
 }
+
+func TestVectorSeq(t *testing.T) {
+	v := Vector[int, widgets.BuiltinInt]([]int{1, 2, 3})
+	test.SlicesMatch[int]([]int{1, 2, 3}, slices.Collect(v.ValsSeq()), t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, slices.Collect(v.KeysSeq()), t)
+	sum := 0
+	for val := range v.ValsSeq() {
+		sum += val
+	}
+	test.Eq(6, sum, t)
+}
+
+func TestSyncedVectorSeqLock(t *testing.T) {
+	v := SyncedVectorValInit[int, widgets.BuiltinInt](1, 2, 3)
+	cntr := 0
+	for range v.ValsSeq() {
+		test.False(v.RWMutex.TryLock(), t)
+		cntr++
+		if cntr == 2 {
+			break
+		}
+	}
+	test.Eq(2, cntr, t)
+	test.True(v.RWMutex.TryLock(), t)
+	v.RWMutex.Unlock()
+}
@@ -0,0 +1,23 @@
+package dynamicContainers
+
+import "github.com/barbell-math/util/src/container/containerTypes"
+
+// An interface that only allows read operations on a bi map.
+type ReadBiMap[K any, V any] interface {
+	ReadMap[K, V]
+	containerTypes.ReadBiKeyedOps[K, V]
+}
+
+// An interface that only allows write operations on a bi map.
+type WriteBiMap[K any, V any] interface {
+	WriteMap[K, V]
+}
+
+// An interface that represents a bi map with no restrictions on reading or
+// writing. A bi map enforces uniqueness on both its keys and values, allowing
+// lookups to be performed in either direction.
+type BiMap[K any, V any] interface {
+	ReadBiMap[K, V]
+	WriteBiMap[K, V]
+	containerTypes.Serializable
+}
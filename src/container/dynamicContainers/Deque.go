@@ -29,4 +29,5 @@ type WriteDeque[V any] interface {
 type Deque[V any] interface {
 	ReadDeque[V]
 	WriteDeque[V]
+	containerTypes.Serializable
 }
@@ -38,4 +38,42 @@ type WriteDirectedGraph[V any, E any] interface {
 type DirectedGraph[V any, E any] interface {
 	ReadDirectedGraph[V, E]
 	WriteDirectedGraph[V, E]
+	containerTypes.Serializable
+}
+
+// An interface that only allows read operations in an undirected graph.
+type ReadUndirectedGraph[V any, E any] interface {
+	containerTypes.RWSyncable
+	containerTypes.Addressable
+	containerTypes.ReadUndirectedGraphOps[V, E]
+	containerTypes.Comparisons[
+		containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+		E,
+	]
+	containerTypes.KeyedComparisons[
+		containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+		V,
+		E,
+	]
+}
+
+// An interface that only allows write operations on an undirected graph.
+type WriteUndirectedGraph[V any, E any] interface {
+	containerTypes.RWSyncable
+	containerTypes.Clear
+	containerTypes.WriteGraphOps[V, E]
+	containerTypes.DeleteGraphOps[V, E]
+	containerTypes.DeleteUndirectedGraphOps[V, E]
+	containerTypes.SetOperations[
+		containerTypes.UndirectedGraphComparisonsConstraint[V, E],
+		V,
+	]
+}
+
+// An interface that represents an undirected graph with no restrictions on
+// reading or writing.
+type UndirectedGraph[V any, E any] interface {
+	ReadUndirectedGraph[V, E]
+	WriteUndirectedGraph[V, E]
+	containerTypes.Serializable
 }
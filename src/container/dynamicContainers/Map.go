@@ -32,4 +32,5 @@ type WriteMap[K any, V any] interface {
 type Map[K any, V any] interface {
 	ReadMap[K, V]
 	WriteMap[K, V]
+	containerTypes.Serializable
 }
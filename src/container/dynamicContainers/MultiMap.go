@@ -0,0 +1,31 @@
+package dynamicContainers
+
+import "github.com/barbell-math/util/src/container/containerTypes"
+
+// An interface that only allows read operations on a multi map.
+type ReadMultiMap[K any, V any] interface {
+	containerTypes.RWSyncable
+	containerTypes.Addressable
+	containerTypes.Length
+	containerTypes.ReadOps[V]
+	containerTypes.ReadMultiKeyedOps[K, V]
+}
+
+// An interface that only allows write operations on a multi map.
+type WriteMultiMap[K any, V any] interface {
+	containerTypes.RWSyncable
+	containerTypes.Clear
+	containerTypes.Length
+	containerTypes.WriteMultiKeyedOps[K, V]
+	containerTypes.DeleteOps[K, V]
+	containerTypes.DeleteKeyedOps[K, V]
+	containerTypes.DeleteMultiKeyedOps[K, V]
+}
+
+// An interface that represents a multi map with no restrictions on reading or
+// writing. A multi map allows a single key to be associated with many values.
+type MultiMap[K any, V any] interface {
+	ReadMultiMap[K, V]
+	WriteMultiMap[K, V]
+	containerTypes.Serializable
+}
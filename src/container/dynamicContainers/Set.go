@@ -33,4 +33,5 @@ type WriteSet[V any] interface {
 type Set[V any] interface {
 	ReadSet[V]
 	WriteSet[V]
+	containerTypes.Serializable
 }
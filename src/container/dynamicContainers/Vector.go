@@ -46,4 +46,5 @@ type WriteVector[V any] interface {
 type Vector[V any] interface {
 	ReadVector[V]
 	WriteVector[V]
+	containerTypes.Serializable
 }
@@ -0,0 +1,86 @@
+package graphAlgos
+
+import (
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// A single outgoing link in an indexed graph.
+	indexedLink[E any] struct {
+		edge E
+		to   int
+	}
+
+	// A snapshot of a graph where every vertex has been assigned a sequential
+	// id. Algorithms that need to visit the entire graph operate on this
+	// representation so that per-vertex bookkeeping can be stored in slices
+	// rather than hash maps.
+	indexedGraph[V any, E any, VI widgets.BaseInterface[V]] struct {
+		ids   containers.HashMap[V, int, VI, widgets.BuiltinInt]
+		verts []V
+		links [][]indexedLink[E]
+	}
+)
+
+func getVertexError[V any](v *V) error {
+	return customerr.WrapValueList(
+		containerTypes.ValueError,
+		"The supplied vertex was not found in the graph.",
+		[]customerr.WrapListVal{
+			{ItemName: "Vertex", Item: *v},
+		},
+	)
+}
+
+func newIndexedGraph[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+) (*indexedGraph[V, E, VI], error) {
+	var err error
+	rv := &indexedGraph[V, E, VI]{}
+	if rv.ids, err = containers.NewHashMap[
+		V, int, VI, widgets.BuiltinInt,
+	](g.NumVertices()); err != nil {
+		return nil, err
+	}
+	if rv.verts, err = g.Vertices().Collect(); err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(rv.verts); i++ {
+		rv.ids.Emplace(basic.Pair[V, int]{A: rv.verts[i], B: i})
+	}
+	rv.links = make([][]indexedLink[E], len(rv.verts))
+	for i := 0; i < len(rv.verts); i++ {
+		err = g.OutEdgesAndVertices(rv.verts[i]).ForEach(
+			func(index int, val basic.Pair[E, V]) (iter.IteratorFeedback, error) {
+				to, err := rv.ids.Get(val.B)
+				if err != nil {
+					return iter.Break, err
+				}
+				rv.links[i] = append(
+					rv.links[i], indexedLink[E]{edge: val.A, to: to},
+				)
+				return iter.Continue, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// Returns the id of the supplied vertex or an error if the vertex is not in
+// the graph.
+func (g *indexedGraph[V, E, VI]) id(v *V) (int, error) {
+	rv, err := g.ids.Get(*v)
+	if err != nil {
+		return rv, getVertexError[V](v)
+	}
+	return rv, nil
+}
@@ -0,0 +1,55 @@
+package graphAlgos
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type testGraph = containers.HashGraph[
+	int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+]
+
+// Creates a graph with the supplied number of vertices, named 0 to
+// numVertices-1. Each link is given as a from, to pair and the i'th link is
+// made with the edge i.
+func buildGraph(numVertices int, links [][2]int, t *testing.T) testGraph {
+	g, err := containers.NewHashGraph[
+		int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](numVertices, len(links))
+	test.Nil(err, t)
+	for i := 0; i < numVertices; i++ {
+		test.Nil(g.AddVertices(i), t)
+	}
+	for i, l := range links {
+		test.Nil(g.AddEdges(i), t)
+		test.Nil(g.Link(l[0], l[1], i), t)
+	}
+	return g
+}
+
+func checkSliceEq[T comparable](exp []T, act []T, t *testing.T) {
+	test.Eq(len(exp), len(act), t)
+	for i := 0; i < min(len(exp), len(act)); i++ {
+		test.Eq(exp[i], act[i], t)
+	}
+}
+
+func TestIndexedGraph(t *testing.T) {
+	g := buildGraph(3, [][2]int{{0, 1}, {0, 2}, {1, 2}}, t)
+	ig, err := newIndexedGraph[int, int, widgets.BuiltinInt](&g)
+	test.Nil(err, t)
+	test.Eq(3, len(ig.verts), t)
+	for i, v := range ig.verts {
+		id, err := ig.id(&v)
+		test.Nil(err, t)
+		test.Eq(i, id, t)
+	}
+	missing := 5
+	_, err = ig.id(&missing)
+	test.NotNil(err, t)
+	zero, _ := ig.id(new(int))
+	test.Eq(2, len(ig.links[zero]), t)
+}
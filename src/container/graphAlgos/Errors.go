@@ -0,0 +1,8 @@
+package graphAlgos
+
+import "errors"
+
+var Cycle = errors.New("The graph contains a cycle.")
+var NegativeWeight = errors.New("The graph contains an edge with a negative weight.")
+var NegativeCycle = errors.New("The graph contains a cycle with a negative total weight.")
+var Unreachable = errors.New("The supplied vertex is not reachable from the start vertex.")
@@ -0,0 +1,300 @@
+package graphAlgos
+
+import (
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/hash"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type (
+	// The result of a single source shortest path search. Holds the total
+	// weight of the shortest path from the start vertex to every reachable
+	// vertex, along with enough information to reconstruct each path. Weights
+	// are combined using the WI widgets Add method and compared using the WI
+	// widgets Lt method.
+	ShortestPaths[
+		V any,
+		W any,
+		VI widgets.BaseInterface[V],
+		WI widgets.PartialOrderArithInterface[W],
+	] struct {
+		start   int
+		ids     *containers.HashMap[V, int, VI, widgets.BuiltinInt]
+		verts   []V
+		dist    []W
+		prev    []int
+		reached []bool
+	}
+
+	pathEntry[W any] struct {
+		dist W
+		id   int
+	}
+
+	pathEntryWidget[W any, WI widgets.PartialOrderArithInterface[W]] struct{}
+)
+
+func (_ pathEntryWidget[W, WI]) Eq(l *pathEntry[W], r *pathEntry[W]) bool {
+	w := widgets.PartialOrderArith[W, WI]{}
+	return l.id == r.id && w.Eq(&l.dist, &r.dist)
+}
+func (_ pathEntryWidget[W, WI]) Hash(other *pathEntry[W]) hash.Hash {
+	w := widgets.PartialOrderArith[W, WI]{}
+	return hash.Hash(other.id).Combine(w.Hash(&other.dist))
+}
+func (_ pathEntryWidget[W, WI]) Zero(other *pathEntry[W]) {
+	*other = pathEntry[W]{}
+}
+func (_ pathEntryWidget[W, WI]) Lt(l *pathEntry[W], r *pathEntry[W]) bool {
+	w := widgets.PartialOrderArith[W, WI]{}
+	return w.Lt(&l.dist, &r.dist)
+}
+
+func newShortestPaths[
+	V any,
+	E any,
+	W any,
+	VI widgets.BaseInterface[V],
+	WI widgets.PartialOrderArithInterface[W],
+](
+	ig *indexedGraph[V, E, VI],
+	start *V,
+) (ShortestPaths[V, W, VI, WI], error) {
+	s, err := ig.id(start)
+	if err != nil {
+		return ShortestPaths[V, W, VI, WI]{}, err
+	}
+	rv := ShortestPaths[V, W, VI, WI]{
+		start:   s,
+		ids:     &ig.ids,
+		verts:   ig.verts,
+		dist:    make([]W, len(ig.verts)),
+		prev:    make([]int, len(ig.verts)),
+		reached: make([]bool, len(ig.verts)),
+	}
+	w := widgets.PartialOrderArith[W, WI]{}
+	rv.dist[s] = w.ZeroVal()
+	rv.prev[s] = -1
+	rv.reached[s] = true
+	return rv, nil
+}
+
+// Description: Computes the shortest path from the start vertex to every
+// reachable vertex in the supplied graph using Dijkstra's algorithm. The
+// weight of each edge is determined by the weight function. All weights must
+// be non-negative, a [NegativeWeight] error will be returned if a negative
+// weight is found. An error will be returned if the start vertex is not in the
+// graph.
+//
+// Time Complexity: O((v+e)*log(v))
+func Dijkstra[
+	V any,
+	E any,
+	W any,
+	VI widgets.BaseInterface[V],
+	WI widgets.PartialOrderArithInterface[W],
+](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	start V,
+	weight func(e E) W,
+) (ShortestPaths[V, W, VI, WI], error) {
+	ig, err := newIndexedGraph[V, E, VI](g)
+	if err != nil {
+		return ShortestPaths[V, W, VI, WI]{}, err
+	}
+	rv, err := newShortestPaths[V, E, W, VI, WI](ig, &start)
+	if err != nil {
+		return rv, err
+	}
+
+	w := widgets.PartialOrderArith[W, WI]{}
+	zero := w.ZeroVal()
+	done := make([]bool, len(ig.verts))
+	handles := make([]*containers.PriorityQueueHandle[pathEntry[W]], len(ig.verts))
+	queue, _ := containers.NewPriorityQueue[
+		pathEntry[W], pathEntryWidget[W, WI],
+	](0, false)
+	handles[rv.start] = queue.Push(pathEntry[W]{dist: zero, id: rv.start})
+	for queue.Length() > 0 {
+		cur, _ := queue.Pop()
+		done[cur.id] = true
+		for _, l := range ig.links[cur.id] {
+			ew := weight(l.edge)
+			if w.Lt(&ew, &zero) {
+				return rv, customerr.WrapValueList(
+					NegativeWeight,
+					"Dijkstra's algorithm requires non-negative edge weights.",
+					[]customerr.WrapListVal{
+						{ItemName: "Edge", Item: l.edge},
+						{ItemName: "Weight", Item: ew},
+					},
+				)
+			}
+			if done[l.to] {
+				continue
+			}
+			var nd W
+			w.Add(&nd, &cur.dist, &ew)
+			if !rv.reached[l.to] {
+				rv.reached[l.to] = true
+				rv.dist[l.to] = nd
+				rv.prev[l.to] = cur.id
+				handles[l.to] = queue.Push(pathEntry[W]{dist: nd, id: l.to})
+			} else if w.Lt(&nd, &rv.dist[l.to]) {
+				rv.dist[l.to] = nd
+				rv.prev[l.to] = cur.id
+				queue.DecreaseKey(handles[l.to], pathEntry[W]{dist: nd, id: l.to})
+			}
+		}
+	}
+	return rv, nil
+}
+
+// Description: Computes the shortest path from the start vertex to every
+// reachable vertex in the supplied graph using the Bellman-Ford algorithm. The
+// weight of each edge is determined by the weight function. Unlike [Dijkstra],
+// negative weights are allowed. If a cycle with a negative total weight is
+// reachable from the start vertex then a [NegativeCycle] error will be
+// returned because no shortest path exists. An error will be returned if the
+// start vertex is not in the graph.
+//
+// Time Complexity: O(v*e)
+func BellmanFord[
+	V any,
+	E any,
+	W any,
+	VI widgets.BaseInterface[V],
+	WI widgets.PartialOrderArithInterface[W],
+](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	start V,
+	weight func(e E) W,
+) (ShortestPaths[V, W, VI, WI], error) {
+	ig, err := newIndexedGraph[V, E, VI](g)
+	if err != nil {
+		return ShortestPaths[V, W, VI, WI]{}, err
+	}
+	rv, err := newShortestPaths[V, E, W, VI, WI](ig, &start)
+	if err != nil {
+		return rv, err
+	}
+
+	w := widgets.PartialOrderArith[W, WI]{}
+	relax := func() bool {
+		changed := false
+		for i := 0; i < len(ig.links); i++ {
+			if !rv.reached[i] {
+				continue
+			}
+			for _, l := range ig.links[i] {
+				var nd W
+				ew := weight(l.edge)
+				w.Add(&nd, &rv.dist[i], &ew)
+				if !rv.reached[l.to] || w.Lt(&nd, &rv.dist[l.to]) {
+					rv.reached[l.to] = true
+					rv.dist[l.to] = nd
+					rv.prev[l.to] = i
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+	for i := 0; i < len(ig.verts)-1; i++ {
+		if !relax() {
+			return rv, nil
+		}
+	}
+	if relax() {
+		return rv, customerr.Wrap(
+			NegativeCycle,
+			"No shortest paths exist from the start vertex.",
+		)
+	}
+	return rv, nil
+}
+
+// Returns the vertex that the shortest paths were computed from.
+func (s *ShortestPaths[V, W, VI, WI]) Start() V {
+	return s.verts[s.start]
+}
+
+// Returns true if there is a path from the start vertex to the supplied
+// vertex, false otherwise.
+func (s *ShortestPaths[V, W, VI, WI]) Reached(v V) bool {
+	id, err := s.ids.Get(v)
+	return err == nil && s.reached[id]
+}
+
+func (s *ShortestPaths[V, W, VI, WI]) reachedId(v *V) (int, error) {
+	id, err := s.ids.Get(*v)
+	if err != nil {
+		return -1, getVertexError[V](v)
+	}
+	if !s.reached[id] {
+		return -1, customerr.WrapValueList(
+			Unreachable,
+			"There is no path to the supplied vertex.",
+			[]customerr.WrapListVal{
+				{ItemName: "Start", Item: s.verts[s.start]},
+				{ItemName: "Vertex", Item: *v},
+			},
+		)
+	}
+	return id, nil
+}
+
+// Description: Returns the total weight of the shortest path from the start
+// vertex to the supplied vertex. An [Unreachable] error will be returned if
+// there is no path to the supplied vertex.
+//
+// Time Complexity: O(1)
+func (s *ShortestPaths[V, W, VI, WI]) Dist(v V) (W, error) {
+	id, err := s.reachedId(&v)
+	if err != nil {
+		var tmp W
+		return tmp, err
+	}
+	return s.dist[id], nil
+}
+
+// Description: Returns an iterator over the vertices along the shortest path
+// from the start vertex to the supplied vertex. Both the start vertex and the
+// supplied vertex are included. The iterator will return an [Unreachable]
+// error if there is no path to the supplied vertex.
+//
+// Time Complexity: O(p), where p=the number of vertices in the path
+func (s *ShortestPaths[V, W, VI, WI]) Path(v V) iter.Iter[V] {
+	id, err := s.reachedId(&v)
+	if err != nil {
+		var tmp V
+		return iter.ValElem[V](tmp, err, 1)
+	}
+	path := []int{}
+	for cur := id; cur != -1; cur = s.prev[cur] {
+		path = append(path, cur)
+	}
+	return iter.SequentialElems[V](
+		len(path),
+		func(i int) (V, error) { return s.verts[path[len(path)-1-i]], nil },
+	)
+}
+
+// Description: Returns an iterator over every vertex that is reachable from
+// the start vertex paired with the total weight of the shortest path to it.
+//
+// Time Complexity: O(v)
+func (s *ShortestPaths[V, W, VI, WI]) Dists() iter.Iter[basic.Pair[V, W]] {
+	return iter.Map[int, basic.Pair[V, W]](
+		iter.Range[int](0, len(s.verts), 1).Filter(
+			func(index int, val int) bool { return s.reached[val] },
+		),
+		func(index int, val int) (basic.Pair[V, W], error) {
+			return basic.Pair[V, W]{A: s.verts[val], B: s.dist[val]}, nil
+		},
+	)
+}
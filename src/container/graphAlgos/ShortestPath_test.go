@@ -0,0 +1,167 @@
+package graphAlgos
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+type weightedLink struct {
+	from   int
+	to     int
+	weight int
+}
+
+func buildWeightedGraph(
+	numVertices int,
+	links []weightedLink,
+	t *testing.T,
+) (testGraph, func(e int) int) {
+	simple := make([][2]int, len(links))
+	for i, l := range links {
+		simple[i] = [2]int{l.from, l.to}
+	}
+	return buildGraph(numVertices, simple, t), func(e int) int {
+		return links[e].weight
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	g, weight := buildWeightedGraph(6, []weightedLink{
+		{0, 1, 7}, {0, 2, 9}, {0, 5, 14}, {1, 2, 10}, {1, 3, 15}, {2, 3, 11},
+		{2, 5, 2}, {3, 4, 6}, {5, 4, 9},
+	}, t)
+	sp, err := Dijkstra[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.Nil(err, t)
+	test.Eq(0, sp.Start(), t)
+	for v, exp := range []int{0, 7, 9, 20, 20, 11} {
+		d, err := sp.Dist(v)
+		test.Nil(err, t)
+		test.Eq(exp, d, t)
+	}
+	path, err := sp.Path(4).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 2, 5, 4}, path, t)
+	path, err = sp.Path(0).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0}, path, t)
+	cnt, err := sp.Dists().Count()
+	test.Nil(err, t)
+	test.Eq(6, cnt, t)
+}
+
+func TestDijkstraUnreachable(t *testing.T) {
+	g, weight := buildWeightedGraph(3, []weightedLink{{0, 1, 1}}, t)
+	sp, err := Dijkstra[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.Nil(err, t)
+	test.True(sp.Reached(1), t)
+	test.False(sp.Reached(2), t)
+	test.False(sp.Reached(3), t)
+	_, err = sp.Dist(2)
+	test.ContainsError(Unreachable, err, t)
+	_, err = sp.Path(2).Collect()
+	test.ContainsError(Unreachable, err, t)
+	_, err = sp.Dist(3)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	_, err = Dijkstra[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 3, weight)
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestDijkstraNegativeWeight(t *testing.T) {
+	g, weight := buildWeightedGraph(3, []weightedLink{
+		{0, 1, 1}, {1, 2, -1},
+	}, t)
+	_, err := Dijkstra[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.ContainsError(NegativeWeight, err, t)
+}
+
+func TestDijkstraFloat(t *testing.T) {
+	g, weight := buildWeightedGraph(3, []weightedLink{
+		{0, 1, 1}, {1, 2, 1}, {0, 2, 3},
+	}, t)
+	sp, err := Dijkstra[
+		int, int, float64, widgets.BuiltinInt, widgets.BuiltinFloat64,
+	](&g, 0, func(e int) float64 { return float64(weight(e)) / 2 })
+	test.Nil(err, t)
+	d, err := sp.Dist(2)
+	test.Nil(err, t)
+	test.Eq(1.0, d, t)
+}
+
+func TestBellmanFord(t *testing.T) {
+	g, weight := buildWeightedGraph(5, []weightedLink{
+		{0, 1, 6}, {0, 2, 7}, {1, 2, 8}, {1, 3, 5}, {1, 4, -4}, {2, 3, -3},
+		{2, 4, 9}, {3, 1, -2}, {4, 0, 2}, {4, 3, 7},
+	}, t)
+	sp, err := BellmanFord[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.Nil(err, t)
+	for v, exp := range []int{0, 2, 7, 4, -2} {
+		d, err := sp.Dist(v)
+		test.Nil(err, t)
+		test.Eq(exp, d, t)
+	}
+	path, err := sp.Path(4).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 2, 3, 1, 4}, path, t)
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g, weight := buildWeightedGraph(4, []weightedLink{
+		{0, 1, 1}, {1, 2, -1}, {2, 1, -1}, {3, 0, 1},
+	}, t)
+	_, err := BellmanFord[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.ContainsError(NegativeCycle, err, t)
+	// The negative cycle is not reachable from 3's perspective when starting
+	// from a vertex with no path to it
+	g2, weight2 := buildWeightedGraph(4, []weightedLink{
+		{1, 2, -1}, {2, 1, -1}, {0, 3, 1},
+	}, t)
+	sp, err := BellmanFord[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g2, 0, weight2)
+	test.Nil(err, t)
+	d, _ := sp.Dist(3)
+	test.Eq(1, d, t)
+}
+
+func TestDijkstraMatchesBellmanFord(t *testing.T) {
+	links := []weightedLink{}
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			if (i*7+j*3)%5 == 0 && i != j {
+				links = append(links, weightedLink{i, j, (i*j)%11 + 1})
+			}
+		}
+	}
+	g, weight := buildWeightedGraph(20, links, t)
+	d, err := Dijkstra[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.Nil(err, t)
+	b, err := BellmanFord[
+		int, int, int, widgets.BuiltinInt, widgets.BuiltinInt,
+	](&g, 0, weight)
+	test.Nil(err, t)
+	for i := 0; i < 20; i++ {
+		test.Eq(b.Reached(i), d.Reached(i), t)
+		if d.Reached(i) {
+			dd, _ := d.Dist(i)
+			bd, _ := b.Dist(i)
+			test.Eq(bd, dd, t)
+		}
+	}
+}
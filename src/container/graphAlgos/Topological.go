@@ -0,0 +1,199 @@
+package graphAlgos
+
+import (
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+// Description: Returns the vertices of the supplied graph in topological
+// order, meaning that for every link from u to v, u will be placed before v.
+// If the graph contains a cycle then a [Cycle] error will be returned and the
+// returned vector will contain the vertices of one of the cycles in the graph,
+// in the order they are linked. The first vertex of the cycle is not repeated
+// at the end of the vector.
+//
+// Time Complexity: O(v+e)
+func TopologicalSort[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+) (containers.Vector[V, VI], error) {
+	ig, err := newIndexedGraph[V, E, VI](g)
+	if err != nil {
+		return containers.Vector[V, VI]{}, err
+	}
+	inDegree := make([]int, len(ig.verts))
+	for i := 0; i < len(ig.links); i++ {
+		for _, l := range ig.links[i] {
+			inDegree[l.to]++
+		}
+	}
+	queue := make([]int, 0, len(ig.verts))
+	for i := 0; i < len(inDegree); i++ {
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	for i := 0; i < len(queue); i++ {
+		for _, l := range ig.links[queue[i]] {
+			inDegree[l.to]--
+			if inDegree[l.to] == 0 {
+				queue = append(queue, l.to)
+			}
+		}
+	}
+	if len(queue) == len(ig.verts) {
+		rv := make([]V, len(queue))
+		for i := 0; i < len(queue); i++ {
+			rv[i] = ig.verts[queue[i]]
+		}
+		return containers.VectorValInit[V, VI](rv...), nil
+	}
+
+	cycle := ig.findCycle(inDegree)
+	rv := make([]V, len(cycle))
+	for i := 0; i < len(cycle); i++ {
+		rv[i] = ig.verts[cycle[i]]
+	}
+	return containers.VectorValInit[V, VI](rv...), customerr.WrapValueList(
+		Cycle,
+		"A topological ordering does not exist.",
+		[]customerr.WrapListVal{
+			{ItemName: "Cycle", Item: rv},
+		},
+	)
+}
+
+// Finds a cycle among the vertices that have a non-zero in degree. Every such
+// vertex has at least one predecessor that also has a non-zero in degree, so
+// walking backwards along those predecessors must eventually repeat a vertex.
+func (g *indexedGraph[V, E, VI]) findCycle(inDegree []int) []int {
+	pred := make([]int, len(g.verts))
+	for i := 0; i < len(pred); i++ {
+		pred[i] = -1
+	}
+	start := -1
+	for i := 0; i < len(g.links); i++ {
+		if inDegree[i] == 0 {
+			continue
+		}
+		for _, l := range g.links[i] {
+			if inDegree[l.to] > 0 {
+				pred[l.to] = i
+				start = l.to
+			}
+		}
+	}
+	seen := make([]bool, len(g.verts))
+	for !seen[start] {
+		seen[start] = true
+		start = pred[start]
+	}
+	rv := []int{start}
+	for cur := pred[start]; cur != start; cur = pred[cur] {
+		rv = append(rv, cur)
+	}
+	// The cycle was found by walking backwards, reverse it to follow the links
+	for i, j := 0, len(rv)-1; i < j; i, j = i+1, j-1 {
+		rv[i], rv[j] = rv[j], rv[i]
+	}
+	return rv
+}
+
+// Description: Returns an iterator over the strongly connected components of
+// the supplied graph, computed using Tarjan's algorithm. Every vertex in the
+// graph will be in exactly one component. Components are returned in reverse
+// topological order, meaning no component has a link to a component that is
+// returned after it. The components are computed the first time the iterator
+// is consumed.
+//
+// Time Complexity: O(v+e)
+func StronglyConnectedComponents[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+) iter.Iter[containers.Vector[V, VI]] {
+	var comps []containers.Vector[V, VI]
+	initialized := false
+	i := -1
+	return func(f iter.IteratorFeedback) (containers.Vector[V, VI], error, bool) {
+		if f == iter.Break {
+			return nil, nil, false
+		}
+		if !initialized {
+			initialized = true
+			ig, err := newIndexedGraph[V, E, VI](g)
+			if err != nil {
+				return nil, err, false
+			}
+			comps = ig.tarjan()
+		}
+		i++
+		if i < len(comps) {
+			return comps[i], nil, true
+		}
+		return nil, nil, false
+	}
+}
+
+func (g *indexedGraph[V, E, VI]) tarjan() []containers.Vector[V, VI] {
+	type frame struct {
+		v       int
+		edgeIdx int
+	}
+	rv := []containers.Vector[V, VI]{}
+	cntr := 0
+	idx := make([]int, len(g.verts))
+	low := make([]int, len(g.verts))
+	onStack := make([]bool, len(g.verts))
+	stack := []int{}
+	calls := []frame{}
+	for i := 0; i < len(idx); i++ {
+		idx[i] = -1
+	}
+	visit := func(v int) {
+		idx[v], low[v] = cntr, cntr
+		cntr++
+		stack = append(stack, v)
+		onStack[v] = true
+		calls = append(calls, frame{v: v})
+	}
+	for s := 0; s < len(g.verts); s++ {
+		if idx[s] != -1 {
+			continue
+		}
+		visit(s)
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.v
+			if top.edgeIdx < len(g.links[v]) {
+				w := g.links[v][top.edgeIdx].to
+				top.edgeIdx++
+				if idx[w] == -1 {
+					visit(w)
+				} else if onStack[w] {
+					low[v] = min(low[v], idx[w])
+				}
+				continue
+			}
+			calls = calls[:len(calls)-1]
+			if low[v] == idx[v] {
+				comp, _ := containers.NewVector[V, VI](0)
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					comp.Append(g.verts[w])
+					if w == v {
+						break
+					}
+				}
+				rv = append(rv, comp)
+			}
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].v
+				low[parent] = min(low[parent], low[v])
+			}
+		}
+	}
+	return rv
+}
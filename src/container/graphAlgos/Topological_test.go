@@ -0,0 +1,103 @@
+package graphAlgos
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func checkTopologicalOrder(
+	g *testGraph,
+	order containers.Vector[int, widgets.BuiltinInt],
+	t *testing.T,
+) {
+	test.Eq(g.NumVertices(), order.Length(), t)
+	pos := map[int]int{}
+	for i, v := range order {
+		pos[v] = i
+	}
+	for _, v := range order {
+		next, err := g.OutVertices(v).Collect()
+		test.Nil(err, t)
+		for _, n := range next {
+			test.True(pos[v] < pos[n], t)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := buildGraph(6, [][2]int{
+		{5, 2}, {5, 0}, {4, 0}, {4, 1}, {2, 3}, {3, 1},
+	}, t)
+	order, err := TopologicalSort[int, int, widgets.BuiltinInt](&g)
+	test.Nil(err, t)
+	checkTopologicalOrder(&g, order, t)
+}
+
+func TestTopologicalSortEmpty(t *testing.T) {
+	g := buildGraph(0, [][2]int{}, t)
+	order, err := TopologicalSort[int, int, widgets.BuiltinInt](&g)
+	test.Nil(err, t)
+	test.Eq(0, order.Length(), t)
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := buildGraph(6, [][2]int{
+		{0, 1}, {1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5},
+	}, t)
+	cycle, err := TopologicalSort[int, int, widgets.BuiltinInt](&g)
+	test.ContainsError(Cycle, err, t)
+	test.Eq(3, cycle.Length(), t)
+	for i := 0; i < cycle.Length(); i++ {
+		cnt, _ := g.EdgesBetween(cycle[i], cycle[(i+1)%cycle.Length()]).Count()
+		test.Eq(1, cnt, t)
+	}
+}
+
+func TestTopologicalSortSelfLoop(t *testing.T) {
+	g := buildGraph(2, [][2]int{{0, 1}, {1, 1}}, t)
+	cycle, err := TopologicalSort[int, int, widgets.BuiltinInt](&g)
+	test.ContainsError(Cycle, err, t)
+	test.Eq(1, cycle.Length(), t)
+	test.Eq(1, cycle[0], t)
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := buildGraph(8, [][2]int{
+		{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}, {6, 5},
+		{6, 7}, {7, 6},
+	}, t)
+	comps, err := StronglyConnectedComponents[int, int, widgets.BuiltinInt](
+		&g,
+	).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(comps), t)
+	compOf := map[int]int{}
+	for i, c := range comps {
+		for _, v := range c {
+			_, ok := compOf[v]
+			test.False(ok, t)
+			compOf[v] = i
+		}
+	}
+	test.Eq(8, len(compOf), t)
+	test.Eq(compOf[0], compOf[1], t)
+	test.Eq(compOf[0], compOf[2], t)
+	test.Eq(compOf[3], compOf[4], t)
+	test.Eq(compOf[3], compOf[5], t)
+	test.Eq(compOf[6], compOf[7], t)
+	// Components are returned in reverse topological order
+	test.True(compOf[3] < compOf[0], t)
+	test.True(compOf[3] < compOf[6], t)
+}
+
+func TestStronglyConnectedComponentsDAG(t *testing.T) {
+	g := buildGraph(4, [][2]int{{0, 1}, {1, 2}, {0, 3}}, t)
+	cnt, err := StronglyConnectedComponents[int, int, widgets.BuiltinInt](
+		&g,
+	).Count()
+	test.Nil(err, t)
+	test.Eq(4, cnt, t)
+}
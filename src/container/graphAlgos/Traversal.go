@@ -0,0 +1,204 @@
+package graphAlgos
+
+import (
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containers"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+// Description: Returns an iterator that performs a breadth first search of the
+// supplied graph starting at the start vertex. Every vertex that is reachable
+// from the start vertex will be returned exactly once, including the start
+// vertex. The iterator is lazy, vertices are only visited as the iterator is
+// consumed. The iterator will return an error if the start vertex is not in
+// the graph. Vertex equality is determined by the VI widget.
+//
+// Time Complexity: O(v+e)
+func BFS[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	start V,
+) iter.Iter[V] {
+	if !g.ContainsVertex(start) {
+		var tmp V
+		return iter.ValElem[V](tmp, getVertexError[V](&start), 1)
+	}
+	visited, _ := containers.NewHashSet[V, VI](0)
+	visited.AppendUnique(start)
+	queue := []V{start}
+	return func(f iter.IteratorFeedback) (V, error, bool) {
+		var tmp V
+		if f == iter.Break || len(queue) == 0 {
+			return tmp, nil, false
+		}
+		cur := queue[0]
+		queue = queue[1:]
+		err := g.OutVertices(cur).ForEach(
+			func(index int, val V) (iter.IteratorFeedback, error) {
+				if !visited.Contains(val) {
+					visited.AppendUnique(val)
+					queue = append(queue, val)
+				}
+				return iter.Continue, nil
+			},
+		)
+		if err != nil {
+			return tmp, err, false
+		}
+		return cur, nil, true
+	}
+}
+
+// Description: Returns an iterator that performs a depth first search of the
+// supplied graph starting at the start vertex. Vertices are returned in
+// pre-order, meaning a vertex is returned before any of the vertices that are
+// discovered through it. Every vertex that is reachable from the start vertex
+// will be returned exactly once, including the start vertex. The iterator is
+// lazy, vertices are only visited as the iterator is consumed. The iterator
+// will return an error if the start vertex is not in the graph. Vertex
+// equality is determined by the VI widget.
+//
+// Time Complexity: O(v+e)
+func DFS[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	start V,
+) iter.Iter[V] {
+	if !g.ContainsVertex(start) {
+		var tmp V
+		return iter.ValElem[V](tmp, getVertexError[V](&start), 1)
+	}
+	visited, _ := containers.NewHashSet[V, VI](0)
+	stack := []V{start}
+	return func(f iter.IteratorFeedback) (V, error, bool) {
+		var tmp V
+		if f == iter.Break {
+			return tmp, nil, false
+		}
+		for len(stack) > 0 {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited.Contains(cur) {
+				continue
+			}
+			visited.AppendUnique(cur)
+			next, err := g.OutVertices(cur).Collect()
+			if err != nil {
+				return tmp, err, false
+			}
+			// Pushed in reverse so that the first out vertex is visited first
+			for i := len(next) - 1; i >= 0; i-- {
+				if !visited.Contains(next[i]) {
+					stack = append(stack, next[i])
+				}
+			}
+			return cur, nil, true
+		}
+		return tmp, nil, false
+	}
+}
+
+// Description: Returns true if there is a path from the from vertex to the to
+// vertex. A vertex is always reachable from itself. An error will be returned
+// if either vertex is not in the graph.
+//
+// Time Complexity: O(v+e)
+func Reachable[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	from V,
+	to V,
+) (bool, error) {
+	if !g.ContainsVertex(to) {
+		return false, getVertexError[V](&to)
+	}
+	w := widgets.Base[V, VI]{}
+	found := false
+	err := BFS[V, E, VI](g, from).ForEach(
+		func(index int, val V) (iter.IteratorFeedback, error) {
+			if w.Eq(&val, &to) {
+				found = true
+				return iter.Break, nil
+			}
+			return iter.Continue, nil
+		},
+	)
+	return found, err
+}
+
+// Description: Returns a set containing every vertex that is reachable from
+// the start vertex, including the start vertex. An error will be returned if
+// the start vertex is not in the graph.
+//
+// Time Complexity: O(v+e)
+func ReachableFrom[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+	start V,
+) (*containers.HashSet[V, VI], error) {
+	rv, _ := containers.NewHashSet[V, VI](0)
+	err := BFS[V, E, VI](g, start).ForEach(
+		func(index int, val V) (iter.IteratorFeedback, error) {
+			rv.AppendUnique(val)
+			return iter.Continue, nil
+		},
+	)
+	return &rv, err
+}
+
+// Description: Computes the transitive closure of the supplied graph. The
+// returned map contains an entry for every vertex in the graph, and the value
+// associated with each vertex is the set of vertices that can be reached from
+// it by following one or more links. A vertex will only be in its own set if
+// it is part of a cycle. Each set is stored by pointer so that the sets are
+// never copied.
+//
+// Time Complexity: O(v*(v+e))
+func TransitiveClosure[V any, E any, VI widgets.BaseInterface[V]](
+	g dynamicContainers.ReadDirectedGraph[V, E],
+) (
+	*containers.HashMap[
+		V,
+		*containers.HashSet[V, VI],
+		VI,
+		widgets.BasePntr[containers.HashSet[V, VI], *containers.HashSet[V, VI]],
+	],
+	error,
+) {
+	rv, _ := containers.NewHashMap[
+		V,
+		*containers.HashSet[V, VI],
+		VI,
+		widgets.BasePntr[containers.HashSet[V, VI], *containers.HashSet[V, VI]],
+	](g.NumVertices())
+	ig, err := newIndexedGraph[V, E, VI](g)
+	if err != nil {
+		return &rv, err
+	}
+	visited := make([]int, len(ig.verts))
+	for i := 0; i < len(visited); i++ {
+		visited[i] = -1
+	}
+	queue := make([]int, 0, len(ig.verts))
+	for i := 0; i < len(ig.verts); i++ {
+		reached, _ := containers.NewHashSet[V, VI](0)
+		queue = queue[:0]
+		for _, l := range ig.links[i] {
+			if visited[l.to] != i {
+				visited[l.to] = i
+				queue = append(queue, l.to)
+			}
+		}
+		for j := 0; j < len(queue); j++ {
+			reached.AppendUnique(ig.verts[queue[j]])
+			for _, l := range ig.links[queue[j]] {
+				if visited[l.to] != i {
+					visited[l.to] = i
+					queue = append(queue, l.to)
+				}
+			}
+		}
+		rv.Emplace(basic.Pair[V, *containers.HashSet[V, VI]]{
+			A: ig.verts[i], B: &reached,
+		})
+	}
+	return &rv, nil
+}
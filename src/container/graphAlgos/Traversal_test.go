@@ -0,0 +1,103 @@
+package graphAlgos
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/test"
+	"github.com/barbell-math/util/src/widgets"
+)
+
+func TestBFS(t *testing.T) {
+	g := buildGraph(6, [][2]int{
+		{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 0},
+	}, t)
+	res, err := BFS[int, int, widgets.BuiltinInt](&g, 0).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 1, 2, 3, 4}, res, t)
+	res, err = BFS[int, int, widgets.BuiltinInt](&g, 5).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{5}, res, t)
+	_, err = BFS[int, int, widgets.BuiltinInt](&g, 6).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestBFSBreak(t *testing.T) {
+	g := buildGraph(4, [][2]int{{0, 1}, {1, 2}, {2, 3}}, t)
+	res, err := BFS[int, int, widgets.BuiltinInt](&g, 0).Take(2).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 1}, res, t)
+}
+
+func TestDFS(t *testing.T) {
+	g := buildGraph(6, [][2]int{
+		{0, 1}, {0, 2}, {1, 3}, {2, 4}, {3, 5}, {5, 0},
+	}, t)
+	res, err := DFS[int, int, widgets.BuiltinInt](&g, 0).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 1, 3, 5, 2, 4}, res, t)
+	_, err = DFS[int, int, widgets.BuiltinInt](&g, 6).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestDFSSynced(t *testing.T) {
+	g := buildGraph(3, [][2]int{{0, 1}, {1, 2}, {0, 2}}, t)
+	sg := g.ToSynced()
+	res, err := DFS[int, int, widgets.BuiltinInt](&sg, 0).Collect()
+	test.Nil(err, t)
+	checkSliceEq([]int{0, 1, 2}, res, t)
+}
+
+func TestReachable(t *testing.T) {
+	g := buildGraph(5, [][2]int{{0, 1}, {1, 2}, {3, 2}}, t)
+	for _, v := range []struct {
+		from int
+		to   int
+		exp  bool
+	}{
+		{0, 2, true}, {0, 0, true}, {2, 0, false}, {0, 3, false}, {3, 2, true},
+		{4, 0, false},
+	} {
+		res, err := Reachable[int, int, widgets.BuiltinInt](&g, v.from, v.to)
+		test.Nil(err, t)
+		test.Eq(v.exp, res, t)
+	}
+	_, err := Reachable[int, int, widgets.BuiltinInt](&g, 0, 10)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	_, err = Reachable[int, int, widgets.BuiltinInt](&g, 10, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+func TestReachableFrom(t *testing.T) {
+	g := buildGraph(5, [][2]int{{0, 1}, {1, 2}, {3, 2}}, t)
+	s, err := ReachableFrom[int, int, widgets.BuiltinInt](&g, 0)
+	test.Nil(err, t)
+	test.Eq(3, s.Length(), t)
+	test.True(s.Contains(0), t)
+	test.True(s.Contains(1), t)
+	test.True(s.Contains(2), t)
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	g := buildGraph(5, [][2]int{{0, 1}, {1, 2}, {2, 1}, {3, 2}}, t)
+	c, err := TransitiveClosure[int, int, widgets.BuiltinInt](&g)
+	test.Nil(err, t)
+	test.Eq(5, c.Length(), t)
+	for _, v := range []struct {
+		vertex int
+		exp    []int
+	}{
+		{0, []int{1, 2}}, {1, []int{1, 2}}, {2, []int{1, 2}}, {3, []int{1, 2}},
+		{4, []int{}},
+	} {
+		s, err := c.Get(v.vertex)
+		test.Nil(err, t)
+		test.Eq(len(v.exp), s.Length(), t)
+		for _, e := range v.exp {
+			test.True(s.Contains(e), t)
+		}
+	}
+	cnt, err := c.Keys().Count()
+	test.Nil(err, t)
+	test.Eq(5, cnt, t)
+}
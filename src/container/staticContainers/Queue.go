@@ -27,4 +27,5 @@ type WriteQueue[V any] interface {
 type Queue[V any] interface {
 	ReadQueue[V]
 	WriteQueue[V]
+	containerTypes.Serializable
 }
@@ -34,4 +34,5 @@ type WriteSet[V any] interface {
 type Set[V any] interface {
 	ReadSet[V]
 	WriteSet[V]
+	containerTypes.Serializable
 }
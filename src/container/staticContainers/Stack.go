@@ -27,4 +27,5 @@ type WriteStack[V any] interface {
 type Stack[V any] interface {
 	ReadStack[V]
 	WriteStack[V]
+	containerTypes.Serializable
 }
@@ -19,3 +19,52 @@
 //     facilitates populating values in the containers as it can simply be done
 //     with standard for loops.
 package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/test"
+)
+
+// Marshals src and unmarshals the result into dest using either the JSON or
+// binary methods of the [containerTypes.Serializable] interface. The JSON
+// methods are called through the [encoding/json] package to make sure the
+// containers work with the standard library.
+func serializationRoundTrip[C containerTypes.Serializable](
+	src C,
+	dest C,
+	binary bool,
+	t *testing.T,
+) {
+	var data []byte
+	var err error
+	if binary {
+		data, err = src.MarshalBinary()
+	} else {
+		data, err = json.Marshal(src)
+	}
+	test.Nil(err, t)
+	if binary {
+		err = dest.UnmarshalBinary(data)
+	} else {
+		err = json.Unmarshal(data, dest)
+	}
+	test.Nil(err, t)
+}
+
+// Attempts to unmarshal invalid data into the supplied container, which must
+// return an error.
+func serializationMalformed[C containerTypes.Serializable](
+	dest C,
+	binary bool,
+	t *testing.T,
+) {
+	data := []byte("{\"not\": \"valid\"")
+	if binary {
+		test.NotNil(dest.UnmarshalBinary(data), t)
+	} else {
+		test.NotNil(dest.UnmarshalJSON(data), t)
+	}
+}
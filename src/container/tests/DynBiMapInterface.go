@@ -0,0 +1,590 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+)
+
+func biMapReadInterface[T any, U any](c dynamicContainers.ReadBiMap[T, U])   {}
+func biMapWriteInterface[T any, U any](c dynamicContainers.WriteBiMap[T, U]) {}
+func biMapInterface[T any, U any](c dynamicContainers.BiMap[T, U])           {}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.RWSyncable] interface.
+func DynBiMapInterfaceSyncableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.RWSyncable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Addressable] interface.
+func DynBiMapInterfaceAddressableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Addressable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Length] interface.
+func DynBiMapInterfaceLengthInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Length = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Clear] interface.
+func DynBiMapInterfaceClearInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Clear = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.WriteKeyedOps] interface.
+func DynBiMapInterfaceWriteKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.WriteKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.ReadOps] interface.
+func DynBiMapInterfaceReadOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.ReadOps[V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.ReadKeyedOps] interface.
+func DynBiMapInterfaceReadKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.ReadKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.ReadBiKeyedOps] interface.
+func DynBiMapInterfaceReadBiKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.ReadBiKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.DeleteOps] interface.
+func DynBiMapInterfaceDeleteOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.DeleteOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.DeleteKeyedOps] interface.
+func DynBiMapInterfaceDeleteKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.DeleteKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.ReadBiMap] interface.
+func ReadDynBiMapInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	biMapReadInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.WriteBiMap] interface.
+func WriteDynBiMapInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	biMapWriteInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.BiMap] interface.
+func DynBiMapInterfaceInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	biMapInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynBiMapInterfaceSerializableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory does not implement the
+// [containerTypes.StaticCapacity] interface.
+func DynBiMapInterfaceStaticCapacityInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.BiMap[K, V],
+	t *testing.T,
+) {
+	test.Panics(
+		func() {
+			var c any
+			c = factory(0)
+			c2 := c.(containerTypes.StaticCapacity)
+			_ = c2
+		},
+		t,
+	)
+}
+
+// Tests the Get method functionality of a dynamic bi map.
+func DynBiMapInterfaceGet(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	_, err := container.Get(0)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	for i := 0; i < 5; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < 5; i++ {
+		_v, err := container.Get(i)
+		test.Eq(i+10, _v, t)
+		test.Nil(err, t)
+	}
+	_, err = container.Get(-1)
+	test.ContainsError(containerTypes.KeyError, err, t)
+	_, err = container.Get(10)
+	test.ContainsError(containerTypes.KeyError, err, t)
+}
+
+// Tests the GetPntr method functionality of a dynamic bi map.
+func DynBiMapInterfaceGetPntr(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	if container.IsAddressable() {
+		_, err := container.GetPntr(0)
+		test.ContainsError(containerTypes.KeyError, err, t)
+		for i := 0; i < 5; i++ {
+			container.Emplace(basic.Pair[int, int]{i, i + 10})
+		}
+		for i := 0; i < 5; i++ {
+			_v, err := container.GetPntr(i)
+			test.Eq(i+10, *_v, t)
+			test.Nil(err, t)
+		}
+	} else {
+		test.Panics(func() { container.GetPntr(1) }, t)
+	}
+}
+
+// Tests the GetKey method functionality of a dynamic bi map.
+func DynBiMapInterfaceGetKey(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	_, err := container.GetKey(0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	for i := 0; i < 5; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < 5; i++ {
+		k, err := container.GetKey(i + 10)
+		test.Eq(i, k, t)
+		test.Nil(err, t)
+	}
+	_, err = container.GetKey(0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	_, err = container.GetKey(15)
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+// Tests the ContainsKey method functionality of a dynamic bi map.
+func DynBiMapInterfaceContainsKey(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.False(container.ContainsKey(0), t)
+	for i := 0; i < 5; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < 5; i++ {
+		test.True(container.ContainsKey(i), t)
+		test.False(container.ContainsKey(i+10), t)
+	}
+}
+
+// Tests the Set method functionality of a dynamic bi map.
+func DynBiMapInterfaceSet(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	err := container.Set(basic.Pair[int, int]{0, 6})
+	test.ContainsError(containerTypes.KeyError, err, t)
+	for i := 0; i < 5; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i})
+	}
+	for i := 4; i >= 0; i-- {
+		err := container.Set(basic.Pair[int, int]{i, i + 1})
+		test.Nil(err, t)
+	}
+	for i := 0; i < 5; i++ {
+		iterV, _ := container.Get(i)
+		test.Eq(i+1, iterV, t)
+		k, _ := container.GetKey(i + 1)
+		test.Eq(i, k, t)
+	}
+	test.False(container.Contains(0), t)
+	test.Eq(5, container.Length(), t)
+	err = container.Set(basic.Pair[int, int]{0, 3})
+	test.ContainsError(containerTypes.Duplicate, err, t)
+	iterV, _ := container.Get(0)
+	test.Eq(1, iterV, t)
+	err = container.Set(basic.Pair[int, int]{-1, 6})
+	test.ContainsError(containerTypes.KeyError, err, t)
+	err = container.Set(basic.Pair[int, int]{0, 1})
+	test.Nil(err, t)
+	test.Eq(5, container.Length(), t)
+}
+
+// Tests the Emplace method functionality of a dynamic bi map.
+func DynBiMapInterfaceEmplace(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	for i := 0; i < 5; i++ {
+		test.Nil(container.Emplace(basic.Pair[int, int]{i, i + 10}), t)
+	}
+	test.Eq(5, container.Length(), t)
+	err := container.Emplace(basic.Pair[int, int]{5, 10})
+	test.ContainsError(containerTypes.Duplicate, err, t)
+	test.False(container.ContainsKey(5), t)
+	k, _ := container.GetKey(10)
+	test.Eq(0, k, t)
+	test.Nil(container.Emplace(basic.Pair[int, int]{0, 10}), t)
+	test.Eq(5, container.Length(), t)
+	test.Nil(container.Emplace(basic.Pair[int, int]{0, 20}), t)
+	test.Eq(5, container.Length(), t)
+	test.False(container.Contains(10), t)
+	_, err = container.GetKey(10)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Nil(container.Emplace(basic.Pair[int, int]{5, 10}), t)
+	test.Eq(6, container.Length(), t)
+	err = container.Emplace(
+		basic.Pair[int, int]{6, 16},
+		basic.Pair[int, int]{7, 11},
+		basic.Pair[int, int]{8, 18},
+	)
+	test.ContainsError(containerTypes.Duplicate, err, t)
+	test.True(container.ContainsKey(6), t)
+	test.False(container.ContainsKey(7), t)
+	test.False(container.ContainsKey(8), t)
+	test.Eq(7, container.Length(), t)
+}
+
+func biMapContainsHelper(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	l int,
+	t *testing.T,
+) {
+	container := factory(0)
+	for i := 0; i < l; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < l; i++ {
+		test.True(container.Contains(i+10), t)
+		test.True(container.ContainsPntr(&[]int{i + 10}[0]), t)
+	}
+	test.False(container.Contains(-1), t)
+	test.False(container.Contains(l+10), t)
+	test.False(container.ContainsPntr(&[]int{l + 10}[0]), t)
+}
+
+// Tests the Contains and ContainsPntr method functionality of a dynamic bi
+// map.
+func DynBiMapInterfaceContains(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	biMapContainsHelper(factory, 0, t)
+	biMapContainsHelper(factory, 1, t)
+	biMapContainsHelper(factory, 2, t)
+	biMapContainsHelper(factory, 5, t)
+}
+
+func biMapKeyOfHelper(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	l int,
+	t *testing.T,
+) {
+	container := factory(0)
+	for i := 0; i < l; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < l; i++ {
+		k, found := container.KeyOf(i + 10)
+		test.Eq(i, k, t)
+		test.True(found, t)
+		k, found = container.KeyOfPntr(&[]int{i + 10}[0])
+		test.Eq(i, k, t)
+		test.True(found, t)
+	}
+	_, found := container.KeyOf(-1)
+	test.False(found, t)
+	_, found = container.KeyOfPntr(&[]int{l + 10}[0])
+	test.False(found, t)
+}
+
+// Tests the KeyOf and KeyOfPntr method functionality of a dynamic bi map.
+func DynBiMapInterfaceKeyOf(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	biMapKeyOfHelper(factory, 0, t)
+	biMapKeyOfHelper(factory, 1, t)
+	biMapKeyOfHelper(factory, 2, t)
+	biMapKeyOfHelper(factory, 5, t)
+}
+
+func biMapPopHelper(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	l int,
+	pntr bool,
+	t *testing.T,
+) {
+	container := factory(0)
+	for i := 0; i < l; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < l; i += 2 {
+		var n int
+		if pntr {
+			n = container.PopPntr(&[]int{i + 10}[0])
+		} else {
+			n = container.Pop(i + 10)
+		}
+		test.Eq(1, n, t)
+	}
+	test.Eq(0, container.Pop(-1), t)
+	test.Eq(l/2, container.Length(), t)
+	for i := 0; i < l; i++ {
+		test.Eq(i%2 == 1, container.ContainsKey(i), t)
+		test.Eq(i%2 == 1, container.Contains(i+10), t)
+	}
+}
+
+// Tests the Pop and PopPntr method functionality of a dynamic bi map.
+func DynBiMapInterfacePop(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 5, 20} {
+		biMapPopHelper(factory, l, false, t)
+		biMapPopHelper(factory, l, true, t)
+	}
+}
+
+// Tests the Delete method functionality of a dynamic bi map.
+func DynBiMapInterfaceDelete(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.ContainsError(containerTypes.KeyError, container.Delete(0), t)
+	for i := 0; i < 6; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	for i := 0; i < 6; i += 2 {
+		test.Nil(container.Delete(i), t)
+	}
+	test.Eq(3, container.Length(), t)
+	for i := 0; i < 6; i++ {
+		test.Eq(i%2 == 1, container.ContainsKey(i), t)
+		test.Eq(i%2 == 1, container.Contains(i+10), t)
+	}
+	test.ContainsError(containerTypes.KeyError, container.Delete(0), t)
+	test.Nil(container.Emplace(basic.Pair[int, int]{6, 10}), t)
+}
+
+// Tests the Clear method functionality of a dynamic bi map.
+func DynBiMapInterfaceClear(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	for i := 0; i < 6; i++ {
+		container.Emplace(basic.Pair[int, int]{i, i + 10})
+	}
+	container.Clear()
+	test.Eq(0, container.Length(), t)
+	test.False(container.ContainsKey(0), t)
+	test.False(container.Contains(10), t)
+	test.Nil(container.Emplace(basic.Pair[int, int]{1, 10}), t)
+}
+
+// Tests the Keys and Vals method functionality of a dynamic bi map.
+func DynBiMapInterfaceKeysVals(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 5} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.Emplace(basic.Pair[int, int]{i, i + 10})
+		}
+		cnt := 0
+		container.Keys().ForEach(
+			func(index, val int) (iter.IteratorFeedback, error) {
+				cnt++
+				test.True(container.ContainsKey(val), t)
+				return iter.Continue, nil
+			},
+		)
+		test.Eq(l, cnt, t)
+		cnt = 0
+		container.Vals().ForEach(
+			func(index, val int) (iter.IteratorFeedback, error) {
+				cnt++
+				test.True(container.Contains(val), t)
+				return iter.Continue, nil
+			},
+		)
+		test.Eq(l, cnt, t)
+	}
+}
+
+// Tests the ValPntrs method functionality of a dynamic bi map.
+func DynBiMapInterfaceValPntrs(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	if !container.IsAddressable() {
+		test.Panics(func() { container.ValPntrs() }, t)
+	}
+}
+
+// Tests the KeyedEq method functionality of a dynamic bi map.
+func DynBiMapInterfaceKeyedEq(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	v := factory(0)
+	v.Emplace(
+		basic.Pair[int, int]{1, 1},
+		basic.Pair[int, int]{2, 2},
+		basic.Pair[int, int]{3, 3},
+	)
+	v2 := factory(0)
+	v2.Emplace(
+		basic.Pair[int, int]{3, 3},
+		basic.Pair[int, int]{1, 1},
+		basic.Pair[int, int]{2, 2},
+	)
+	test.True(v.KeyedEq(v2), t)
+	test.True(v2.KeyedEq(v), t)
+	v.Pop(3)
+	test.False(v.KeyedEq(v2), t)
+	test.False(v2.KeyedEq(v), t)
+	v.Emplace(basic.Pair[int, int]{3, 4})
+	test.False(v.KeyedEq(v2), t)
+	test.False(v2.KeyedEq(v), t)
+	v = factory(0)
+	v2 = factory(0)
+	test.True(v.KeyedEq(v2), t)
+	test.True(v2.KeyedEq(v), t)
+}
+
+func dynBiMapSerializationHelper(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.Emplace(basic.Pair[int, int]{i, 2 * i})
+		}
+		other := factory(0)
+		other.Emplace(basic.Pair[int, int]{-1, -1}, basic.Pair[int, int]{1, -2})
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l, other.Length(), t)
+		test.True(container.KeyedEq(other), t)
+		_, err := other.Get(-1)
+		test.ContainsError(containerTypes.KeyError, err, t)
+		for i := 0; i < l; i++ {
+			k, err := other.GetKey(2 * i)
+			test.Nil(err, t)
+			test.Eq(i, k, t)
+		}
+	}
+	container := factory(0)
+	container.Emplace(basic.Pair[int, int]{1, 1})
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a dynamic bi
+// map.
+func DynBiMapInterfaceJSON(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	dynBiMapSerializationHelper(factory, false, t)
+	container := factory(0)
+	container.Emplace(basic.Pair[int, int]{1, 1})
+	err := container.UnmarshalJSON(
+		[]byte(`[{"key":1,"val":2},{"key":2,"val":2}]`),
+	)
+	test.NotNil(err, t)
+	test.Eq(1, container.Length(), t)
+	v, _ := container.Get(1)
+	test.Eq(1, v, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic bi map.
+func DynBiMapInterfaceBinary(
+	factory func(capacity int) dynamicContainers.BiMap[int, int],
+	t *testing.T,
+) {
+	dynBiMapSerializationHelper(factory, true, t)
+}
@@ -150,6 +150,16 @@ func DynDequeInterfaceInterface[V any](
 	dynDequeInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynDequeInterfaceSerializableInterface[V any](
+	factory func(capacity int) dynamicContainers.Deque[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Deque] interface.
 func DynDequeInterfaceStaticCapacityInterface[V any](
@@ -404,3 +414,54 @@ func DynDequeInterfaceForcePushBack(
 		test.Eq(0, iterV, t)
 	}
 }
+
+func dynDequeSerializationHelper(
+	factory func(capacity int) dynamicContainers.Deque[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			if i%2 == 0 {
+				container.PushBack(i)
+			} else {
+				container.PushFront(i)
+			}
+		}
+		other := factory(0)
+		other.PushBack(-1, -2)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		for container.Length() > 0 {
+			exp, err := container.PopFront()
+			test.Nil(err, t)
+			iterV, err := other.PopFront()
+			test.Nil(err, t)
+			test.Eq(exp, iterV, t)
+		}
+		test.Eq(0, other.Length(), t)
+	}
+	container := factory(0)
+	container.PushBack(1)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic deque.
+func DynDequeInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Deque[int],
+	t *testing.T,
+) {
+	dynDequeSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic deque.
+func DynDequeInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Deque[int],
+	t *testing.T,
+) {
+	dynDequeSerializationHelper(factory, true, t)
+}
@@ -111,6 +111,16 @@ func DynDirectedGraphInterfaceInterface[V any, E any](
 	directedGraphInterface[V, E](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynDirectedGraphInterfaceSerializableInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.DirectedGraph[V, E],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Map] interface.
 func DynDirectedGraphInterfaceStaticCapacityInterface[V any, E any](
@@ -3100,3 +3110,73 @@ func DynDirectedGraphIsSubset(
 		t,
 	)
 }
+
+func directedGraphSerializationHelper(
+	factory func(capacity int) dynamicContainers.DirectedGraph[int, int],
+	binary bool,
+	t *testing.T,
+) {
+	constructions := []directedGraphConstruction{
+		{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		{
+			// Vertex 5 and edge 3 are not part of any link and must still be
+			// preserved.
+			vertices: iter.Range[int](0, 6, 1),
+			edges:    iter.Range[int](0, 4, 1),
+			links: [][3]int{
+				// from, to, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 0},
+				[3]int{1, 2, 1},
+				[3]int{2, 3, 2},
+				[3]int{4, 4, 1},
+			},
+		},
+	}
+	for _, c := range constructions {
+		container := c.makeGraph(factory, t)
+		other := (&directedGraphConstruction{
+			vertices: iter.Range[int](-2, 0, 1),
+			edges:    iter.Range[int](-1, 0, 1),
+			links:    [][3]int{[3]int{-2, -1, -1}},
+		}).makeGraph(factory, t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.NumVertices(), other.NumVertices(), t)
+		test.Eq(container.NumEdges(), other.NumEdges(), t)
+		test.Eq(container.NumLinks(), other.NumLinks(), t)
+		test.True(container.KeyedEq(other), t)
+		test.False(other.ContainsVertex(-1), t)
+		test.False(other.ContainsEdge(-1), t)
+	}
+	container := (&directedGraphConstruction{
+		vertices: iter.Range[int](0, 2, 1),
+		edges:    iter.Range[int](0, 1, 1),
+		links:    [][3]int{[3]int{0, 1, 0}},
+	}).makeGraph(factory, t)
+	serializationMalformed(container, binary, t)
+	test.Eq(2, container.NumVertices(), t)
+	test.Eq(1, container.NumEdges(), t)
+	test.Eq(1, container.NumLinks(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic directed graph.
+func DynDirectedGraphInterfaceJSON(
+	factory func(capacity int) dynamicContainers.DirectedGraph[int, int],
+	t *testing.T,
+) {
+	directedGraphSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic directed graph.
+func DynDirectedGraphInterfaceBinary(
+	factory func(capacity int) dynamicContainers.DirectedGraph[int, int],
+	t *testing.T,
+) {
+	directedGraphSerializationHelper(factory, true, t)
+}
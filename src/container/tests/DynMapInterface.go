@@ -131,6 +131,16 @@ func DynMapInterfaceInterface[K any, V any](
 	mapInterface[K, V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynMapInterfaceSerializableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.Map[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Map] interface.
 func DynMapInterfaceStaticCapacityInterface[K any, V any](
@@ -611,3 +621,45 @@ func HashDynMapInterfaceKeyedEq(
 	test.True(v.KeyedEq(v2), t)
 	test.True(v2.KeyedEq(v), t)
 }
+
+func dynMapSerializationHelper(
+	factory func(capacity int) dynamicContainers.Map[int, int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.Emplace(basic.Pair[int, int]{i, 2 * i})
+		}
+		other := factory(0)
+		other.Emplace(basic.Pair[int, int]{-1, -1}, basic.Pair[int, int]{1, -1})
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l, other.Length(), t)
+		test.True(container.KeyedEq(other), t)
+		_, err := other.Get(-1)
+		test.ContainsError(containerTypes.KeyError, err, t)
+	}
+	container := factory(0)
+	container.Emplace(basic.Pair[int, int]{1, 1})
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic map.
+func DynMapInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Map[int, int],
+	t *testing.T,
+) {
+	dynMapSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic map.
+func DynMapInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Map[int, int],
+	t *testing.T,
+) {
+	dynMapSerializationHelper(factory, true, t)
+}
@@ -0,0 +1,490 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+)
+
+func multiMapReadInterface[T any, U any](c dynamicContainers.ReadMultiMap[T, U])   {}
+func multiMapWriteInterface[T any, U any](c dynamicContainers.WriteMultiMap[T, U]) {}
+func multiMapInterface[T any, U any](c dynamicContainers.MultiMap[T, U])           {}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.RWSyncable] interface.
+func DynMultiMapInterfaceSyncableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.RWSyncable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Addressable] interface.
+func DynMultiMapInterfaceAddressableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Addressable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Length] interface.
+func DynMultiMapInterfaceLengthInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Length = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Clear] interface.
+func DynMultiMapInterfaceClearInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Clear = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.ReadOps] interface.
+func DynMultiMapInterfaceReadOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.ReadOps[V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.ReadMultiKeyedOps] interface.
+func DynMultiMapInterfaceReadMultiKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.ReadMultiKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.WriteMultiKeyedOps] interface.
+func DynMultiMapInterfaceWriteMultiKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.WriteMultiKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.DeleteOps] interface.
+func DynMultiMapInterfaceDeleteOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.DeleteOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.DeleteKeyedOps] interface.
+func DynMultiMapInterfaceDeleteKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.DeleteKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.DeleteMultiKeyedOps] interface.
+func DynMultiMapInterfaceDeleteMultiKeyedOpsInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.DeleteMultiKeyedOps[K, V] = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.ReadMultiMap] interface.
+func ReadDynMultiMapInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	multiMapReadInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.WriteMultiMap] interface.
+func WriteDynMultiMapInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	multiMapWriteInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.MultiMap] interface.
+func DynMultiMapInterfaceInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	multiMapInterface[K, V](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynMultiMapInterfaceSerializableInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory does not implement the
+// [containerTypes.StaticCapacity] interface.
+func DynMultiMapInterfaceStaticCapacityInterface[K any, V any](
+	factory func(capacity int) dynamicContainers.MultiMap[K, V],
+	t *testing.T,
+) {
+	test.Panics(
+		func() {
+			var c any
+			c = factory(0)
+			c2 := c.(containerTypes.StaticCapacity)
+			_ = c2
+		},
+		t,
+	)
+}
+
+// Populates the container so that key i is associated with the values
+// [0, i), giving l*(l-1)/2 total key value pairs across l-1 keys.
+func multiMapPopulate(
+	container dynamicContainers.MultiMap[int, int],
+	l int,
+) {
+	for i := 0; i < l; i++ {
+		for j := 0; j < i; j++ {
+			container.Add(basic.Pair[int, int]{i, j})
+		}
+	}
+}
+
+// Tests the Add, Length, NumKeys and Count method functionality of a dynamic
+// multi map.
+func DynMultiMapInterfaceAdd(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.Eq(0, container.Length(), t)
+	test.Eq(0, container.NumKeys(), t)
+	test.Eq(0, container.Count(0), t)
+	multiMapPopulate(container, 6)
+	test.Eq(15, container.Length(), t)
+	test.Eq(5, container.NumKeys(), t)
+	for i := 0; i < 6; i++ {
+		test.Eq(i, container.Count(i), t)
+	}
+	test.Nil(container.Add(
+		basic.Pair[int, int]{1, 0},
+		basic.Pair[int, int]{0, 0},
+	), t)
+	test.Eq(17, container.Length(), t)
+	test.Eq(6, container.NumKeys(), t)
+	test.Eq(2, container.Count(1), t)
+	test.Eq(1, container.Count(0), t)
+}
+
+// Tests the Get method functionality of a dynamic multi map.
+func DynMultiMapInterfaceGet(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	vals, err := container.Get(0).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{}, vals, t)
+	multiMapPopulate(container, 6)
+	for i := 0; i < 6; i++ {
+		vals, err := container.Get(i).Collect()
+		test.Nil(err, t)
+		exp := []int{}
+		for j := 0; j < i; j++ {
+			exp = append(exp, j)
+		}
+		test.SlicesMatch[int](exp, vals, t)
+	}
+	container.Add(basic.Pair[int, int]{2, 0})
+	vals, err = container.Get(2).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 0}, vals, t)
+	vals, err = container.Get(5).Take(2).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1}, vals, t)
+}
+
+// Tests the ContainsKey and ContainsPair method functionality of a dynamic
+// multi map.
+func DynMultiMapInterfaceContainsKey(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.False(container.ContainsKey(0), t)
+	test.False(container.ContainsPair(0, 0), t)
+	multiMapPopulate(container, 6)
+	test.False(container.ContainsKey(0), t)
+	for i := 1; i < 6; i++ {
+		test.True(container.ContainsKey(i), t)
+		for j := 0; j < 6; j++ {
+			test.Eq(j < i, container.ContainsPair(i, j), t)
+		}
+	}
+	test.False(container.ContainsKey(6), t)
+}
+
+// Tests the Contains and ContainsPntr method functionality of a dynamic multi
+// map.
+func DynMultiMapInterfaceContains(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.False(container.Contains(0), t)
+	multiMapPopulate(container, 6)
+	for i := 0; i < 5; i++ {
+		test.True(container.Contains(i), t)
+		test.True(container.ContainsPntr(&i), t)
+	}
+	test.False(container.Contains(5), t)
+	test.False(container.Contains(-1), t)
+}
+
+func multiMapPopHelper(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	pntr bool,
+	t *testing.T,
+) {
+	container := factory(0)
+	test.Eq(0, container.Pop(0), t)
+	multiMapPopulate(container, 6)
+	container.Add(basic.Pair[int, int]{5, 0})
+	pop := func(v int) int {
+		if pntr {
+			return container.PopPntr(&v)
+		}
+		return container.Pop(v)
+	}
+	test.Eq(6, pop(0), t)
+	test.Eq(10, container.Length(), t)
+	test.Eq(4, container.NumKeys(), t)
+	test.False(container.ContainsKey(1), t)
+	test.Eq(4, container.Count(5), t)
+	test.False(container.Contains(0), t)
+	test.Eq(0, pop(0), t)
+	test.Eq(4, pop(1), t)
+	test.Eq(3, container.NumKeys(), t)
+	test.Eq(6, container.Length(), t)
+	test.Eq(1, pop(4), t)
+	test.Eq(2, pop(3), t)
+	test.Eq(3, pop(2), t)
+	test.Eq(0, container.Length(), t)
+	test.Eq(0, container.NumKeys(), t)
+}
+
+// Tests the Pop and PopPntr method functionality of a dynamic multi map.
+func DynMultiMapInterfacePop(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	multiMapPopHelper(factory, false, t)
+	multiMapPopHelper(factory, true, t)
+}
+
+// Tests the Delete method functionality of a dynamic multi map.
+func DynMultiMapInterfaceDelete(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.ContainsError(containerTypes.KeyError, container.Delete(0), t)
+	multiMapPopulate(container, 6)
+	test.ContainsError(containerTypes.KeyError, container.Delete(0), t)
+	test.Nil(container.Delete(3), t)
+	test.Eq(12, container.Length(), t)
+	test.Eq(4, container.NumKeys(), t)
+	test.False(container.ContainsKey(3), t)
+	test.Eq(0, container.Count(3), t)
+	test.ContainsError(containerTypes.KeyError, container.Delete(3), t)
+	for _, k := range []int{1, 2, 4, 5} {
+		test.Nil(container.Delete(k), t)
+	}
+	test.Eq(0, container.Length(), t)
+	test.Eq(0, container.NumKeys(), t)
+}
+
+// Tests the DeletePair method functionality of a dynamic multi map.
+func DynMultiMapInterfaceDeletePair(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.ContainsError(containerTypes.KeyError, container.DeletePair(0, 0), t)
+	container.Add(
+		basic.Pair[int, int]{0, 1},
+		basic.Pair[int, int]{0, 2},
+		basic.Pair[int, int]{0, 1},
+		basic.Pair[int, int]{1, 1},
+	)
+	test.ContainsError(containerTypes.ValueError, container.DeletePair(0, 3), t)
+	test.ContainsError(containerTypes.KeyError, container.DeletePair(2, 1), t)
+	test.Eq(4, container.Length(), t)
+	test.Nil(container.DeletePair(0, 1), t)
+	test.Eq(3, container.Length(), t)
+	vals, _ := container.Get(0).Collect()
+	test.SlicesMatch[int]([]int{2, 1}, vals, t)
+	test.True(container.ContainsPair(1, 1), t)
+	test.Nil(container.DeletePair(0, 1), t)
+	test.False(container.ContainsPair(0, 1), t)
+	test.ContainsError(containerTypes.ValueError, container.DeletePair(0, 1), t)
+	test.Nil(container.DeletePair(0, 2), t)
+	test.False(container.ContainsKey(0), t)
+	test.Eq(1, container.NumKeys(), t)
+	test.Eq(1, container.Length(), t)
+	test.Nil(container.DeletePair(1, 1), t)
+	test.Eq(0, container.NumKeys(), t)
+	test.Eq(0, container.Length(), t)
+}
+
+// Tests the Clear method functionality of a dynamic multi map.
+func DynMultiMapInterfaceClear(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	multiMapPopulate(container, 6)
+	container.Clear()
+	test.Eq(0, container.Length(), t)
+	test.Eq(0, container.NumKeys(), t)
+	test.False(container.ContainsKey(1), t)
+	container.Add(basic.Pair[int, int]{1, 1})
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the Keys method functionality of a dynamic multi map.
+func DynMultiMapInterfaceKeys(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 6} {
+		container := factory(0)
+		multiMapPopulate(container, l)
+		cnt := 0
+		container.Keys().ForEach(
+			func(index, val int) (iter.IteratorFeedback, error) {
+				cnt++
+				test.True(container.ContainsKey(val), t)
+				return iter.Continue, nil
+			},
+		)
+		test.Eq(container.NumKeys(), cnt, t)
+	}
+}
+
+// Tests the Vals method functionality of a dynamic multi map.
+func DynMultiMapInterfaceVals(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 6} {
+		container := factory(0)
+		multiMapPopulate(container, l)
+		counts := map[int]int{}
+		container.Vals().ForEach(
+			func(index, val int) (iter.IteratorFeedback, error) {
+				counts[val]++
+				return iter.Continue, nil
+			},
+		)
+		total := 0
+		for v, c := range counts {
+			test.Eq(l-v-1, c, t)
+			total += c
+		}
+		test.Eq(container.Length(), total, t)
+	}
+	container := factory(0)
+	multiMapPopulate(container, 6)
+	vals, err := container.Vals().Take(7).Collect()
+	test.Nil(err, t)
+	test.Eq(7, len(vals), t)
+}
+
+// Tests the ValPntrs method functionality of a dynamic multi map.
+func DynMultiMapInterfaceValPntrs(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	if !container.IsAddressable() {
+		test.Panics(func() { container.ValPntrs() }, t)
+	}
+}
+
+func dynMultiMapSerializationHelper(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 6, 20} {
+		container := factory(0)
+		multiMapPopulate(container, l)
+		container.Add(basic.Pair[int, int]{1, 0})
+		other := factory(0)
+		other.Add(basic.Pair[int, int]{-1, -1}, basic.Pair[int, int]{1, -1})
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		test.Eq(container.NumKeys(), other.NumKeys(), t)
+		test.False(other.ContainsKey(-1), t)
+		test.False(other.ContainsPair(1, -1), t)
+		for i := 0; i < l; i++ {
+			exp, _ := container.Get(i).Collect()
+			got, _ := other.Get(i).Collect()
+			test.SlicesMatch[int](exp, got, t)
+		}
+	}
+	container := factory(0)
+	container.Add(basic.Pair[int, int]{1, 1})
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a dynamic
+// multi map.
+func DynMultiMapInterfaceJSON(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	dynMultiMapSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic multi map.
+func DynMultiMapInterfaceBinary(
+	factory func(capacity int) dynamicContainers.MultiMap[int, int],
+	t *testing.T,
+) {
+	dynMultiMapSerializationHelper(factory, true, t)
+}
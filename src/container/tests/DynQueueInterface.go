@@ -120,6 +120,16 @@ func DynQueueInterfaceInterface[V any](
 	dynQueueInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynQueueInterfaceSerializableInterface[V any](
+	factory func(capacity int) dynamicContainers.Queue[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Queue] interface.
 func DynQueueInterfaceStaticCapacityInterface[V any](
@@ -247,3 +257,50 @@ func DynQueueInterfaceForcePushBack(
 		test.Eq(0, iterV, t)
 	}
 }
+
+func dynQueueSerializationHelper(
+	factory func(capacity int) dynamicContainers.Queue[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.PushBack(i)
+		}
+		other := factory(0)
+		other.PushBack(-1, -2)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		for container.Length() > 0 {
+			exp, err := container.PopFront()
+			test.Nil(err, t)
+			iterV, err := other.PopFront()
+			test.Nil(err, t)
+			test.Eq(exp, iterV, t)
+		}
+		test.Eq(0, other.Length(), t)
+	}
+	container := factory(0)
+	container.PushBack(1)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic queue.
+func DynQueueInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Queue[int],
+	t *testing.T,
+) {
+	dynQueueSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic queue.
+func DynQueueInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Queue[int],
+	t *testing.T,
+) {
+	dynQueueSerializationHelper(factory, true, t)
+}
@@ -111,6 +111,16 @@ func DynSetInterfaceInterface[V any](
 	dynamicSetInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynSetInterfaceSerializableInterface[V any](
+	factory func(capacity int) dynamicContainers.Set[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [containerTypes.StaticCapacity] interface.
 func DynSetInterfaceStaticCapacityInterface[V any](
@@ -679,3 +689,45 @@ func DynSetInterfaceIsSubset(
 	test.False(v.IsSubset(v2), t)
 	test.True(v2.IsSubset(v), t)
 }
+
+func dynSetSerializationHelper(
+	factory func(capacity int) dynamicContainers.Set[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.AppendUnique(i)
+		}
+		other := factory(0)
+		other.AppendUnique(-1, -2)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l, other.Length(), t)
+		test.True(container.UnorderedEq(other), t)
+		test.False(other.Contains(-1), t)
+	}
+	container := factory(0)
+	container.AppendUnique(1)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+	test.True(container.Contains(1), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic set.
+func DynSetInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Set[int],
+	t *testing.T,
+) {
+	dynSetSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic set.
+func DynSetInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Set[int],
+	t *testing.T,
+) {
+	dynSetSerializationHelper(factory, true, t)
+}
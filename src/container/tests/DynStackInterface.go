@@ -120,6 +120,16 @@ func DynStackInterfaceInterface[V any](
 	dynStackInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynStackInterfaceSerializableInterface[V any](
+	factory func(capacity int) dynamicContainers.Stack[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Stack] interface.
 func DynStackInterfaceStaticCapacityInterface[V any](
@@ -247,3 +257,50 @@ func DynStackInterfaceForcePushBack(
 		test.Eq(i+1, iterV, t)
 	}
 }
+
+func dynStackSerializationHelper(
+	factory func(capacity int) dynamicContainers.Stack[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.PushBack(i)
+		}
+		other := factory(0)
+		other.PushBack(-1, -2)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		for container.Length() > 0 {
+			exp, err := container.PopBack()
+			test.Nil(err, t)
+			iterV, err := other.PopBack()
+			test.Nil(err, t)
+			test.Eq(exp, iterV, t)
+		}
+		test.Eq(0, other.Length(), t)
+	}
+	container := factory(0)
+	container.PushBack(1)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic stack.
+func DynStackInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Stack[int],
+	t *testing.T,
+) {
+	dynStackSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic stack.
+func DynStackInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Stack[int],
+	t *testing.T,
+) {
+	dynStackSerializationHelper(factory, true, t)
+}
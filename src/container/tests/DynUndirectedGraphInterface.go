@@ -0,0 +1,1174 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/container/containerTypes"
+	"github.com/barbell-math/util/src/container/dynamicContainers"
+	"github.com/barbell-math/util/src/iter"
+	"github.com/barbell-math/util/src/test"
+)
+
+type undirectedGraphConstruction struct {
+	vertices    iter.Iter[int]
+	edges       iter.Iter[int]
+	links       [][3]int
+	numVertices int
+	numEdges    int
+}
+
+func (g *undirectedGraphConstruction) makeGraph(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) dynamicContainers.UndirectedGraph[int, int] {
+	g.numEdges = 0
+	g.numVertices = 0
+	rv := factory(0)
+	g.edges.ForEach(func(index, val int) (iter.IteratorFeedback, error) {
+		g.numEdges++
+		test.Nil(rv.AddEdges(val), t)
+		return iter.Continue, nil
+	})
+	g.vertices.ForEach(func(index, val int) (iter.IteratorFeedback, error) {
+		g.numVertices++
+		test.Nil(rv.AddVertices(val), t)
+		return iter.Continue, nil
+	})
+	for i := 0; i < len(g.links); i++ {
+		test.Nil(rv.Link(g.links[i][0], g.links[i][1], g.links[i][2]), t)
+	}
+	for i := 0; i < len(g.links); i++ {
+		test.True(
+			rv.ContainsLink(g.links[i][0], g.links[i][1], g.links[i][2]),
+			t,
+		)
+		test.True(
+			rv.ContainsLink(g.links[i][1], g.links[i][0], g.links[i][2]),
+			t,
+		)
+	}
+	test.Eq(len(g.links), rv.NumLinks(), t)
+	test.Eq(g.numVertices, rv.NumVertices(), t)
+	test.Eq(g.numEdges, rv.NumEdges(), t)
+	return rv
+}
+
+func undirectedGraphReadInterface[T any, U any](c dynamicContainers.ReadUndirectedGraph[T, U])   {}
+func undirectedGraphWriteInterface[T any, U any](c dynamicContainers.WriteUndirectedGraph[T, U]) {}
+func undirectedGraphInterface[T any, U any](c dynamicContainers.UndirectedGraph[T, U])           {}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.RWSyncable] interface.
+func DynUndirectedGraphInterfaceSyncableInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	var container containerTypes.RWSyncable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Addressable] interface.
+func DynUndirectedGraphInterfaceAddressableInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	var container containerTypes.Addressable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Clear] interface.
+func DynUndirectedGraphInterfaceClearInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	var container containerTypes.Clear = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.ReadUndirectedGraph] interface.
+func ReadDynUndirectedGraphInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	undirectedGraphReadInterface[V, E](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.WriteUndirectedGraph] interface.
+func WriteDynUndirectedGraphInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	undirectedGraphWriteInterface[V, E](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [dynamicContainers.UndirectedGraph] interface.
+func DynUndirectedGraphInterfaceInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	undirectedGraphInterface[V, E](factory(0))
+}
+
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynUndirectedGraphInterfaceSerializableInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
+// Tests that the value supplied by the factory does not implement the
+// [containerTypes.StaticCapacity] interface.
+func DynUndirectedGraphInterfaceStaticCapacityInterface[V any, E any](
+	factory func(capacity int) dynamicContainers.UndirectedGraph[V, E],
+	t *testing.T,
+) {
+	test.Panics(
+		func() {
+			var c any
+			c = factory(0)
+			c2 := c.(containerTypes.StaticCapacity)
+			_ = c2
+		},
+		t,
+	)
+}
+
+// Tests the ContainsEdge and ContainsVertex methods functionality of a dynamic
+// undirected graph.
+func DynUndirectedGraphInterfaceContains(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 5} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			test.Nil(container.AddEdges(i), t)
+			test.Nil(container.AddVertices(i), t)
+		}
+		for i := 0; i < l; i++ {
+			test.True(container.ContainsEdge(i), t)
+			test.True(container.ContainsVertex(i), t)
+		}
+		test.False(container.ContainsEdge(-1), t)
+		test.False(container.ContainsEdge(l), t)
+		test.False(container.ContainsVertex(-1), t)
+		test.False(container.ContainsVertex(l), t)
+	}
+}
+
+// Tests the Edges and Vertices methods functionality of a dynamic undirected
+// graph.
+func DynUndirectedGraphInterfaceEdgesAndVertices(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 2, 5} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			test.Nil(container.AddEdges(i), t)
+			test.Nil(container.AddVertices(i, i+l), t)
+		}
+		cnt, err := container.Edges().Count()
+		test.Nil(err, t)
+		test.Eq(l, cnt, t)
+		cnt, err = container.Vertices().Count()
+		test.Nil(err, t)
+		test.Eq(2*l, cnt, t)
+	}
+}
+
+func undirectedGraphLinkHelper(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	construction undirectedGraphConstruction,
+	t *testing.T,
+) {
+	container := construction.makeGraph(factory, t)
+
+	// Links are symmetric, re-adding a link in either direction is a no-op
+	for i := 0; i < len(construction.links); i++ {
+		test.Nil(
+			container.Link(
+				construction.links[i][0],
+				construction.links[i][1],
+				construction.links[i][2],
+			),
+			t,
+		)
+		test.Nil(
+			container.Link(
+				construction.links[i][1],
+				construction.links[i][0],
+				construction.links[i][2],
+			),
+			t,
+		)
+	}
+	test.Eq(len(construction.links), container.NumLinks(), t)
+	test.Eq(construction.numVertices, container.NumVertices(), t)
+	test.Eq(construction.numEdges, container.NumEdges(), t)
+
+	err := container.Link(-1, 0, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	err = container.Link(0, -1, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	err = container.Link(0, 0, -1)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Eq(len(construction.links), container.NumLinks(), t)
+}
+
+// Tests the Link method functionality of a dynamic undirected graph.
+func DynUndirectedGraphLink(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	undirectedGraphLinkHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 5, 1),
+			edges:    iter.Range[int](0, 4, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 1},
+				[3]int{2, 3, 2},
+				[3]int{3, 4, 3},
+			},
+		},
+		t,
+	)
+	undirectedGraphLinkHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 3, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 0, 0},
+				[3]int{0, 1, 0},
+				[3]int{0, 1, 1},
+				[3]int{1, 1, 2},
+				[3]int{1, 2, 2},
+			},
+		},
+		t,
+	)
+}
+
+// Tests the Degree method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDegree(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+	test.Eq(3, container.Degree(0), t)
+	test.Eq(2, container.Degree(1), t)
+	test.Eq(3, container.Degree(2), t)
+	test.Eq(0, container.Degree(3), t)
+	test.Eq(0, container.Degree(4), t)
+	test.Eq(0, container.Degree(5), t)
+}
+
+func undirectedGraphIterMatches[T any](
+	exp []T,
+	i iter.Iter[T],
+	t *testing.T,
+) {
+	vals, err := i.Collect()
+	test.Nil(err, t)
+	test.SlicesMatchUnordered[T](exp, vals, t)
+}
+
+// Tests the Neighbors method functionality of a dynamic undirected graph.
+func DynUndirectedGraphNeighbors(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+	undirectedGraphIterMatches([]int{1, 1, 2}, container.Neighbors(0), t)
+	undirectedGraphIterMatches([]int{0, 0}, container.Neighbors(1), t)
+	undirectedGraphIterMatches([]int{0, 2}, container.Neighbors(2), t)
+	undirectedGraphIterMatches([]int{}, container.Neighbors(3), t)
+	_, err := container.Neighbors(5).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+// Tests the IncidentEdges method functionality of a dynamic undirected graph.
+func DynUndirectedGraphIncidentEdges(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+	undirectedGraphIterMatches([]int{0, 0, 1}, container.IncidentEdges(0), t)
+	undirectedGraphIterMatches([]int{0, 1}, container.IncidentEdges(1), t)
+	undirectedGraphIterMatches([]int{0, 2}, container.IncidentEdges(2), t)
+	undirectedGraphIterMatches([]int{}, container.IncidentEdges(4), t)
+	_, err := container.IncidentEdges(5).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+// Tests the IncidentEdgesAndNeighbors method functionality of a dynamic
+// undirected graph.
+func DynUndirectedGraphIncidentEdgesAndNeighbors(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+	undirectedGraphIterMatches(
+		[]basic.Pair[int, int]{
+			{A: 0, B: 1},
+			{A: 0, B: 2},
+			{A: 1, B: 1},
+		},
+		container.IncidentEdgesAndNeighbors(0),
+		t,
+	)
+	undirectedGraphIterMatches(
+		[]basic.Pair[int, int]{
+			{A: 0, B: 0},
+			{A: 1, B: 0},
+		},
+		container.IncidentEdgesAndNeighbors(1),
+		t,
+	)
+	undirectedGraphIterMatches(
+		[]basic.Pair[int, int]{
+			{A: 0, B: 0},
+			{A: 2, B: 2},
+		},
+		container.IncidentEdgesAndNeighbors(2),
+		t,
+	)
+	_, err := container.IncidentEdgesAndNeighbors(5).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+// Tests the EdgesBetween method functionality of a dynamic undirected graph.
+func DynUndirectedGraphEdgesBetween(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+	undirectedGraphIterMatches([]int{0, 1}, container.EdgesBetween(0, 1), t)
+	undirectedGraphIterMatches([]int{0, 1}, container.EdgesBetween(1, 0), t)
+	undirectedGraphIterMatches([]int{2}, container.EdgesBetween(2, 2), t)
+	undirectedGraphIterMatches([]int{}, container.EdgesBetween(1, 2), t)
+	_, err := container.EdgesBetween(5, 0).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+	_, err = container.EdgesBetween(0, 5).Collect()
+	test.ContainsError(containerTypes.ValueError, err, t)
+}
+
+// Tests the DeleteLink method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDeleteLink(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 3, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{1, 2, 0},
+			[3]int{2, 2, 2},
+		},
+	}).makeGraph(factory, t)
+
+	test.Nil(container.DeleteLink(1, 0, 0), t)
+	test.Eq(3, container.NumLinks(), t)
+	test.False(container.ContainsLink(0, 1, 0), t)
+	test.False(container.ContainsLink(1, 0, 0), t)
+	test.True(container.ContainsLink(0, 1, 1), t)
+	test.Eq(1, container.Degree(0), t)
+
+	// Deleting a link that does not exist is a no-op
+	test.Nil(container.DeleteLink(0, 1, 0), t)
+	test.Eq(3, container.NumLinks(), t)
+
+	test.Nil(container.DeleteLink(2, 2, 2), t)
+	test.Eq(2, container.NumLinks(), t)
+	test.Eq(1, container.Degree(2), t)
+
+	err := container.DeleteLink(-1, 0, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	err = container.DeleteLink(0, -1, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	err = container.DeleteLink(0, 1, -1)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Eq(2, container.NumLinks(), t)
+	test.Eq(3, container.NumVertices(), t)
+	test.Eq(3, container.NumEdges(), t)
+}
+
+// Tests the DeleteLinks method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDeleteLinks(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 3, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 1, 2},
+			[3]int{1, 2, 0},
+			[3]int{0, 0, 0},
+		},
+	}).makeGraph(factory, t)
+
+	test.Nil(container.DeleteLinks(1, 0), t)
+	test.Eq(2, container.NumLinks(), t)
+	test.Eq(2, container.Degree(0), t)
+	test.Eq(1, container.Degree(1), t)
+	cnt, err := container.EdgesBetween(0, 1).Count()
+	test.Nil(err, t)
+	test.Eq(0, cnt, t)
+
+	test.Nil(container.DeleteLinks(0, 0), t)
+	test.Eq(1, container.NumLinks(), t)
+	test.Eq(0, container.Degree(0), t)
+
+	err = container.DeleteLinks(-1, 0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	err = container.DeleteLinks(0, -1)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Eq(1, container.NumLinks(), t)
+}
+
+// Tests the DeleteVertex method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDeleteVertex(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{1, 2, 0},
+			[3]int{1, 1, 2},
+			[3]int{2, 3, 2},
+		},
+	}).makeGraph(factory, t)
+
+	test.Nil(container.DeleteVertex(1), t)
+	test.Eq(1, container.NumLinks(), t)
+	test.Eq(3, container.NumVertices(), t)
+	test.Eq(3, container.NumEdges(), t)
+	test.False(container.ContainsVertex(1), t)
+	test.Eq(0, container.Degree(0), t)
+	test.Eq(1, container.Degree(2), t)
+	test.True(container.ContainsLink(3, 2, 2), t)
+
+	err := container.DeleteVertex(1)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Eq(1, container.NumLinks(), t)
+}
+
+// Tests the DeleteEdge method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDeleteEdge(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{1, 2, 0},
+			[3]int{1, 1, 0},
+			[3]int{2, 3, 2},
+		},
+	}).makeGraph(factory, t)
+
+	test.Nil(container.DeleteEdge(0), t)
+	test.Eq(2, container.NumLinks(), t)
+	test.Eq(4, container.NumVertices(), t)
+	test.Eq(2, container.NumEdges(), t)
+	test.False(container.ContainsEdge(0), t)
+	test.Eq(1, container.Degree(0), t)
+	test.Eq(1, container.Degree(1), t)
+	test.True(container.ContainsLink(1, 0, 1), t)
+
+	err := container.DeleteEdge(0)
+	test.ContainsError(containerTypes.ValueError, err, t)
+	test.Eq(2, container.NumLinks(), t)
+}
+
+// Tests the Clear method functionality of a dynamic undirected graph.
+func DynUndirectedGraphClear(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 5, 1),
+		edges:    iter.Range[int](0, 5, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{0, 1, 1},
+			[3]int{0, 2, 3},
+			[3]int{2, 2, 4},
+		},
+	}).makeGraph(factory, t)
+	container.Clear()
+	test.Eq(0, container.NumLinks(), t)
+	test.Eq(0, container.NumVertices(), t)
+	test.Eq(0, container.NumEdges(), t)
+
+	container.Clear()
+	test.Eq(0, container.NumLinks(), t)
+	test.Eq(0, container.NumVertices(), t)
+	test.Eq(0, container.NumEdges(), t)
+}
+
+// Tests the ConnectedComponents method functionality of a dynamic undirected
+// graph.
+func DynUndirectedGraphConnectedComponents(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	comps, err := container.ConnectedComponents().Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(comps), t)
+
+	container = (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 7, 1),
+		edges:    iter.Range[int](0, 2, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{2, 1, 0},
+			[3]int{3, 4, 1},
+			[3]int{5, 5, 1},
+		},
+	}).makeGraph(factory, t)
+	comps, err = container.ConnectedComponents().Collect()
+	test.Nil(err, t)
+	test.Eq(4, len(comps), t)
+	compOf := map[int]int{}
+	for i, c := range comps {
+		for _, v := range c {
+			_, ok := compOf[v]
+			test.False(ok, t)
+			compOf[v] = i
+		}
+	}
+	test.Eq(7, len(compOf), t)
+	test.Eq(compOf[0], compOf[1], t)
+	test.Eq(compOf[0], compOf[2], t)
+	test.Eq(compOf[3], compOf[4], t)
+	test.Neq(compOf[0], compOf[3], t)
+	test.Neq(compOf[0], compOf[5], t)
+	test.Neq(compOf[0], compOf[6], t)
+	test.Neq(compOf[5], compOf[6], t)
+
+	cnt, err := container.ConnectedComponents().Take(2).Count()
+	test.Nil(err, t)
+	test.Eq(2, cnt, t)
+}
+
+// Tests the NumConnectedComponents method functionality of a dynamic
+// undirected graph.
+func DynUndirectedGraphNumConnectedComponents(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := factory(0)
+	test.Eq(0, container.NumConnectedComponents(), t)
+
+	container = (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 7, 1),
+		edges:    iter.Range[int](0, 2, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{2, 1, 0},
+			[3]int{3, 4, 1},
+			[3]int{5, 5, 1},
+		},
+	}).makeGraph(factory, t)
+	test.Eq(4, container.NumConnectedComponents(), t)
+	test.Nil(container.Link(2, 3, 1), t)
+	test.Eq(3, container.NumConnectedComponents(), t)
+	test.Nil(container.DeleteLinks(1, 2), t)
+	test.Eq(4, container.NumConnectedComponents(), t)
+}
+
+// Tests the Connected method functionality of a dynamic undirected graph.
+func DynUndirectedGraphConnected(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 7, 1),
+		edges:    iter.Range[int](0, 2, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{2, 1, 0},
+			[3]int{3, 4, 1},
+			[3]int{5, 5, 1},
+		},
+	}).makeGraph(factory, t)
+	test.True(container.Connected(0, 2), t)
+	test.True(container.Connected(2, 0), t)
+	test.True(container.Connected(4, 3), t)
+	test.True(container.Connected(5, 5), t)
+	test.True(container.Connected(6, 6), t)
+	test.False(container.Connected(0, 3), t)
+	test.False(container.Connected(5, 6), t)
+	test.False(container.Connected(0, 7), t)
+	test.False(container.Connected(7, 0), t)
+}
+
+func undirectedGraphKeyedEqHelper(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	g1 undirectedGraphConstruction,
+	g2 undirectedGraphConstruction,
+	expResult bool,
+	t *testing.T,
+) {
+	container1 := g1.makeGraph(factory, t)
+	container2 := g2.makeGraph(factory, t)
+	test.Eq(expResult, container1.KeyedEq(container2), t)
+	test.Eq(expResult, container2.KeyedEq(container1), t)
+	test.Eq(expResult, container1.UnorderedEq(container2), t)
+	test.Eq(expResult, container2.UnorderedEq(container1), t)
+}
+
+// Tests the KeyedEq method functionality of a dynamic undirected graph.
+func DynUndirectedGraphKeyedEq(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	undirectedGraphKeyedEqHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		true,
+		t,
+	)
+	undirectedGraphKeyedEqHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 1},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+				[3]int{2, 1, 1},
+			},
+		},
+		true,
+		t,
+	)
+	undirectedGraphKeyedEqHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 1},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{0, 2, 1},
+			},
+		},
+		false,
+		t,
+	)
+	undirectedGraphKeyedEqHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{1, 1, 1},
+			},
+		},
+		false,
+		t,
+	)
+	undirectedGraphKeyedEqHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links:    [][3]int{},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 4, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links:    [][3]int{},
+		},
+		false,
+		t,
+	)
+}
+
+func undirectedGraphSetOpHelper(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	op func(
+		res dynamicContainers.UndirectedGraph[int, int],
+		l dynamicContainers.UndirectedGraph[int, int],
+		r dynamicContainers.UndirectedGraph[int, int],
+	),
+	g1 undirectedGraphConstruction,
+	g2 undirectedGraphConstruction,
+	expG undirectedGraphConstruction,
+	t *testing.T,
+) {
+	container1 := g1.makeGraph(factory, t)
+	container2 := g2.makeGraph(factory, t)
+	container3 := expG.makeGraph(factory, t)
+	container4 := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](100, 103, 1),
+		edges:    iter.Range[int](100, 101, 1),
+		links:    [][3]int{[3]int{100, 101, 100}},
+	}).makeGraph(factory, t)
+	op(container4, container1, container2)
+	test.True(container3.KeyedEq(container4), t)
+}
+
+// Tests the Intersection method functionality of a dynamic undirected graph.
+func DynUndirectedGraphIntersection(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	op := func(res, l, r dynamicContainers.UndirectedGraph[int, int]) {
+		res.Intersection(l, r)
+	}
+	g1 := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{1, 2, 1},
+			[3]int{2, 2, 2},
+		},
+	}
+	g2 := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{1, 0, 0},
+			[3]int{2, 1, 0},
+			[3]int{2, 2, 2},
+			[3]int{3, 0, 2},
+		},
+	}
+	exp := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 3, 1),
+		edges:    iter.SliceElems[int]([]int{0, 2}),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{2, 2, 2},
+		},
+	}
+	undirectedGraphSetOpHelper(factory, op, g1, g2, exp, t)
+	g1.vertices, g1.edges = iter.Range[int](0, 4, 1), iter.Range[int](0, 3, 1)
+	g2.vertices, g2.edges = iter.Range[int](0, 4, 1), iter.Range[int](0, 3, 1)
+	exp.vertices, exp.edges = iter.Range[int](0, 3, 1), iter.SliceElems[int]([]int{0, 2})
+	undirectedGraphSetOpHelper(factory, op, g2, g1, exp, t)
+}
+
+// Tests the Union method functionality of a dynamic undirected graph.
+func DynUndirectedGraphUnion(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	op := func(res, l, r dynamicContainers.UndirectedGraph[int, int]) {
+		res.Union(l, r)
+	}
+	g1 := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 3, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{1, 2, 1},
+		},
+	}
+	g2 := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{1, 0, 0},
+			[3]int{2, 2, 2},
+			[3]int{3, 0, 2},
+		},
+	}
+	exp := undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 4, 1),
+		edges:    iter.Range[int](0, 3, 1),
+		links: [][3]int{
+			// v1, v2, e
+			[3]int{0, 1, 0},
+			[3]int{1, 2, 1},
+			[3]int{2, 2, 2},
+			[3]int{0, 3, 2},
+		},
+	}
+	undirectedGraphSetOpHelper(factory, op, g1, g2, exp, t)
+	g1.vertices, g1.edges = iter.Range[int](0, 3, 1), iter.Range[int](0, 3, 1)
+	g2.vertices, g2.edges = iter.Range[int](0, 4, 1), iter.Range[int](0, 3, 1)
+	exp.vertices, exp.edges = iter.Range[int](0, 4, 1), iter.Range[int](0, 3, 1)
+	undirectedGraphSetOpHelper(factory, op, g2, g1, exp, t)
+}
+
+// Tests the Difference method functionality of a dynamic undirected graph.
+func DynUndirectedGraphDifference(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	op := func(res, l, r dynamicContainers.UndirectedGraph[int, int]) {
+		res.Difference(l, r)
+	}
+	undirectedGraphSetOpHelper(
+		factory,
+		op,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 4, 1),
+			edges:    iter.Range[int](0, 3, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 1},
+				[3]int{2, 2, 2},
+				[3]int{3, 0, 2},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 4, 1),
+			edges:    iter.Range[int](0, 3, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+				[3]int{2, 2, 2},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.SliceElems[int]([]int{0, 1, 2, 3}),
+			edges:    iter.SliceElems[int]([]int{1, 2}),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{2, 1, 1},
+				[3]int{0, 3, 2},
+			},
+		},
+		t,
+	)
+	undirectedGraphSetOpHelper(
+		factory,
+		op,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 2, 1),
+			edges:    iter.Range[int](0, 1, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 2, 1),
+			edges:    iter.Range[int](0, 1, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		t,
+	)
+}
+
+func undirectedGraphSubsetHelper(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	g1 undirectedGraphConstruction,
+	g2 undirectedGraphConstruction,
+	expSubset bool,
+	expSuperset bool,
+	t *testing.T,
+) {
+	container1 := g1.makeGraph(factory, t)
+	container2 := g2.makeGraph(factory, t)
+	test.Eq(expSubset, container1.IsSubset(container2), t)
+	test.Eq(expSubset, container2.IsSuperset(container1), t)
+	test.Eq(expSuperset, container1.IsSuperset(container2), t)
+	test.Eq(expSuperset, container2.IsSubset(container1), t)
+}
+
+// Tests the IsSubset and IsSuperset methods functionality of a dynamic
+// undirected graph.
+func DynUndirectedGraphIsSubsetIsSuperset(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	undirectedGraphSubsetHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		true,
+		true,
+		t,
+	)
+	undirectedGraphSubsetHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 4, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+				[3]int{2, 2, 1},
+			},
+		},
+		true,
+		false,
+		t,
+	)
+	undirectedGraphSubsetHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 1},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 4, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+				[3]int{2, 2, 1},
+			},
+		},
+		false,
+		false,
+		t,
+	)
+	undirectedGraphSubsetHelper(
+		factory,
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{0, 1, 0},
+				[3]int{2, 2, 1},
+			},
+		},
+		undirectedGraphConstruction{
+			vertices: iter.Range[int](0, 3, 1),
+			edges:    iter.Range[int](0, 2, 1),
+			links: [][3]int{
+				// v1, v2, e
+				[3]int{1, 0, 0},
+				[3]int{2, 2, 1},
+			},
+		},
+		true,
+		true,
+		t,
+	)
+}
+
+func undirectedGraphSerializationHelper(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	binary bool,
+	t *testing.T,
+) {
+	constructions := []undirectedGraphConstruction{
+		{
+			vertices: iter.NoElem[int](),
+			edges:    iter.NoElem[int](),
+			links:    [][3]int{},
+		},
+		{
+			// Vertex 5 and edge 3 are not part of any link and must still be
+			// preserved.
+			vertices: iter.Range[int](0, 6, 1),
+			edges:    iter.Range[int](0, 4, 1),
+			links: [][3]int{
+				// from, to, e
+				[3]int{0, 1, 0},
+				[3]int{1, 2, 0},
+				[3]int{1, 2, 1},
+				[3]int{2, 3, 2},
+				[3]int{4, 4, 1},
+			},
+		},
+	}
+	for _, c := range constructions {
+		container := c.makeGraph(factory, t)
+		other := (&undirectedGraphConstruction{
+			vertices: iter.Range[int](-2, 0, 1),
+			edges:    iter.Range[int](-1, 0, 1),
+			links:    [][3]int{[3]int{-2, -1, -1}},
+		}).makeGraph(factory, t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.NumVertices(), other.NumVertices(), t)
+		test.Eq(container.NumEdges(), other.NumEdges(), t)
+		test.Eq(container.NumLinks(), other.NumLinks(), t)
+		test.True(container.KeyedEq(other), t)
+		test.False(other.ContainsVertex(-1), t)
+		test.False(other.ContainsEdge(-1), t)
+	}
+	container := (&undirectedGraphConstruction{
+		vertices: iter.Range[int](0, 2, 1),
+		edges:    iter.Range[int](0, 1, 1),
+		links:    [][3]int{[3]int{0, 1, 0}},
+	}).makeGraph(factory, t)
+	serializationMalformed(container, binary, t)
+	test.Eq(2, container.NumVertices(), t)
+	test.Eq(1, container.NumEdges(), t)
+	test.Eq(1, container.NumLinks(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic undirected graph.
+func DynUndirectedGraphInterfaceJSON(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	undirectedGraphSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic undirected graph.
+func DynUndirectedGraphInterfaceBinary(
+	factory func(capacity int) dynamicContainers.UndirectedGraph[int, int],
+	t *testing.T,
+) {
+	undirectedGraphSerializationHelper(factory, true, t)
+}
@@ -192,6 +192,16 @@ func DynVectorInterfaceInterface[V any](
 	dynamicVectorInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func DynVectorInterfaceSerializableInterface[V any](
+	factory func(capacity int) dynamicContainers.Vector[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests that the value supplied by the factory does not implement the
 // [staticContainers.Vector] interface.
 func DynVectorInterfaceStaticCapacityInterface[V any](
@@ -1292,3 +1302,43 @@ func DynVectorInterfaceIsSubset(
 	test.False(v.IsSubset(v2), t)
 	test.True(v2.IsSubset(v), t)
 }
+
+func dynVectorSerializationHelper(
+	factory func(capacity int) dynamicContainers.Vector[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{0, 1, 5, 20} {
+		container := factory(0)
+		for i := 0; i < l; i++ {
+			container.Append(i)
+		}
+		other := factory(0)
+		other.Append(-1, -2)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l, other.Length(), t)
+		test.True(container.KeyedEq(other), t)
+	}
+	container := factory(0)
+	container.Append(1)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// dynamic vector.
+func DynVectorInterfaceJSON(
+	factory func(capacity int) dynamicContainers.Vector[int],
+	t *testing.T,
+) {
+	dynVectorSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// dynamic vector.
+func DynVectorInterfaceBinary(
+	factory func(capacity int) dynamicContainers.Vector[int],
+	t *testing.T,
+) {
+	dynVectorSerializationHelper(factory, true, t)
+}
@@ -140,6 +140,16 @@ func StaticDequeInterfaceInterface[V any](
 	staticDequeInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func StaticDequeInterfaceSerializableInterface[V any](
+	factory func(capacity int) staticContainers.Deque[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests the Clear method functionality of a static Deque.
 func StaticDequeInterfaceClear(
 	factory func(capacity int) staticContainers.Deque[int],
@@ -549,3 +559,56 @@ func StaticDequeInterfaceForcePushBack(
 	test.Nil(err, t)
 	test.Eq(7, v, t)
 }
+
+func staticDequeSerializationHelper(
+	factory func(capacity int) staticContainers.Deque[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{1, 5, 20} {
+		container := factory(l)
+		for i := 0; i < l-1; i++ {
+			if i%2 == 0 {
+				test.Nil(container.PushBack(i), t)
+			} else {
+				test.Nil(container.PushFront(i), t)
+			}
+		}
+		other := factory(2)
+		test.Nil(other.PushBack(-1, -2), t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		test.Eq(l, other.Capacity(), t)
+		for container.Length() > 0 {
+			exp, err := container.PopFront()
+			test.Nil(err, t)
+			iterV, err := other.PopFront()
+			test.Nil(err, t)
+			test.Eq(exp, iterV, t)
+		}
+		test.Eq(0, other.Length(), t)
+	}
+	container := factory(2)
+	test.Nil(container.PushBack(1), t)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+	test.Eq(2, container.Capacity(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// static deque.
+func StaticDequeInterfaceJSON(
+	factory func(capacity int) staticContainers.Deque[int],
+	t *testing.T,
+) {
+	staticDequeSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// static deque.
+func StaticDequeInterfaceBinary(
+	factory func(capacity int) staticContainers.Deque[int],
+	t *testing.T,
+) {
+	staticDequeSerializationHelper(factory, true, t)
+}
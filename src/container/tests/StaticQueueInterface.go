@@ -110,6 +110,16 @@ func StaticQueueInterfaceInterface[V any](
 	staticQueueInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func StaticQueueInterfaceSerializableInterface[V any](
+	factory func(capacity int) staticContainers.Queue[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 // Tests the Clear method functionality of a static Queue.
 func StaticQueueInterfaceClear(
 	factory func(capacity int) staticContainers.Queue[int],
@@ -300,3 +310,52 @@ func StaticQueueInterfaceForcePushBack(
 	test.Nil(err, t)
 	test.Eq(3, v, t)
 }
+
+func staticQueueSerializationHelper(
+	factory func(capacity int) staticContainers.Queue[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{1, 5, 20} {
+		container := factory(l)
+		for i := 0; i < l-1; i++ {
+			test.Nil(container.PushBack(i), t)
+		}
+		other := factory(2)
+		test.Nil(other.PushBack(-1, -2), t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(container.Length(), other.Length(), t)
+		test.Eq(l, other.Capacity(), t)
+		for container.Length() > 0 {
+			exp, err := container.PopFront()
+			test.Nil(err, t)
+			iterV, err := other.PopFront()
+			test.Nil(err, t)
+			test.Eq(exp, iterV, t)
+		}
+		test.Eq(0, other.Length(), t)
+	}
+	container := factory(2)
+	test.Nil(container.PushBack(1), t)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+	test.Eq(2, container.Capacity(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// static queue.
+func StaticQueueInterfaceJSON(
+	factory func(capacity int) staticContainers.Queue[int],
+	t *testing.T,
+) {
+	staticQueueSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// static queue.
+func StaticQueueInterfaceBinary(
+	factory func(capacity int) staticContainers.Queue[int],
+	t *testing.T,
+) {
+	staticQueueSerializationHelper(factory, true, t)
+}
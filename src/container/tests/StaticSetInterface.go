@@ -101,6 +101,16 @@ func StaticSetInterfaceInterface[V any](
 	staticSetInterface[V](factory(0))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func StaticSetInterfaceSerializableInterface[V any](
+	factory func(capacity int) staticContainers.Set[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 func staticSetValsHelper(
 	factory func(capacity int) staticContainers.Set[int],
 	l int,
@@ -741,3 +751,46 @@ func StaticSetInterfaceIsSubset(
 	test.False(v.IsSubset(v2), t)
 	test.True(v2.IsSubset(v), t)
 }
+
+func staticSetSerializationHelper(
+	factory func(capacity int) staticContainers.Set[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{1, 5, 20} {
+		container := factory(l)
+		for i := 0; i < l-1; i++ {
+			test.Nil(container.AppendUnique(i), t)
+		}
+		other := factory(2)
+		test.Nil(other.AppendUnique(-1, -2), t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l-1, other.Length(), t)
+		test.Eq(l, other.Capacity(), t)
+		test.True(container.UnorderedEq(other), t)
+		test.False(other.Contains(-1), t)
+	}
+	container := factory(2)
+	test.Nil(container.AppendUnique(1), t)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+	test.True(container.Contains(1), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// static set.
+func StaticSetInterfaceJSON(
+	factory func(capacity int) staticContainers.Set[int],
+	t *testing.T,
+) {
+	staticSetSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// static set.
+func StaticSetInterfaceBinary(
+	factory func(capacity int) staticContainers.Set[int],
+	t *testing.T,
+) {
+	staticSetSerializationHelper(factory, true, t)
+}
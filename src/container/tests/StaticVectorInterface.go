@@ -192,6 +192,16 @@ func StaticVectorInterfaceInterface[V any](
 	staticVectorInterface[V](factory(1))
 }
 
+// Tests that the value supplied by the factory implements the
+// [containerTypes.Serializable] interface.
+func StaticVectorInterfaceSerializableInterface[V any](
+	factory func(capacity int) staticContainers.Vector[V],
+	t *testing.T,
+) {
+	var container containerTypes.Serializable = factory(0)
+	_ = container
+}
+
 func staticVectorGetHelper(
 	factory func(capacity int) staticContainers.Vector[int],
 	l int,
@@ -1196,3 +1206,47 @@ func StaticVectorInterfaceKeyedEq(
 	test.True(v.KeyedEq(v2), t)
 	test.True(v2.KeyedEq(v), t)
 }
+
+func staticVectorSerializationHelper(
+	factory func(capacity int) staticContainers.Vector[int],
+	binary bool,
+	t *testing.T,
+) {
+	for _, l := range []int{1, 5, 20} {
+		container := factory(l)
+		for i := 0; i < l-1; i++ {
+			test.Nil(container.Append(i), t)
+		}
+		other := factory(2)
+		test.Nil(other.Append(-1, -2), t)
+		serializationRoundTrip(container, other, binary, t)
+		test.Eq(l-1, other.Length(), t)
+		test.Eq(l, other.Capacity(), t)
+		test.True(container.KeyedEq(other), t)
+		test.Nil(other.Append(l), t)
+		test.True(other.Full(), t)
+	}
+	container := factory(2)
+	test.Nil(container.Append(1), t)
+	serializationMalformed(container, binary, t)
+	test.Eq(1, container.Length(), t)
+	test.Eq(2, container.Capacity(), t)
+}
+
+// Tests the MarshalJSON and UnmarshalJSON methods functionality of a
+// static vector.
+func StaticVectorInterfaceJSON(
+	factory func(capacity int) staticContainers.Vector[int],
+	t *testing.T,
+) {
+	staticVectorSerializationHelper(factory, false, t)
+}
+
+// Tests the MarshalBinary and UnmarshalBinary methods functionality of a
+// static vector.
+func StaticVectorInterfaceBinary(
+	factory func(capacity int) staticContainers.Vector[int],
+	t *testing.T,
+) {
+	staticVectorSerializationHelper(factory, true, t)
+}
@@ -2,6 +2,12 @@
 // pull style iterators.
 package iter
 
+import (
+	"time"
+
+	"github.com/barbell-math/util/src/customerr"
+)
+
 // A type that defines the valid states that an iterator chain can use.
 type IteratorFeedback int
 
@@ -20,3 +26,29 @@ const (
 // in the iterator sequence
 //   - bool: a flag to indicate whether or not to continue iteration
 type Iter[T any] func(f IteratorFeedback) (T, error, bool)
+
+// A clock that is used by the iterators that depend on the passage of time.
+// Supplying a custom clock allows the passage of time to be controlled, which
+// is useful when testing.
+type Clock interface {
+	// Returns a channel that will receive the current time once the supplied
+	// duration has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// A [Clock] that uses the system time.
+type SystemClock struct{}
+
+func (s SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func greaterThanZeroCheck(name string, val int) error {
+	if val < 1 {
+		return customerr.Wrap(
+			customerr.ValOutsideRange,
+			"Expected %s >0 | Got: %d", name, val,
+		)
+	}
+	return nil
+}
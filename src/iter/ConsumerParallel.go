@@ -1,6 +1,9 @@
 package iter
 
 import (
+	"context"
+	"sync"
+
 	"github.com/barbell-math/util/src/customerr"
 )
 
@@ -87,6 +90,105 @@ func (i Iter[T]) Parallel(
 	return Parallel(i, workerOp, resOp, numThreads)
 }
 
+func forEachCtxWorker[T any, U any](
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	jobs chan T,
+	results chan forEachParallelResult[T, U],
+	op func(val T) (U, error)) {
+	defer wg.Done()
+	for val := range jobs {
+		tmp, err := op(val)
+		select {
+		case results <- forEachParallelResult[T, U]{
+			val: val,
+			res: tmp,
+			err: err,
+		}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// This function is a consumer.
+//
+// ParallelCtx is equivalent to [Parallel], the only difference is that no new
+// values will be given to the workers once the supplied context is done. When
+// this happens the contexts error will be returned, the iterator chain will be
+// cleaned up, and the results of any jobs that were still running will be
+// discarded without calling the result operation. This function will not
+// return until all of the worker go routines have exited. A worker cannot be
+// interrupted while it is running the worker operation, so long running worker
+// operations should observe the context themselves so that they can return
+// early. Any errors generated by the iterator chain will also be returned.
+func ParallelCtx[T any, U any](
+	ctx context.Context,
+	i Iter[T],
+	workerOp func(val T) (U, error),
+	resOp func(val T, res U, err error),
+	numThreads int,
+) error {
+	if err := numThreadsCheck(numThreads); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	running := 0
+	jobs, results := make(chan T), make(chan forEachParallelResult[T, U])
+	err := i.ForEachCtx(ctx, func(index int, val T) (IteratorFeedback, error) {
+		if index < numThreads {
+			//If another worker can be created, make one
+			wg.Add(1)
+			go forEachCtxWorker(ctx, &wg, jobs, results, workerOp)
+		} else {
+			//If all the worker threads are used wait for one to finish
+			select {
+			case res := <-results:
+				resOp(res.Unpack())
+				running--
+			case <-ctx.Done():
+				return Break, ctx.Err()
+			}
+		}
+		select {
+		case jobs <- val:
+			running++
+		case <-ctx.Done():
+			return Break, ctx.Err()
+		}
+		return Continue, nil
+	})
+	close(jobs)
+	//Need to wait for all the results that were not interrupted!!
+	for running > 0 && ctx.Err() == nil {
+		select {
+		case res := <-results:
+			resOp(res.Unpack())
+			running--
+		case <-ctx.Done():
+		}
+	}
+	if running > 0 && err == nil {
+		err = ctx.Err()
+	}
+	wg.Wait()
+	return err
+}
+
+// This function is a consumer.
+//
+// This function is equivalent to [ParallelCtx], the only difference is that
+// the inputs and outputs of the workers must be the same. It is offered as a
+// convenience function.
+func (i Iter[T]) ParallelCtx(
+	ctx context.Context,
+	workerOp func(val T) (T, error),
+	resOp func(val T, res T, err error),
+	numThreads int,
+) error {
+	return ParallelCtx(ctx, i, workerOp, resOp, numThreads)
+}
+
 func numThreadsCheck(numThreads int) error {
 	if numThreads < 1 {
 		return customerr.Wrap(
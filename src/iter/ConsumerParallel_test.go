@@ -1,9 +1,15 @@
 package iter
 
 import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
 	"github.com/barbell-math/util/src/customerr"
 	"github.com/barbell-math/util/src/test"
-	"testing"
 )
 
 func parallelIterHelper(numVals int, numThreads int, t *testing.T) {
@@ -41,3 +47,164 @@ func TestParallel(t *testing.T) {
 		parallelIterHelper(200, i, t)
 	}
 }
+
+// Waits for the number of running go routines to drop back down to at most
+// the supplied value, failing the test if it does not happen in a timely
+// manner. Go routines from previous tests may still be exiting when start is
+// recorded, so the count is allowed to drop below start.
+func noLeakedGoroutines(start int, t *testing.T) {
+	for i := 0; i < 100 && runtime.NumGoroutine() > start; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	test.True(runtime.NumGoroutine() <= start, t)
+}
+
+func parallelCtxIterHelper(numVals int, numThreads int, t *testing.T) {
+	vals := make([]int, numVals)
+	for i := 0; i < numVals; i++ {
+		vals[i] = i
+	}
+
+	cpy := make([]int, len(vals))
+	rv := SliceElems(vals).ParallelCtx(
+		context.Background(),
+		func(val int) (int, error) {
+			return val + 1, nil
+		},
+		func(val int, res int, err error) {
+			test.Eq(val+1, res, t)
+			test.Nil(err, t)
+			cpy[val] = res + 1
+		},
+		numThreads,
+	)
+	test.Nil(rv, t)
+	for i, v := range cpy {
+		test.Eq(vals[i]+2, v, t)
+	}
+}
+func TestParallelCtx(t *testing.T) {
+	rv := SliceElems([]int{1, 2, 3, 4}).ParallelCtx(
+		context.Background(),
+		func(val int) (int, error) { return 0, nil },
+		NoOp[int, int], 0,
+	)
+	test.ContainsError(customerr.ValOutsideRange, rv, t)
+
+	start := runtime.NumGoroutine()
+	for _, i := range []int{1, 25, 50, 75, 100} {
+		parallelCtxIterHelper(0, i, t)
+		parallelCtxIterHelper(1, i, t)
+		parallelCtxIterHelper(200, i, t)
+	}
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelCtxIterError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	start := runtime.NumGoroutine()
+	numRes := 0
+	rv := ParallelCtx(
+		context.Background(),
+		ValElem(1, nil, 10).Inject(
+			func(idx int, val int, injectedPrev bool) (int, error, bool) {
+				return 0, nil, false
+			},
+		).Next(
+			func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+				if index == 5 {
+					return Break, 0, expectedError
+				}
+				return Continue, val, nil
+			},
+		),
+		func(val int) (int, error) { return val, nil },
+		func(val int, res int, err error) { numRes++ },
+		2,
+	)
+	test.ContainsError(expectedError, rv, t)
+	test.Eq(5, numRes, t)
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelCtxCancelMidStream(t *testing.T) {
+	for _, numThreads := range []int{1, 4, 25} {
+		ctx, cancel := context.WithCancel(context.Background())
+		start := runtime.NumGoroutine()
+		teardownRan := false
+		numRes := 0
+		rv := Range(0, 1000000, 1).Teardown(func() error {
+			teardownRan = true
+			return nil
+		}).ParallelCtx(
+			ctx,
+			func(val int) (int, error) { return val, nil },
+			func(val int, res int, err error) {
+				numRes++
+				if numRes == 50 {
+					cancel()
+				}
+			},
+			numThreads,
+		)
+		test.ContainsError(context.Canceled, rv, t)
+		test.Eq(50, numRes, t)
+		test.True(teardownRan, t)
+		noLeakedGoroutines(start, t)
+		cancel()
+	}
+}
+
+func TestParallelCtxCancelBlockedWorkers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := runtime.NumGoroutine()
+	var running atomic.Int32
+	teardownRan := false
+	go func() {
+		for running.Load() < 4 {
+			runtime.Gosched()
+		}
+		cancel()
+	}()
+	rv := Range(0, 1000000, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).ParallelCtx(
+		ctx,
+		func(val int) (int, error) {
+			// Simulates a long running job that observes the context.
+			running.Add(1)
+			<-ctx.Done()
+			return val, ctx.Err()
+		},
+		func(val int, res int, err error) {
+			t.Error("The result operation should not be called.")
+		},
+		4,
+	)
+	test.ContainsError(context.Canceled, rv, t)
+	test.Eq(int32(4), running.Load(), t)
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelCtxDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	start := runtime.NumGoroutine()
+	teardownRan := false
+	rv := ParallelCtx(
+		ctx,
+		Range(0, 1, 0).Teardown(func() error {
+			teardownRan = true
+			return nil
+		}),
+		func(val int) (int, error) { return val, nil },
+		NoOp[int, int],
+		8,
+	)
+	test.ContainsError(context.DeadlineExceeded, rv, t)
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+}
@@ -1,6 +1,9 @@
 package iter
 
 import (
+	"context"
+	"time"
+
 	"github.com/barbell-math/util/src/customerr"
 )
 
@@ -72,6 +75,29 @@ func (i Iter[T]) SetupTeardown(setup func() error, teardown func() error) Iter[T
 	}
 }
 
+// This function is an intermediary.
+//
+// WithContext will stop iteration once the supplied context is cancelled or
+// its deadline is exceeded. The context is checked before each value is
+// requested from the parent iterator. Once the context is done the parent
+// iterator will no longer be called and the contexts error will be returned,
+// stopping iteration. The parent iterators are then cleaned up by the break
+// action that is passed up the iterator chain by the consumer, so any teardown
+// procedures will still be run. Note that a parent iterator that is blocked
+// waiting for a value cannot be interrupted by this intermediary, use a
+// context aware producer such as [ChanElemsCtx] for that purpose.
+func (i Iter[T]) WithContext(ctx context.Context) Iter[T] {
+	return func(f IteratorFeedback) (T, error, bool) {
+		if f != Break {
+			if err := ctx.Err(); err != nil {
+				var tmp T
+				return tmp, err, false
+			}
+		}
+		return i(f)
+	}
+}
+
 // This function is an intermediary.
 //
 // Inject will inject values into the iterator stream based on the operation (op)
@@ -119,3 +145,187 @@ func (i Iter[T]) Inject(
 		}
 	}
 }
+
+// This function is an intermediary.
+//
+// Chunk will group the values from it's parent iterator into slices of length
+// n, which must be >=1. Chunks will not overlap, as shown in the example below.
+// The last chunk will contain any leftover values and may have a length less
+// than n.
+//
+//   - Iteration 1: 1,2,3,4
+//   - Iteration 2: 5,6,7,8
+//   - Iteration 3: 9
+//
+// If reuseBuf is true then the same underlying slice will be returned for every
+// chunk, meaning a chunk is only valid until the next value is requested. This
+// avoids an allocation per chunk. If reuseBuf is false then each chunk is a
+// newly allocated slice that may be kept. If an error is returned from the
+// parent iterator then the values received before the error will be returned
+// as a partial chunk before the error is returned and iteration stops.
+func Chunk[T any](i Iter[T], n int, reuseBuf bool) Iter[[]T] {
+	var buf []T
+	done := false
+	var parentErr error
+	return func(f IteratorFeedback) ([]T, error, bool) {
+		if f == Break {
+			_, err, _ := i(f)
+			return nil, err, false
+		}
+		if done {
+			err := parentErr
+			parentErr = nil
+			return nil, err, false
+		}
+		if err := greaterThanZeroCheck("chunk size", n); err != nil {
+			done = true
+			return nil, err, false
+		}
+		if buf == nil || !reuseBuf {
+			buf = make([]T, 0, n)
+		} else {
+			buf = buf[:0]
+		}
+		for len(buf) < n {
+			next, err, cont := i(f)
+			if err != nil || !cont {
+				done, parentErr = true, err
+				break
+			}
+			buf = append(buf, next)
+		}
+		if len(buf) == 0 {
+			err := parentErr
+			parentErr = nil
+			return nil, err, false
+		}
+		return buf, nil, true
+	}
+}
+
+type batchResult[T any] struct {
+	val  T
+	err  error
+	cont bool
+}
+
+func batchWorker[T any](
+	i Iter[T],
+	req chan IteratorFeedback,
+	res chan batchResult[T],
+) {
+	for f := range req {
+		next, err, cont := i(f)
+		res <- batchResult[T]{val: next, err: err, cont: cont}
+	}
+}
+
+// This function is an intermediary.
+//
+// Batch will group the values from it's parent iterator into slices. A batch
+// is returned once it contains maxSize values or once maxWait has elapsed since
+// the first value in the batch was received, whichever happens first. This
+// allows values from a slow or bursty parent iterator to be grouped without
+// waiting indefinitely for a batch to fill, for example when grouping rows
+// into bulk database inserts. The last batch will contain any leftover values.
+// maxSize must be >=1. If maxWait is <=0 then batches will only be returned
+// once they are full, the same as [Chunk].
+//
+// The supplied clock is used to measure maxWait, if it is nil then the system
+// clock will be used. If reuseBuf is true then the same underlying slice will
+// be returned for every batch, meaning a batch is only valid until the next
+// value is requested. If reuseBuf is false then each batch is a newly
+// allocated slice that may be kept.
+//
+// So that a batch can be returned while the parent iterator is waiting on a
+// value, the parent iterator is called from a separate go routine. Only one
+// call to the parent iterator is ever active at a time, so it does not need to
+// be thread safe. The go routine is not started until the first value is
+// requested and it exits once the break action has been passed on to the
+// parent iterator. If the parent iterator is waiting on a value when the break
+// action is received then the break action will wait for that value to be
+// returned before being passed on. If an error is returned from the parent
+// iterator then the values received before the error will be returned as a
+// partial batch before the error is returned and iteration stops.
+func Batch[T any](
+	i Iter[T],
+	maxSize int,
+	maxWait time.Duration,
+	clock Clock,
+	reuseBuf bool,
+) Iter[[]T] {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	var buf []T
+	var req chan IteratorFeedback
+	var res chan batchResult[T]
+	pending, done := false, false
+	var parentErr error
+	return func(f IteratorFeedback) ([]T, error, bool) {
+		if f == Break {
+			if req == nil {
+				_, err, _ := i(f)
+				return nil, err, false
+			}
+			if pending {
+				<-res
+				pending = false
+			}
+			req <- Break
+			r := <-res
+			close(req)
+			req = nil
+			done = true
+			return nil, r.err, false
+		}
+		if done {
+			err := parentErr
+			parentErr = nil
+			return nil, err, false
+		}
+		if err := greaterThanZeroCheck("batch size", maxSize); err != nil {
+			done = true
+			return nil, err, false
+		}
+		if req == nil {
+			req, res = make(chan IteratorFeedback), make(chan batchResult[T])
+			go batchWorker(i, req, res)
+		}
+		if buf == nil || !reuseBuf {
+			buf = make([]T, 0, maxSize)
+		} else {
+			buf = buf[:0]
+		}
+		// The timeout channel is nil, and will therefore never be selected,
+		// until the first value of the batch has been received.
+		var timeout <-chan time.Time
+	batchLoop:
+		for len(buf) < maxSize {
+			if !pending {
+				req <- f
+				pending = true
+			}
+			select {
+			case r := <-res:
+				pending = false
+				if r.err != nil || !r.cont {
+					done, parentErr = true, r.err
+					break batchLoop
+				}
+				buf = append(buf, r.val)
+				if len(buf) == 1 && maxWait > 0 {
+					timeout = clock.After(maxWait)
+				}
+			case <-timeout:
+				break batchLoop
+			}
+		}
+		if len(buf) == 0 {
+			err := parentErr
+			parentErr = nil
+			return nil, err, false
+		}
+		return buf, nil, true
+	}
+}
@@ -0,0 +1,170 @@
+package iter
+
+import (
+	"sync"
+
+	"github.com/barbell-math/util/src/customerr"
+)
+
+type (
+	parallelMapJob[T any] struct {
+		idx int
+		val T
+	}
+	parallelMapResult[U any] struct {
+		idx   int
+		res   U
+		err   error
+		valid bool
+	}
+)
+
+func parallelMapWorker[T any, U any](
+	wg *sync.WaitGroup,
+	quit chan struct{},
+	jobs chan parallelMapJob[T],
+	results chan parallelMapResult[U],
+	op func(index int, val T) (U, error),
+) {
+	defer wg.Done()
+	for j := range jobs {
+		select {
+		case <-quit:
+			return
+		default:
+		}
+		res, err := op(j.idx, j.val)
+		// The results channel has enough room for every job that can be in
+		// flight, so this will never block.
+		results <- parallelMapResult[U]{idx: j.idx, res: res, err: err, valid: true}
+	}
+}
+
+// This function is an intermediary.
+//
+// ParallelMap will take it's parent iterator and consume its values, applying
+// the operation (op) function to each value using numThreads go routines. The
+// transformed values are passed on to the child iterator in the same order
+// that they were received from the parent iterator. Use ParallelMap when a
+// single stage in the middle of an iterator chain is expensive enough to be
+// worth parallelizing.
+//
+// The parent iterator is only ever called from the go routine that is calling
+// this iterator, so it does not need to be thread safe. To keep the workers
+// busy, up to bufferSize values will be read ahead from the parent iterator.
+// This limits the number of values that can be in flight at once, which bounds
+// the memory used to put the results back in order. Both numThreads and
+// bufferSize must be >=1. The worker go routines are not started until the
+// first value is requested.
+//
+// If the operation function returns an error it will be returned, wrapped with
+// the index of the value that caused it, once all of the values before it have
+// been passed on and iteration will stop. Errors from the parent iterator are
+// returned unchanged once all of the values before them have been passed on.
+// When the break action is received any values that are still in flight are
+// discarded and the worker go routines are stopped before the break action is
+// passed on to the parent iterator.
+func ParallelMap[T any, U any](
+	i Iter[T],
+	op func(index int, val T) (U, error),
+	numThreads int,
+	bufferSize int,
+) Iter[U] {
+	started, done := false, false
+	next, dispatched := 0, 0
+	var parentErr error
+	parentDone := false
+	var wg sync.WaitGroup
+	var quit chan struct{}
+	var jobs chan parallelMapJob[T]
+	var results chan parallelMapResult[U]
+	var reorder []parallelMapResult[U]
+
+	stop := func() {
+		if quit != nil {
+			close(quit)
+			close(jobs)
+			wg.Wait()
+			quit = nil
+		}
+	}
+
+	return func(f IteratorFeedback) (U, error, bool) {
+		var tmp U
+		if f == Break {
+			stop()
+			done = true
+			_, err, _ := i(f)
+			return tmp, err, false
+		}
+		if done {
+			return tmp, nil, false
+		}
+		if !started {
+			if err := numThreadsCheck(numThreads); err != nil {
+				done = true
+				return tmp, err, false
+			}
+			if err := greaterThanZeroCheck("buffer size", bufferSize); err != nil {
+				done = true
+				return tmp, err, false
+			}
+			started = true
+			quit = make(chan struct{})
+			jobs = make(chan parallelMapJob[T], bufferSize)
+			results = make(chan parallelMapResult[U], bufferSize)
+			reorder = make([]parallelMapResult[U], bufferSize)
+			wg.Add(numThreads)
+			for j := 0; j < numThreads; j++ {
+				go parallelMapWorker(&wg, quit, jobs, results, op)
+			}
+		}
+
+		// Keep the workers busy by reading ahead from the parent iterator until
+		// the buffer is full.
+		for !parentDone && dispatched-next < bufferSize {
+			val, err, cont := i(f)
+			if err != nil || !cont {
+				parentErr, parentDone = err, true
+				break
+			}
+			jobs <- parallelMapJob[T]{idx: dispatched, val: val}
+			dispatched++
+		}
+		if next == dispatched {
+			stop()
+			done = true
+			return tmp, parentErr, false
+		}
+
+		// Wait for the next value in order to be ready. Every result that is
+		// received has an index in [next, next+bufferSize) so no two results
+		// will ever occupy the same slot.
+		for !reorder[next%bufferSize].valid {
+			r := <-results
+			reorder[r.idx%bufferSize] = r
+		}
+		r := reorder[next%bufferSize]
+		reorder[next%bufferSize] = parallelMapResult[U]{}
+		next++
+		if r.err != nil {
+			stop()
+			done = true
+			return tmp, customerr.Wrap(r.err, "Index: %d", r.idx), false
+		}
+		return r.res, nil, true
+	}
+}
+
+// This function is an intermediary.
+//
+// This function is equivalent to [ParallelMap], the only difference is that
+// the inputs iterator type and output iterator type must be the same. It is
+// offered as a convenience function.
+func (i Iter[T]) ParallelMap(
+	op func(index int, val T) (T, error),
+	numThreads int,
+	bufferSize int,
+) Iter[T] {
+	return ParallelMap(i, op, numThreads, bufferSize)
+}
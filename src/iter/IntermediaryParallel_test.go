@@ -0,0 +1,200 @@
+package iter
+
+import (
+	"errors"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
+)
+
+func parallelMapHelper(numVals int, numThreads int, bufferSize int, t *testing.T) {
+	res, err := ParallelMap(
+		Range(0, numVals, 1),
+		func(index int, val int) (string, error) {
+			test.Eq(index, val, t)
+			// Random delays make the workers finish out of order.
+			time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+			return strings.Repeat("a", val), nil
+		},
+		numThreads,
+		bufferSize,
+	).Collect()
+	test.Nil(err, t)
+	test.Eq(numVals, len(res), t)
+	for i, v := range res {
+		test.Eq(strings.Repeat("a", i), v, t)
+	}
+}
+func TestParallelMap(t *testing.T) {
+	start := runtime.NumGoroutine()
+	for _, numThreads := range []int{1, 4, 25} {
+		for _, bufferSize := range []int{1, 4, 50} {
+			parallelMapHelper(0, numThreads, bufferSize, t)
+			parallelMapHelper(1, numThreads, bufferSize, t)
+			parallelMapHelper(200, numThreads, bufferSize, t)
+		}
+	}
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelMapBadArgs(t *testing.T) {
+	teardownRan := false
+	_, err := SliceElems([]int{1, 2}).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).ParallelMap(func(index int, val int) (int, error) {
+		return val, nil
+	}, 0, 1).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	test.False(teardownRan, t)
+	_, err = SliceElems([]int{1, 2}).ParallelMap(func(index int, val int) (int, error) {
+		return val, nil
+	}, 1, 0).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestParallelMapIsLazy(t *testing.T) {
+	start := runtime.NumGoroutine()
+	parentCalls := 0
+	i := Range(0, 100, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if status != Break {
+				parentCalls++
+			}
+			return Continue, val, nil
+		},
+	).ParallelMap(func(index int, val int) (int, error) {
+		return val, nil
+	}, 4, 8)
+	test.Eq(0, parentCalls, t)
+	test.Eq(start, runtime.NumGoroutine(), t)
+	test.Nil(i.Stop(), t)
+	test.Eq(0, parentCalls, t)
+}
+
+func TestParallelMapBoundedBuffer(t *testing.T) {
+	var parentCalls, consumed int
+	err := Range(0, 500, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if status != Break {
+				parentCalls++
+			}
+			return Continue, val, nil
+		},
+	).ParallelMap(func(index int, val int) (int, error) {
+		return val, nil
+	}, 4, 8).ForEach(func(index int, val int) (IteratorFeedback, error) {
+		consumed++
+		test.Eq(index, val, t)
+		test.True(parentCalls-consumed < 8, t)
+		return Continue, nil
+	})
+	test.Nil(err, t)
+	test.Eq(500, consumed, t)
+}
+
+func TestParallelMapRunsInParallel(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	_, err := Range(0, 16, 1).ParallelMap(func(index int, val int) (int, error) {
+		cur := running.Add(1)
+		for prev := maxRunning.Load(); cur > prev; prev = maxRunning.Load() {
+			if maxRunning.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+		// Give the other workers a chance to start before finishing.
+		for i := 0; i < 1000 && running.Load() < 4; i++ {
+			time.Sleep(time.Microsecond)
+		}
+		running.Add(-1)
+		return val, nil
+	}, 4, 8).Collect()
+	test.Nil(err, t)
+	test.Eq(int32(4), maxRunning.Load(), t)
+}
+
+func TestParallelMapOpError(t *testing.T) {
+	start := runtime.NumGoroutine()
+	expectedError := errors.New("ERROR")
+	teardownRan := false
+	res, err := Range(0, 100, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).ParallelMap(func(index int, val int) (int, error) {
+		if val == 37 {
+			return 0, expectedError
+		}
+		return val, nil
+	}, 8, 16).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.True(strings.Contains(err.Error(), "Index: 37"), t)
+	test.Eq(37, len(res), t)
+	for i, v := range res {
+		test.Eq(i, v, t)
+	}
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelMapParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	res, err := Range(0, 100, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if val == 10 {
+				return Break, 0, expectedError
+			}
+			return Continue, val, nil
+		},
+	).ParallelMap(func(index int, val int) (int, error) {
+		return val * 2, nil
+	}, 4, 4).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.False(strings.Contains(err.Error(), "Index:"), t)
+	test.Eq(10, len(res), t)
+	for i, v := range res {
+		test.Eq(i*2, v, t)
+	}
+}
+
+func TestParallelMapBreakMidStream(t *testing.T) {
+	start := runtime.NumGoroutine()
+	teardownRan := false
+	res, err := Range(0, 1000000, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).ParallelMap(func(index int, val int) (int, error) {
+		time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+		return val, nil
+	}, 8, 32).Take(50).Collect()
+	test.Nil(err, t)
+	test.Eq(50, len(res), t)
+	for i, v := range res {
+		test.Eq(i, v, t)
+	}
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+}
+
+func TestParallelMapInChain(t *testing.T) {
+	res, err := Map(
+		Range(0, 100, 1).Filter(func(index int, val int) bool {
+			return val%2 == 0
+		}).ParallelMap(func(index int, val int) (int, error) {
+			return val * val, nil
+		}, 4, 8),
+		func(index int, val int) (int, error) {
+			return val + 1, nil
+		},
+	).Collect()
+	test.Nil(err, t)
+	test.Eq(50, len(res), t)
+	for i, v := range res {
+		test.Eq((2*i)*(2*i)+1, v, t)
+	}
+}
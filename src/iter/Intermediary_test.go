@@ -1,9 +1,13 @@
 package iter
 
 import (
+	"context"
 	"errors"
+	"runtime"
 	"testing"
+	"time"
 
+	"github.com/barbell-math/util/src/customerr"
 	"github.com/barbell-math/util/src/test"
 )
 
@@ -266,3 +270,269 @@ func TestInjectMultiValue(t *testing.T) {
 	multiValueInjectHelper[int]([]int{1, 2, 3, 4}, []int{5}, 4, []int{1, 2, 3, 4, 5}, t)
 	multiValueInjectHelper[int]([]int{1, 2, 3, 4}, []int{5, 6}, 4, []int{1, 2, 3, 4, 5, 6}, t)
 }
+
+func TestWithContext(t *testing.T) {
+	res, err := SliceElems([]int{0, 1, 2}).WithContext(context.Background()).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	parentCalled := false
+	teardownRan := false
+	cnt, err := SliceElems([]int{0, 1, 2}).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if status != Break {
+				parentCalled = true
+			}
+			return Continue, val, nil
+		},
+	).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).WithContext(ctx).Count()
+	test.ContainsError(context.Canceled, err, t)
+	test.Eq(0, cnt, t)
+	test.False(parentCalled, t)
+	test.False(teardownRan, t)
+}
+
+func TestWithContextCancelMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	teardownRan := false
+	res := []int{}
+	err := Range(0, 1000, 1).SetupTeardown(
+		func() error { return nil },
+		func() error { teardownRan = true; return nil },
+	).WithContext(ctx).ForEach(func(index int, val int) (IteratorFeedback, error) {
+		res = append(res, val)
+		if val == 4 {
+			cancel()
+		}
+		return Continue, nil
+	})
+	test.ContainsError(context.Canceled, err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2, 3, 4}, res, t)
+	test.True(teardownRan, t)
+}
+
+func TestWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	teardownRan := false
+	cnt, err := Range(0, 1, 0).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).WithContext(ctx).Count()
+	test.ContainsError(context.DeadlineExceeded, err, t)
+	test.True(cnt > 0, t)
+	test.True(teardownRan, t)
+}
+
+func chunkHelper(numVals int, n int, reuseBuf bool, t *testing.T) {
+	vals := []int{}
+	cntr := 0
+	err := Chunk(Range(0, numVals, 1), n, reuseBuf).ForEach(
+		func(index int, val []int) (IteratorFeedback, error) {
+			cntr++
+			if cntr*n <= numVals {
+				test.Eq(n, len(val), t)
+			} else {
+				test.Eq(numVals%n, len(val), t)
+			}
+			vals = append(vals, val...)
+			return Continue, nil
+		},
+	)
+	test.Nil(err, t)
+	test.Eq((numVals+n-1)/n, cntr, t)
+	test.Eq(numVals, len(vals), t)
+	for i, v := range vals {
+		test.Eq(i, v, t)
+	}
+}
+func TestChunk(t *testing.T) {
+	for _, reuseBuf := range []bool{true, false} {
+		for _, n := range []int{1, 2, 3, 10} {
+			chunkHelper(0, n, reuseBuf, t)
+			chunkHelper(1, n, reuseBuf, t)
+			chunkHelper(9, n, reuseBuf, t)
+			chunkHelper(10, n, reuseBuf, t)
+		}
+	}
+	_, err := Chunk(Range(0, 10, 1), 0, false).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestChunkReuseBuf(t *testing.T) {
+	res, err := Chunk(Range(0, 6, 1), 2, false).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	for i, v := range res {
+		test.SlicesMatch[int]([]int{2 * i, 2*i + 1}, v, t)
+	}
+	// With a reused buffer every chunk shares the same backing array.
+	res, err = Chunk(Range(0, 6, 1), 2, true).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	for _, v := range res {
+		test.SlicesMatch[int]([]int{4, 5}, v, t)
+	}
+}
+
+func TestChunkParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	teardownRan := false
+	res, err := Chunk(Range(0, 10, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if val == 5 {
+				return Break, 0, expectedError
+			}
+			return Continue, val, nil
+		},
+	).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}), 3, false).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.Eq(2, len(res), t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res[0], t)
+	test.SlicesMatch[int]([]int{3, 4}, res[1], t)
+	test.True(teardownRan, t)
+}
+
+type testClock struct {
+	durations []time.Duration
+	c         chan time.Time
+}
+
+func (t *testClock) After(d time.Duration) <-chan time.Time {
+	t.durations = append(t.durations, d)
+	return t.c
+}
+
+func TestBatchFullBatches(t *testing.T) {
+	clock := &testClock{c: make(chan time.Time)}
+	for _, reuseBuf := range []bool{true, false} {
+		vals := []int{}
+		cntr := 0
+		err := Batch(Range(0, 10, 1), 3, time.Hour, clock, reuseBuf).ForEach(
+			func(index int, val []int) (IteratorFeedback, error) {
+				cntr++
+				vals = append(vals, val...)
+				return Continue, nil
+			},
+		)
+		test.Nil(err, t)
+		test.Eq(4, cntr, t)
+		test.SlicesMatch[int]([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, vals, t)
+	}
+	test.Eq(8, len(clock.durations), t)
+	for _, d := range clock.durations {
+		test.Eq(time.Hour, d, t)
+	}
+	_, err := Batch(Range(0, 10, 1), 0, time.Hour, clock, false).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	clock := &testClock{c: make(chan time.Time, 1)}
+	release := make(chan struct{})
+	teardownRan := false
+	cntr := 0
+	parent := Iter[int](func(f IteratorFeedback) (int, error, bool) {
+		if f == Break {
+			return 0, nil, false
+		}
+		cntr++
+		if cntr == 3 {
+			// The first two values are in the batch, simulate a stalled
+			// parent iterator that takes longer than the max wait time.
+			clock.c <- time.Time{}
+			<-release
+		}
+		return cntr, nil, cntr <= 5
+	}).Teardown(func() error {
+		teardownRan = true
+		return nil
+	})
+	b := Batch(parent, 10, time.Second, clock, false)
+	v, err, cont := b.PullOne()
+	test.Nil(err, t)
+	test.True(cont, t)
+	test.SlicesMatch[int]([]int{1, 2}, v, t)
+	close(release)
+	v, err, cont = b.PullOne()
+	test.Nil(err, t)
+	test.True(cont, t)
+	test.SlicesMatch[int]([]int{3, 4, 5}, v, t)
+	_, err, cont = b.PullOne()
+	test.Nil(err, t)
+	test.False(cont, t)
+	test.Nil(b.Stop(), t)
+	test.True(teardownRan, t)
+	test.SlicesMatch[time.Duration](
+		[]time.Duration{time.Second, time.Second}, clock.durations, t,
+	)
+}
+
+func TestBatchSystemClock(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		c <- 0
+		c <- 1
+		// Stall long enough for the batch to be returned before the rest of
+		// the values are sent.
+		time.Sleep(100 * time.Millisecond)
+		for i := 2; i < 5; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	res, err := Batch(ChanElems(c), 100, time.Millisecond, nil, false).Collect()
+	test.Nil(err, t)
+	test.True(len(res) >= 2, t)
+	vals := []int{}
+	for _, v := range res {
+		vals = append(vals, v...)
+	}
+	test.SlicesMatch[int]([]int{0, 1, 2, 3, 4}, vals, t)
+}
+
+func TestBatchBreakMidStream(t *testing.T) {
+	start := runtime.NumGoroutine()
+	teardownRan := false
+	res, err := Batch(Range(0, 1000000, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}), 4, 0, nil, true).Take(3).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+
+	// Stopping before the first value is requested never starts the go routine.
+	teardownRan = false
+	test.Nil(Batch(Range(0, 10, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}), 4, 0, nil, false).Stop(), t)
+	test.False(teardownRan, t)
+}
+
+func TestBatchParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	res, err := Batch(Range(0, 10, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if val == 5 {
+				return Break, 0, expectedError
+			}
+			return Continue, val, nil
+		},
+	), 3, 0, nil, false).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.Eq(2, len(res), t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res[0], t)
+	test.SlicesMatch[int]([]int{3, 4}, res[1], t)
+}
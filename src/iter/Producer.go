@@ -2,6 +2,7 @@ package iter
 
 import (
 	"bufio"
+	"context"
 	"os"
 
 	"github.com/barbell-math/util/src/container/basic"
@@ -250,6 +251,29 @@ func ChanElems[T any](c <-chan T) Iter[T] {
 	}
 }
 
+// This function is a producer.
+//
+// ChanElemsCtx returns an iterator that iterates over the elements in a
+// channel until either the channel is closed or the supplied context is done.
+// Calling this function will block until the channel receives a value or the
+// context is done, whichever happens first. If the context is done the
+// contexts error will be returned and iteration will stop. Like [ChanElems]
+// this funciton does not close the channel once it is done iterating.
+func ChanElemsCtx[T any](ctx context.Context, c <-chan T) Iter[T] {
+	return func(f IteratorFeedback) (T, error, bool) {
+		var rv T
+		if f == Break {
+			return rv, nil, false
+		}
+		select {
+		case next, ok := <-c:
+			return next, nil, ok
+		case <-ctx.Done():
+			return rv, ctx.Err(), false
+		}
+	}
+}
+
 // This function is a producer.
 //
 // FileLines returns an iterator that iterates over the lines in a file. If an
@@ -1,8 +1,10 @@
 package iter
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/barbell-math/util/src/test"
 )
@@ -262,3 +264,45 @@ func TestRecurse(t *testing.T) {
 	}
 	test.Nil(err, t)
 }
+
+func TestChanElemsCtx(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		for i := 0; i < 5; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	res, err := ChanElemsCtx(context.Background(), c).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2, 3, 4}, res, t)
+}
+
+func TestChanElemsCtxCancelMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := make(chan int)
+	go func() {
+		// The channel is never closed, only the context can stop iteration.
+		for i := 0; i < 3; i++ {
+			c <- i
+		}
+		cancel()
+	}()
+	teardownRan := false
+	res, err := ChanElemsCtx(ctx, c).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).Collect()
+	test.ContainsError(context.Canceled, err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+	test.True(teardownRan, t)
+}
+
+func TestChanElemsCtxDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	cnt, err := ChanElemsCtx(ctx, make(chan int)).Count()
+	test.ContainsError(context.DeadlineExceeded, err, t)
+	test.Eq(0, cnt, t)
+}
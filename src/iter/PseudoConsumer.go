@@ -1,10 +1,25 @@
 package iter
 
 import (
+	"context"
 	"fmt"
 	"io"
 )
 
+// This function is a consumer.
+//
+// ForEachCtx is equivalent to [Iter.ForEach], the only difference is that
+// iteration will stop once the supplied context is done. When this happens the
+// contexts error will be returned and the iterator chain will be cleaned up
+// the same way as if iteration had stopped for any other reason. See
+// [Iter.WithContext] for more details.
+func (i Iter[T]) ForEachCtx(
+	ctx context.Context,
+	op func(index int, val T) (IteratorFeedback, error),
+) error {
+	return i.WithContext(ctx).ForEach(op)
+}
+
 // This function is a Consumer.
 //
 // Consume will consume its parent iterators entirely, only stopping early if it
@@ -1,5 +1,9 @@
 package iter
 
+import (
+	"context"
+)
+
 // This funciton is a consumer.
 //
 // This function will filter each value from it's parent iterator in parallel.
@@ -19,3 +23,25 @@ func (i Iter[T]) FilterParallel(op func(val T) bool, numThreads int) ([]T, error
 	}, numThreads)
 	return rv, err
 }
+
+// This funciton is a consumer.
+//
+// This function is equivalent to [Iter.FilterParallel], the only difference is
+// that filtering will stop once the supplied context is done. When this
+// happens the contexts error will be returned along with the values that were
+// kept before the context was done. See [ParallelCtx] for more details.
+func (i Iter[T]) FilterParallelCtx(
+	ctx context.Context,
+	op func(val T) bool,
+	numThreads int,
+) ([]T, error) {
+	rv := make([]T, 0)
+	err := ParallelCtx(ctx, i, func(val T) (bool, error) {
+		return op(val), nil
+	}, func(val T, res bool, err error) {
+		if res {
+			rv = append(rv, val)
+		}
+	}, numThreads)
+	return rv, err
+}
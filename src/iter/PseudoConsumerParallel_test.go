@@ -1,9 +1,12 @@
 package iter
 
 import (
+	"context"
+	"runtime"
+	"testing"
+
 	"github.com/barbell-math/util/src/customerr"
 	"github.com/barbell-math/util/src/test"
-	"testing"
 )
 
 func filterParallelHelper(vals []int, numThreads int, t *testing.T) {
@@ -30,3 +33,42 @@ func TestFilterParallel(t *testing.T) {
 		filterParallelHelper(vals, i, t)
 	}
 }
+
+func TestFilterParallelCtx(t *testing.T) {
+	_, err := SliceElems([]int{1, 2, 3, 4}).FilterParallelCtx(
+		context.Background(),
+		func(val int) bool { return false },
+		0,
+	)
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	rv, err := Range(0, 200, 1).FilterParallelCtx(
+		context.Background(),
+		func(val int) bool { return val%2 == 0 },
+		25,
+	)
+	test.Nil(err, t)
+	test.Eq(100, len(rv), t)
+	for _, v := range rv {
+		test.Eq(0, v%2, t)
+	}
+}
+
+func TestFilterParallelCtxCancelMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := runtime.NumGoroutine()
+	teardownRan := false
+	rv, err := Range(0, 1000000, 1).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).Map(func(index int, val int) (int, error) {
+		if val == 100 {
+			cancel()
+		}
+		return val, nil
+	}).FilterParallelCtx(ctx, func(val int) bool { return true }, 4)
+	test.ContainsError(context.Canceled, err, t)
+	test.True(len(rv) <= 100, t)
+	test.True(teardownRan, t)
+	noLeakedGoroutines(start, t)
+}
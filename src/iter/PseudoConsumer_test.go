@@ -2,6 +2,7 @@ package iter
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -280,3 +281,34 @@ func TestReduce(t *testing.T) {
 	test.Eq(1, i, t)
 	test.Eq(newErr, err, t)
 }
+
+func TestForEachCtx(t *testing.T) {
+	res := []int{}
+	err := SliceElems([]int{0, 1, 2}).ForEachCtx(
+		context.Background(),
+		func(index int, val int) (IteratorFeedback, error) {
+			res = append(res, val)
+			return Continue, nil
+		},
+	)
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res = []int{}
+	teardownRan := false
+	err = SliceElems([]int{0, 1, 2, 3, 4}).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}).ForEachCtx(ctx, func(index int, val int) (IteratorFeedback, error) {
+		res = append(res, val)
+		if index == 1 {
+			cancel()
+		}
+		return Continue, nil
+	})
+	test.ContainsError(context.Canceled, err, t)
+	test.SlicesMatch[int]([]int{0, 1}, res, t)
+	test.True(teardownRan, t)
+}
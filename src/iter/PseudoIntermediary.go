@@ -1,5 +1,11 @@
 package iter
 
+import (
+	"slices"
+
+	"github.com/barbell-math/util/src/container/basic"
+)
+
 // This function is an intermediary.
 //
 // Take will consume the first num elements of it's parent iterator. It will
@@ -158,3 +164,100 @@ func (i Iter[T]) Setup(setup func() error) Iter[T] {
 func (i Iter[T]) Teardown(teardown func() error) Iter[T] {
 	return i.SetupTeardown(func() error { return nil }, teardown)
 }
+
+// This function is an intermediary.
+//
+// Window will group the values from it's parent iterator into windows of
+// length size. Each window starts step values after the start of the previous
+// window, as shown in the example below where size is 4 and step is 2. Both
+// size and step must be >=1.
+//
+//   - Iteration 1: 1,2,3,4
+//   - Iteration 2: 3,4,5,6
+//   - Iteration 3: 5,6,7,8
+//
+// If step is less than size the windows will overlap, if step is equal to size
+// the windows will be adjacent, and if step is greater than size the values
+// between the windows will be skipped. Only full windows are returned, meaning
+// any leftover values that do not make a full window at the end of iteration
+// will not be returned. If reuseBuf is true then the same underlying slice will
+// be returned for every window, meaning a window is only valid until the next
+// value is requested. If reuseBuf is false then each window is a newly
+// allocated slice that may be kept. An error will stop iteration.
+func Window[T any](
+	i Iter[T],
+	size int,
+	step int,
+	reuseBuf bool,
+) Iter[[]T] {
+	var buf []T
+	skip := 0
+	return Next(
+		i,
+		func(index int, val T, status IteratorFeedback) (IteratorFeedback, []T, error) {
+			if status == Break {
+				return Break, nil, nil
+			}
+			if err := greaterThanZeroCheck("window size", size); err != nil {
+				return Break, nil, err
+			}
+			if err := greaterThanZeroCheck("window step", step); err != nil {
+				return Break, nil, err
+			}
+			if buf == nil {
+				buf = make([]T, 0, size)
+			} else if len(buf) == size {
+				// The previous window was returned, slide it forward before
+				// adding the new value.
+				if step >= size {
+					skip = step - size
+					buf = buf[:0]
+				} else {
+					buf = buf[:copy(buf, buf[step:])]
+				}
+			}
+			if skip > 0 {
+				skip--
+				return Iterate, nil, nil
+			}
+			buf = append(buf, val)
+			if len(buf) < size {
+				return Iterate, nil, nil
+			}
+			if reuseBuf {
+				return Continue, buf, nil
+			}
+			return Continue, slices.Clone(buf), nil
+		},
+	)
+}
+
+// This function is an intermediary.
+//
+// Pairwise will return each value from it's parent iterator paired with the
+// value that came before it, as shown in the example below. If the parent
+// iterator produces less than two values then no pairs will be returned. An
+// error will stop iteration.
+//
+//   - Iteration 1: (1,2)
+//   - Iteration 2: (2,3)
+//   - Iteration 3: (3,4)
+func Pairwise[T any](i Iter[T]) Iter[basic.Pair[T, T]] {
+	var prev T
+	havePrev := false
+	return Next(
+		i,
+		func(index int, val T, status IteratorFeedback) (IteratorFeedback, basic.Pair[T, T], error) {
+			if status == Break {
+				return Break, basic.Pair[T, T]{}, nil
+			}
+			if !havePrev {
+				prev, havePrev = val, true
+				return Iterate, basic.Pair[T, T]{}, nil
+			}
+			rv := basic.Pair[T, T]{A: prev, B: val}
+			prev = val
+			return Continue, rv, nil
+		},
+	)
+}
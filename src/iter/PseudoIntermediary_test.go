@@ -1,9 +1,13 @@
 package iter
 
 import (
+	"errors"
 	"fmt"
-	"github.com/barbell-math/util/src/test"
 	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/customerr"
+	"github.com/barbell-math/util/src/test"
 )
 
 func TestTake(t *testing.T) {
@@ -145,3 +149,95 @@ func TestFilter(t *testing.T) {
 	test.Eq(0, cntr, t)
 	test.Nil(err, t)
 }
+
+func windowHelper(numVals int, size int, step int, t *testing.T) {
+	for _, reuseBuf := range []bool{true, false} {
+		exp := [][]int{}
+		for start := 0; start+size <= numVals; start += step {
+			w := []int{}
+			for i := start; i < start+size; i++ {
+				w = append(w, i)
+			}
+			exp = append(exp, w)
+		}
+		cntr := 0
+		err := Window(Range(0, numVals, 1), size, step, reuseBuf).ForEach(
+			func(index int, val []int) (IteratorFeedback, error) {
+				test.SlicesMatch[int](exp[index], val, t)
+				cntr++
+				return Continue, nil
+			},
+		)
+		test.Nil(err, t)
+		test.Eq(len(exp), cntr, t)
+	}
+}
+func TestWindow(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 5} {
+		for _, step := range []int{1, 2, 3, 7} {
+			windowHelper(0, size, step, t)
+			windowHelper(1, size, step, t)
+			windowHelper(4, size, step, t)
+			windowHelper(20, size, step, t)
+		}
+	}
+	_, err := Window(Range(0, 10, 1), 0, 1, false).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+	_, err = Window(Range(0, 10, 1), 1, 0, false).Collect()
+	test.ContainsError(customerr.ValOutsideRange, err, t)
+}
+
+func TestWindowReuseBuf(t *testing.T) {
+	res, err := Window(Range(0, 5, 1), 3, 1, false).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	for i, v := range res {
+		test.SlicesMatch[int]([]int{i, i + 1, i + 2}, v, t)
+	}
+	// With a reused buffer every window shares the same backing array.
+	res, err = Window(Range(0, 5, 1), 3, 1, true).Collect()
+	test.Nil(err, t)
+	test.Eq(3, len(res), t)
+	for _, v := range res {
+		test.SlicesMatch[int]([]int{2, 3, 4}, v, t)
+	}
+}
+
+func TestWindowParentError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	teardownRan := false
+	res, err := Window(Range(0, 10, 1).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if val == 5 {
+				return Break, 0, expectedError
+			}
+			return Continue, val, nil
+		},
+	).Teardown(func() error {
+		teardownRan = true
+		return nil
+	}), 2, 2, false).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.Eq(2, len(res), t)
+	test.True(teardownRan, t)
+}
+
+func TestPairwise(t *testing.T) {
+	res, err := Pairwise(NoElem[int]()).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = Pairwise(SliceElems([]int{1})).Collect()
+	test.Nil(err, t)
+	test.Eq(0, len(res), t)
+	res, err = Pairwise(SliceElems([]int{1, 2, 3, 4})).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[int, int]](
+		[]basic.Pair[int, int]{{A: 1, B: 2}, {A: 2, B: 3}, {A: 3, B: 4}},
+		res, t,
+	)
+	res, err = Pairwise(SliceElems([]int{1, 2, 3, 4})).Take(1).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[basic.Pair[int, int]](
+		[]basic.Pair[int, int]{{A: 1, B: 2}}, res, t,
+	)
+}
@@ -0,0 +1,156 @@
+package iter
+
+import (
+	stditer "iter"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/customerr"
+)
+
+// This function is a consumer.
+//
+// Seq converts the iterator chain into a [stditer.Seq] so that it can be used
+// in a for-range loop or passed to the standard library functions that accept
+// sequences, such as slices.Collect. The iterator chain is consumed lazily as
+// the sequence is ranged over. Once the loop ends, either because the iterator
+// chain ran out of values or because the loop body exited early, the iterator
+// chain is cleaned up the same way [Iter.Stop] cleans it up. Like the iterator
+// chain itself the returned sequence can only be ranged over once.
+//
+// A sequence has no way to report errors, so any error that is generated by
+// the iterator chain, including errors generated while cleaning up, will
+// silently end the sequence. Use [Iter.Seq2] if errors need to be observed.
+func (i Iter[T]) Seq() stditer.Seq[T] {
+	return func(yield func(T) bool) {
+		i.Seq2()(func(v T, err error) bool {
+			return err == nil && yield(v)
+		})
+	}
+}
+
+// This function is a consumer.
+//
+// Seq2 converts the iterator chain into a [stditer.Seq2] so that it can be used
+// in a for-range loop or passed to the standard library functions that accept
+// sequences. Every value is paired with a nil error. If the iterator chain
+// generates an error, or an error is generated while cleaning up the iterator
+// chain once it runs out of values, a single zero value is yielded along with
+// the error and then the sequence ends. Once the loop ends, either because the
+// iterator chain ran out of values or because the loop body exited early, the
+// iterator chain is cleaned up the same way [Iter.Stop] cleans it up. If the
+// loop body exits early there is nothing left to yield a clean up error to, so
+// any such error is discarded. Like the iterator chain itself the returned
+// sequence can only be ranged over once.
+func (i Iter[T]) Seq2() stditer.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		// The iterator chain is still cleaned up if the loop body panics.
+		defer func() {
+			if !stopped {
+				i.Stop()
+			}
+		}()
+		for {
+			v, err, cont := i(Iterate)
+			if err != nil || !cont {
+				stopped = true
+				if err = customerr.AppendError(err, i.Stop()); err != nil {
+					var tmp T
+					yield(tmp, err)
+				}
+				return
+			}
+			if !yield(v, nil) {
+				stopped = true
+				i.Stop()
+				return
+			}
+		}
+	}
+}
+
+// This function is a consumer.
+//
+// PairSeq converts an iterator chain of pairs into a [stditer.Seq2] that yields
+// the A and B values of each pair, such as the key value pairs of a map. Like
+// [Iter.Seq] any error that is generated by the iterator chain will silently
+// end the sequence and the iterator chain is cleaned up once the loop ends,
+// even if the loop body exited early.
+func PairSeq[A any, B any](i Iter[basic.Pair[A, B]]) stditer.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		i.Seq()(func(p basic.Pair[A, B]) bool { return yield(p.A, p.B) })
+	}
+}
+
+// This function is a producer.
+//
+// FromPull creates an iterator from a pull style next function and its
+// associated stop function, such as the pair that is returned by
+// [stditer.Pull]. The iterator will produce values until next returns false.
+// Stop will be called when the iterator chain is cleaned up and must be safe to
+// call more than once.
+func FromPull[T any](next func() (T, bool), stop func()) Iter[T] {
+	return func(f IteratorFeedback) (T, error, bool) {
+		if f == Break {
+			stop()
+			var tmp T
+			return tmp, nil, false
+		}
+		v, ok := next()
+		return v, nil, ok
+	}
+}
+
+// This function is a producer.
+//
+// FromSeq creates an iterator that produces the values from the supplied
+// [stditer.Seq]. The sequence is not started until the first value is
+// requested. When the iterator chain is cleaned up the sequence is stopped, so
+// any deferred calls inside the sequence will be run even if the sequence was
+// not fully consumed.
+func FromSeq[T any](s stditer.Seq[T]) Iter[T] {
+	var rv Iter[T]
+	return func(f IteratorFeedback) (T, error, bool) {
+		if rv == nil {
+			if f == Break {
+				var tmp T
+				return tmp, nil, false
+			}
+			rv = FromPull(stditer.Pull(s))
+		}
+		return rv(f)
+	}
+}
+
+// This function is a producer.
+//
+// FromSeq2 creates an iterator that produces the values from the supplied
+// [stditer.Seq2]. If the sequence yields a non-nil error then the iterator will
+// return that error and stop producing values. The sequence is not started
+// until the first value is requested. When the iterator chain is cleaned up the
+// sequence is stopped, so any deferred calls inside the sequence will be run
+// even if the sequence was not fully consumed.
+func FromSeq2[T any](s stditer.Seq2[T, error]) Iter[T] {
+	var next func() (T, error, bool)
+	var stop func()
+	return func(f IteratorFeedback) (T, error, bool) {
+		var tmp T
+		if next == nil {
+			if f == Break {
+				return tmp, nil, false
+			}
+			next, stop = stditer.Pull2(s)
+		}
+		if f == Break {
+			stop()
+			return tmp, nil, false
+		}
+		v, err, ok := next()
+		if !ok {
+			return tmp, nil, false
+		} else if err != nil {
+			return tmp, err, false
+		}
+		return v, nil, true
+	}
+}
@@ -0,0 +1,213 @@
+package iter
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/barbell-math/util/src/container/basic"
+	"github.com/barbell-math/util/src/test"
+)
+
+func TestSeq(t *testing.T) {
+	res := slices.Collect(SliceElems([]int{0, 1, 2, 3}).Seq())
+	test.SlicesMatch[int]([]int{0, 1, 2, 3}, res, t)
+	res = slices.Collect(NoElem[int]().Seq())
+	test.Eq(0, len(res), t)
+	res = []int{}
+	for v := range SliceElems([]int{0, 1, 2, 3}).Seq() {
+		res = append(res, v)
+	}
+	test.SlicesMatch[int]([]int{0, 1, 2, 3}, res, t)
+}
+
+func TestSeqWithError(t *testing.T) {
+	res := slices.Collect(ValElem(1, errors.New("ERROR"), 3).Seq())
+	test.Eq(0, len(res), t)
+}
+
+func TestSeqBreakCleansUp(t *testing.T) {
+	setupRan := false
+	teardownRan := false
+	setup := func() error { setupRan = true; return nil }
+	teardown := func() error { teardownRan = true; return nil }
+	cntr := 0
+	for v := range SliceElems([]int{0, 1, 2, 3}).SetupTeardown(setup, teardown).Seq() {
+		test.Eq(cntr, v, t)
+		cntr++
+		if cntr == 2 {
+			break
+		}
+	}
+	test.Eq(2, cntr, t)
+	test.True(setupRan, t)
+	test.True(teardownRan, t)
+}
+
+func TestSeqPanicCleansUp(t *testing.T) {
+	teardownRan := false
+	teardown := func() error { teardownRan = true; return nil }
+	test.Panics(func() {
+		for range SliceElems([]int{0, 1}).SetupTeardown(
+			func() error { return nil }, teardown,
+		).Seq() {
+			panic("PANIC")
+		}
+	}, t)
+	test.True(teardownRan, t)
+}
+
+func TestSeqWithMapVals(t *testing.T) {
+	// MapVals is backed by a goroutine which must be shut down by the break
+	// action once the loop exits early.
+	for range MapVals(map[int]int{0: 0, 1: 1, 2: 2}).Seq() {
+		break
+	}
+	res := slices.Collect(MapVals(map[int]int{0: 0, 1: 1, 2: 2}).Seq())
+	test.SlicesMatchUnordered[int]([]int{0, 1, 2}, res, t)
+}
+
+func TestSeq2(t *testing.T) {
+	res := []int{}
+	for v, err := range SliceElems([]int{0, 1, 2}).Seq2() {
+		test.Nil(err, t)
+		res = append(res, v)
+	}
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+}
+
+func TestSeq2WithError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	cntr := 0
+	for v, err := range SliceElems([]int{0, 1, 2, 3}).Next(
+		func(index int, val int, status IteratorFeedback) (IteratorFeedback, int, error) {
+			if val == 2 {
+				return Break, val, expectedError
+			}
+			return Continue, val, nil
+		},
+	).Seq2() {
+		if cntr < 2 {
+			test.Nil(err, t)
+			test.Eq(cntr, v, t)
+		} else {
+			test.ContainsError(expectedError, err, t)
+		}
+		cntr++
+	}
+	test.Eq(3, cntr, t)
+}
+
+func TestSeq2WithTeardownError(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	teardown := func() error { return expectedError }
+	errs := []error{}
+	for _, err := range SliceElems([]int{0, 1}).SetupTeardown(
+		func() error { return nil }, teardown,
+	).Seq2() {
+		errs = append(errs, err)
+	}
+	test.Eq(3, len(errs), t)
+	test.Nil(errs[0], t)
+	test.Nil(errs[1], t)
+	test.ContainsError(expectedError, errs[2], t)
+}
+
+func TestPairSeq(t *testing.T) {
+	res := maps.Collect(PairSeq(SliceElems([]basic.Pair[int, string]{
+		{A: 0, B: "a"}, {A: 1, B: "b"}, {A: 2, B: "c"},
+	})))
+	test.MapsMatch[int, string](map[int]string{0: "a", 1: "b", 2: "c"}, res, t)
+	res = maps.Collect(PairSeq(NoElem[basic.Pair[int, string]]()))
+	test.Eq(0, len(res), t)
+}
+
+func TestPairSeqBreakCleansUp(t *testing.T) {
+	teardownRan := false
+	teardown := func() error { teardownRan = true; return nil }
+	cntr := 0
+	for k, v := range PairSeq(SliceElems([]basic.Pair[int, int]{
+		{A: 0, B: 0}, {A: 1, B: 2}, {A: 2, B: 4},
+	}).SetupTeardown(func() error { return nil }, teardown)) {
+		test.Eq(cntr, k, t)
+		test.Eq(cntr*2, v, t)
+		cntr++
+		if cntr == 2 {
+			break
+		}
+	}
+	test.Eq(2, cntr, t)
+	test.True(teardownRan, t)
+}
+
+func TestFromPull(t *testing.T) {
+	stopped := false
+	vals := []int{0, 1, 2}
+	i := 0
+	res, err := FromPull(
+		func() (int, bool) {
+			if i >= len(vals) {
+				return 0, false
+			}
+			i++
+			return vals[i-1], true
+		},
+		func() { stopped = true },
+	).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int](vals, res, t)
+	test.True(stopped, t)
+}
+
+func TestFromSeq(t *testing.T) {
+	res, err := FromSeq(slices.Values([]int{0, 1, 2, 3})).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2, 3}, res, t)
+	res, err = FromSeq(maps.Keys(map[int]int{0: 0, 1: 1})).Collect()
+	test.Nil(err, t)
+	test.SlicesMatchUnordered[int]([]int{0, 1}, res, t)
+	err = FromSeq(slices.Values([]int{})).Consume()
+	test.Nil(err, t)
+}
+
+func TestFromSeqStopsSequence(t *testing.T) {
+	deferRan := false
+	seq := func(yield func(int) bool) {
+		defer func() { deferRan = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	res, err := FromSeq(seq).Take(3).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+	test.True(deferRan, t)
+
+	deferRan = false
+	test.Nil(FromSeq(seq).Stop(), t)
+	test.False(deferRan, t)
+}
+
+func TestFromSeq2(t *testing.T) {
+	expectedError := errors.New("ERROR")
+	seq := func(yield func(int, error) bool) {
+		for i := 0; i < 4; i++ {
+			var err error
+			if i == 2 {
+				err = expectedError
+			}
+			if !yield(i, err) {
+				return
+			}
+		}
+	}
+	res, err := FromSeq2(seq).Collect()
+	test.ContainsError(expectedError, err, t)
+	test.SlicesMatch[int]([]int{0, 1}, res, t)
+	res, err = FromSeq2(SliceElems([]int{0, 1, 2}).Seq2()).Collect()
+	test.Nil(err, t)
+	test.SlicesMatch[int]([]int{0, 1, 2}, res, t)
+}
@@ -0,0 +1,36 @@
+package widgets
+
+import (
+	"time"
+
+	"github.com/barbell-math/util/src/hash"
+)
+
+type (
+	// A widget to represent the [time.Time] type. Times are compared as
+	// instants, so two times that represent the same instant in different
+	// locations are considered equal and will have the same hash.
+	Time struct{}
+)
+
+// Returns true if both times represent the same instant. Uses the
+// [time.Time.Equal] method internally.
+func (_ Time) Eq(l *time.Time, r *time.Time) bool {
+	return l.Equal(*r)
+}
+
+// Provides a hash function for the time that it is wrapping.
+func (_ Time) Hash(v *time.Time) hash.Hash {
+	return hash.Hash(v.Unix()).Combine(hash.Hash(v.Nanosecond()))
+}
+
+// Zeros the supplied value.
+func (_ Time) Zero(other *time.Time) {
+	*other = time.Time{}
+}
+
+// Returns true if l is before r. Uses the [time.Time.Before] method
+// internally.
+func (_ Time) Lt(l *time.Time, r *time.Time) bool {
+	return l.Before(*r)
+}
@@ -0,0 +1,23 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/barbell-math/util/src/test"
+)
+
+func TestTimeWidget(t *testing.T) {
+	w := PartialOrder[time.Time, Time]{}
+	utc := time.Date(2024, 3, 1, 12, 0, 0, 5, time.UTC)
+	est := utc.In(time.FixedZone("EST", -5*60*60))
+	later := utc.Add(time.Nanosecond)
+	test.True(w.Eq(&utc, &est), t)
+	test.Eq(w.Hash(&utc), w.Hash(&est), t)
+	test.False(w.Eq(&utc, &later), t)
+	test.True(w.Lt(&utc, &later), t)
+	test.False(w.Lt(&later, &utc), t)
+	test.False(w.Lt(&utc, &est), t)
+	w.Zero(&later)
+	test.True(later.IsZero(), t)
+}